@@ -45,6 +45,9 @@ type artifactMetrics struct {
 	updateDataFailureCounter labeled.Counter
 	deleteDataSuccessCounter labeled.Counter
 	deleteDataFailureCounter labeled.Counter
+	deleteResponseTime       labeled.StopWatch
+	deleteSuccessCounter     labeled.Counter
+	deleteFailureCounter     labeled.Counter
 }
 
 type artifactManager struct {
@@ -177,6 +180,20 @@ type artifactQueryHandle interface {
 }
 
 func (m *artifactManager) findArtifact(ctx context.Context, datasetID *datacatalog.DatasetID, queryHandle artifactQueryHandle) (models.Artifact, error) {
+	artifactModel, err := m.getArtifactModel(ctx, datasetID, queryHandle)
+	if err != nil {
+		return models.Artifact{}, err
+	}
+
+	if len(artifactModel.ArtifactData) == 0 {
+		return models.Artifact{}, errors.NewDataCatalogErrorf(codes.Internal, "artifact [%+v] with key %v does not have artifact data associated", artifactModel, artifactModel.ArtifactID)
+	}
+
+	return artifactModel, nil
+}
+
+// getArtifactModel retrieves the artifact by its ID or tag without requiring it to have any artifact data
+func (m *artifactManager) getArtifactModel(ctx context.Context, datasetID *datacatalog.DatasetID, queryHandle artifactQueryHandle) (models.Artifact, error) {
 	var artifactModel models.Artifact
 
 	key := queryHandle.GetArtifactId()
@@ -215,10 +232,6 @@ func (m *artifactManager) findArtifact(ctx context.Context, datasetID *datacatal
 		artifactModel = tag.Artifact
 	}
 
-	if len(artifactModel.ArtifactData) == 0 {
-		return models.Artifact{}, errors.NewDataCatalogErrorf(codes.Internal, "artifact [%+v] with key %v does not have artifact data associated", artifactModel, key)
-	}
-
 	return artifactModel, nil
 }
 
@@ -416,6 +429,162 @@ func (m *artifactManager) UpdateArtifact(ctx context.Context, request *datacatal
 	}, nil
 }
 
+// DeleteArtifact deletes the given artifact along with its tags and partitions. The associated ArtifactData is removed
+// from the underlying blob storage after the database records were deleted.
+func (m *artifactManager) DeleteArtifact(ctx context.Context, request *datacatalog.DeleteArtifactRequest) (*datacatalog.DeleteArtifactResponse, error) {
+	ctx = contextutils.WithProjectDomain(ctx, request.GetDataset().GetProject(), request.GetDataset().GetDomain())
+
+	timer := m.systemMetrics.deleteResponseTime.Start(ctx)
+	defer timer.Stop()
+
+	err := validators.ValidateDeleteArtifactRequest(request)
+	if err != nil {
+		logger.Warningf(ctx, "Invalid delete artifact request %v, err: %v", request, err)
+		m.systemMetrics.validationErrorCounter.Inc(ctx)
+		m.systemMetrics.deleteFailureCounter.Inc(ctx)
+		return nil, err
+	}
+
+	artifactModel, err := m.getArtifactModel(ctx, request.GetDataset(), request)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to get artifact for delete artifact request %v, err: %v", request, err)
+		m.systemMetrics.deleteFailureCounter.Inc(ctx)
+		return nil, err
+	}
+
+	if err := m.deleteArtifact(ctx, artifactModel); err != nil {
+		m.systemMetrics.deleteFailureCounter.Inc(ctx)
+		return nil, err
+	}
+
+	logger.Debugf(ctx, "Successfully deleted artifact id: %v", artifactModel.ArtifactID)
+
+	m.systemMetrics.deleteSuccessCounter.Inc(ctx)
+	return &datacatalog.DeleteArtifactResponse{
+		ArtifactId: artifactModel.ArtifactID,
+	}, nil
+}
+
+// DeleteArtifacts deletes all artifacts of the given dataset matching the tag and partition filters of the request.
+// Artifacts are deleted one by one, the artifacts failing to be deleted are reported in the response and the remaining
+// artifacts are still deleted.
+func (m *artifactManager) DeleteArtifacts(ctx context.Context, request *datacatalog.DeleteArtifactsRequest) (*datacatalog.DeleteArtifactsResponse, error) {
+	ctx = contextutils.WithProjectDomain(ctx, request.GetDataset().GetProject(), request.GetDataset().GetDomain())
+
+	timer := m.systemMetrics.deleteResponseTime.Start(ctx)
+	defer timer.Stop()
+
+	err := validators.ValidateDeleteArtifactsRequest(request)
+	if err != nil {
+		logger.Warningf(ctx, "Invalid delete artifacts request %v, err: %v", request, err)
+		m.systemMetrics.validationErrorCounter.Inc(ctx)
+		m.systemMetrics.deleteFailureCounter.Inc(ctx)
+		return nil, err
+	}
+
+	datasetKey := transformers.FromDatasetID(request.GetDataset())
+	dataset, err := m.repo.DatasetRepo().Get(ctx, datasetKey)
+	if err != nil {
+		logger.Warnf(ctx, "Failed to get dataset for deleting artifacts %v, err: %v", datasetKey, err)
+		m.systemMetrics.deleteFailureCounter.Inc(ctx)
+		return nil, err
+	}
+
+	listInput, err := transformers.FilterToListInput(ctx, common.Artifact, request.GetFilter())
+	if err != nil {
+		logger.Warningf(ctx, "Invalid delete artifacts request %v, err: %v", request, err)
+		m.systemMetrics.validationErrorCounter.Inc(ctx)
+		m.systemMetrics.deleteFailureCounter.Inc(ctx)
+		return nil, err
+	}
+
+	// collect all matching artifacts first, deleting while paginating would skip artifacts
+	artifactModels, err := listAllArtifacts(ctx, m.repo, dataset.DatasetKey, listInput)
+	if err != nil {
+		logger.Errorf(ctx, "Unable to list artifacts to delete err: %v", err)
+		m.systemMetrics.deleteFailureCounter.Inc(ctx)
+		return nil, err
+	}
+
+	artifactIDs := make([]string, 0, len(artifactModels))
+	deleteErrors := make([]*datacatalog.DeleteArtifactError, 0)
+	for _, artifactModel := range artifactModels {
+		if err := m.deleteArtifact(ctx, artifactModel); err != nil {
+			m.systemMetrics.deleteFailureCounter.Inc(ctx)
+			deleteErrors = append(deleteErrors, &datacatalog.DeleteArtifactError{
+				ArtifactId: artifactModel.ArtifactID,
+				Message:    err.Error(),
+			})
+			continue
+		}
+		artifactIDs = append(artifactIDs, artifactModel.ArtifactID)
+	}
+
+	logger.Debugf(ctx, "Deleted %v matching artifacts, %v failed to be deleted", len(artifactIDs), len(deleteErrors))
+
+	if len(deleteErrors) == 0 {
+		m.systemMetrics.deleteSuccessCounter.Inc(ctx)
+	}
+	return &datacatalog.DeleteArtifactsResponse{
+		ArtifactIds: artifactIDs,
+		Errors:      deleteErrors,
+	}, nil
+}
+
+func (m *artifactManager) deleteArtifact(ctx context.Context, artifactModel models.Artifact) error {
+	err := m.repo.ArtifactRepo().Delete(ctx, artifactModel.ArtifactKey)
+	if err != nil {
+		if errors.IsDoesNotExistError(err) {
+			logger.Warnf(ctx, "Artifact does not exist key: %+v, err %v", artifactModel.ArtifactKey, err)
+			m.systemMetrics.doesNotExistCounter.Inc(ctx)
+		} else {
+			logger.Errorf(ctx, "Failed to delete artifact %v, err: %v", artifactModel.ArtifactKey, err)
+		}
+		return err
+	}
+
+	// blob storage data is removed last in case the DB delete fails, same as when updating an artifact
+	for _, artifactData := range artifactModel.ArtifactData {
+		if err := m.artifactStore.DeleteData(ctx, artifactData); err != nil {
+			logger.Errorf(ctx, "Failed to delete artifact data of deleted artifact %v, err: %v", artifactModel.ArtifactKey, err)
+			m.systemMetrics.deleteDataFailureCounter.Inc(ctx)
+			return err
+		}
+
+		m.systemMetrics.deleteDataSuccessCounter.Inc(ctx)
+	}
+
+	return nil
+}
+
+// listAllArtifacts pages through all artifacts of the dataset matching the list input filters
+func listAllArtifacts(ctx context.Context, repo repositories.RepositoryInterface, datasetKey models.DatasetKey, listInput models.ListModelsInput) ([]models.Artifact, error) {
+	artifactModels := make([]models.Artifact, 0)
+	for offset := 0; ; {
+		pageInput := models.ListModelsInput{ModelFilters: append([]models.ModelFilter{}, listInput.ModelFilters...)}
+		err := transformers.ApplyPagination(&datacatalog.PaginationOptions{
+			Token:     strconv.Itoa(offset),
+			Limit:     common.MaxPageLimit,
+			SortKey:   datacatalog.PaginationOptions_CREATION_TIME,
+			SortOrder: datacatalog.PaginationOptions_ASCENDING,
+		}, &pageInput)
+		if err != nil {
+			return nil, err
+		}
+
+		page, err := repo.ArtifactRepo().List(ctx, datasetKey, pageInput)
+		if err != nil {
+			return nil, err
+		}
+
+		artifactModels = append(artifactModels, page...)
+		offset += len(page)
+		if len(page) < common.MaxPageLimit {
+			return artifactModels, nil
+		}
+	}
+}
+
 func NewArtifactManager(repo repositories.RepositoryInterface, store *storage.DataStore, storagePrefix storage.DataReference, artifactScope promutils.Scope) interfaces.ArtifactManager {
 	artifactMetrics := artifactMetrics{
 		scope:                    artifactScope,
@@ -440,6 +609,9 @@ func NewArtifactManager(repo repositories.RepositoryInterface, store *storage.Da
 		updateDataFailureCounter: labeled.NewCounter("update_data_failure_count", "The number of times update artifact data failed", artifactScope, labeled.EmitUnlabeledMetric),
 		deleteDataSuccessCounter: labeled.NewCounter("delete_data_success_count", "The number of times delete artifact data succeeded", artifactScope, labeled.EmitUnlabeledMetric),
 		deleteDataFailureCounter: labeled.NewCounter("delete_data_failure_count", "The number of times delete artifact data failed", artifactScope, labeled.EmitUnlabeledMetric),
+		deleteResponseTime:       labeled.NewStopWatch("delete_duration", "The duration of the delete artifact calls.", time.Millisecond, artifactScope, labeled.EmitUnlabeledMetric),
+		deleteSuccessCounter:     labeled.NewCounter("delete_success_count", "The number of times delete artifact succeeded", artifactScope, labeled.EmitUnlabeledMetric),
+		deleteFailureCounter:     labeled.NewCounter("delete_failure_count", "The number of times delete artifact failed", artifactScope, labeled.EmitUnlabeledMetric),
 	}
 
 	return &artifactManager{
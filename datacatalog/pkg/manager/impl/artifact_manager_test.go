@@ -935,3 +935,334 @@ func TestUpdateArtifact(t *testing.T) {
 		assert.Nil(t, artifactResponse)
 	})
 }
+
+func TestDeleteArtifact(t *testing.T) {
+	ctx := context.Background()
+	datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+	testStoragePrefix, err := datastore.ConstructReference(ctx, datastore.GetBaseContainerFQN(ctx), "test")
+	assert.NoError(t, err)
+
+	expectedDataset := getTestDataset()
+	expectedArtifact := getTestArtifact()
+	expectedTag := getTestTag()
+
+	t.Run("Delete by ID", func(t *testing.T) {
+		ctx := context.Background()
+		datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+		mockArtifactModel := getExpectedArtifactModel(ctx, t, datastore, expectedArtifact)
+
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockArtifactRepo.On("Get",
+			mock.MatchedBy(func(ctx context.Context) bool { return true }),
+			mock.MatchedBy(func(artifactKey models.ArtifactKey) bool {
+				return artifactKey.ArtifactID == expectedArtifact.GetId() &&
+					artifactKey.DatasetProject == expectedArtifact.GetDataset().GetProject() &&
+					artifactKey.DatasetDomain == expectedArtifact.GetDataset().GetDomain() &&
+					artifactKey.DatasetName == expectedArtifact.GetDataset().GetName() &&
+					artifactKey.DatasetVersion == expectedArtifact.GetDataset().GetVersion()
+			})).Return(mockArtifactModel, nil)
+		dcRepo.MockArtifactRepo.On("Delete",
+			mock.MatchedBy(func(ctx context.Context) bool { return true }),
+			mock.MatchedBy(func(artifactKey models.ArtifactKey) bool {
+				return artifactKey.ArtifactID == expectedArtifact.GetId()
+			})).Return(nil)
+
+		request := &datacatalog.DeleteArtifactRequest{
+			Dataset: expectedDataset.GetId(),
+			QueryHandle: &datacatalog.DeleteArtifactRequest_ArtifactId{
+				ArtifactId: expectedArtifact.GetId(),
+			},
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifact(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedArtifact.GetId(), artifactResponse.GetArtifactId())
+		dcRepo.MockArtifactRepo.AssertExpectations(t)
+
+		// the artifact data should be removed from the datastore
+		dataRef, err := getExpectedDatastoreLocation(ctx, datastore, testStoragePrefix, expectedArtifact, 0)
+		assert.NoError(t, err)
+		var value core.Literal
+		err = datastore.ReadProtobuf(ctx, dataRef, &value)
+		assert.Error(t, err)
+		assert.True(t, stdErrors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("Delete by artifact tag", func(t *testing.T) {
+		ctx := context.Background()
+		datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+		mockArtifactModel := getExpectedArtifactModel(ctx, t, datastore, expectedArtifact)
+
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockTagRepo.On("Get", mock.Anything,
+			mock.MatchedBy(func(tagKey models.TagKey) bool {
+				return tagKey.TagName == expectedTag.TagName
+			})).Return(models.Tag{
+			TagKey:      expectedTag.TagKey,
+			DatasetUUID: expectedTag.DatasetUUID,
+			ArtifactID:  expectedArtifact.GetId(),
+			Artifact:    mockArtifactModel,
+		}, nil)
+		dcRepo.MockArtifactRepo.On("Delete", mock.Anything,
+			mock.MatchedBy(func(artifactKey models.ArtifactKey) bool {
+				return artifactKey.ArtifactID == expectedArtifact.GetId()
+			})).Return(nil)
+
+		request := &datacatalog.DeleteArtifactRequest{
+			Dataset: expectedDataset.GetId(),
+			QueryHandle: &datacatalog.DeleteArtifactRequest_TagName{
+				TagName: expectedTag.TagName,
+			},
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifact(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedArtifact.GetId(), artifactResponse.GetArtifactId())
+		dcRepo.MockTagRepo.AssertExpectations(t)
+		dcRepo.MockArtifactRepo.AssertExpectations(t)
+
+		dataRef, err := getExpectedDatastoreLocation(ctx, datastore, testStoragePrefix, expectedArtifact, 0)
+		assert.NoError(t, err)
+		var value core.Literal
+		err = datastore.ReadProtobuf(ctx, dataRef, &value)
+		assert.True(t, stdErrors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("Artifact not found", func(t *testing.T) {
+		ctx := context.Background()
+		datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockArtifactRepo.On("Get", mock.Anything, mock.Anything).Return(models.Artifact{}, repoErrors.GetMissingEntityError("Artifact", &datacatalog.Artifact{
+			Dataset: expectedDataset.GetId(),
+			Id:      expectedArtifact.GetId(),
+		}))
+
+		request := &datacatalog.DeleteArtifactRequest{
+			Dataset: expectedDataset.GetId(),
+			QueryHandle: &datacatalog.DeleteArtifactRequest_ArtifactId{
+				ArtifactId: expectedArtifact.GetId(),
+			},
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifact(ctx, request)
+		assert.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, artifactResponse)
+		dcRepo.MockArtifactRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Delete failed", func(t *testing.T) {
+		ctx := context.Background()
+		datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+		mockArtifactModel := getExpectedArtifactModel(ctx, t, datastore, expectedArtifact)
+
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockArtifactRepo.On("Get", mock.Anything, mock.Anything).Return(mockArtifactModel, nil)
+		dcRepo.MockArtifactRepo.On("Delete", mock.Anything, mock.Anything).Return(errors.NewDataCatalogErrorf(codes.Internal, "failed"))
+
+		request := &datacatalog.DeleteArtifactRequest{
+			Dataset: expectedDataset.GetId(),
+			QueryHandle: &datacatalog.DeleteArtifactRequest_ArtifactId{
+				ArtifactId: expectedArtifact.GetId(),
+			},
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifact(ctx, request)
+		assert.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, artifactResponse)
+
+		// the artifact data has to be retained if the artifact could not be deleted
+		dataRef, err := getExpectedDatastoreLocation(ctx, datastore, testStoragePrefix, expectedArtifact, 0)
+		assert.NoError(t, err)
+		var value core.Literal
+		err = datastore.ReadProtobuf(ctx, dataRef, &value)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Missing query handle", func(t *testing.T) {
+		ctx := context.Background()
+		datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+
+		dcRepo := newMockDataCatalogRepo()
+
+		request := &datacatalog.DeleteArtifactRequest{
+			Dataset: expectedDataset.GetId(),
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifact(ctx, request)
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, artifactResponse)
+	})
+
+	t.Run("Missing dataset", func(t *testing.T) {
+		ctx := context.Background()
+		datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+
+		dcRepo := newMockDataCatalogRepo()
+
+		request := &datacatalog.DeleteArtifactRequest{
+			QueryHandle: &datacatalog.DeleteArtifactRequest_ArtifactId{
+				ArtifactId: expectedArtifact.GetId(),
+			},
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifact(ctx, request)
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, artifactResponse)
+	})
+}
+
+func TestDeleteArtifacts(t *testing.T) {
+	ctx := context.Background()
+	datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+	testStoragePrefix, err := datastore.ConstructReference(ctx, datastore.GetBaseContainerFQN(ctx), "test")
+	assert.NoError(t, err)
+
+	expectedDataset := getTestDataset()
+	expectedArtifact := getTestArtifact()
+
+	filter := &datacatalog.FilterExpression{
+		Filters: []*datacatalog.SinglePropertyFilter{
+			{
+				PropertyFilter: &datacatalog.SinglePropertyFilter_PartitionFilter{
+					PartitionFilter: &datacatalog.PartitionPropertyFilter{
+						Property: &datacatalog.PartitionPropertyFilter_KeyVal{
+							KeyVal: &datacatalog.KeyValuePair{Key: "key1", Value: "value1"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("Delete by partition", func(t *testing.T) {
+		ctx := context.Background()
+		datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+		mockArtifactModel := getExpectedArtifactModel(ctx, t, datastore, expectedArtifact)
+		mockDatasetModel := models.Dataset{
+			DatasetKey: models.DatasetKey{
+				Project: expectedDataset.GetId().GetProject(),
+				Domain:  expectedDataset.GetId().GetDomain(),
+				Name:    expectedDataset.GetId().GetName(),
+				Version: expectedDataset.GetId().GetVersion(),
+				UUID:    expectedDataset.GetId().GetUUID(),
+			},
+		}
+
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockDatasetRepo.On("Get", mock.Anything, mock.Anything).Return(mockDatasetModel, nil)
+		dcRepo.MockArtifactRepo.On("List", mock.Anything,
+			mock.MatchedBy(func(datasetKey models.DatasetKey) bool {
+				return datasetKey.UUID == expectedDataset.GetId().GetUUID()
+			}),
+			mock.MatchedBy(func(listInput models.ListModelsInput) bool {
+				return len(listInput.ModelFilters) == 1 &&
+					len(listInput.ModelFilters[0].ValueFilters) == 2 &&
+					listInput.Limit == common.MaxPageLimit &&
+					listInput.Offset == 0
+			})).Return([]models.Artifact{mockArtifactModel}, nil)
+		dcRepo.MockArtifactRepo.On("Delete", mock.Anything,
+			mock.MatchedBy(func(artifactKey models.ArtifactKey) bool {
+				return artifactKey.ArtifactID == expectedArtifact.GetId()
+			})).Return(nil)
+
+		request := &datacatalog.DeleteArtifactsRequest{
+			Dataset: expectedDataset.GetId(),
+			Filter:  filter,
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifacts(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{expectedArtifact.GetId()}, artifactResponse.GetArtifactIds())
+		dcRepo.MockArtifactRepo.AssertExpectations(t)
+
+		dataRef, err := getExpectedDatastoreLocation(ctx, datastore, testStoragePrefix, expectedArtifact, 0)
+		assert.NoError(t, err)
+		var value core.Literal
+		err = datastore.ReadProtobuf(ctx, dataRef, &value)
+		assert.True(t, stdErrors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("Failed artifacts are reported", func(t *testing.T) {
+		failing := models.Artifact{ArtifactKey: models.ArtifactKey{ArtifactID: "failing"}}
+		deleted := models.Artifact{ArtifactKey: models.ArtifactKey{ArtifactID: "deleted"}}
+
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockDatasetRepo.On("Get", mock.Anything, mock.Anything).Return(models.Dataset{}, nil)
+		dcRepo.MockArtifactRepo.On("List", mock.Anything, mock.Anything, mock.Anything).Return([]models.Artifact{failing, deleted}, nil)
+		dcRepo.MockArtifactRepo.On("Delete", mock.Anything, failing.ArtifactKey).Return(errors.NewDataCatalogErrorf(codes.Internal, "failed"))
+		dcRepo.MockArtifactRepo.On("Delete", mock.Anything, deleted.ArtifactKey).Return(nil)
+
+		request := &datacatalog.DeleteArtifactsRequest{
+			Dataset: expectedDataset.GetId(),
+			Filter:  filter,
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifacts(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"deleted"}, artifactResponse.GetArtifactIds())
+		if assert.Len(t, artifactResponse.GetErrors(), 1) {
+			assert.Equal(t, "failing", artifactResponse.GetErrors()[0].GetArtifactId())
+			assert.Contains(t, artifactResponse.GetErrors()[0].GetMessage(), "failed")
+		}
+	})
+
+	t.Run("No matching artifacts", func(t *testing.T) {
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockDatasetRepo.On("Get", mock.Anything, mock.Anything).Return(models.Dataset{}, nil)
+		dcRepo.MockArtifactRepo.On("List", mock.Anything, mock.Anything, mock.Anything).Return([]models.Artifact{}, nil)
+
+		request := &datacatalog.DeleteArtifactsRequest{
+			Dataset: expectedDataset.GetId(),
+			Filter:  filter,
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifacts(ctx, request)
+		assert.NoError(t, err)
+		assert.Empty(t, artifactResponse.GetArtifactIds())
+		dcRepo.MockArtifactRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Dataset not found", func(t *testing.T) {
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockDatasetRepo.On("Get", mock.Anything, mock.Anything).Return(models.Dataset{}, repoErrors.GetMissingEntityError("Dataset", expectedDataset.GetId()))
+
+		request := &datacatalog.DeleteArtifactsRequest{
+			Dataset: expectedDataset.GetId(),
+			Filter:  filter,
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifacts(ctx, request)
+		assert.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, artifactResponse)
+	})
+
+	t.Run("Missing filter", func(t *testing.T) {
+		dcRepo := newMockDataCatalogRepo()
+
+		request := &datacatalog.DeleteArtifactsRequest{
+			Dataset: expectedDataset.GetId(),
+		}
+
+		artifactManager := NewArtifactManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		artifactResponse, err := artifactManager.DeleteArtifacts(ctx, request)
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, artifactResponse)
+	})
+}
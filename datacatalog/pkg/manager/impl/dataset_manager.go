@@ -12,8 +12,10 @@ import (
 	"github.com/flyteorg/flyte/datacatalog/pkg/manager/impl/validators"
 	"github.com/flyteorg/flyte/datacatalog/pkg/manager/interfaces"
 	"github.com/flyteorg/flyte/datacatalog/pkg/repositories"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
 	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/transformers"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
@@ -34,11 +36,15 @@ type datasetMetrics struct {
 	validationErrorCounter  labeled.Counter
 	alreadyExistsCounter    labeled.Counter
 	doesNotExistCounter     labeled.Counter
+	deleteResponseTime      labeled.StopWatch
+	deleteSuccessCounter    labeled.Counter
+	deleteErrorCounter      labeled.Counter
 }
 
 type datasetManager struct {
 	repo          repositories.RepositoryInterface
 	store         *storage.DataStore
+	artifactStore ArtifactDataStore
 	systemMetrics datasetMetrics
 }
 
@@ -190,10 +196,77 @@ func (dm *datasetManager) ListDatasets(ctx context.Context, request *datacatalog
 	return &datacatalog.ListDatasetsResponse{Datasets: datasetList, NextToken: token}, nil
 }
 
-func NewDatasetManager(repo repositories.RepositoryInterface, store *storage.DataStore, datasetScope promutils.Scope) interfaces.DatasetManager {
+// DeleteDataset deletes the dataset with the given DatasetID along with all of its artifacts, their tags and partitions.
+// The ArtifactData of the deleted artifacts is removed from the underlying blob storage after the database records were
+// deleted.
+func (dm *datasetManager) DeleteDataset(ctx context.Context, request *datacatalog.DeleteDatasetRequest) (*datacatalog.DeleteDatasetResponse, error) {
+	ctx = contextutils.WithProjectDomain(ctx, request.GetDataset().GetProject(), request.GetDataset().GetDomain())
+
+	timer := dm.systemMetrics.deleteResponseTime.Start(ctx)
+	defer timer.Stop()
+
+	err := validators.ValidateDeleteDatasetRequest(request)
+	if err != nil {
+		logger.Warnf(ctx, "Invalid delete dataset request %+v err: %v", request, err)
+		dm.systemMetrics.validationErrorCounter.Inc(ctx)
+		return nil, err
+	}
+
+	datasetKey := transformers.FromDatasetID(request.GetDataset())
+	datasetModel, err := dm.repo.DatasetRepo().Get(ctx, datasetKey)
+	if err != nil {
+		if errors.IsDoesNotExistError(err) {
+			logger.Warnf(ctx, "Dataset does not exist key: %+v, err %v", datasetKey, err)
+			dm.systemMetrics.doesNotExistCounter.Inc(ctx)
+		} else {
+			logger.Errorf(ctx, "Unable to get dataset for delete dataset request %+v err: %v", request, err)
+			dm.systemMetrics.deleteErrorCounter.Inc(ctx)
+		}
+		return nil, err
+	}
+
+	// collect the artifact data locations before the database records are gone
+	artifactModels, err := listAllArtifacts(ctx, dm.repo, datasetModel.DatasetKey, models.ListModelsInput{})
+	if err != nil {
+		logger.Errorf(ctx, "Unable to list artifacts of dataset %+v to delete err: %v", datasetKey, err)
+		dm.systemMetrics.deleteErrorCounter.Inc(ctx)
+		return nil, err
+	}
+
+	err = dm.repo.DatasetRepo().Delete(ctx, datasetModel.DatasetKey)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to delete dataset %+v err: %v", datasetKey, err)
+		dm.systemMetrics.deleteErrorCounter.Inc(ctx)
+		return nil, err
+	}
+
+	// the dataset is gone at this point, attempt to delete all blobs and report the ones left orphaned
+	deleteDataErrs := make([]error, 0)
+	for _, artifactModel := range artifactModels {
+		for _, artifactData := range artifactModel.ArtifactData {
+			if err := dm.artifactStore.DeleteData(ctx, artifactData); err != nil {
+				logger.Errorf(ctx, "Failed to delete artifact data of deleted artifact %v, err: %v", artifactModel.ArtifactKey, err)
+				deleteDataErrs = append(deleteDataErrs, err)
+			}
+		}
+	}
+
+	if len(deleteDataErrs) > 0 {
+		dm.systemMetrics.deleteErrorCounter.Inc(ctx)
+		return nil, errors.NewCollectedErrors(codes.Internal, deleteDataErrs)
+	}
+
+	logger.Debugf(ctx, "Successfully deleted dataset %+v with %v artifacts", datasetKey, len(artifactModels))
+
+	dm.systemMetrics.deleteSuccessCounter.Inc(ctx)
+	return &datacatalog.DeleteDatasetResponse{}, nil
+}
+
+func NewDatasetManager(repo repositories.RepositoryInterface, store *storage.DataStore, storagePrefix storage.DataReference, datasetScope promutils.Scope) interfaces.DatasetManager {
 	return &datasetManager{
-		repo:  repo,
-		store: store,
+		repo:          repo,
+		store:         store,
+		artifactStore: NewArtifactDataStore(store, storagePrefix),
 		systemMetrics: datasetMetrics{
 			scope:                   datasetScope,
 			createResponseTime:      labeled.NewStopWatch("create_duration", "The duration of the create dataset calls.", time.Millisecond, datasetScope, labeled.EmitUnlabeledMetric),
@@ -208,6 +281,9 @@ func NewDatasetManager(repo repositories.RepositoryInterface, store *storage.Dat
 			doesNotExistCounter:     labeled.NewCounter("does_not_exists_count", "The number of times a dataset was not found", datasetScope, labeled.EmitUnlabeledMetric),
 			listSuccessCounter:      labeled.NewCounter("list_success_count", "The number of times list dataset succeeded", datasetScope, labeled.EmitUnlabeledMetric),
 			listFailureCounter:      labeled.NewCounter("list_failure_count", "The number of times list dataset failed", datasetScope, labeled.EmitUnlabeledMetric),
+			deleteResponseTime:      labeled.NewStopWatch("delete_duration", "The duration of the delete dataset calls.", time.Millisecond, datasetScope, labeled.EmitUnlabeledMetric),
+			deleteSuccessCounter:    labeled.NewCounter("delete_success_count", "The number of times delete dataset succeeded", datasetScope, labeled.EmitUnlabeledMetric),
+			deleteErrorCounter:      labeled.NewCounter("delete_failed_count", "The number of times delete dataset failed", datasetScope, labeled.EmitUnlabeledMetric),
 		},
 	}
 }
@@ -2,6 +2,8 @@ package impl
 
 import (
 	"context"
+	stdErrors "errors"
+	"os"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -15,6 +17,7 @@ import (
 	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/mocks"
 	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
 	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/transformers"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
 	"github.com/flyteorg/flyte/flytestdlib/contextutils"
 	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
@@ -53,7 +56,7 @@ func TestCreateDataset(t *testing.T) {
 
 	t.Run("CreateDatasetWithPartitions", func(t *testing.T) {
 		dcRepo := getDataCatalogRepo()
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 		dcRepo.MockDatasetRepo.On("Create",
 			mock.MatchedBy(func(ctx context.Context) bool { return true }),
 			mock.MatchedBy(func(dataset models.Dataset) bool {
@@ -74,7 +77,7 @@ func TestCreateDataset(t *testing.T) {
 
 	t.Run("CreateDatasetNoPartitions", func(t *testing.T) {
 		dcRepo := getDataCatalogRepo()
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 		dcRepo.MockDatasetRepo.On("Create",
 			mock.MatchedBy(func(ctx context.Context) bool { return true }),
 			mock.MatchedBy(func(dataset models.Dataset) bool {
@@ -95,7 +98,7 @@ func TestCreateDataset(t *testing.T) {
 
 	t.Run("MissingInput", func(t *testing.T) {
 		dcRepo := getDataCatalogRepo()
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 		request := &datacatalog.CreateDatasetRequest{
 			Dataset: &datacatalog.Dataset{
 				Id: &datacatalog.DatasetID{
@@ -114,7 +117,7 @@ func TestCreateDataset(t *testing.T) {
 
 	t.Run("AlreadyExists", func(t *testing.T) {
 		dcRepo := getDataCatalogRepo()
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 
 		dcRepo.MockDatasetRepo.On("Create",
 			mock.Anything,
@@ -133,7 +136,7 @@ func TestCreateDataset(t *testing.T) {
 		dcRepo := getDataCatalogRepo()
 		badDataset := getTestDataset()
 		badDataset.PartitionKeys = append(badDataset.PartitionKeys, badDataset.GetPartitionKeys()[0])
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 
 		dcRepo.MockDatasetRepo.On("Create",
 			mock.Anything,
@@ -153,7 +156,7 @@ func TestGetDataset(t *testing.T) {
 
 	t.Run("HappyPath", func(t *testing.T) {
 		dcRepo := getDataCatalogRepo()
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 
 		datasetModelResponse, err := transformers.CreateDatasetModel(expectedDataset)
 		assert.NoError(t, err)
@@ -177,7 +180,7 @@ func TestGetDataset(t *testing.T) {
 
 	t.Run("Does not exist", func(t *testing.T) {
 		dcRepo := getDataCatalogRepo()
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 
 		dcRepo.MockDatasetRepo.On("Get",
 			mock.MatchedBy(func(ctx context.Context) bool { return true }),
@@ -203,7 +206,7 @@ func TestListDatasets(t *testing.T) {
 	dcRepo := getDataCatalogRepo()
 
 	t.Run("List Datasets on invalid filter", func(t *testing.T) {
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 		filter := &datacatalog.FilterExpression{
 			Filters: []*datacatalog.SinglePropertyFilter{
 				{
@@ -226,7 +229,7 @@ func TestListDatasets(t *testing.T) {
 	})
 
 	t.Run("List Datasets with Project and Name", func(t *testing.T) {
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 		filter := &datacatalog.FilterExpression{
 			Filters: []*datacatalog.SinglePropertyFilter{
 				{
@@ -271,7 +274,7 @@ func TestListDatasets(t *testing.T) {
 	})
 
 	t.Run("List Datasets with no filtering", func(t *testing.T) {
-		datasetManager := NewDatasetManager(dcRepo, nil, mockScope.NewTestScope())
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
 
 		datasetModel, err := transformers.CreateDatasetModel(expectedDataset)
 		assert.NoError(t, err)
@@ -289,3 +292,90 @@ func TestListDatasets(t *testing.T) {
 		assert.Len(t, datasetResponse.GetDatasets(), 1)
 	})
 }
+
+func TestDeleteDataset(t *testing.T) {
+	expectedDataset := getTestDataset()
+	expectedArtifact := getTestArtifact()
+
+	t.Run("HappyPath", func(t *testing.T) {
+		ctx := context.Background()
+		datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+		testStoragePrefix, err := datastore.ConstructReference(ctx, datastore.GetBaseContainerFQN(ctx), "test")
+		assert.NoError(t, err)
+		mockArtifactModel := getExpectedArtifactModel(ctx, t, datastore, expectedArtifact)
+
+		datasetModel, err := transformers.CreateDatasetModel(expectedDataset)
+		assert.NoError(t, err)
+
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockDatasetRepo.On("Get", mock.Anything,
+			mock.MatchedBy(func(datasetKey models.DatasetKey) bool {
+				return datasetKey.Name == expectedDataset.GetId().GetName() &&
+					datasetKey.Project == expectedDataset.GetId().GetProject() &&
+					datasetKey.Domain == expectedDataset.GetId().GetDomain() &&
+					datasetKey.Version == expectedDataset.GetId().GetVersion()
+			})).Return(*datasetModel, nil)
+		dcRepo.MockArtifactRepo.On("List", mock.Anything,
+			mock.MatchedBy(func(datasetKey models.DatasetKey) bool {
+				return datasetKey.UUID == expectedDataset.GetId().GetUUID()
+			}),
+			mock.MatchedBy(func(listInput models.ListModelsInput) bool {
+				return len(listInput.ModelFilters) == 0 && listInput.Offset == 0
+			})).Return([]models.Artifact{mockArtifactModel}, nil)
+		dcRepo.MockDatasetRepo.On("Delete", mock.Anything,
+			mock.MatchedBy(func(datasetKey models.DatasetKey) bool {
+				return datasetKey.UUID == expectedDataset.GetId().GetUUID()
+			})).Return(nil)
+
+		datasetManager := NewDatasetManager(dcRepo, datastore, testStoragePrefix, mockScope.NewTestScope())
+		datasetResponse, err := datasetManager.DeleteDataset(ctx, &datacatalog.DeleteDatasetRequest{Dataset: expectedDataset.GetId()})
+		assert.NoError(t, err)
+		assert.NotNil(t, datasetResponse)
+		dcRepo.MockDatasetRepo.AssertExpectations(t)
+
+		// the data of all artifacts of the dataset should be removed from the datastore
+		dataRef, err := getExpectedDatastoreLocation(ctx, datastore, testStoragePrefix, expectedArtifact, 0)
+		assert.NoError(t, err)
+		var value core.Literal
+		err = datastore.ReadProtobuf(ctx, dataRef, &value)
+		assert.True(t, stdErrors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("Does not exist", func(t *testing.T) {
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockDatasetRepo.On("Get", mock.Anything, mock.Anything).Return(models.Dataset{}, errors.NewDataCatalogError(codes.NotFound, "dataset does not exist"))
+
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
+		datasetResponse, err := datasetManager.DeleteDataset(context.Background(), &datacatalog.DeleteDatasetRequest{Dataset: expectedDataset.GetId()})
+		assert.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, datasetResponse)
+		dcRepo.MockDatasetRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Delete failed", func(t *testing.T) {
+		datasetModel, err := transformers.CreateDatasetModel(expectedDataset)
+		assert.NoError(t, err)
+
+		dcRepo := newMockDataCatalogRepo()
+		dcRepo.MockDatasetRepo.On("Get", mock.Anything, mock.Anything).Return(*datasetModel, nil)
+		dcRepo.MockArtifactRepo.On("List", mock.Anything, mock.Anything, mock.Anything).Return([]models.Artifact{}, nil)
+		dcRepo.MockDatasetRepo.On("Delete", mock.Anything, mock.Anything).Return(errors.NewDataCatalogError(codes.Internal, "failed"))
+
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
+		datasetResponse, err := datasetManager.DeleteDataset(context.Background(), &datacatalog.DeleteDatasetRequest{Dataset: expectedDataset.GetId()})
+		assert.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, datasetResponse)
+	})
+
+	t.Run("Missing dataset", func(t *testing.T) {
+		dcRepo := newMockDataCatalogRepo()
+
+		datasetManager := NewDatasetManager(dcRepo, nil, "", mockScope.NewTestScope())
+		datasetResponse, err := datasetManager.DeleteDataset(context.Background(), &datacatalog.DeleteDatasetRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, datasetResponse)
+	})
+}
@@ -0,0 +1,367 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/common"
+	"github.com/flyteorg/flyte/datacatalog/pkg/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/manager/interfaces"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories"
+	repo_errors "github.com/flyteorg/flyte/datacatalog/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/transformers"
+	"github.com/flyteorg/flyte/datacatalog/pkg/runtime/configs"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+const defaultSweepInterval = time.Hour
+
+// sweepLeaseKey identifies the reservation used as a lease, so only a single datacatalog replica sweeps at a time.
+// It does not belong to any dataset.
+var sweepLeaseKey = models.ReservationKey{
+	DatasetProject: "datacatalog",
+	DatasetName:    "retention-sweeper",
+}
+
+type retentionMetrics struct {
+	scope                promutils.Scope
+	sweepResponseTime    labeled.StopWatch
+	sweepFailureCounter  labeled.Counter
+	sweepSkippedCounter  labeled.Counter
+	expiredCounter       labeled.Counter
+	deleteSuccessCounter labeled.Counter
+	deleteFailureCounter labeled.Counter
+	deleteDataCounter    labeled.Counter
+}
+
+type retentionManager struct {
+	repo          repositories.RepositoryInterface
+	artifactStore ArtifactDataStore
+	config        configs.RetentionConfig
+	ownerID       string
+	now           NowFunc
+	systemMetrics retentionMetrics
+}
+
+// Sweep finds all artifacts expired according to the retention policy of their dataset and deletes them together
+// with their tags and partitions, and optionally their offloaded data. Failures to delete single artifacts are
+// counted in the report and retried in the next sweep.
+func (m *retentionManager) Sweep(ctx context.Context, dryRun bool) (interfaces.RetentionReport, error) {
+	timer := m.systemMetrics.sweepResponseTime.Start(ctx)
+	defer timer.Stop()
+
+	report := interfaces.RetentionReport{DryRun: dryRun}
+	now := m.now()
+	// Datasets are listed page by page from the most recently created one, so the number of versions of a dataset
+	// seen so far is the number of its newer versions. Datasets created during the sweep are left out so they don't
+	// shift the pages.
+	newerVersions := make(map[datasetName]int)
+	for offset := 0; ; {
+		datasets, err := m.listDatasets(ctx, now, offset)
+		if err != nil {
+			logger.Errorf(ctx, "Unable to list datasets for retention sweep, err: %v", err)
+			m.systemMetrics.sweepFailureCounter.Inc(ctx)
+			return report, err
+		}
+
+		for _, dataset := range datasets {
+			name := getDatasetName(dataset)
+			m.sweepDatasetVersion(ctx, dataset, newerVersions[name], now, dryRun, &report)
+			newerVersions[name]++
+		}
+
+		offset += len(datasets)
+		if len(datasets) < m.config.BatchSize {
+			return report, nil
+		}
+	}
+}
+
+// sweepDatasetVersion deletes the expired artifacts of a dataset version which has newerVersions more recently created
+// versions
+func (m *retentionManager) sweepDatasetVersion(ctx context.Context, dataset models.Dataset, newerVersions int, now time.Time, dryRun bool, report *interfaces.RetentionReport) {
+	policy := m.getPolicy(dataset.DatasetKey)
+
+	var reason string
+	filters := make([]models.ModelFilter, 0, 1)
+	if policy.MaxVersions > 0 && newerVersions >= policy.MaxVersions {
+		reason = interfaces.RetentionReasonMaxVersions
+	} else if policy.TTL.Duration > 0 {
+		reason = interfaces.RetentionReasonTTL
+		filters = append(filters, transformers.NewCreatedBeforeFilter(common.Artifact, now.Add(-policy.TTL.Duration)))
+	} else {
+		return
+	}
+
+	expired, err := m.sweepDataset(contextutils.WithProjectDomain(ctx, dataset.Project, dataset.Domain), dataset, filters, dryRun, report)
+	if err != nil {
+		logger.Errorf(ctx, "Unable to list expired artifacts of dataset %v, err: %v", dataset.DatasetKey, err)
+		m.systemMetrics.sweepFailureCounter.Inc(ctx)
+		report.Failures++
+	}
+
+	if len(expired) > 0 {
+		report.Datasets = append(report.Datasets, interfaces.ExpiredDataset{
+			Dataset: &datacatalog.DatasetID{
+				Project: dataset.Project,
+				Domain:  dataset.Domain,
+				Name:    dataset.Name,
+				Version: dataset.Version,
+				UUID:    dataset.UUID,
+			},
+			Reason:      reason,
+			ArtifactIDs: expired,
+		})
+	}
+}
+
+// Run sweeps for expired artifacts in the configured interval until the context is cancelled. Replicas share a lease
+// stored in the reservations table and only the replica holding the lease sweeps. The lease expires after two sweep
+// intervals without being extended, so another replica takes over if the holder stops.
+func (m *retentionManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.SweepInterval.Duration)
+	defer ticker.Stop()
+	defer m.releaseLease(ctx)
+
+	for {
+		acquired, err := m.tryAcquireLease(ctx)
+		if err != nil {
+			logger.Errorf(ctx, "Unable to acquire the retention sweep lease, err: %v", err)
+			m.systemMetrics.sweepFailureCounter.Inc(ctx)
+		} else if !acquired {
+			logger.Debugf(ctx, "Skipping retention sweep, the lease is held by another replica")
+			m.systemMetrics.sweepSkippedCounter.Inc(ctx)
+		} else {
+			report, err := m.Sweep(ctx, m.config.DryRun)
+			if err != nil {
+				logger.Errorf(ctx, "Retention sweep failed, err: %v", err)
+			} else {
+				logger.Infof(ctx, "Retention sweep (dry run: %v) found expired artifacts in %d datasets, deleted %d artifacts and %d data blobs, %d failures",
+					report.DryRun, len(report.Datasets), report.DeletedArtifacts, report.DeletedData, report.Failures)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tryAcquireLease creates or extends the sweep lease and returns whether this replica holds it. Create and Update only
+// succeed if no other replica holds an unexpired lease, so at most one replica acquires it.
+func (m *retentionManager) tryAcquireLease(ctx context.Context) (bool, error) {
+	repo := m.repo.ReservationRepo()
+	now := m.now()
+	lease := models.Reservation{
+		ReservationKey: sweepLeaseKey,
+		OwnerID:        m.ownerID,
+		ExpiresAt:      now.Add(2 * m.config.SweepInterval.Duration),
+	}
+
+	existing, err := repo.Get(ctx, sweepLeaseKey)
+	switch {
+	case err != nil && errors.IsDoesNotExistError(err):
+		err = repo.Create(ctx, lease, now)
+	case err != nil:
+		return false, err
+	case existing.OwnerID == m.ownerID || existing.ExpiresAt.Before(now):
+		err = repo.Update(ctx, lease, now)
+	default:
+		return false, nil
+	}
+
+	if err != nil {
+		if err.Error() == repo_errors.AlreadyExists {
+			// another replica acquired the lease concurrently
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// releaseLease lets another replica take over immediately when this replica stops sweeping
+func (m *retentionManager) releaseLease(ctx context.Context) {
+	err := m.repo.ReservationRepo().Delete(context.WithoutCancel(ctx), sweepLeaseKey, m.ownerID)
+	if err != nil && !errors.IsDoesNotExistError(err) {
+		logger.Warnf(ctx, "Unable to release the retention sweep lease, err: %v", err)
+	}
+}
+
+// listDatasets reads the page of the datasets created before createdBefore starting at offset, ordered from the most
+// recently created one
+func (m *retentionManager) listDatasets(ctx context.Context, createdBefore time.Time, offset int) ([]models.Dataset, error) {
+	listInput := models.ListModelsInput{
+		ModelFilters: []models.ModelFilter{transformers.NewCreatedBeforeFilter(common.Dataset, createdBefore)},
+	}
+	if err := transformers.ApplyPagination(m.getPaginationOptions(offset, datacatalog.PaginationOptions_DESCENDING), &listInput); err != nil {
+		return nil, err
+	}
+
+	return m.repo.DatasetRepo().List(ctx, listInput)
+}
+
+// sweepDataset deletes the artifacts of the dataset matching the given filters and returns their IDs
+func (m *retentionManager) sweepDataset(ctx context.Context, dataset models.Dataset, filters []models.ModelFilter, dryRun bool, report *interfaces.RetentionReport) ([]string, error) {
+	expired := make([]string, 0)
+	// Deleted artifacts drop out of the next batch, so the offset only skips the artifacts which remain in place.
+	for offset := 0; ; {
+		listInput := models.ListModelsInput{ModelFilters: append([]models.ModelFilter{}, filters...)}
+		if err := transformers.ApplyPagination(m.getPaginationOptions(offset, datacatalog.PaginationOptions_ASCENDING), &listInput); err != nil {
+			return expired, err
+		}
+
+		artifacts, err := m.repo.ArtifactRepo().List(ctx, dataset.DatasetKey, listInput)
+		if err != nil {
+			return expired, err
+		}
+
+		for _, artifact := range artifacts {
+			expired = append(expired, artifact.ArtifactID)
+			m.systemMetrics.expiredCounter.Inc(ctx)
+			if dryRun {
+				logger.Infof(ctx, "Dry run: artifact %v of dataset %v expired", artifact.ArtifactID, dataset.DatasetKey)
+				offset++
+				continue
+			}
+
+			if err := m.deleteArtifact(ctx, artifact, report); err != nil {
+				logger.Errorf(ctx, "Unable to delete expired artifact %v of dataset %v, err: %v", artifact.ArtifactID, dataset.DatasetKey, err)
+				m.systemMetrics.deleteFailureCounter.Inc(ctx)
+				report.Failures++
+				offset++
+			}
+		}
+
+		if len(artifacts) < m.config.BatchSize {
+			return expired, nil
+		}
+	}
+}
+
+// deleteArtifact deletes the artifact from the database before deleting its data, so the artifact is never returned
+// without its data. Blobs which fail to be deleted are orphaned and reported as failures.
+func (m *retentionManager) deleteArtifact(ctx context.Context, artifact models.Artifact, report *interfaces.RetentionReport) error {
+	if err := m.repo.ArtifactRepo().Delete(ctx, artifact.ArtifactKey); err != nil {
+		if errors.IsDoesNotExistError(err) {
+			// already deleted by a concurrent sweep
+			logger.Debugf(ctx, "Expired artifact %v was already deleted", artifact.ArtifactID)
+			return nil
+		}
+		return err
+	}
+	m.systemMetrics.deleteSuccessCounter.Inc(ctx)
+	report.DeletedArtifacts++
+
+	if !m.config.DeleteData {
+		return nil
+	}
+
+	for _, data := range artifact.ArtifactData {
+		if err := m.artifactStore.DeleteData(ctx, data); err != nil {
+			logger.Errorf(ctx, "Unable to delete data %v of expired artifact %v, err: %v", data.Name, artifact.ArtifactID, err)
+			m.systemMetrics.deleteFailureCounter.Inc(ctx)
+			report.Failures++
+			continue
+		}
+		m.systemMetrics.deleteDataCounter.Inc(ctx)
+		report.DeletedData++
+	}
+
+	return nil
+}
+
+func (m *retentionManager) getPaginationOptions(offset int, sortOrder datacatalog.PaginationOptions_SortOrder) *datacatalog.PaginationOptions {
+	return &datacatalog.PaginationOptions{
+		Token:     strconv.Itoa(offset),
+		Limit:     uint32(m.config.BatchSize),
+		SortKey:   datacatalog.PaginationOptions_CREATION_TIME,
+		SortOrder: sortOrder,
+	}
+}
+
+// getPolicy returns the first dataset policy matching the dataset or the default policy
+func (m *retentionManager) getPolicy(datasetKey models.DatasetKey) configs.RetentionPolicy {
+	for _, policy := range m.config.Policies {
+		if matchesRetentionPolicy(policy, datasetKey) {
+			return policy.Policy
+		}
+	}
+
+	return m.config.DefaultPolicy
+}
+
+func matchesRetentionPolicy(policy configs.DatasetRetentionPolicy, datasetKey models.DatasetKey) bool {
+	if len(policy.Project) > 0 && policy.Project != datasetKey.Project {
+		return false
+	}
+	if len(policy.Domain) > 0 && policy.Domain != datasetKey.Domain {
+		return false
+	}
+	if strings.HasSuffix(policy.Name, "*") {
+		return strings.HasPrefix(datasetKey.Name, strings.TrimSuffix(policy.Name, "*"))
+	}
+
+	return len(policy.Name) == 0 || policy.Name == datasetKey.Name
+}
+
+// datasetName identifies a dataset across its versions
+type datasetName struct {
+	project, domain, name string
+}
+
+func getDatasetName(dataset models.Dataset) datasetName {
+	return datasetName{project: dataset.Project, domain: dataset.Domain, name: dataset.Name}
+}
+
+// getSweepLeaseOwnerID identifies this replica as the owner of the sweep lease
+func getSweepLeaseOwnerID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "datacatalog"
+	}
+
+	return fmt.Sprintf("%s-%s", hostname, uuid.Must(uuid.NewV4()).String())
+}
+
+func NewRetentionManager(repo repositories.RepositoryInterface, store *storage.DataStore, storagePrefix storage.DataReference, config configs.RetentionConfig, nowFunc NowFunc, retentionScope promutils.Scope) interfaces.RetentionManager {
+	if config.BatchSize <= 0 {
+		config.BatchSize = common.MaxPageLimit
+	}
+	if config.SweepInterval.Duration <= 0 {
+		config.SweepInterval.Duration = defaultSweepInterval
+	}
+
+	return &retentionManager{
+		repo:          repo,
+		artifactStore: NewArtifactDataStore(store, storagePrefix),
+		config:        config,
+		ownerID:       getSweepLeaseOwnerID(),
+		now:           nowFunc,
+		systemMetrics: retentionMetrics{
+			scope:                retentionScope,
+			sweepResponseTime:    labeled.NewStopWatch("sweep_duration", "The duration of the retention sweeps.", time.Millisecond, retentionScope, labeled.EmitUnlabeledMetric),
+			sweepFailureCounter:  labeled.NewCounter("sweep_failed_count", "The number of failures listing datasets or artifacts while sweeping", retentionScope, labeled.EmitUnlabeledMetric),
+			sweepSkippedCounter:  labeled.NewCounter("sweep_skipped_count", "The number of sweeps skipped because another replica holds the lease", retentionScope, labeled.EmitUnlabeledMetric),
+			expiredCounter:       labeled.NewCounter("expired_artifact_count", "The number of expired artifacts found while sweeping", retentionScope, labeled.EmitUnlabeledMetric),
+			deleteSuccessCounter: labeled.NewCounter("delete_artifact_success_count", "The number of expired artifacts deleted", retentionScope, labeled.EmitUnlabeledMetric),
+			deleteFailureCounter: labeled.NewCounter("delete_artifact_failed_count", "The number of failures deleting expired artifacts or their data", retentionScope, labeled.EmitUnlabeledMetric),
+			deleteDataCounter:    labeled.NewCounter("delete_data_success_count", "The number of data blobs of expired artifacts deleted", retentionScope, labeled.EmitUnlabeledMetric),
+		},
+	}
+}
@@ -0,0 +1,361 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/manager/interfaces"
+	repo_errors "github.com/flyteorg/flyte/datacatalog/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/mocks"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
+	"github.com/flyteorg/flyte/datacatalog/pkg/runtime/configs"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+func getRetentionTestDataset(version string, createdAt time.Time) models.Dataset {
+	return models.Dataset{
+		BaseModel: models.BaseModel{CreatedAt: createdAt},
+		DatasetKey: models.DatasetKey{
+			Project: "test-project",
+			Domain:  "test-domain",
+			Name:    "test-name",
+			Version: version,
+			UUID:    "uuid-" + version,
+		},
+	}
+}
+
+func getRetentionTestArtifact(dataset models.Dataset, artifactID string, location storage.DataReference) models.Artifact {
+	artifactKey := models.ArtifactKey{
+		DatasetProject: dataset.Project,
+		DatasetDomain:  dataset.Domain,
+		DatasetName:    dataset.Name,
+		DatasetVersion: dataset.Version,
+		ArtifactID:     artifactID,
+	}
+	return models.Artifact{
+		ArtifactKey: artifactKey,
+		DatasetUUID: dataset.UUID,
+		ArtifactData: []models.ArtifactData{
+			{ArtifactKey: artifactKey, Name: "data1", Location: location.String()},
+		},
+	}
+}
+
+func getRetentionTestRepo() *mocks.DataCatalogRepo {
+	return &mocks.DataCatalogRepo{
+		MockDatasetRepo:  &mocks.DatasetRepo{},
+		MockArtifactRepo: &mocks.ArtifactRepo{},
+	}
+}
+
+func withOffset(offset int) interface{} {
+	return mock.MatchedBy(func(in models.ListModelsInput) bool {
+		return in.Offset == offset
+	})
+}
+
+func TestRetentionSweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	nowFunc := func() time.Time { return now }
+	dataset := getRetentionTestDataset("v1", now.Add(-time.Hour*24*30))
+
+	t.Run("TTL", func(t *testing.T) {
+		datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+		location := storage.DataReference("/test/data1")
+		assert.NoError(t, datastore.WriteProtobuf(ctx, location, storage.Options{}, getTestStringLiteral()))
+		artifact := getRetentionTestArtifact(dataset, "artifact1", location)
+
+		dcRepo := getRetentionTestRepo()
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, withOffset(0)).Return([]models.Dataset{dataset}, nil)
+		dcRepo.MockArtifactRepo.OnListMatch(mock.Anything, dataset.DatasetKey, mock.MatchedBy(func(in models.ListModelsInput) bool {
+			if len(in.ModelFilters) != 1 {
+				return false
+			}
+			expr, err := in.ModelFilters[0].ValueFilters[0].GetDBQueryExpression("artifacts")
+			return err == nil && expr.Query == "artifacts.created_at < ?" && expr.Args == now.Add(-time.Hour*24*7)
+		})).Return([]models.Artifact{artifact}, nil)
+		dcRepo.MockArtifactRepo.OnDeleteMatch(mock.Anything, artifact.ArtifactKey).Return(nil)
+
+		retentionManager := NewRetentionManager(dcRepo, datastore, "", configs.RetentionConfig{
+			BatchSize:     10,
+			DeleteData:    true,
+			DefaultPolicy: configs.RetentionPolicy{TTL: config.Duration{Duration: time.Hour * 24 * 7}},
+		}, nowFunc, mockScope.NewTestScope())
+		report, err := retentionManager.Sweep(ctx, false)
+		assert.NoError(t, err)
+		assert.False(t, report.DryRun)
+		assert.Equal(t, 1, report.DeletedArtifacts)
+		assert.Equal(t, 1, report.DeletedData)
+		assert.Equal(t, 0, report.Failures)
+		assert.Len(t, report.Datasets, 1)
+		assert.Equal(t, "v1", report.Datasets[0].Dataset.GetVersion())
+		assert.Equal(t, interfaces.RetentionReasonTTL, report.Datasets[0].Reason)
+		assert.Equal(t, []string{"artifact1"}, report.Datasets[0].ArtifactIDs)
+
+		metadata, err := datastore.Head(ctx, location)
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+
+	t.Run("MaxVersions", func(t *testing.T) {
+		latest := getRetentionTestDataset("v2", now.Add(-time.Hour))
+		artifact := getRetentionTestArtifact(dataset, "artifact1", "/test/data1")
+
+		dcRepo := getRetentionTestRepo()
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, mock.MatchedBy(func(in models.ListModelsInput) bool {
+			if in.Offset != 0 || len(in.ModelFilters) != 1 {
+				return false
+			}
+			expr, err := in.ModelFilters[0].ValueFilters[0].GetDBQueryExpression("datasets")
+			return err == nil && expr.Query == "datasets.created_at < ?" && expr.Args == now &&
+				in.SortParameter.GetDBOrderExpression("datasets") == "datasets.created_at desc"
+		})).Return([]models.Dataset{latest, dataset}, nil)
+		dcRepo.MockArtifactRepo.OnListMatch(mock.Anything, dataset.DatasetKey, mock.MatchedBy(func(in models.ListModelsInput) bool {
+			return len(in.ModelFilters) == 0
+		})).Return([]models.Artifact{artifact}, nil)
+		dcRepo.MockArtifactRepo.OnDeleteMatch(mock.Anything, artifact.ArtifactKey).Return(nil)
+
+		retentionManager := NewRetentionManager(dcRepo, createInmemoryDataStore(t, mockScope.NewTestScope()), "", configs.RetentionConfig{
+			BatchSize:     10,
+			DefaultPolicy: configs.RetentionPolicy{MaxVersions: 1},
+		}, nowFunc, mockScope.NewTestScope())
+		report, err := retentionManager.Sweep(ctx, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.DeletedArtifacts)
+		assert.Equal(t, 0, report.DeletedData)
+		assert.Len(t, report.Datasets, 1)
+		assert.Equal(t, "v1", report.Datasets[0].Dataset.GetVersion())
+		assert.Equal(t, interfaces.RetentionReasonMaxVersions, report.Datasets[0].Reason)
+		dcRepo.MockArtifactRepo.AssertNotCalled(t, "List", mock.Anything, latest.DatasetKey, mock.Anything)
+	})
+
+	t.Run("MaxVersionsAcrossPages", func(t *testing.T) {
+		latest := getRetentionTestDataset("v3", now.Add(-time.Hour))
+		previous := getRetentionTestDataset("v2", now.Add(-time.Hour*2))
+		artifact := getRetentionTestArtifact(dataset, "artifact1", "/test/data1")
+
+		dcRepo := getRetentionTestRepo()
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, withOffset(0)).Return([]models.Dataset{latest, previous}, nil)
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, withOffset(2)).Return([]models.Dataset{dataset}, nil)
+		dcRepo.MockArtifactRepo.OnListMatch(mock.Anything, dataset.DatasetKey, mock.Anything).Return([]models.Artifact{artifact}, nil)
+		dcRepo.MockArtifactRepo.OnDeleteMatch(mock.Anything, artifact.ArtifactKey).Return(nil)
+
+		retentionManager := NewRetentionManager(dcRepo, createInmemoryDataStore(t, mockScope.NewTestScope()), "", configs.RetentionConfig{
+			BatchSize:     2,
+			DefaultPolicy: configs.RetentionPolicy{MaxVersions: 2},
+		}, nowFunc, mockScope.NewTestScope())
+		report, err := retentionManager.Sweep(ctx, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.DeletedArtifacts)
+		assert.Len(t, report.Datasets, 1)
+		assert.Equal(t, "v1", report.Datasets[0].Dataset.GetVersion())
+		dcRepo.MockArtifactRepo.AssertNotCalled(t, "List", mock.Anything, latest.DatasetKey, mock.Anything)
+		dcRepo.MockArtifactRepo.AssertNotCalled(t, "List", mock.Anything, previous.DatasetKey, mock.Anything)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		artifacts := []models.Artifact{
+			getRetentionTestArtifact(dataset, "artifact1", "/test/data1"),
+			getRetentionTestArtifact(dataset, "artifact2", "/test/data2"),
+		}
+
+		dcRepo := getRetentionTestRepo()
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, withOffset(0)).Return([]models.Dataset{dataset}, nil)
+		dcRepo.MockArtifactRepo.OnListMatch(mock.Anything, dataset.DatasetKey, withOffset(0)).Return(artifacts, nil)
+		dcRepo.MockArtifactRepo.OnListMatch(mock.Anything, dataset.DatasetKey, withOffset(2)).Return([]models.Artifact{}, nil)
+
+		retentionManager := NewRetentionManager(dcRepo, createInmemoryDataStore(t, mockScope.NewTestScope()), "", configs.RetentionConfig{
+			BatchSize:     2,
+			DeleteData:    true,
+			DefaultPolicy: configs.RetentionPolicy{TTL: config.Duration{Duration: time.Hour}},
+		}, nowFunc, mockScope.NewTestScope())
+		report, err := retentionManager.Sweep(ctx, true)
+		assert.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 0, report.DeletedArtifacts)
+		assert.Len(t, report.Datasets, 1)
+		assert.Equal(t, []string{"artifact1", "artifact2"}, report.Datasets[0].ArtifactIDs)
+		dcRepo.MockArtifactRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("DeleteFailure", func(t *testing.T) {
+		failing := getRetentionTestArtifact(dataset, "artifact1", "/test/data1")
+		deleted := getRetentionTestArtifact(dataset, "artifact2", "/test/data2")
+
+		dcRepo := getRetentionTestRepo()
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, withOffset(0)).Return([]models.Dataset{dataset}, nil)
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, withOffset(1)).Return([]models.Dataset{}, nil)
+		dcRepo.MockArtifactRepo.OnListMatch(mock.Anything, dataset.DatasetKey, withOffset(0)).Return([]models.Artifact{failing}, nil).Once()
+		// the failed artifact remains in place and is skipped by the next batch
+		dcRepo.MockArtifactRepo.OnListMatch(mock.Anything, dataset.DatasetKey, withOffset(1)).Return([]models.Artifact{deleted}, nil).Once()
+		dcRepo.MockArtifactRepo.OnListMatch(mock.Anything, dataset.DatasetKey, withOffset(1)).Return([]models.Artifact{}, nil)
+		dcRepo.MockArtifactRepo.OnDeleteMatch(mock.Anything, failing.ArtifactKey).Return(errors.NewDataCatalogErrorf(codes.Internal, "failed"))
+		dcRepo.MockArtifactRepo.OnDeleteMatch(mock.Anything, deleted.ArtifactKey).Return(nil)
+
+		retentionManager := NewRetentionManager(dcRepo, createInmemoryDataStore(t, mockScope.NewTestScope()), "", configs.RetentionConfig{
+			BatchSize:     1,
+			DefaultPolicy: configs.RetentionPolicy{TTL: config.Duration{Duration: time.Hour}},
+		}, nowFunc, mockScope.NewTestScope())
+		report, err := retentionManager.Sweep(ctx, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.DeletedArtifacts)
+		assert.Equal(t, 1, report.Failures)
+		assert.Equal(t, []string{"artifact1", "artifact2"}, report.Datasets[0].ArtifactIDs)
+	})
+
+	t.Run("AlreadyDeleted", func(t *testing.T) {
+		artifact := getRetentionTestArtifact(dataset, "artifact1", "/test/data1")
+
+		dcRepo := getRetentionTestRepo()
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, withOffset(0)).Return([]models.Dataset{dataset}, nil)
+		dcRepo.MockArtifactRepo.OnListMatch(mock.Anything, dataset.DatasetKey, mock.Anything).Return([]models.Artifact{artifact}, nil)
+		dcRepo.MockArtifactRepo.OnDeleteMatch(mock.Anything, artifact.ArtifactKey).Return(errors.NewDataCatalogErrorf(codes.NotFound, "not found"))
+
+		retentionManager := NewRetentionManager(dcRepo, createInmemoryDataStore(t, mockScope.NewTestScope()), "", configs.RetentionConfig{
+			BatchSize:     10,
+			DefaultPolicy: configs.RetentionPolicy{TTL: config.Duration{Duration: time.Hour}},
+		}, nowFunc, mockScope.NewTestScope())
+		report, err := retentionManager.Sweep(ctx, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, report.DeletedArtifacts)
+		assert.Equal(t, 0, report.Failures)
+	})
+
+	t.Run("NoPolicy", func(t *testing.T) {
+		dcRepo := getRetentionTestRepo()
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, withOffset(0)).Return([]models.Dataset{dataset}, nil)
+
+		retentionManager := NewRetentionManager(dcRepo, createInmemoryDataStore(t, mockScope.NewTestScope()), "", configs.RetentionConfig{
+			BatchSize: 10,
+		}, nowFunc, mockScope.NewTestScope())
+		report, err := retentionManager.Sweep(ctx, false)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Datasets)
+		dcRepo.MockArtifactRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ListDatasetsFailure", func(t *testing.T) {
+		dcRepo := getRetentionTestRepo()
+		dcRepo.MockDatasetRepo.OnListMatch(mock.Anything, mock.Anything).Return(nil, errors.NewDataCatalogErrorf(codes.Internal, "failed"))
+
+		retentionManager := NewRetentionManager(dcRepo, createInmemoryDataStore(t, mockScope.NewTestScope()), "", configs.RetentionConfig{
+			BatchSize: 10,
+		}, nowFunc, mockScope.NewTestScope())
+		_, err := retentionManager.Sweep(ctx, false)
+		assert.Error(t, err)
+	})
+}
+
+func TestRetentionPolicyMatching(t *testing.T) {
+	defaultPolicy := configs.RetentionPolicy{MaxVersions: 10}
+	retentionManager := &retentionManager{
+		config: configs.RetentionConfig{
+			DefaultPolicy: defaultPolicy,
+			Policies: []configs.DatasetRetentionPolicy{
+				{Project: "p1", Domain: "development", Policy: configs.RetentionPolicy{MaxVersions: 1}},
+				{Name: "flyte_task-my.module.*", Policy: configs.RetentionPolicy{MaxVersions: 2}},
+				{Project: "p1", Name: "exact", Policy: configs.RetentionPolicy{MaxVersions: 3}},
+			},
+		},
+	}
+
+	assert.Equal(t, 1, retentionManager.getPolicy(models.DatasetKey{Project: "p1", Domain: "development", Name: "exact"}).MaxVersions)
+	assert.Equal(t, 2, retentionManager.getPolicy(models.DatasetKey{Project: "p2", Domain: "production", Name: "flyte_task-my.module.fn"}).MaxVersions)
+	assert.Equal(t, 3, retentionManager.getPolicy(models.DatasetKey{Project: "p1", Domain: "production", Name: "exact"}).MaxVersions)
+	assert.Equal(t, defaultPolicy, retentionManager.getPolicy(models.DatasetKey{Project: "p2", Domain: "production", Name: "exact"}))
+	assert.Equal(t, defaultPolicy, retentionManager.getPolicy(models.DatasetKey{Project: "p2", Domain: "production", Name: "flyte_task-other"}))
+}
+
+func TestRetentionSweepLease(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	nowFunc := func() time.Time { return now }
+	retentionConfig := configs.RetentionConfig{SweepInterval: config.Duration{Duration: time.Hour}}
+	matchesLease := mock.MatchedBy(func(lease models.Reservation) bool {
+		return lease.ReservationKey == sweepLeaseKey && lease.ExpiresAt.Equal(now.Add(2*time.Hour))
+	})
+
+	getLeaseTestManager := func() (*retentionManager, *mocks.ReservationRepo) {
+		dcRepo := getRetentionTestRepo()
+		dcRepo.MockReservationRepo = &mocks.ReservationRepo{}
+		m := NewRetentionManager(dcRepo, createInmemoryDataStore(t, mockScope.NewTestScope()), "", retentionConfig, nowFunc,
+			mockScope.NewTestScope()).(*retentionManager)
+		return m, dcRepo.MockReservationRepo
+	}
+
+	t.Run("CreateLease", func(t *testing.T) {
+		m, reservationRepo := getLeaseTestManager()
+		reservationRepo.OnGetMatch(mock.Anything, sweepLeaseKey).Return(models.Reservation{}, errors.NewDataCatalogErrorf(codes.NotFound, "not found"))
+		reservationRepo.OnCreateMatch(mock.Anything, matchesLease, now).Return(nil)
+
+		acquired, err := m.tryAcquireLease(ctx)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("ExtendOwnLease", func(t *testing.T) {
+		m, reservationRepo := getLeaseTestManager()
+		reservationRepo.OnGetMatch(mock.Anything, sweepLeaseKey).Return(models.Reservation{
+			ReservationKey: sweepLeaseKey, OwnerID: m.ownerID, ExpiresAt: now.Add(time.Hour)}, nil)
+		reservationRepo.OnUpdateMatch(mock.Anything, matchesLease, now).Return(nil)
+
+		acquired, err := m.tryAcquireLease(ctx)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("TakeOverExpiredLease", func(t *testing.T) {
+		m, reservationRepo := getLeaseTestManager()
+		reservationRepo.OnGetMatch(mock.Anything, sweepLeaseKey).Return(models.Reservation{
+			ReservationKey: sweepLeaseKey, OwnerID: "other", ExpiresAt: now.Add(-time.Minute)}, nil)
+		reservationRepo.OnUpdateMatch(mock.Anything, matchesLease, now).Return(nil)
+
+		acquired, err := m.tryAcquireLease(ctx)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("LeaseHeldByOtherReplica", func(t *testing.T) {
+		m, reservationRepo := getLeaseTestManager()
+		reservationRepo.OnGetMatch(mock.Anything, sweepLeaseKey).Return(models.Reservation{
+			ReservationKey: sweepLeaseKey, OwnerID: "other", ExpiresAt: now.Add(time.Hour)}, nil)
+
+		acquired, err := m.tryAcquireLease(ctx)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+		reservationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("LostConcurrentAcquire", func(t *testing.T) {
+		m, reservationRepo := getLeaseTestManager()
+		reservationRepo.OnGetMatch(mock.Anything, sweepLeaseKey).Return(models.Reservation{}, errors.NewDataCatalogErrorf(codes.NotFound, "not found"))
+		reservationRepo.OnCreateMatch(mock.Anything, matchesLease, now).Return(errors.NewDataCatalogError(codes.FailedPrecondition, repo_errors.AlreadyExists))
+
+		acquired, err := m.tryAcquireLease(ctx)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+	})
+
+	t.Run("SkipSweepWithoutLease", func(t *testing.T) {
+		m, reservationRepo := getLeaseTestManager()
+		reservationRepo.OnGetMatch(mock.Anything, sweepLeaseKey).Return(models.Reservation{
+			ReservationKey: sweepLeaseKey, OwnerID: "other", ExpiresAt: now.Add(time.Hour)}, nil)
+		reservationRepo.OnDeleteMatch(mock.Anything, sweepLeaseKey, m.ownerID).Return(errors.NewDataCatalogErrorf(codes.NotFound, "not found"))
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		m.Run(cancelledCtx)
+		m.repo.DatasetRepo().(*mocks.DatasetRepo).AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+		reservationRepo.AssertCalled(t, "Delete", mock.Anything, sweepLeaseKey, m.ownerID)
+	})
+}
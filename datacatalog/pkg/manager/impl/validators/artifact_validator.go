@@ -11,6 +11,7 @@ const (
 	artifactID         = "artifactID"
 	artifactDataEntity = "artifactData"
 	artifactEntity     = "artifact"
+	artifactFilter     = "filter"
 )
 
 func ValidateGetArtifactRequest(request *datacatalog.GetArtifactRequest) error {
@@ -138,3 +139,40 @@ func ValidateUpdateArtifactRequest(request *datacatalog.UpdateArtifactRequest) e
 
 	return nil
 }
+
+func ValidateDeleteArtifactRequest(request *datacatalog.DeleteArtifactRequest) error {
+	// the dataset is always required, deleting by artifact ID alone might match artifacts of other datasets
+	if err := ValidateDatasetID(request.GetDataset()); err != nil {
+		return err
+	}
+
+	switch request.GetQueryHandle().(type) {
+	case *datacatalog.DeleteArtifactRequest_ArtifactId:
+		if err := ValidateEmptyStringField(request.GetArtifactId(), artifactID); err != nil {
+			return err
+		}
+	case *datacatalog.DeleteArtifactRequest_TagName:
+		if err := ValidateEmptyStringField(request.GetTagName(), tagName); err != nil {
+			return err
+		}
+	case nil:
+		return NewMissingArgumentError(fmt.Sprintf("one of %s/%s", artifactID, tagName))
+	default:
+		return NewInvalidArgumentError("QueryHandle", "invalid type")
+	}
+
+	return nil
+}
+
+// Validate the delete request, requiring at least one filter to avoid accidentally deleting all artifacts of a dataset
+func ValidateDeleteArtifactsRequest(request *datacatalog.DeleteArtifactsRequest) error {
+	if err := ValidateDatasetID(request.GetDataset()); err != nil {
+		return err
+	}
+
+	if len(request.GetFilter().GetFilters()) == 0 {
+		return NewMissingArgumentError(artifactFilter)
+	}
+
+	return ValidateArtifactFilterTypes(request.GetFilter().GetFilters())
+}
@@ -54,3 +54,8 @@ func ValidateListDatasetsRequest(request *datacatalog.ListDatasetsRequest) error
 	}
 	return nil
 }
+
+// Ensure delete Dataset request is properly constructed
+func ValidateDeleteDatasetRequest(request *datacatalog.DeleteDatasetRequest) error {
+	return ValidateDatasetID(request.GetDataset())
+}
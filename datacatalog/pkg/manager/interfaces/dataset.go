@@ -10,4 +10,5 @@ type DatasetManager interface {
 	CreateDataset(ctx context.Context, request *idl_datacatalog.CreateDatasetRequest) (*idl_datacatalog.CreateDatasetResponse, error)
 	GetDataset(ctx context.Context, request *idl_datacatalog.GetDatasetRequest) (*idl_datacatalog.GetDatasetResponse, error)
 	ListDatasets(ctx context.Context, request *idl_datacatalog.ListDatasetsRequest) (*idl_datacatalog.ListDatasetsResponse, error)
+	DeleteDataset(ctx context.Context, request *idl_datacatalog.DeleteDatasetRequest) (*idl_datacatalog.DeleteDatasetResponse, error)
 }
@@ -0,0 +1,38 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+)
+
+const (
+	// RetentionReasonTTL marks artifacts created longer ago than the TTL of their dataset's retention policy
+	RetentionReasonTTL = "ttl"
+	// RetentionReasonMaxVersions marks artifacts of dataset versions older than the most recent max-versions
+	RetentionReasonMaxVersions = "max-versions"
+)
+
+// ExpiredDataset lists the expired artifacts of a single dataset found during a retention sweep
+type ExpiredDataset struct {
+	Dataset     *datacatalog.DatasetID
+	Reason      string
+	ArtifactIDs []string
+}
+
+// RetentionReport summarizes a retention sweep. In a dry run, the expired artifacts are reported but not deleted.
+type RetentionReport struct {
+	DryRun           bool
+	Datasets         []ExpiredDataset
+	DeletedArtifacts int
+	DeletedData      int
+	Failures         int
+}
+
+// RetentionManager is the interface to delete artifacts that expired according to their dataset's retention policy
+type RetentionManager interface {
+	// Sweep deletes all currently expired artifacts, or only reports them if dryRun is set
+	Sweep(ctx context.Context, dryRun bool) (RetentionReport, error)
+	// Run sweeps periodically until the context is cancelled
+	Run(ctx context.Context)
+}
@@ -56,6 +56,47 @@ func (_m *DatasetManager) CreateDataset(ctx context.Context, request *datacatalo
 	return r0, r1
 }
 
+type DatasetManager_DeleteDataset struct {
+	*mock.Call
+}
+
+func (_m DatasetManager_DeleteDataset) Return(_a0 *datacatalog.DeleteDatasetResponse, _a1 error) *DatasetManager_DeleteDataset {
+	return &DatasetManager_DeleteDataset{Call: _m.Call.Return(_a0, _a1)}
+}
+
+func (_m *DatasetManager) OnDeleteDataset(ctx context.Context, request *datacatalog.DeleteDatasetRequest) *DatasetManager_DeleteDataset {
+	c_call := _m.On("DeleteDataset", ctx, request)
+	return &DatasetManager_DeleteDataset{Call: c_call}
+}
+
+func (_m *DatasetManager) OnDeleteDatasetMatch(matchers ...interface{}) *DatasetManager_DeleteDataset {
+	c_call := _m.On("DeleteDataset", matchers...)
+	return &DatasetManager_DeleteDataset{Call: c_call}
+}
+
+// DeleteDataset provides a mock function with given fields: ctx, request
+func (_m *DatasetManager) DeleteDataset(ctx context.Context, request *datacatalog.DeleteDatasetRequest) (*datacatalog.DeleteDatasetResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *datacatalog.DeleteDatasetResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datacatalog.DeleteDatasetRequest) *datacatalog.DeleteDatasetResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datacatalog.DeleteDatasetResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datacatalog.DeleteDatasetRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type DatasetManager_GetDataset struct {
 	*mock.Call
 }
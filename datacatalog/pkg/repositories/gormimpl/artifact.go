@@ -180,3 +180,64 @@ func (h *artifactRepo) Update(ctx context.Context, artifact models.Artifact) err
 
 	return nil
 }
+
+// Delete deletes the artifact along with its ArtifactData, partitions and the tags pointing to it in a single
+// transaction. The offloaded data in blob storage is left untouched.
+func (h *artifactRepo) Delete(ctx context.Context, in models.ArtifactKey) error {
+	timer := h.repoMetrics.DeleteDuration.Start(ctx)
+	defer timer.Stop()
+
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Error; err != nil {
+		return err
+	}
+
+	var artifact models.Artifact
+	if err := tx.Where(&models.Artifact{ArtifactKey: in}).Take(&artifact).Error; err != nil {
+		tx.Rollback()
+		if err.Error() == gorm.ErrRecordNotFound.Error() {
+			return errors.GetMissingEntityError(string(common.Artifact), &datacatalog.Artifact{
+				Dataset: &datacatalog.DatasetID{
+					Project: in.DatasetProject,
+					Domain:  in.DatasetDomain,
+					Name:    in.DatasetName,
+					Version: in.DatasetVersion,
+				},
+				Id: in.ArtifactID,
+			})
+		}
+		return h.errorTransformer.ToDataCatalogError(err)
+	}
+
+	if err := tx.Where("dataset_uuid = ? AND artifact_id = ?", artifact.DatasetUUID, artifact.ArtifactID).Delete(&models.Tag{}).Error; err != nil {
+		tx.Rollback()
+		return h.errorTransformer.ToDataCatalogError(err)
+	}
+
+	if err := tx.Where("dataset_uuid = ? AND artifact_id = ?", artifact.DatasetUUID, artifact.ArtifactID).Delete(&models.Partition{}).Error; err != nil {
+		tx.Rollback()
+		return h.errorTransformer.ToDataCatalogError(err)
+	}
+
+	if err := tx.Where(&models.ArtifactData{ArtifactKey: in}).Delete(&models.ArtifactData{}).Error; err != nil {
+		tx.Rollback()
+		return h.errorTransformer.ToDataCatalogError(err)
+	}
+
+	if err := tx.Where(&models.Artifact{ArtifactKey: in}).Delete(&models.Artifact{}).Error; err != nil {
+		tx.Rollback()
+		return h.errorTransformer.ToDataCatalogError(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return h.errorTransformer.ToDataCatalogError(err)
+	}
+
+	return nil
+}
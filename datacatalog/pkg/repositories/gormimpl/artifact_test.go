@@ -558,3 +558,48 @@ func TestUpdateArtifactError(t *testing.T) {
 		assert.True(t, artifactDataDeleted)
 	})
 }
+
+func TestDeleteArtifact(t *testing.T) {
+	ctx := context.Background()
+	artifact := getTestArtifact()
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "artifacts" WHERE "artifacts"."dataset_project" = $1 AND "artifacts"."dataset_name" = $2 AND "artifacts"."dataset_domain" = $3 AND "artifacts"."dataset_version" = $4 AND "artifacts"."artifact_id" = $5 LIMIT 1`).
+		WithReply(getDBArtifactResponse(artifact))
+
+	deletedTables := make(map[string]bool)
+	for _, query := range []string{
+		`DELETE FROM "tags" WHERE dataset_uuid = $1 AND artifact_id = $2`,
+		`DELETE FROM "partitions" WHERE dataset_uuid = $1 AND artifact_id = $2`,
+		`DELETE FROM "artifact_data" WHERE "artifact_data"."dataset_project" = $1 AND "artifact_data"."dataset_name" = $2 AND "artifact_data"."dataset_domain" = $3 AND "artifact_data"."dataset_version" = $4 AND "artifact_data"."artifact_id" = $5`,
+		`DELETE FROM "artifacts" WHERE "artifacts"."dataset_project" = $1 AND "artifacts"."dataset_name" = $2 AND "artifacts"."dataset_domain" = $3 AND "artifacts"."dataset_version" = $4 AND "artifacts"."artifact_id" = $5`,
+	} {
+		query := query
+		GlobalMock.NewMock().WithQuery(query).WithRowsNum(1).WithCallback(func(s string, values []driver.NamedValue) {
+			deletedTables[query] = true
+		})
+	}
+
+	artifactRepo := NewArtifactRepo(utils.GetDbForTest(t), errors.NewPostgresErrorTransformer(), promutils.NewTestScope())
+	err := artifactRepo.Delete(ctx, artifact.ArtifactKey)
+	assert.NoError(t, err)
+	assert.Len(t, deletedTables, 4)
+}
+
+func TestDeleteArtifactDoesNotExist(t *testing.T) {
+	artifact := getTestArtifact()
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	// by default mocket will return nil for any queries
+	artifactRepo := NewArtifactRepo(utils.GetDbForTest(t), errors.NewPostgresErrorTransformer(), promutils.NewTestScope())
+	err := artifactRepo.Delete(context.Background(), artifact.ArtifactKey)
+	assert.Error(t, err)
+	dcErr, ok := err.(apiErrors.DataCatalogError)
+	assert.True(t, ok)
+	assert.Equal(t, dcErr.Code(), codes.NotFound)
+}
@@ -67,6 +67,68 @@ func (h *dataSetRepo) Get(ctx context.Context, in models.DatasetKey) (models.Dat
 	return ds, nil
 }
 
+// Delete deletes the dataset along with its partition keys and all of its artifacts, their ArtifactData, partitions
+// and tags in a single transaction. The offloaded data in blob storage is left untouched.
+func (h *dataSetRepo) Delete(ctx context.Context, in models.DatasetKey) error {
+	timer := h.repoMetrics.DeleteDuration.Start(ctx)
+	defer timer.Stop()
+
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Error; err != nil {
+		return err
+	}
+
+	var dataset models.Dataset
+	if err := tx.Where(&models.Dataset{DatasetKey: in}).Take(&dataset).Error; err != nil {
+		tx.Rollback()
+		if err.Error() == gorm.ErrRecordNotFound.Error() {
+			return errors.GetMissingEntityError("Dataset", &idl_datacatalog.DatasetID{
+				Project: in.Project,
+				Domain:  in.Domain,
+				Name:    in.Name,
+				Version: in.Version,
+			})
+		}
+		return h.errorTransformer.ToDataCatalogError(err)
+	}
+
+	artifactDataKey := models.ArtifactData{ArtifactKey: models.ArtifactKey{
+		DatasetProject: dataset.Project,
+		DatasetName:    dataset.Name,
+		DatasetDomain:  dataset.Domain,
+		DatasetVersion: dataset.Version,
+	}}
+	for _, deletion := range []struct {
+		model interface{}
+		query interface{}
+		args  []interface{}
+	}{
+		{model: &models.Tag{}, query: "dataset_uuid = ?", args: []interface{}{dataset.UUID}},
+		{model: &models.Partition{}, query: "dataset_uuid = ?", args: []interface{}{dataset.UUID}},
+		{model: &models.ArtifactData{}, query: &artifactDataKey},
+		{model: &models.Artifact{}, query: "dataset_uuid = ?", args: []interface{}{dataset.UUID}},
+		{model: &models.PartitionKey{}, query: "dataset_uuid = ?", args: []interface{}{dataset.UUID}},
+		{model: &models.Dataset{}, query: &models.Dataset{DatasetKey: in}},
+	} {
+		if err := tx.Where(deletion.query, deletion.args...).Delete(deletion.model).Error; err != nil {
+			tx.Rollback()
+			return h.errorTransformer.ToDataCatalogError(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return h.errorTransformer.ToDataCatalogError(err)
+	}
+
+	return nil
+}
+
 func (h *dataSetRepo) List(ctx context.Context, in models.ListModelsInput) ([]models.Dataset, error) {
 	timer := h.repoMetrics.ListDuration.Start(ctx)
 	defer timer.Stop()
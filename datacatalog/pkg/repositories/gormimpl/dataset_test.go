@@ -321,3 +321,49 @@ func TestListDatasetWithFilter(t *testing.T) {
 	assert.Len(t, datasets[0].PartitionKeys, 1)
 	assert.Equal(t, datasets[0].PartitionKeys[0].Name, "key1")
 }
+
+func TestDeleteDataset(t *testing.T) {
+	dataset := getTestDataset()
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "datasets" WHERE "datasets"."project" = $1 AND "datasets"."name" = $2 AND "datasets"."domain" = $3 AND "datasets"."version" = $4 AND "datasets"."uuid" = $5 LIMIT 1`).
+		WithReply(getDBDatasetResponse(dataset))
+
+	deletedTables := make(map[string]bool)
+	for _, query := range []string{
+		`DELETE FROM "tags" WHERE dataset_uuid = $1`,
+		`DELETE FROM "partitions" WHERE dataset_uuid = $1`,
+		`DELETE FROM "artifact_data" WHERE "artifact_data"."dataset_project" = $1 AND "artifact_data"."dataset_name" = $2 AND "artifact_data"."dataset_domain" = $3 AND "artifact_data"."dataset_version" = $4`,
+		`DELETE FROM "artifacts" WHERE dataset_uuid = $1`,
+		`DELETE FROM "partition_keys" WHERE dataset_uuid = $1`,
+		`DELETE FROM "datasets" WHERE "datasets"."project" = $1 AND "datasets"."name" = $2 AND "datasets"."domain" = $3 AND "datasets"."version" = $4 AND "datasets"."uuid" = $5`,
+	} {
+		query := query
+		GlobalMock.NewMock().WithQuery(query).WithRowsNum(1).WithCallback(func(s string, values []driver.NamedValue) {
+			deletedTables[query] = true
+		})
+	}
+
+	datasetRepo := NewDatasetRepo(utils.GetDbForTest(t), errors.NewPostgresErrorTransformer(), promutils.NewTestScope())
+	err := datasetRepo.Delete(context.Background(), dataset.DatasetKey)
+	assert.NoError(t, err)
+	assert.Len(t, deletedTables, 6)
+}
+
+func TestDeleteDatasetNotFound(t *testing.T) {
+	dataset := getTestDataset()
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	// by default mocket will return nil for any queries
+	datasetRepo := NewDatasetRepo(utils.GetDbForTest(t), errors.NewPostgresErrorTransformer(), promutils.NewTestScope())
+	err := datasetRepo.Delete(context.Background(), dataset.DatasetKey)
+	assert.Error(t, err)
+	dcErr, ok := err.(datacatalog_error.DataCatalogError)
+	assert.True(t, ok)
+	assert.Equal(t, dcErr.Code(), codes.NotFound)
+}
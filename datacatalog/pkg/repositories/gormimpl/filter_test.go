@@ -16,6 +16,14 @@ func TestGormValueFilter(t *testing.T) {
 	assert.Equal(t, expression.Args, "region")
 }
 
+func TestGormValueFilterLessThan(t *testing.T) {
+	filter := NewGormValueFilter(common.LessThan, "created_at", "2024-01-01")
+	expression, err := filter.GetDBQueryExpression("artifacts")
+	assert.NoError(t, err)
+	assert.Equal(t, expression.Query, "artifacts.created_at < ?")
+	assert.Equal(t, expression.Args, "2024-01-01")
+}
+
 func TestGormValueFilterInvalidOperator(t *testing.T) {
 	filter := NewGormValueFilter(123, "key", "region")
 	_, err := filter.GetDBQueryExpression("partitions")
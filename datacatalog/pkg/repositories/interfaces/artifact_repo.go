@@ -13,4 +13,5 @@ type ArtifactRepo interface {
 	Get(ctx context.Context, in models.ArtifactKey) (models.Artifact, error)
 	List(ctx context.Context, datasetKey models.DatasetKey, in models.ListModelsInput) ([]models.Artifact, error)
 	Update(ctx context.Context, artifact models.Artifact) error
+	Delete(ctx context.Context, in models.ArtifactKey) error
 }
@@ -12,4 +12,5 @@ type DatasetRepo interface {
 	Create(ctx context.Context, in models.Dataset) error
 	Get(ctx context.Context, in models.DatasetKey) (models.Dataset, error)
 	List(ctx context.Context, in models.ListModelsInput) ([]models.Dataset, error)
+	Delete(ctx context.Context, in models.DatasetKey) error
 }
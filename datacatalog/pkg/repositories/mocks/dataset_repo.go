@@ -47,6 +47,38 @@ func (_m *DatasetRepo) Create(ctx context.Context, in models.Dataset) error {
 	return r0
 }
 
+type DatasetRepo_Delete struct {
+	*mock.Call
+}
+
+func (_m DatasetRepo_Delete) Return(_a0 error) *DatasetRepo_Delete {
+	return &DatasetRepo_Delete{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *DatasetRepo) OnDelete(ctx context.Context, in models.DatasetKey) *DatasetRepo_Delete {
+	c_call := _m.On("Delete", ctx, in)
+	return &DatasetRepo_Delete{Call: c_call}
+}
+
+func (_m *DatasetRepo) OnDeleteMatch(matchers ...interface{}) *DatasetRepo_Delete {
+	c_call := _m.On("Delete", matchers...)
+	return &DatasetRepo_Delete{Call: c_call}
+}
+
+// Delete provides a mock function with given fields: ctx, in
+func (_m *DatasetRepo) Delete(ctx context.Context, in models.DatasetKey) error {
+	ret := _m.Called(ctx, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetKey) error); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type DatasetRepo_Get struct {
 	*mock.Call
 }
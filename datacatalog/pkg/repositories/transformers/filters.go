@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"context"
+	"time"
 
 	"github.com/flyteorg/flyte/datacatalog/pkg/common"
 	"github.com/flyteorg/flyte/datacatalog/pkg/manager/impl/validators"
@@ -19,6 +20,7 @@ const (
 	domainFieldName         = "domain"
 	nameFieldName           = "name"
 	versionFieldName        = "version"
+	createdAtFieldName      = "created_at"
 )
 
 var comparisonOperatorMap = map[datacatalog.SinglePropertyFilter_ComparisonOperator]common.ComparisonOperator{
@@ -43,6 +45,14 @@ func FilterToListInput(ctx context.Context, sourceEntity common.Entity, filterEx
 	}, nil
 }
 
+// NewCreatedBeforeFilter returns a filter matching the models of the source entity created before the given time
+func NewCreatedBeforeFilter(sourceEntity common.Entity, createdBefore time.Time) models.ModelFilter {
+	return models.ModelFilter{
+		Entity:       sourceEntity,
+		ValueFilters: []models.ModelValueFilter{gormimpl.NewGormValueFilter(common.LessThan, createdAtFieldName, createdBefore)},
+	}
+}
+
 func constructModelFilter(ctx context.Context, singleFilter *datacatalog.SinglePropertyFilter, sourceEntity common.Entity) (models.ModelFilter, error) {
 	operator := comparisonOperatorMap[singleFilter.GetOperator()]
 	var modelFilter models.ModelFilter
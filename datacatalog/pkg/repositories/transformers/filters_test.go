@@ -3,6 +3,7 @@ package transformers
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -100,3 +101,12 @@ func TestEmptyFiledListInput(t *testing.T) {
 	_, err := FilterToListInput(context.Background(), common.Artifact, filter)
 	assert.Error(t, err)
 }
+
+func TestNewCreatedBeforeFilter(t *testing.T) {
+	createdBefore := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := NewCreatedBeforeFilter(common.Artifact, createdBefore)
+	assert.Equal(t, common.Artifact, filter.Entity)
+	assert.Nil(t, filter.JoinCondition)
+	assert.Len(t, filter.ValueFilters, 1)
+	assertFilterExpression(t, filter.ValueFilters[0], "artifacts", "artifacts.created_at < ?", createdBefore)
+}
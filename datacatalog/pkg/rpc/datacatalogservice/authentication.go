@@ -0,0 +1,75 @@
+package datacatalogservice
+
+import (
+	"context"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/runtime/configs"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+type identityKey struct{}
+
+// tokenVerifier verifies the raw access token and returns the identity of the caller it was issued to
+type tokenVerifier func(ctx context.Context, token string) (string, error)
+
+// identityFromContext returns the identity of the caller stored by the authentication interceptor
+func identityFromContext(ctx context.Context) (string, bool) {
+	identity, ok := ctx.Value(identityKey{}).(string)
+	return identity, ok && len(identity) > 0
+}
+
+// newTokenVerifier returns the verifier of the access tokens issued by the configured issuer or nil if no issuer is
+// configured
+func newTokenVerifier(ctx context.Context, cfg configs.AuthenticationConfig) tokenVerifier {
+	if len(cfg.Issuer) == 0 {
+		return nil
+	}
+
+	verifier := oidc.NewVerifier(cfg.Issuer, oidc.NewRemoteKeySet(ctx, cfg.JWKSURL), &oidc.Config{
+		ClientID:          cfg.Audience,
+		SkipClientIDCheck: len(cfg.Audience) == 0,
+	})
+	return func(ctx context.Context, token string) (string, error) {
+		idToken, err := verifier.Verify(ctx, token)
+		if err != nil {
+			return "", err
+		}
+		return idToken.Subject, nil
+	}
+}
+
+// newAuthenticationInterceptor returns an interceptor verifying the bearer access token of the requests and storing
+// the identity it was issued to in the request context. Requests without a token pass through unauthenticated,
+// requests with an invalid token are rejected.
+func newAuthenticationInterceptor(verify tokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		values := metadata.ValueFromIncomingContext(ctx, authorizationHeader)
+		if verify == nil || len(values) == 0 {
+			return handler(ctx, req)
+		}
+
+		if !strings.HasPrefix(values[0], bearerPrefix) {
+			return nil, status.Errorf(codes.Unauthenticated, "expected a bearer access token")
+		}
+
+		identity, err := verify(ctx, strings.TrimPrefix(values[0], bearerPrefix))
+		if err != nil {
+			logger.Infof(ctx, "Rejected %s request with an invalid access token, err: %v", info.FullMethod, err)
+			return nil, status.Errorf(codes.Unauthenticated, "invalid access token")
+		}
+
+		return handler(context.WithValue(ctx, identityKey{}, identity), req)
+	}
+}
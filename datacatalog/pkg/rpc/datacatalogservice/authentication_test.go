@@ -0,0 +1,63 @@
+package datacatalogservice
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/runtime/configs"
+	catalog "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+)
+
+func TestAuthenticationInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: catalog.DataCatalog_DeleteDataset_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		identity, _ := identityFromContext(ctx)
+		return identity, nil
+	}
+	verify := func(ctx context.Context, token string) (string, error) {
+		if token != "valid" {
+			return "", fmt.Errorf("invalid token")
+		}
+		return "alice", nil
+	}
+	withAuthorization := func(value string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, value))
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		resp, err := newAuthenticationInterceptor(verify)(withAuthorization("Bearer valid"), nil, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", resp)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := newAuthenticationInterceptor(verify)(withAuthorization("Bearer forged"), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("not a bearer token", func(t *testing.T) {
+		_, err := newAuthenticationInterceptor(verify)(withAuthorization("Basic YWxpY2U6"), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		resp, err := newAuthenticationInterceptor(verify)(context.Background(), nil, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "", resp)
+	})
+
+	t.Run("no issuer configured", func(t *testing.T) {
+		verifier := newTokenVerifier(context.Background(), configs.AuthenticationConfig{})
+		assert.Nil(t, verifier)
+		resp, err := newAuthenticationInterceptor(verifier)(withAuthorization("Bearer valid"), nil, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "", resp)
+	})
+}
@@ -0,0 +1,56 @@
+package datacatalogservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/runtime/configs"
+	catalog "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+var deletionMethods = map[string]bool{
+	catalog.DataCatalog_DeleteArtifact_FullMethodName:  true,
+	catalog.DataCatalog_DeleteArtifacts_FullMethodName: true,
+	catalog.DataCatalog_DeleteDataset_FullMethodName:   true,
+}
+
+// newDeletionAuthorizer returns an interceptor authorizing the requests deleting artifacts and datasets according to
+// the deletion config. Callers are identified by the authentication interceptor, all delete requests are denied if
+// callers are not authenticated. All other requests pass through.
+func newDeletionAuthorizer(cfg configs.DeletionConfig, authenticated bool) grpc.UnaryServerInterceptor {
+	allowedIdentities := make(map[string]bool, len(cfg.AllowedIdentities))
+	for _, identity := range cfg.AllowedIdentities {
+		allowedIdentities[identity] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !deletionMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		if !cfg.Enabled {
+			return nil, status.Errorf(codes.PermissionDenied, "deleting artifacts and datasets is disabled")
+		}
+
+		if !authenticated {
+			return nil, status.Errorf(codes.PermissionDenied, "deleting artifacts and datasets requires authentication to be configured")
+		}
+
+		identity, ok := identityFromContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "deleting artifacts and datasets requires an access token")
+		}
+
+		if len(allowedIdentities) > 0 && !allowedIdentities[identity] {
+			logger.Warnf(ctx, "Denied %s request of %s", info.FullMethod, identity)
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to delete artifacts and datasets", identity)
+		}
+
+		logger.Infof(ctx, "Authorized %s request of %s", info.FullMethod, identity)
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,69 @@
+package datacatalogservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/runtime/configs"
+	catalog "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+)
+
+func TestDeletionAuthorizer(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "handled", nil
+	}
+	deleteInfo := &grpc.UnaryServerInfo{FullMethod: catalog.DataCatalog_DeleteDataset_FullMethodName}
+	withIdentity := func(identity string) context.Context {
+		return context.WithValue(context.Background(), identityKey{}, identity)
+	}
+
+	t.Run("other methods pass through", func(t *testing.T) {
+		authorizer := newDeletionAuthorizer(configs.DeletionConfig{}, false)
+		resp, err := authorizer(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: catalog.DataCatalog_GetDataset_FullMethodName}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		authorizer := newDeletionAuthorizer(configs.DeletionConfig{}, true)
+		_, err := authorizer(withIdentity("alice"), nil, deleteInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("enabled without authentication", func(t *testing.T) {
+		authorizer := newDeletionAuthorizer(configs.DeletionConfig{Enabled: true}, false)
+		_, err := authorizer(withIdentity("alice"), nil, deleteInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("missing identity", func(t *testing.T) {
+		authorizer := newDeletionAuthorizer(configs.DeletionConfig{Enabled: true}, true)
+		_, err := authorizer(context.Background(), nil, deleteInfo, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("any authenticated identity", func(t *testing.T) {
+		authorizer := newDeletionAuthorizer(configs.DeletionConfig{Enabled: true}, true)
+		resp, err := authorizer(withIdentity("alice"), nil, deleteInfo, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+	})
+
+	t.Run("allowed identities", func(t *testing.T) {
+		authorizer := newDeletionAuthorizer(configs.DeletionConfig{
+			Enabled:           true,
+			AllowedIdentities: []string{"alice"},
+		}, true)
+		resp, err := authorizer(withIdentity("alice"), nil, deleteInfo, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+
+		_, err = authorizer(withIdentity("bob"), nil, deleteInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -67,6 +67,18 @@ func (s *DataCatalogService) UpdateArtifact(ctx context.Context, request *catalo
 	return s.ArtifactManager.UpdateArtifact(ctx, request)
 }
 
+func (s *DataCatalogService) DeleteArtifact(ctx context.Context, request *catalog.DeleteArtifactRequest) (*catalog.DeleteArtifactResponse, error) {
+	return s.ArtifactManager.DeleteArtifact(ctx, request)
+}
+
+func (s *DataCatalogService) DeleteArtifacts(ctx context.Context, request *catalog.DeleteArtifactsRequest) (*catalog.DeleteArtifactsResponse, error) {
+	return s.ArtifactManager.DeleteArtifacts(ctx, request)
+}
+
+func (s *DataCatalogService) DeleteDataset(ctx context.Context, request *catalog.DeleteDatasetRequest) (*catalog.DeleteDatasetResponse, error) {
+	return s.DatasetManager.DeleteDataset(ctx, request)
+}
+
 func (s *DataCatalogService) GetOrExtendReservation(ctx context.Context, request *catalog.GetOrExtendReservationRequest) (*catalog.GetOrExtendReservationResponse, error) {
 	return s.ReservationManager.GetOrExtendReservation(ctx, request)
 }
@@ -108,8 +120,15 @@ func NewDataCatalogService() *DataCatalogService {
 	repos := repositories.GetRepository(ctx, repositories.POSTGRES, *dbConfigValues, catalogScope)
 	logger.Infof(ctx, "Created DB connection.")
 
+	if dataCatalogConfig.Retention.Enabled {
+		retentionManager := impl.NewRetentionManager(repos, dataStorageClient, storagePrefix, dataCatalogConfig.Retention, time.Now,
+			catalogScope.NewSubScope("retention"))
+		go retentionManager.Run(ctx)
+		logger.Infof(ctx, "Started retention sweeper.")
+	}
+
 	return &DataCatalogService{
-		DatasetManager:  impl.NewDatasetManager(repos, dataStorageClient, catalogScope.NewSubScope("dataset")),
+		DatasetManager:  impl.NewDatasetManager(repos, dataStorageClient, storagePrefix, catalogScope.NewSubScope("dataset")),
 		ArtifactManager: impl.NewArtifactManager(repos, dataStorageClient, storagePrefix, catalogScope.NewSubScope("artifact")),
 		TagManager:      impl.NewTagManager(repos, dataStorageClient, catalogScope.NewSubScope("tag")),
 		ReservationManager: impl.NewReservationManager(repos, time.Duration(dataCatalogConfig.HeartbeatGracePeriodMultiplier), dataCatalogConfig.MaxReservationHeartbeat.Duration, time.Now,
@@ -131,14 +150,18 @@ func ServeInsecure(ctx context.Context, cfg *config.Config) error {
 }
 
 // Creates a new GRPC Server with all the configuration
-func newGRPCServer(_ context.Context, cfg *config.Config) *grpc.Server {
+func newGRPCServer(ctx context.Context, cfg *config.Config) *grpc.Server {
+	dataCatalogConfig := runtime.NewConfigurationProvider().ApplicationConfiguration().GetDataCatalogConfig()
+	verifier := newTokenVerifier(ctx, dataCatalogConfig.Authentication)
 	tracerProvider := otelutils.GetTracerProvider(otelutils.DataCatalogServerTracer)
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(
+		grpc.ChainUnaryInterceptor(
 			otelgrpc.UnaryServerInterceptor(
 				otelgrpc.WithTracerProvider(tracerProvider),
 				otelgrpc.WithPropagators(propagation.TraceContext{}),
 			),
+			newAuthenticationInterceptor(verifier),
+			newDeletionAuthorizer(dataCatalogConfig.Deletion, verifier != nil),
 		),
 	)
 	catalog.RegisterDataCatalogServer(grpcServer, NewDataCatalogService())
@@ -14,13 +14,68 @@ var defaultConfig = &DataCatalogConfig{
 	ProfilerPort:                   10254,
 	HeartbeatGracePeriodMultiplier: 3,
 	MaxReservationHeartbeat:        config.Duration{Duration: time.Second * 10},
+	Retention: RetentionConfig{
+		SweepInterval: config.Duration{Duration: time.Hour},
+		BatchSize:     100,
+	},
 }
 
 // DataCatalogConfig is the base configuration to start datacatalog
 type DataCatalogConfig struct {
-	StoragePrefix                  string          `json:"storage-prefix" pflag:",StoragePrefix specifies the prefix where DataCatalog stores offloaded ArtifactData in CloudStorage. If not specified, the data will be stored in the base container directly."`
-	MetricsScope                   string          `json:"metrics-scope" pflag:",Scope that the metrics will record under."`
-	ProfilerPort                   int             `json:"profiler-port" pflag:",Port that the profiling service is listening on."`
-	HeartbeatGracePeriodMultiplier int             `json:"heartbeat-grace-period-multiplier" pflag:",Number of heartbeats before a reservation expires without an extension."`
-	MaxReservationHeartbeat        config.Duration `json:"max-reservation-heartbeat" pflag:",The maximum available reservation extension heartbeat interval."`
+	StoragePrefix                  string               `json:"storage-prefix" pflag:",StoragePrefix specifies the prefix where DataCatalog stores offloaded ArtifactData in CloudStorage. If not specified, the data will be stored in the base container directly."`
+	MetricsScope                   string               `json:"metrics-scope" pflag:",Scope that the metrics will record under."`
+	ProfilerPort                   int                  `json:"profiler-port" pflag:",Port that the profiling service is listening on."`
+	HeartbeatGracePeriodMultiplier int                  `json:"heartbeat-grace-period-multiplier" pflag:",Number of heartbeats before a reservation expires without an extension."`
+	MaxReservationHeartbeat        config.Duration      `json:"max-reservation-heartbeat" pflag:",The maximum available reservation extension heartbeat interval."`
+	Retention                      RetentionConfig      `json:"retention" pflag:",Retention policies for artifacts and their offloaded data."`
+	Deletion                       DeletionConfig       `json:"deletion" pflag:",Authorization of the requests deleting artifacts and datasets."`
+	Authentication                 AuthenticationConfig `json:"authentication" pflag:",Verification of the access tokens identifying the callers."`
+}
+
+// DeletionConfig authorizes the DeleteArtifact, DeleteArtifacts and DeleteDataset requests. Callers are identified by
+// their access token verified according to the AuthenticationConfig, all delete requests are denied if no token issuer
+// is configured.
+type DeletionConfig struct {
+	Enabled bool `json:"enabled" pflag:",Allows deleting artifacts and datasets. All delete requests are denied if disabled."`
+	// AllowedIdentities restricts the delete requests to the listed callers. Any authenticated caller may delete if empty.
+	AllowedIdentities []string `json:"allowed-identities" pflag:",Subjects of the access tokens allowed to delete artifacts and datasets. Any authenticated caller is allowed if empty."`
+}
+
+// AuthenticationConfig configures the verification of the bearer access tokens sent by the callers, e.g. the tokens
+// flytepropeller and flytectl obtain from the flyteadmin authorization server. The subject of a valid token identifies
+// the caller. Requests without a token are served unauthenticated, requests with an invalid token are rejected.
+type AuthenticationConfig struct {
+	Issuer   string `json:"issuer" pflag:",Issuer of the access tokens. Callers are not authenticated if empty."`
+	JWKSURL  string `json:"jwks-url" pflag:",URL of the JSON web key set the access tokens are signed with."`
+	Audience string `json:"audience" pflag:",Audience the access tokens need to be issued for. The audience is not checked if empty."`
+}
+
+// RetentionConfig configures the sweeper deleting artifacts that expired according to their dataset's retention policy
+type RetentionConfig struct {
+	Enabled       bool            `json:"enabled" pflag:",Enables the background sweeper deleting expired artifacts."`
+	SweepInterval config.Duration `json:"sweep-interval" pflag:",Interval between two sweeps for expired artifacts."`
+	DryRun        bool            `json:"dry-run" pflag:",Only report the artifacts the sweeper would delete without deleting them."`
+	DeleteData    bool            `json:"delete-data" pflag:",Also deletes the offloaded ArtifactData of expired artifacts from blob storage."`
+	BatchSize     int             `json:"batch-size" pflag:",Number of datasets or artifacts read per database query while sweeping."`
+	// DefaultPolicy applies to all datasets not matching any of the Policies.
+	DefaultPolicy RetentionPolicy `json:"default-policy" pflag:",Retention policy of datasets not matching any of the dataset policies."`
+	// Policies are matched against datasets in order, the first match applies.
+	Policies []DatasetRetentionPolicy `json:"policies" pflag:"-"`
+}
+
+// RetentionPolicy defines when the artifacts of a dataset expire. The zero value keeps artifacts forever.
+type RetentionPolicy struct {
+	TTL config.Duration `json:"ttl" pflag:",Artifacts created longer ago than the TTL expire. 0 disables the TTL."`
+	// MaxVersions keeps the artifacts of the most recently created versions of a dataset, e.g. the latest cache versions
+	// of a task, and expires the artifacts of all older versions.
+	MaxVersions int `json:"max-versions" pflag:",Number of most recent dataset versions whose artifacts are kept. 0 keeps all versions."`
+}
+
+// DatasetRetentionPolicy is a retention policy for the datasets matching project, domain and name. Empty fields match
+// any value and a name ending in * matches all dataset names with the given prefix, e.g. "flyte_task-my.module.*".
+type DatasetRetentionPolicy struct {
+	Project string          `json:"project"`
+	Domain  string          `json:"domain"`
+	Name    string          `json:"name"`
+	Policy  RetentionPolicy `json:"policy"`
 }
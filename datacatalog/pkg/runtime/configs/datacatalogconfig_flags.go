@@ -55,5 +55,17 @@ func (cfg DataCatalogConfig) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "profiler-port"), defaultConfig.ProfilerPort, "Port that the profiling service is listening on.")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "heartbeat-grace-period-multiplier"), defaultConfig.HeartbeatGracePeriodMultiplier, "Number of heartbeats before a reservation expires without an extension.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "max-reservation-heartbeat"), defaultConfig.MaxReservationHeartbeat.String(), "The maximum available reservation extension heartbeat interval.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "retention.enabled"), defaultConfig.Retention.Enabled, "Enables the background sweeper deleting expired artifacts.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "retention.sweep-interval"), defaultConfig.Retention.SweepInterval.String(), "Interval between two sweeps for expired artifacts.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "retention.dry-run"), defaultConfig.Retention.DryRun, "Only report the artifacts the sweeper would delete without deleting them.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "retention.delete-data"), defaultConfig.Retention.DeleteData, "Also deletes the offloaded ArtifactData of expired artifacts from blob storage.")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "retention.batch-size"), defaultConfig.Retention.BatchSize, "Number of datasets or artifacts read per database query while sweeping.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "retention.default-policy.ttl"), defaultConfig.Retention.DefaultPolicy.TTL.String(), "Artifacts created longer ago than the TTL expire. 0 disables the TTL.")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "retention.default-policy.max-versions"), defaultConfig.Retention.DefaultPolicy.MaxVersions, "Number of most recent dataset versions whose artifacts are kept. 0 keeps all versions.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "deletion.enabled"), defaultConfig.Deletion.Enabled, "Allows deleting artifacts and datasets. All delete requests are denied if disabled.")
+	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "deletion.allowed-identities"), defaultConfig.Deletion.AllowedIdentities, "Subjects of the access tokens allowed to delete artifacts and datasets. Any authenticated caller is allowed if empty.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authentication.issuer"), defaultConfig.Authentication.Issuer, "Issuer of the access tokens. Callers are not authenticated if empty.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authentication.jwks-url"), defaultConfig.Authentication.JWKSURL, "URL of the JSON web key set the access tokens are signed with.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "authentication.audience"), defaultConfig.Authentication.Audience, "Audience the access tokens need to be issued for. The audience is not checked if empty.")
 	return cmdFlags
 }
@@ -169,4 +169,172 @@ func TestDataCatalogConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_retention.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("retention.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("retention.enabled"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vBool), &actual.Retention.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_retention.sweep-interval", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultConfig.Retention.SweepInterval.String()
+
+			cmdFlags.Set("retention.sweep-interval", testValue)
+			if vString, err := cmdFlags.GetString("retention.sweep-interval"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vString), &actual.Retention.SweepInterval)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_retention.dry-run", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("retention.dry-run", testValue)
+			if vBool, err := cmdFlags.GetBool("retention.dry-run"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vBool), &actual.Retention.DryRun)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_retention.delete-data", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("retention.delete-data", testValue)
+			if vBool, err := cmdFlags.GetBool("retention.delete-data"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vBool), &actual.Retention.DeleteData)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_retention.batch-size", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("retention.batch-size", testValue)
+			if vInt, err := cmdFlags.GetInt("retention.batch-size"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vInt), &actual.Retention.BatchSize)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_retention.default-policy.ttl", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultConfig.Retention.DefaultPolicy.TTL.String()
+
+			cmdFlags.Set("retention.default-policy.ttl", testValue)
+			if vString, err := cmdFlags.GetString("retention.default-policy.ttl"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vString), &actual.Retention.DefaultPolicy.TTL)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_retention.default-policy.max-versions", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("retention.default-policy.max-versions", testValue)
+			if vInt, err := cmdFlags.GetInt("retention.default-policy.max-versions"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vInt), &actual.Retention.DefaultPolicy.MaxVersions)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_deletion.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("deletion.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("deletion.enabled"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vBool), &actual.Deletion.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_deletion.allowed-identities", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := join_DataCatalogConfig(defaultConfig.Deletion.AllowedIdentities, ",")
+
+			cmdFlags.Set("deletion.allowed-identities", testValue)
+			if vStringSlice, err := cmdFlags.GetStringSlice("deletion.allowed-identities"); err == nil {
+				testDecodeRaw_DataCatalogConfig(t, join_DataCatalogConfig(vStringSlice, ","), &actual.Deletion.AllowedIdentities)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authentication.issuer", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authentication.issuer", testValue)
+			if vString, err := cmdFlags.GetString("authentication.issuer"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vString), &actual.Authentication.Issuer)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authentication.jwks-url", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authentication.jwks-url", testValue)
+			if vString, err := cmdFlags.GetString("authentication.jwks-url"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vString), &actual.Authentication.JWKSURL)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_authentication.audience", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("authentication.audience", testValue)
+			if vString, err := cmdFlags.GetString("authentication.audience"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vString), &actual.Authentication.Audience)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
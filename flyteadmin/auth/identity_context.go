@@ -11,6 +11,8 @@ import (
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils"
 )
 
+const groupsClaim = "groups"
+
 var (
 	emptyIdentityContext = IdentityContext{}
 )
@@ -77,6 +79,25 @@ func (c IdentityContext) Claims() map[string]interface{} {
 	return make(map[string]interface{})
 }
 
+// Groups returns the groups the identity is a member of, read from the groups claim of its token.
+func (c IdentityContext) Groups() sets.String {
+	groups := sets.NewString()
+	switch claim := c.Claims()[groupsClaim].(type) {
+	case string:
+		groups.Insert(claim)
+	case []string:
+		groups.Insert(claim...)
+	case []interface{}:
+		for _, group := range claim {
+			if name, ok := group.(string); ok {
+				groups.Insert(name)
+			}
+		}
+	}
+
+	return groups
+}
+
 func (c IdentityContext) WithContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, ContextKeyIdentityContext, c)
 }
@@ -33,3 +33,21 @@ func TestWithExecutionUserIdentifier(t *testing.T) {
 	assert.Equal(t, "", idctx.ExecutionIdentity())
 	assert.Equal(t, "byhsu", newIDCtx.ExecutionIdentity())
 }
+
+func TestGroups(t *testing.T) {
+	noClaimsCtx, err := NewIdentityContext("", "", "", time.Now(), nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, noClaimsCtx.Groups())
+
+	withGroupsCtx, err := NewIdentityContext("", "", "", time.Now(), nil, nil, map[string]interface{}{
+		"groups": []interface{}{"g1", "g2", 3},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, sets.NewString("g1", "g2"), withGroupsCtx.Groups())
+
+	withGroupCtx, err := NewIdentityContext("", "", "", time.Now(), nil, nil, map[string]interface{}{
+		"groups": "g1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, sets.NewString("g1"), withGroupCtx.Groups())
+}
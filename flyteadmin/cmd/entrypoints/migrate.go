@@ -46,9 +46,20 @@ var seedProjectsCmd = &cobra.Command{
 	},
 }
 
+// This backfills the filter columns of the executions created before they were added
+var backfillExecutionFiltersCmd = &cobra.Command{
+	Use:   "backfill-execution-filters",
+	Short: "Backfill the labels, annotations and inputs the existing executions can be filtered by.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		return server.BackfillExecutionFilterColumns(ctx)
+	},
+}
+
 func init() {
 	RootCmd.AddCommand(parentMigrateCmd)
 	parentMigrateCmd.AddCommand(migrateCmd)
 	parentMigrateCmd.AddCommand(rollbackCmd)
 	parentMigrateCmd.AddCommand(seedProjectsCmd)
+	parentMigrateCmd.AddCommand(backfillExecutionFiltersCmd)
 }
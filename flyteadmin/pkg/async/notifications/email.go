@@ -101,9 +101,16 @@ var getTemplateValueFuncs = map[string]GetTemplateValue{
 }
 
 func substituteEmailParameters(message string, request *admin.WorkflowExecutionEventRequest, execution *admin.Execution) string {
+	return substituteParameters(message, request, execution, func(value string) string { return value })
+}
+
+// substituteParameters replaces the templates in message with the escaped values of the execution.
+func substituteParameters(message string, request *admin.WorkflowExecutionEventRequest, execution *admin.Execution,
+	escape func(string) string) string {
 	for template, function := range getTemplateValueFuncs {
-		message = strings.Replace(message, fmt.Sprintf(substitutionParam, template), function(request, execution), replaceAllInstances)
-		message = strings.Replace(message, fmt.Sprintf(substitutionParamNoSpaces, template), function(request, execution), replaceAllInstances)
+		value := escape(function(request, execution))
+		message = strings.Replace(message, fmt.Sprintf(substitutionParam, template), value, replaceAllInstances)
+		message = strings.Replace(message, fmt.Sprintf(substitutionParamNoSpaces, template), value, replaceAllInstances)
 	}
 	return message
 }
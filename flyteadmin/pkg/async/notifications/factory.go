@@ -132,6 +132,30 @@ func GetEmailer(config runtimeInterfaces.NotificationsConfig, scope promutils.Sc
 
 }
 
+// getNotificationSender returns the emailer routing the messages addressed to the configured webhooks to their
+// publishers.
+func getNotificationSender(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope, sm core.SecretManager) interfaces.Emailer {
+
+	emailer := GetEmailer(config, scope, sm)
+
+	if len(config.NotificationsWebhooksConfig) == 0 {
+
+		return emailer
+
+	}
+
+	webhooks := make(map[string]interfaces.Emailer, len(config.NotificationsWebhooksConfig))
+
+	for _, webhook := range config.NotificationsWebhooksConfig {
+
+		webhooks[webhook.Name] = implementations.NewWebhookPublisher(context.Background(), webhook, scope, sm)
+
+	}
+
+	return implementations.NewNotificationRouter(emailer, webhooks)
+
+}
+
 func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope, sm core.SecretManager) interfaces.Processor {
 
 	reconnectAttempts := config.ReconnectAttempts
@@ -193,7 +217,7 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 
 		}
 
-		emailer = GetEmailer(config, scope, sm)
+		emailer = getNotificationSender(config, scope, sm)
 
 		return implementations.NewProcessor(sub, emailer, scope)
 
@@ -225,13 +249,13 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 
 		}
 
-		emailer = GetEmailer(config, scope, sm)
+		emailer = getNotificationSender(config, scope, sm)
 
 		return implementations.NewGcpProcessor(sub, emailer, scope)
 
 	case common.Sandbox:
 
-		emailer = GetEmailer(config, scope, sm)
+		emailer = getNotificationSender(config, scope, sm)
 
 		return implementations.NewSandboxProcessor(msgChan, emailer)
 
@@ -0,0 +1,48 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// webhookTargetPrefix starts the recipient of the messages published for a webhook. It can't be mistaken for an email
+// address.
+const webhookTargetPrefix = "webhook://"
+
+// WebhookTarget returns the recipient of the messages published for the webhook named name.
+func WebhookTarget(name string) string {
+	return webhookTargetPrefix + name
+}
+
+// NotificationRouter sends the messages targeting a configured webhook through the publisher of that webhook and the
+// remaining ones through the emailer.
+type NotificationRouter struct {
+	emailer  interfaces.Emailer
+	webhooks map[string]interfaces.Emailer
+}
+
+func (r *NotificationRouter) SendEmail(ctx context.Context, email *admin.EmailMessage) error {
+	recipients := email.GetRecipientsEmail()
+	if len(recipients) != 1 || !strings.HasPrefix(recipients[0], webhookTargetPrefix) {
+		return r.emailer.SendEmail(ctx, email)
+	}
+
+	name := strings.TrimPrefix(recipients[0], webhookTargetPrefix)
+	webhook, ok := r.webhooks[name]
+	if !ok {
+		return fmt.Errorf("notification targets the unknown webhook [%s]", name)
+	}
+
+	return webhook.SendEmail(ctx, email)
+}
+
+func NewNotificationRouter(emailer interfaces.Emailer, webhooks map[string]interfaces.Emailer) interfaces.Emailer {
+	return &NotificationRouter{
+		emailer:  emailer,
+		webhooks: webhooks,
+	}
+}
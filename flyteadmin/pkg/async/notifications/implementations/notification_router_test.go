@@ -0,0 +1,36 @@
+package implementations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+func TestNotificationRouter(t *testing.T) {
+	ctx := context.Background()
+	var emailed, posted []*admin.EmailMessage
+	var emailer mocks.MockEmailer
+	emailer.SetSendEmailFunc(func(ctx context.Context, email *admin.EmailMessage) error {
+		emailed = append(emailed, email)
+		return nil
+	})
+	var webhook mocks.MockEmailer
+	webhook.SetSendEmailFunc(func(ctx context.Context, email *admin.EmailMessage) error {
+		posted = append(posted, email)
+		return nil
+	})
+	router := NewNotificationRouter(&emailer, map[string]interfaces.Emailer{"oncall": &webhook})
+
+	assert.NoError(t, router.SendEmail(ctx, &admin.EmailMessage{RecipientsEmail: []string{WebhookTarget("oncall")}}))
+	// Recipients named like a webhook are still emailed.
+	assert.NoError(t, router.SendEmail(ctx, &admin.EmailMessage{RecipientsEmail: []string{"oncall"}}))
+	assert.NoError(t, router.SendEmail(ctx, &admin.EmailMessage{RecipientsEmail: []string{"owner@example.com"}}))
+	assert.Error(t, router.SendEmail(ctx, &admin.EmailMessage{RecipientsEmail: []string{WebhookTarget("unknown")}}))
+	assert.Len(t, posted, 1)
+	assert.Len(t, emailed, 2)
+}
@@ -0,0 +1,261 @@
+package implementations
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+const (
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of "<timestamp>.<body>" prefixed with "sha256=".
+	SignatureHeader = "X-Flyte-Signature"
+	// SignatureTimestampHeader holds the unix time the request was signed at. Receivers should reject old requests.
+	SignatureTimestampHeader = "X-Flyte-Signature-Timestamp"
+
+	defaultWebhookMaxAttempts = 3
+	defaultWebhookBackoff     = time.Second
+	defaultWebhookTimeout     = 10 * time.Second
+)
+
+// WebhookType selects the format of the requests posted to a webhook.
+type WebhookType = string
+
+const (
+	Webhook WebhookType = "webhook"
+	Slack   WebhookType = "slack"
+	Teams   WebhookType = "teams"
+)
+
+// NotificationType is the type of the launch plan notifications a webhook is selected for.
+type NotificationType = string
+
+const (
+	EmailNotification     NotificationType = "email"
+	SlackNotification     NotificationType = "slack"
+	PagerDutyNotification NotificationType = "pager_duty"
+)
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// teamsMessage is the MessageCard format accepted by MS Teams incoming webhooks.
+type teamsMessage struct {
+	Type    string `json:"@type"`
+	Context string `json:"@context"`
+	Summary string `json:"summary"`
+	Title   string `json:"title"`
+	Text    string `json:"text"`
+}
+
+// WebhookPublisher posts the notifications addressed to a single webhook to its HTTP endpoint. The body of the message
+// holds the rendered webhook body which is sent as is for the webhook type and wrapped into a Slack or MS Teams
+// message otherwise.
+type WebhookPublisher struct {
+	name          string
+	webhookType   WebhookType
+	url           string
+	headers       map[string]string
+	signingKey    []byte
+	maxAttempts   int
+	backoff       time.Duration
+	client        *http.Client
+	systemMetrics emailMetrics
+	retries       prometheus.Counter
+}
+
+func (w *WebhookPublisher) getPayload(email *admin.EmailMessage) ([]byte, error) {
+	switch w.webhookType {
+	case Slack:
+		return json.Marshal(slackMessage{Text: email.GetBody()})
+	case Teams:
+		return json.Marshal(teamsMessage{
+			Type:    "MessageCard",
+			Context: "http://schema.org/extensions",
+			Summary: email.GetSubjectLine(),
+			Title:   email.GetSubjectLine(),
+			Text:    email.GetBody(),
+		})
+	default:
+		if !json.Valid([]byte(email.GetBody())) {
+			return nil, fmt.Errorf("webhook body is not valid JSON")
+		}
+		return []byte(email.GetBody()), nil
+	}
+}
+
+// sign computes the signature of the payload sent at timestamp.
+func sign(key []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// post sends the payload once. The returned bool is true if the request is worth retrying.
+func (w *WebhookPublisher) post(ctx context.Context, payload []byte) (bool, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range w.headers {
+		request.Header.Set(key, value)
+	}
+
+	if len(w.signingKey) > 0 {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		request.Header.Set(SignatureTimestampHeader, timestamp)
+		request.Header.Set(SignatureHeader, sign(w.signingKey, timestamp, payload))
+	}
+
+	response, err := w.client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	defer response.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+
+	retryable := response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError
+	return retryable, fmt.Errorf("webhook responded with status %v", response.Status)
+}
+
+func (w *WebhookPublisher) SendEmail(ctx context.Context, email *admin.EmailMessage) error {
+	w.systemMetrics.SendTotal.Inc()
+	payload, err := w.getPayload(email)
+	if err != nil {
+		return w.emailError(ctx, fmt.Sprintf("Error creating %s payload: %s", w.name, err))
+	}
+
+	backoff := w.backoff
+	for attempt := 1; ; attempt++ {
+		retryable, err := w.post(ctx, payload)
+		if err == nil {
+			w.systemMetrics.SendSuccess.Inc()
+			return nil
+		}
+
+		if !retryable || attempt >= w.maxAttempts {
+			return w.emailError(ctx, fmt.Sprintf("Error posting %s notification after %d attempts: %s",
+				w.name, attempt, err))
+		}
+
+		logger.Warnf(ctx, "Attempt %d to post %s notification failed, retrying in %v: %s", attempt, w.name,
+			backoff, err)
+		w.retries.Inc()
+		select {
+		case <-ctx.Done():
+			return w.emailError(ctx, fmt.Sprintf("Error posting %s notification: %s", w.name, ctx.Err()))
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (w *WebhookPublisher) emailError(ctx context.Context, error string) error {
+	w.systemMetrics.SendError.Inc()
+	logger.Error(ctx, error)
+	return errors.NewFlyteAdminErrorf(codes.Internal, "errors were seen while posting notifications")
+}
+
+func NewWebhookPublisher(ctx context.Context, webhookConf runtimeInterfaces.NotificationsWebhookConfig,
+	scope promutils.Scope, sm core.SecretManager) interfaces.Emailer {
+	name := webhookConf.Name
+	if name == "" {
+		panic(fmt.Errorf("notifications webhooks need a name"))
+	}
+
+	switch webhookConf.Type {
+	case Webhook, Slack, Teams:
+	default:
+		panic(fmt.Errorf("unknown type %q of the %s notifications webhook", webhookConf.Type, name))
+	}
+
+	for _, notificationType := range webhookConf.NotificationTypes {
+		switch notificationType {
+		case EmailNotification, SlackNotification, PagerDutyNotification:
+		default:
+			panic(fmt.Errorf("unknown notification type %q selected by the %s notifications webhook",
+				notificationType, name))
+		}
+	}
+
+	url := webhookConf.URL
+	if webhookConf.URLSecretName != "" {
+		var err error
+		url, err = sm.Get(ctx, webhookConf.URLSecretName)
+		if err != nil {
+			panic(fmt.Errorf("failed to read the %s webhook url from secret %s: %w", name,
+				webhookConf.URLSecretName, err))
+		}
+	}
+
+	if url == "" {
+		panic(fmt.Errorf("no url configured for the %s notifications webhook", name))
+	}
+
+	var signingKey []byte
+	if webhookConf.SigningSecretName != "" {
+		key, err := sm.Get(ctx, webhookConf.SigningSecretName)
+		if err != nil {
+			panic(fmt.Errorf("failed to read the %s webhook signing key from secret %s: %w", name,
+				webhookConf.SigningSecretName, err))
+		}
+		signingKey = []byte(key)
+	}
+
+	maxAttempts := webhookConf.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultWebhookMaxAttempts
+	}
+
+	backoff := webhookConf.Backoff.Duration
+	if backoff <= 0 {
+		backoff = defaultWebhookBackoff
+	}
+
+	timeout := webhookConf.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+
+	webhookScope := scope.NewSubScope(promutils.SanitizeMetricName(name))
+	return &WebhookPublisher{
+		name:          name,
+		webhookType:   webhookConf.Type,
+		url:           url,
+		headers:       webhookConf.Headers,
+		signingKey:    signingKey,
+		maxAttempts:   maxAttempts,
+		backoff:       backoff,
+		client:        &http.Client{Timeout: timeout},
+		systemMetrics: newEmailMetrics(webhookScope),
+		retries:       webhookScope.MustNewCounter("send_retry", "Number of retried attempts to post notifications"),
+	}
+}
@@ -0,0 +1,173 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+var webhookEmail = &admin.EmailMessage{
+	SubjectLine: "Execution e124 failed",
+	Body:        `{"name": "e124", "phase": "failed"}`,
+}
+
+func getWebhookConfig(webhookType, url string) runtimeInterfaces.NotificationsWebhookConfig {
+	return runtimeInterfaces.NotificationsWebhookConfig{
+		Name:              "oncall-" + webhookType,
+		Type:              webhookType,
+		NotificationTypes: []NotificationType{SlackNotification},
+		URL:               url,
+		Headers:           map[string]string{"X-Team": "oncall"},
+		MaxAttempts:       3,
+		Backoff:           config.Duration{Duration: time.Millisecond},
+	}
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("webhook with signature", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, webhookEmail.GetBody(), string(body))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, "oncall", r.Header.Get("X-Team"))
+			timestamp := r.Header.Get(SignatureTimestampHeader)
+			assert.Equal(t, sign([]byte("signing-key"), timestamp, body), r.Header.Get(SignatureHeader))
+		}))
+		defer server.Close()
+
+		sm := &mocks.SecretManager{}
+		sm.OnGetMatch(ctx, "signing").Return("signing-key", nil)
+		cfg := getWebhookConfig(Webhook, server.URL)
+		cfg.SigningSecretName = "signing"
+		publisher := NewWebhookPublisher(ctx, cfg, promutils.NewTestScope(), sm)
+		assert.NoError(t, publisher.SendEmail(ctx, webhookEmail))
+	})
+
+	t.Run("invalid webhook body", func(t *testing.T) {
+		publisher := NewWebhookPublisher(ctx, getWebhookConfig(Webhook, "http://localhost"), promutils.NewTestScope(),
+			&mocks.SecretManager{})
+		assert.Error(t, publisher.SendEmail(ctx, &admin.EmailMessage{Body: "not json"}))
+	})
+
+	t.Run("slack", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			message := slackMessage{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+			assert.Equal(t, webhookEmail.GetBody(), message.Text)
+		}))
+		defer server.Close()
+
+		sm := &mocks.SecretManager{}
+		sm.OnGetMatch(ctx, "slack-url").Return(server.URL, nil)
+		cfg := getWebhookConfig(Slack, "")
+		cfg.URLSecretName = "slack-url"
+		publisher := NewWebhookPublisher(ctx, cfg, promutils.NewTestScope(), sm)
+		assert.NoError(t, publisher.SendEmail(ctx, webhookEmail))
+	})
+
+	t.Run("teams", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			message := teamsMessage{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+			assert.Equal(t, "MessageCard", message.Type)
+			assert.Equal(t, webhookEmail.GetSubjectLine(), message.Title)
+			assert.Equal(t, webhookEmail.GetBody(), message.Text)
+		}))
+		defer server.Close()
+
+		publisher := NewWebhookPublisher(ctx, getWebhookConfig(Teams, server.URL), promutils.NewTestScope(),
+			&mocks.SecretManager{})
+		assert.NoError(t, publisher.SendEmail(ctx, webhookEmail))
+	})
+
+	t.Run("retries server errors", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer server.Close()
+
+		publisher := NewWebhookPublisher(ctx, getWebhookConfig(Webhook, server.URL), promutils.NewTestScope(),
+			&mocks.SecretManager{})
+		assert.NoError(t, publisher.SendEmail(ctx, webhookEmail))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		publisher := NewWebhookPublisher(ctx, getWebhookConfig(Webhook, server.URL), promutils.NewTestScope(),
+			&mocks.SecretManager{})
+		assert.Error(t, publisher.SendEmail(ctx, webhookEmail))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("doesn't retry client errors", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		publisher := NewWebhookPublisher(ctx, getWebhookConfig(Webhook, server.URL), promutils.NewTestScope(),
+			&mocks.SecretManager{})
+		assert.Error(t, publisher.SendEmail(ctx, webhookEmail))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("missing url", func(t *testing.T) {
+		defer func() { assert.NotNil(t, recover()) }()
+		NewWebhookPublisher(ctx, getWebhookConfig(Webhook, ""), promutils.NewTestScope(), &mocks.SecretManager{})
+		t.Errorf("did not panic")
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		defer func() { assert.NotNil(t, recover()) }()
+		NewWebhookPublisher(ctx, getWebhookConfig("pager", "http://localhost"), promutils.NewTestScope(),
+			&mocks.SecretManager{})
+		t.Errorf("did not panic")
+	})
+
+	t.Run("unknown notification type", func(t *testing.T) {
+		defer func() { assert.NotNil(t, recover()) }()
+		cfg := getWebhookConfig(Webhook, "http://localhost")
+		cfg.NotificationTypes = []NotificationType{"sms"}
+		NewWebhookPublisher(ctx, cfg, promutils.NewTestScope(), &mocks.SecretManager{})
+		t.Errorf("did not panic")
+	})
+
+	t.Run("missing url secret", func(t *testing.T) {
+		defer func() { assert.NotNil(t, recover()) }()
+		sm := &mocks.SecretManager{}
+		sm.OnGetMatch(ctx, "missing").Return("", fmt.Errorf("not found"))
+		cfg := getWebhookConfig(Webhook, "")
+		cfg.URLSecretName = "missing"
+		NewWebhookPublisher(ctx, cfg, promutils.NewTestScope(), sm)
+		t.Errorf("did not panic")
+	})
+}
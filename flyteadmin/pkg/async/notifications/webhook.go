@@ -0,0 +1,81 @@
+package notifications
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/implementations"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+const defaultWebhookBody = `{"project": "{{ project }}", "domain": "{{ domain }}", "name": "{{ name }}", ` +
+	`"phase": "{{ phase }}", "error": "{{ error }}", "launch_plan": {"project": "{{ launch_plan.project }}", ` +
+	`"domain": "{{ launch_plan.domain }}", "name": "{{ launch_plan.name }}", "version": "{{ launch_plan.version }}"}}`
+
+const defaultChatBody = "Execution {{ name }} in {{ project }}/{{ domain }} has {{ phase }}.{{ error }}"
+
+// escapeJSONString escapes value to be embedded within the quotes of a JSON string.
+func escapeJSONString(value string) string {
+	escaped, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(string(escaped), `"`), `"`)
+}
+
+// toWebhookMessageFromWorkflowExecutionEvent renders the body of webhook for the execution. The webhook publisher
+// posts the body as is for the webhook type and wraps it into a chat message for slack and teams.
+func toWebhookMessageFromWorkflowExecutionEvent(
+	config runtimeInterfaces.NotificationsConfig,
+	webhook runtimeInterfaces.NotificationsWebhookConfig,
+	request *admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) *admin.EmailMessage {
+
+	body := webhook.Body
+	var rendered string
+	if webhook.Type == implementations.Webhook {
+		if body == "" {
+			body = defaultWebhookBody
+		}
+		rendered = substituteParameters(body, request, execution, escapeJSONString)
+	} else {
+		if body == "" {
+			body = defaultChatBody
+		}
+		rendered = substituteEmailParameters(body, request, execution)
+	}
+
+	return &admin.EmailMessage{
+		SubjectLine: substituteEmailParameters(config.NotificationsEmailerConfig.Subject, request, execution),
+		SenderEmail: config.NotificationsEmailerConfig.Sender,
+		// The processor routes the message to the webhook it targets.
+		RecipientsEmail: []string{implementations.WebhookTarget(webhook.Name)},
+		Body:            rendered,
+	}
+}
+
+// ToNotificationMessagesFromWorkflowExecutionEvent converts a terminal execution event into the messages to publish
+// for a notification of type notificationType: one per configured webhook selecting the type, rendered with the body
+// of that webhook, or an email to the recipients if no webhook selects it.
+func ToNotificationMessagesFromWorkflowExecutionEvent(
+	config runtimeInterfaces.NotificationsConfig,
+	notificationType string,
+	emailNotification *admin.EmailNotification,
+	request *admin.WorkflowExecutionEventRequest,
+	execution *admin.Execution) []*admin.EmailMessage {
+
+	var messages []*admin.EmailMessage
+	for _, webhook := range config.NotificationsWebhooksConfig {
+		if slices.Contains(webhook.NotificationTypes, notificationType) {
+			messages = append(messages, toWebhookMessageFromWorkflowExecutionEvent(config, webhook, request, execution))
+		}
+	}
+
+	if len(messages) == 0 {
+		messages = append(messages, ToEmailMessageFromWorkflowExecutionEvent(config, emailNotification, request, execution))
+	}
+
+	return messages
+}
@@ -0,0 +1,96 @@
+package notifications
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/implementations"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
+)
+
+func getWebhookNotificationsConfig(webhookType, body string) runtimeInterfaces.NotificationsConfig {
+	return runtimeInterfaces.NotificationsConfig{
+		NotificationsEmailerConfig: runtimeInterfaces.NotificationsEmailerConfig{
+			Subject: "Execution {{ name }} {{ phase }}",
+			Body:    "Execution {{ name }} {{ phase }}",
+		},
+		NotificationsWebhooksConfig: []runtimeInterfaces.NotificationsWebhookConfig{
+			{
+				Name:              "oncall",
+				Type:              webhookType,
+				NotificationTypes: []string{implementations.SlackNotification},
+				Body:              body,
+			},
+		},
+	}
+}
+
+func TestToNotificationMessagesFromWorkflowExecutionEvent(t *testing.T) {
+	emailNotification := &admin.EmailNotification{RecipientsEmail: []string{"oncall@example.com"}}
+	request := &admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase: core.WorkflowExecution_FAILED,
+			OutputResult: &event.WorkflowExecutionEvent_Error{
+				Error: &core.ExecutionError{
+					Message: "task \"t1\" failed\nwith a multi-line error",
+				},
+			},
+		},
+	}
+
+	t.Run("default webhook body is valid JSON", func(t *testing.T) {
+		cfg := getWebhookNotificationsConfig(implementations.Webhook, "")
+		messages := ToNotificationMessagesFromWorkflowExecutionEvent(cfg, implementations.SlackNotification, emailNotification, request, workflowExecution)
+		assert.Len(t, messages, 1)
+		message := messages[0]
+
+		var body map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(message.GetBody()), &body))
+		assert.Equal(t, executionNameValue, body["name"])
+		assert.Equal(t, "failed", body["phase"])
+		assert.Contains(t, body["error"], "task \"t1\" failed\nwith a multi-line error")
+		assert.Equal(t, launchPlanVersionValue, body["launch_plan"].(map[string]interface{})["version"])
+		assert.Equal(t, "Execution e124 failed", message.GetSubjectLine())
+		assert.Equal(t, []string{implementations.WebhookTarget("oncall")}, message.GetRecipientsEmail())
+	})
+
+	t.Run("custom webhook body", func(t *testing.T) {
+		cfg := getWebhookNotificationsConfig(implementations.Webhook, `{"id": "{{ project }}/{{ domain }}/{{ name }}"}`)
+		messages := ToNotificationMessagesFromWorkflowExecutionEvent(cfg, implementations.SlackNotification, emailNotification, request, workflowExecution)
+		assert.Len(t, messages, 1)
+		message := messages[0]
+		assert.Equal(t, `{"id": "proj/prod/e124"}`, message.GetBody())
+	})
+
+	t.Run("chat body is plain text", func(t *testing.T) {
+		cfg := getWebhookNotificationsConfig(implementations.Slack, "")
+		messages := ToNotificationMessagesFromWorkflowExecutionEvent(cfg, implementations.SlackNotification, emailNotification, request, workflowExecution)
+		assert.Len(t, messages, 1)
+		message := messages[0]
+		assert.Equal(t, "Execution e124 in proj/prod has failed. The execution failed with error: "+
+			"[task \"t1\" failed\nwith a multi-line error].", message.GetBody())
+	})
+
+	t.Run("notification types not selected by a webhook are emailed", func(t *testing.T) {
+		cfg := getWebhookNotificationsConfig(implementations.Slack, "")
+		messages := ToNotificationMessagesFromWorkflowExecutionEvent(cfg, implementations.EmailNotification,
+			emailNotification, request, workflowExecution)
+		if assert.Len(t, messages, 1) {
+			assert.Equal(t, []string{"oncall@example.com"}, messages[0].GetRecipientsEmail())
+			assert.Equal(t, "Execution e124 failed", messages[0].GetBody())
+		}
+	})
+
+	t.Run("no webhooks", func(t *testing.T) {
+		messages := ToNotificationMessagesFromWorkflowExecutionEvent(runtimeInterfaces.NotificationsConfig{},
+			implementations.SlackNotification, emailNotification, request, workflowExecution)
+		if assert.Len(t, messages, 1) {
+			assert.Equal(t, []string{"oncall@example.com"}, messages[0].GetRecipientsEmail())
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// CronTzDirective prefixes the IANA timezone a cron expression is evaluated in.
+	CronTzDirective   = "CRON_TZ="
+	tzDirective       = "TZ="
+	calendarDirective = "CALENDAR="
+)
+
+// CronScheduleSpec is a cron schedule string split into the plain cron expression and the directives prefixing it.
+// The schedule string of a launch plan may start with any of
+//
+//	CRON_TZ=<IANA timezone> or TZ=<IANA timezone> to evaluate the expression in the given timezone
+//	CALENDAR=<name> to skip the dates listed in the named exclusion calendar of the scheduler
+//
+// e.g. "CRON_TZ=Europe/Berlin CALENDAR=de-holidays 0 9 * * MON-FRI"
+type CronScheduleSpec struct {
+	Expression        string
+	Timezone          string
+	ExclusionCalendar string
+}
+
+// ParseCronSchedule splits the directives off the schedule string and validates the timezone and the cron expression.
+func ParseCronSchedule(schedule string) (CronScheduleSpec, error) {
+	spec := CronScheduleSpec{}
+	fields := strings.Fields(schedule)
+	for len(fields) > 0 {
+		field := fields[0]
+		if strings.HasPrefix(field, CronTzDirective) {
+			spec.Timezone = strings.TrimPrefix(field, CronTzDirective)
+		} else if strings.HasPrefix(field, tzDirective) {
+			spec.Timezone = strings.TrimPrefix(field, tzDirective)
+		} else if strings.HasPrefix(field, calendarDirective) {
+			spec.ExclusionCalendar = strings.TrimPrefix(field, calendarDirective)
+			if len(spec.ExclusionCalendar) == 0 {
+				return CronScheduleSpec{}, fmt.Errorf("empty exclusion calendar name in schedule %v", schedule)
+			}
+		} else {
+			break
+		}
+		fields = fields[1:]
+	}
+
+	spec.Expression = strings.Join(fields, " ")
+	if len(spec.Timezone) > 0 {
+		if _, err := time.LoadLocation(spec.Timezone); err != nil {
+			return CronScheduleSpec{}, fmt.Errorf("invalid timezone %v in schedule %v: %w", spec.Timezone, schedule, err)
+		}
+	}
+
+	if _, err := cron.ParseStandard(spec.Expression); err != nil {
+		return CronScheduleSpec{}, err
+	}
+
+	return spec, nil
+}
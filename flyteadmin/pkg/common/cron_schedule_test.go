@@ -0,0 +1,38 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	t.Run("plain expression", func(t *testing.T) {
+		spec, err := ParseCronSchedule("0 19 * * *")
+		assert.Nil(t, err)
+		assert.Equal(t, CronScheduleSpec{Expression: "0 19 * * *"}, spec)
+	})
+	t.Run("timezone and calendar", func(t *testing.T) {
+		spec, err := ParseCronSchedule("CRON_TZ=Europe/Berlin CALENDAR=holidays 0 9 * * MON-FRI")
+		assert.Nil(t, err)
+		assert.Equal(t, CronScheduleSpec{Expression: "0 9 * * MON-FRI", Timezone: "Europe/Berlin",
+			ExclusionCalendar: "holidays"}, spec)
+	})
+	t.Run("tz directive", func(t *testing.T) {
+		spec, err := ParseCronSchedule("TZ=America/New_York @daily")
+		assert.Nil(t, err)
+		assert.Equal(t, CronScheduleSpec{Expression: "@daily", Timezone: "America/New_York"}, spec)
+	})
+	t.Run("unknown timezone", func(t *testing.T) {
+		_, err := ParseCronSchedule("CRON_TZ=Mars/Olympus_Mons 0 9 * * *")
+		assert.NotNil(t, err)
+	})
+	t.Run("empty calendar", func(t *testing.T) {
+		_, err := ParseCronSchedule("CALENDAR= 0 9 * * *")
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid expression", func(t *testing.T) {
+		_, err := ParseCronSchedule("CRON_TZ=Europe/Berlin 0 9 * *")
+		assert.NotNil(t, err)
+	})
+}
@@ -4,6 +4,8 @@ package common
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 
@@ -31,6 +33,13 @@ const (
 	NotEqual
 	ValueIn
 	ValueNotIn
+	ContainsCaseInsensitive
+)
+
+// SQL dialects, as reported by gorm.Dialector.Name(), for which filters are compiled differently.
+const (
+	PostgresDialect = "postgres"
+	SQLiteDialect   = "sqlite"
 )
 
 // String formats for various filter expression queries
@@ -47,18 +56,27 @@ const (
 	notEqualQuery           = "%s <> ?"
 	valueInQuery            = "%s in (?)"
 	valueNotInQuery         = "%s not in (?)"
+	iContainsQuery          = "%s ILIKE ?"
+	// SQLite has no ILIKE, lower case both sides instead.
+	iContainsSQLiteQuery = "LOWER(%s) LIKE LOWER(?)"
+	// JSON path fields are compared as text.
+	jsonFieldQuery = "%s ->> '%s'"
+	// SQLite has no JSON operators, it needs to be built with the JSON functions, e.g. with the sqlite_json build tag
+	// of go-sqlite3. The columns are read as text since newer versions take blobs for their binary JSON format.
+	jsonFieldSQLiteQuery = `json_extract(CAST(%s AS TEXT), '$."%s"')`
 )
 
 // Set of available filters which exclusively accept a single argument value.
 var singleValueFilters = map[FilterExpression]bool{
-	Contains:           true,
-	NotLike:            true,
-	GreaterThan:        true,
-	GreaterThanOrEqual: true,
-	LessThan:           true,
-	LessThanOrEqual:    true,
-	Equal:              true,
-	NotEqual:           true,
+	Contains:                true,
+	NotLike:                 true,
+	GreaterThan:             true,
+	GreaterThanOrEqual:      true,
+	LessThan:                true,
+	LessThanOrEqual:         true,
+	Equal:                   true,
+	NotEqual:                true,
+	ContainsCaseInsensitive: true,
 }
 
 // Set of available filters which exclusively accept repeated argument values.
@@ -80,19 +98,21 @@ var filterNameMappings = map[string]FilterExpression{
 	"ne":            NotEqual,
 	"value_in":      ValueIn,
 	"value_not_in":  ValueNotIn,
+	"icontains":     ContainsCaseInsensitive,
 }
 
 var filterQueryMappings = map[FilterExpression]string{
-	Contains:           containsQuery,
-	NotLike:            notLikeQuery,
-	GreaterThan:        greaterThanQuery,
-	GreaterThanOrEqual: greaterThanOrEqualQuery,
-	LessThan:           lessThanQuery,
-	LessThanOrEqual:    lessThanOrEqualQuery,
-	Equal:              equalQuery,
-	NotEqual:           notEqualQuery,
-	ValueIn:            valueInQuery,
-	ValueNotIn:         valueNotInQuery,
+	Contains:                containsQuery,
+	NotLike:                 notLikeQuery,
+	GreaterThan:             greaterThanQuery,
+	GreaterThanOrEqual:      greaterThanOrEqualQuery,
+	LessThan:                lessThanQuery,
+	LessThanOrEqual:         lessThanOrEqualQuery,
+	Equal:                   equalQuery,
+	NotEqual:                notEqualQuery,
+	ValueIn:                 valueInQuery,
+	ValueNotIn:              valueNotInQuery,
+	ContainsCaseInsensitive: iContainsQuery,
 }
 
 var executionIdentifierFields = map[string]bool{
@@ -108,6 +128,20 @@ var executionIdentifierEntities = map[Entity]bool{
 	TaskExecution: true,
 }
 
+// JSON columns which are filtered on by key rather than as a whole, e.g. "labels.team" or "input.date", mapped to the
+// database column holding the JSON object.
+var jsonFieldColumns = map[Entity]map[string]string{
+	Execution: {
+		"labels":      "labels",
+		"annotations": "annotations",
+		"input":       "inputs",
+	},
+}
+
+// Keys of JSON path fields are inlined in the query, restrict them to the characters valid in label keys and input
+// names.
+var jsonFieldKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_./-]+$`)
+
 var entityMetadataFields = map[string]bool{
 	"description": true,
 	"state":       true,
@@ -140,6 +174,8 @@ func getFilterExpressionName(expression FilterExpression) string {
 		return "value in"
 	case ValueNotIn:
 		return "value not in"
+	case ContainsCaseInsensitive:
+		return "contains case insensitive"
 	default:
 		return ""
 	}
@@ -179,11 +215,47 @@ type InlineFilter interface {
 	// Include other db-specific methods here.
 }
 
+// DialectInlineFilter is implemented by the inline filters which compile to different queries depending on the SQL
+// dialect, InlineFilter.GetGormQueryExpr and InlineFilter.GetGormJoinTableQueryExpr compile them for postgres.
+type DialectInlineFilter interface {
+	// Same as GetGormQueryExpr but compiled for the given SQL dialect.
+	GetDialectGormQueryExpr(dialect string) (GormQueryExpr, error)
+	// Same as GetGormJoinTableQueryExpr but compiled for the given SQL dialect.
+	GetDialectGormJoinTableQueryExpr(dialect, tableName string) (GormQueryExpr, error)
+}
+
+// IsJSONColumn returns true if the field is a JSON column of the entity which can only be filtered on by key.
+func IsJSONColumn(entity Entity, field string) bool {
+	for _, column := range jsonFieldColumns[entity] {
+		if column == field {
+			return true
+		}
+	}
+	return false
+}
+
+// IsJSONPathField returns true if the field references a key of a JSON column of the entity, e.g. "labels.team".
+func IsJSONPathField(entity Entity, field string) bool {
+	_, _, ok := splitJSONPathField(entity, field)
+	return ok
+}
+
+func splitJSONPathField(entity Entity, field string) (column string, key string, ok bool) {
+	prefix, key, found := strings.Cut(field, ".")
+	if !found {
+		return "", "", false
+	}
+	column, ok = jsonFieldColumns[entity][prefix]
+	return column, key, ok
+}
+
 // FilterInterface implementation. Only one of value or repeatedValue should ever be populated (based on the function).
 type inlineFilterImpl struct {
-	entity        Entity
-	function      FilterExpression
-	field         string
+	entity   Entity
+	function FilterExpression
+	field    string
+	// Set when the filter applies to a key of the JSON column field.
+	jsonKey       string
 	value         interface{}
 	repeatedValue interface{}
 }
@@ -196,7 +268,18 @@ func (f *inlineFilterImpl) GetField() string {
 	return f.field
 }
 
-func (f *inlineFilterImpl) getGormQueryExpr(formattedField string) (GormQueryExpr, error) {
+// formatField returns the expression for the filtered column, extracting the JSON key for JSON path fields.
+func (f *inlineFilterImpl) formatField(dialect, column string) string {
+	if len(f.jsonKey) == 0 {
+		return column
+	}
+	if dialect == SQLiteDialect {
+		return fmt.Sprintf(jsonFieldSQLiteQuery, column, f.jsonKey)
+	}
+	return fmt.Sprintf(jsonFieldQuery, column, f.jsonKey)
+}
+
+func (f *inlineFilterImpl) getGormQueryExpr(dialect, formattedField string) (GormQueryExpr, error) {
 
 	// Filters that use repeated values
 	if _, ok := repeatedValueFilters[f.function]; ok {
@@ -215,6 +298,16 @@ func (f *inlineFilterImpl) getGormQueryExpr(formattedField string) (GormQueryExp
 			// args renders to something like: "%value%"
 			Args: fmt.Sprintf(containsArgs, f.value),
 		}, nil
+	case ContainsCaseInsensitive:
+		query := iContainsQuery
+		if dialect == SQLiteDialect {
+			query = iContainsSQLiteQuery
+		}
+		return GormQueryExpr{
+			// WHERE field ILIKE %value%
+			Query: fmt.Sprintf(query, formattedField),
+			Args:  fmt.Sprintf(containsArgs, f.value),
+		}, nil
 	case NotLike:
 		return GormQueryExpr{
 			// WHERE field NOT LIKE value
@@ -263,12 +356,20 @@ func (f *inlineFilterImpl) getGormQueryExpr(formattedField string) (GormQueryExp
 }
 
 func (f *inlineFilterImpl) GetGormQueryExpr() (GormQueryExpr, error) {
-	return f.getGormQueryExpr(f.field)
+	return f.GetDialectGormQueryExpr(PostgresDialect)
 }
 
 func (f *inlineFilterImpl) GetGormJoinTableQueryExpr(tableName string) (GormQueryExpr, error) {
-	formattedField := fmt.Sprintf(joinArgsFormat, tableName, f.field)
-	return f.getGormQueryExpr(formattedField)
+	return f.GetDialectGormJoinTableQueryExpr(PostgresDialect, tableName)
+}
+
+func (f *inlineFilterImpl) GetDialectGormQueryExpr(dialect string) (GormQueryExpr, error) {
+	return f.getGormQueryExpr(dialect, f.formatField(dialect, f.field))
+}
+
+func (f *inlineFilterImpl) GetDialectGormJoinTableQueryExpr(dialect, tableName string) (GormQueryExpr, error) {
+	formattedField := f.formatField(dialect, fmt.Sprintf(joinArgsFormat, tableName, f.field))
+	return f.getGormQueryExpr(dialect, formattedField)
 }
 
 func customizeField(field string, entity Entity) string {
@@ -296,19 +397,38 @@ func customizeEntity(field string, entity Entity) Entity {
 	return entity
 }
 
+// newInlineFilter resolves the column filtered on, JSON path fields such as "labels.team" filter on the key of a JSON
+// column.
+func newInlineFilter(entity Entity, function FilterExpression, field string) (*inlineFilterImpl, error) {
+	if column, key, ok := splitJSONPathField(entity, field); ok {
+		if !jsonFieldKeyRegex.MatchString(key) {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid key in filter field: %s", field)
+		}
+		return &inlineFilterImpl{
+			entity:   entity,
+			function: function,
+			field:    column,
+			jsonKey:  key,
+		}, nil
+	}
+	return &inlineFilterImpl{
+		entity:   customizeEntity(field, entity),
+		function: function,
+		field:    customizeField(field, entity),
+	}, nil
+}
+
 // Returns a filter which uses a single argument value.
 func NewSingleValueFilter(entity Entity, function FilterExpression, field string, value interface{}) (InlineFilter, error) {
 	if _, ok := singleValueFilters[function]; !ok {
 		return nil, GetInvalidSingleValueFilterErr(function)
 	}
-	customizedField := customizeField(field, entity)
-	customizedEntity := customizeEntity(field, entity)
-	return &inlineFilterImpl{
-		entity:   customizedEntity,
-		function: function,
-		field:    customizedField,
-		value:    value,
-	}, nil
+	filter, err := newInlineFilter(entity, function, field)
+	if err != nil {
+		return nil, err
+	}
+	filter.value = value
+	return filter, nil
 }
 
 // Returns a filter which uses a repeated argument value.
@@ -316,15 +436,12 @@ func NewRepeatedValueFilter(entity Entity, function FilterExpression, field stri
 	if _, ok := repeatedValueFilters[function]; !ok {
 		return nil, GetInvalidRepeatedValueFilterErr(function)
 	}
-	customizedField := customizeField(field, entity)
-	customizedEntity := customizeEntity(field, entity)
-
-	return &inlineFilterImpl{
-		entity:        customizedEntity,
-		function:      function,
-		field:         customizedField,
-		repeatedValue: repeatedValue,
-	}, nil
+	filter, err := newInlineFilter(entity, function, field)
+	if err != nil {
+		return nil, err
+	}
+	filter.repeatedValue = repeatedValue
+	return filter, nil
 }
 
 func NewInlineFilter(entity Entity, function string, field string, value interface{}) (InlineFilter, error) {
@@ -366,13 +483,22 @@ type withDefaultValueFilter struct {
 }
 
 func (f *withDefaultValueFilter) GetGormQueryExpr() (GormQueryExpr, error) {
-	formattedField := fmt.Sprintf(queryWithDefaultFmt, f.GetField(), f.defaultValue)
-	return f.getGormQueryExpr(formattedField)
+	return f.GetDialectGormQueryExpr(PostgresDialect)
 }
 
 func (f *withDefaultValueFilter) GetGormJoinTableQueryExpr(tableName string) (GormQueryExpr, error) {
-	formattedField := fmt.Sprintf(queryWithDefaultFmt, fmt.Sprintf(joinArgsFormat, tableName, f.GetField()), f.defaultValue)
-	return f.getGormQueryExpr(formattedField)
+	return f.GetDialectGormJoinTableQueryExpr(PostgresDialect, tableName)
+}
+
+func (f *withDefaultValueFilter) GetDialectGormQueryExpr(dialect string) (GormQueryExpr, error) {
+	formattedField := fmt.Sprintf(queryWithDefaultFmt, f.formatField(dialect, f.GetField()), f.defaultValue)
+	return f.getGormQueryExpr(dialect, formattedField)
+}
+
+func (f *withDefaultValueFilter) GetDialectGormJoinTableQueryExpr(dialect, tableName string) (GormQueryExpr, error) {
+	formattedField := fmt.Sprintf(queryWithDefaultFmt,
+		f.formatField(dialect, fmt.Sprintf(joinArgsFormat, tableName, f.GetField())), f.defaultValue)
+	return f.getGormQueryExpr(dialect, formattedField)
 }
 
 func NewWithDefaultValueFilter(defaultValue interface{}, filter InlineFilter) (InlineFilter, error) {
@@ -106,14 +106,15 @@ func TestGetGormJoinTableQueryExpr(t *testing.T) {
 }
 
 var expectedArgsForFilters = map[FilterExpression]string{
-	Contains:           "%value%",
-	NotLike:            "value",
-	GreaterThan:        "value",
-	GreaterThanOrEqual: "value",
-	LessThan:           "value",
-	LessThanOrEqual:    "value",
-	Equal:              "value",
-	NotEqual:           "value",
+	Contains:                "%value%",
+	NotLike:                 "value",
+	GreaterThan:             "value",
+	GreaterThanOrEqual:      "value",
+	LessThan:                "value",
+	LessThanOrEqual:         "value",
+	Equal:                   "value",
+	NotEqual:                "value",
+	ContainsCaseInsensitive: "%value%",
 }
 
 func TestQueryExpressions(t *testing.T) {
@@ -185,3 +186,50 @@ func TestNotLikeFilter(t *testing.T) {
 	assert.Equal(t, "named_entity_metadata.name NOT LIKE ?", queryExpression.Query)
 	assert.Equal(t, ".flytegen%", queryExpression.Args)
 }
+
+func TestContainsCaseInsensitiveFilter(t *testing.T) {
+	filter, err := NewInlineFilter(Execution, "icontains", "name", "Report")
+	assert.NoError(t, err)
+
+	queryExpression, err := filter.(DialectInlineFilter).GetDialectGormJoinTableQueryExpr(PostgresDialect, "executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "executions.execution_name ILIKE ?", queryExpression.Query)
+	assert.Equal(t, "%Report%", queryExpression.Args)
+
+	queryExpression, err = filter.(DialectInlineFilter).GetDialectGormJoinTableQueryExpr(SQLiteDialect, "executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "LOWER(executions.execution_name) LIKE LOWER(?)", queryExpression.Query)
+	assert.Equal(t, "%Report%", queryExpression.Args)
+}
+
+func TestJSONPathFilter(t *testing.T) {
+	assert.True(t, IsJSONColumn(Execution, "labels"))
+	assert.False(t, IsJSONColumn(Execution, "phase"))
+	assert.True(t, IsJSONPathField(Execution, "input.date"))
+	assert.False(t, IsJSONPathField(Task, "input.date"))
+
+	filter, err := NewSingleValueFilter(Execution, Equal, "labels.app.kubernetes.io/name", "flyte")
+	assert.NoError(t, err)
+	assert.Equal(t, Execution, filter.GetEntity())
+	assert.Equal(t, "labels", filter.GetField())
+
+	queryExpression, err := filter.GetGormQueryExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, "labels ->> 'app.kubernetes.io/name' = ?", queryExpression.Query)
+	assert.Equal(t, "flyte", queryExpression.Args)
+
+	queryExpression, err = filter.(DialectInlineFilter).GetDialectGormJoinTableQueryExpr(SQLiteDialect, "executions")
+	assert.NoError(t, err)
+	assert.Equal(t, `json_extract(CAST(executions.labels AS TEXT), '$."app.kubernetes.io/name"') = ?`, queryExpression.Query)
+
+	filter, err = NewRepeatedValueFilter(Execution, ValueIn, "input.region", []string{"eu", "us"})
+	assert.NoError(t, err)
+
+	queryExpression, err = filter.GetGormJoinTableQueryExpr("executions")
+	assert.NoError(t, err)
+	assert.Equal(t, "executions.inputs ->> 'region' in (?)", queryExpression.Query)
+	assert.Equal(t, []string{"eu", "us"}, queryExpression.Args)
+
+	_, err = NewSingleValueFilter(Execution, Equal, "annotations.a' OR 1=1 --", "x")
+	assert.Error(t, err)
+}
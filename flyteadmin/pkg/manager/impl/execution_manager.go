@@ -13,11 +13,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/flyteorg/flyte/flyteadmin/auth"
 	cloudeventInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/async/cloudevent/interfaces"
 	eventWriter "github.com/flyteorg/flyte/flyteadmin/pkg/async/events/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications"
+	notificationsImplementations "github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/implementations"
 	notificationInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	dataInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/data/interfaces"
@@ -88,10 +90,12 @@ type ExecutionManager struct {
 	namedEntityManager        interfaces.NamedEntityInterface
 	resourceManager           interfaces.ResourceInterface
 	qualityOfServiceAllocator executions.QualityOfServiceAllocator
-	eventPublisher            notificationInterfaces.Publisher
-	cloudEventPublisher       notificationInterfaces.Publisher
-	dbEventWriter             eventWriter.WorkflowExecutionEventWriter
-	pluginRegistry            *plugins.Registry
+	// Applies the resource recommendation of the tasks that opted into it.
+	resourceRecommendationManager interfaces.TaskResourceRecommendationInterface
+	eventPublisher                notificationInterfaces.Publisher
+	cloudEventPublisher           notificationInterfaces.Publisher
+	dbEventWriter                 eventWriter.WorkflowExecutionEventWriter
+	pluginRegistry                *plugins.Registry
 }
 
 func getExecutionContext(ctx context.Context, id *core.WorkflowExecutionIdentifier) context.Context {
@@ -272,6 +276,55 @@ func (m *ExecutionManager) setCompiledTaskDefaults(ctx context.Context, task *co
 	}
 }
 
+// setCompiledTaskRecommendation overrides the cpu and memory requests of the compiled task with the task resource
+// attributes recommended for its task version, if any. The limits are left as is and cap the recommended requests.
+func (m *ExecutionManager) setCompiledTaskRecommendation(ctx context.Context, task *core.CompiledTask) {
+	attributes, err := m.resourceRecommendationManager.GetTaskResourceAttributes(ctx, task)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to get the resource recommendation of task [%+v] with err: %v",
+			task.GetTemplate().GetId(), err)
+		return
+	}
+
+	if attributes == nil {
+		return
+	}
+
+	recommended := map[core.Resources_ResourceName]string{
+		core.Resources_CPU:    attributes.GetDefaults().GetCpu(),
+		core.Resources_MEMORY: attributes.GetDefaults().GetMemory(),
+	}
+	taskResources := task.GetTemplate().GetContainer().GetResources()
+	for _, request := range taskResources.GetRequests() {
+		value := recommended[request.GetName()]
+		if len(value) == 0 {
+			continue
+		}
+
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			logger.Warningf(ctx, "Failed to parse the recommended [%v] [%s] of task [%+v] with err: %v",
+				request.GetName(), value, task.GetTemplate().GetId(), err)
+			continue
+		}
+
+		for _, limit := range taskResources.GetLimits() {
+			if limit.GetName() != request.GetName() {
+				continue
+			}
+
+			if limitQuantity, err := resource.ParseQuantity(limit.GetValue()); err == nil && quantity.Cmp(limitQuantity) > 0 {
+				quantity = limitQuantity
+			}
+		}
+
+		request.Value = quantity.String()
+	}
+
+	logger.Infof(ctx, "Applied resource recommendation [%+v] to task [%+v]", attributes.GetDefaults(),
+		task.GetTemplate().GetId())
+}
+
 // Fetches inherited execution metadata including the parent node execution db model id and the source execution model id
 // as well as sets request spec metadata with the inherited principal and adjusted nesting data.
 func (m *ExecutionManager) getInheritedExecMetadata(ctx context.Context, requestSpec *admin.ExecutionSpec,
@@ -560,6 +613,7 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 	platformTaskResources := util.GetTaskResources(ctx, workflow.GetId(), m.resourceManager, m.config.TaskResourceConfiguration())
 	for _, t := range workflow.GetClosure().GetCompiledWorkflow().GetTasks() {
 		m.setCompiledTaskDefaults(ctx, t, platformTaskResources)
+		m.setCompiledTaskRecommendation(ctx, t)
 	}
 
 	// Dynamically assign execution queues.
@@ -682,6 +736,9 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		SecurityContext:       executionConfig.GetSecurityContext(),
 		LaunchEntity:          taskIdentifier.GetResourceType(),
 		Namespace:             namespace,
+		Labels:                labels,
+		Annotations:           annotations,
+		Inputs:                executionInputs,
 	})
 	if err != nil {
 		logger.Infof(ctx, "Failed to create execution model in transformer for id: [%+v] with err: %v",
@@ -995,6 +1052,7 @@ func (m *ExecutionManager) launchExecution(
 	platformTaskResources := util.GetTaskResources(ctx, workflow.GetId(), m.resourceManager, m.config.TaskResourceConfiguration())
 	for _, task := range workflow.GetClosure().GetCompiledWorkflow().GetTasks() {
 		m.setCompiledTaskDefaults(ctx, task, platformTaskResources)
+		m.setCompiledTaskRecommendation(ctx, task)
 	}
 
 	// Dynamically assign execution queues.
@@ -1094,6 +1152,9 @@ func (m *ExecutionManager) launchExecution(
 		SecurityContext:       executionConfig.GetSecurityContext(),
 		LaunchEntity:          launchPlan.GetId().GetResourceType(),
 		Namespace:             namespace,
+		Labels:                labels,
+		Annotations:           annotations,
+		Inputs:                executionInputs,
 	}
 
 	workflowExecutor := plugins.Get[workflowengineInterfaces.WorkflowExecutor](m.pluginRegistry, plugins.PluginIDWorkflowExecutor)
@@ -1758,12 +1819,16 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request *ad
 		// Currently all three supported notifications use email underneath to send the notification.
 		// Convert Slack and PagerDuty into an EmailNotification type.
 		emailNotification := &admin.EmailNotification{}
+		var notificationType string
 		if notification.GetEmail() != nil {
 			emailNotification.RecipientsEmail = notification.GetEmail().GetRecipientsEmail()
+			notificationType = notificationsImplementations.EmailNotification
 		} else if notification.GetPagerDuty() != nil {
 			emailNotification.RecipientsEmail = notification.GetPagerDuty().GetRecipientsEmail()
+			notificationType = notificationsImplementations.PagerDutyNotification
 		} else if notification.GetSlack() != nil {
 			emailNotification.RecipientsEmail = notification.GetSlack().GetRecipientsEmail()
+			notificationType = notificationsImplementations.SlackNotification
 		} else {
 			logger.Debugf(ctx, "failed to publish notification, encountered unrecognized type: %v", notification.GetType())
 			m.systemMetrics.UnexpectedDataError.Inc()
@@ -1772,16 +1837,20 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request *ad
 				notification.GetType(), request.GetEvent().GetExecutionId())
 		}
 
-		// Convert the email Notification into an email message to be published.
+		// Convert the email Notification into the messages to be published, the webhooks configured for the
+		// notification type get a message of their own.
 		// Currently there are no possible errors while creating an email message.
 		// Once customizable content is specified, errors are possible.
-		email := notifications.ToEmailMessageFromWorkflowExecutionEvent(
-			*m.config.ApplicationConfiguration().GetNotificationsConfig(), emailNotification, request, adminExecution)
-		// Errors seen while publishing a message are considered non-fatal to the method and will not result
-		// in the method returning an error.
-		if err = m.notificationClient.Publish(ctx, proto.MessageName(emailNotification), email); err != nil {
-			m.systemMetrics.PublishNotificationError.Inc()
-			logger.Infof(ctx, "error publishing email notification [%+v] with err: [%v]", notification, err)
+		messages := notifications.ToNotificationMessagesFromWorkflowExecutionEvent(
+			*m.config.ApplicationConfiguration().GetNotificationsConfig(), notificationType, emailNotification, request,
+			adminExecution)
+		for _, email := range messages {
+			// Errors seen while publishing a message are considered non-fatal to the method and will not result
+			// in the method returning an error.
+			if err = m.notificationClient.Publish(ctx, proto.MessageName(emailNotification), email); err != nil {
+				m.systemMetrics.PublishNotificationError.Inc()
+				logger.Infof(ctx, "error publishing email notification [%+v] with err: [%v]", notification, err)
+			}
 		}
 	}
 	return nil
@@ -1888,23 +1957,24 @@ func NewExecutionManager(db repositoryInterfaces.Repository, pluginRegistry *plu
 
 	resourceManager := resources.NewResourceManager(db, config.ApplicationConfiguration())
 	return &ExecutionManager{
-		db:                        db,
-		config:                    config,
-		storageClient:             storageClient,
-		queueAllocator:            queueAllocator,
-		_clock:                    clock.New(),
-		systemMetrics:             systemMetrics,
-		userMetrics:               userMetrics,
-		notificationClient:        publisher,
-		urlData:                   urlData,
-		workflowManager:           workflowManager,
-		namedEntityManager:        namedEntityManager,
-		resourceManager:           resourceManager,
-		qualityOfServiceAllocator: executions.NewQualityOfServiceAllocator(config, resourceManager),
-		eventPublisher:            eventPublisher,
-		cloudEventPublisher:       cloudEventPublisher,
-		dbEventWriter:             eventWriter,
-		pluginRegistry:            pluginRegistry,
+		db:                            db,
+		config:                        config,
+		storageClient:                 storageClient,
+		queueAllocator:                queueAllocator,
+		_clock:                        clock.New(),
+		systemMetrics:                 systemMetrics,
+		userMetrics:                   userMetrics,
+		notificationClient:            publisher,
+		urlData:                       urlData,
+		workflowManager:               workflowManager,
+		namedEntityManager:            namedEntityManager,
+		resourceManager:               resourceManager,
+		qualityOfServiceAllocator:     executions.NewQualityOfServiceAllocator(config, resourceManager),
+		resourceRecommendationManager: NewTaskResourceRecommendationManager(db, config),
+		eventPublisher:                eventPublisher,
+		cloudEventPublisher:           cloudEventPublisher,
+		dbEventWriter:                 eventWriter,
+		pluginRegistry:                pluginRegistry,
 	}
 }
 
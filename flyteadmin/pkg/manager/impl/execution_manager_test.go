@@ -535,6 +535,28 @@ func TestCreateExecution_NoAssignedName(t *testing.T) {
 	assert.NotEmpty(t, response.GetId().GetName())
 }
 
+func TestCreateExecution_ResourceRecommendation(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	mockExecutor := workflowengineMocks.WorkflowExecutor{}
+	mockExecutor.OnExecuteMatch(mock.Anything, mock.Anything).Return(workflowengineInterfaces.ExecutionResponse{
+		Cluster: testCluster,
+	}, nil)
+	mockExecutor.OnID().Return("customMockExecutor")
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &mockExecutor)
+
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	mockRecommendationManager := &managerMocks.TaskResourceRecommendationInterface{}
+	mockRecommendationManager.OnGetTaskResourceAttributesMatch(mock.Anything, mock.Anything).Return(
+		nil, errors.New("expected error"))
+	execManager.(*ExecutionManager).resourceRecommendationManager = mockRecommendationManager
+
+	_, err := execManager.CreateExecution(context.Background(), testutils.GetExecutionRequest(), requestedAt)
+	assert.NoError(t, err)
+	mockRecommendationManager.AssertCalled(t, "GetTaskResourceAttributes", mock.Anything, mock.Anything)
+}
+
 func TestCreateExecution_TaggedQueue(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
@@ -4340,6 +4362,60 @@ func TestSetDefaults_OptionalRequiredResources(t *testing.T) {
 	})
 
 }
+
+func TestSetCompiledTaskRecommendation(t *testing.T) {
+	task := &core.CompiledTask{
+		Template: &core.TaskTemplate{
+			Target: &core.TaskTemplate_Container{
+				Container: &core.Container{
+					Resources: &core.Resources{
+						Requests: []*core.Resources_ResourceEntry{
+							{Name: core.Resources_CPU, Value: "4"},
+							{Name: core.Resources_MEMORY, Value: "1Gi"},
+							{Name: core.Resources_GPU, Value: "1"},
+						},
+						Limits: []*core.Resources_ResourceEntry{
+							{Name: core.Resources_CPU, Value: "4"},
+							{Name: core.Resources_MEMORY, Value: "2Gi"},
+							{Name: core.Resources_GPU, Value: "1"},
+						},
+					},
+				},
+			},
+			Id: &core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "task_name",
+				Version: "version",
+			},
+		},
+	}
+
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &defaultTestExecutor)
+	execManager := NewExecutionManager(repositoryMocks.NewMockRepository(), r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	mockRecommendationManager := &managerMocks.TaskResourceRecommendationInterface{}
+	mockRecommendationManager.OnGetTaskResourceAttributes(context.Background(), task).Return(&admin.TaskResourceAttributes{
+		Defaults: &admin.TaskResourceSpec{Cpu: "1500m", Memory: "3Gi"},
+	}, nil)
+	execManager.(*ExecutionManager).resourceRecommendationManager = mockRecommendationManager
+
+	execManager.(*ExecutionManager).setCompiledTaskRecommendation(context.Background(), task)
+	assert.True(t, proto.Equal(
+		&core.Resources{
+			Requests: []*core.Resources_ResourceEntry{
+				{Name: core.Resources_CPU, Value: "1500m"},
+				{Name: core.Resources_MEMORY, Value: "2Gi"},
+				{Name: core.Resources_GPU, Value: "1"},
+			},
+			Limits: []*core.Resources_ResourceEntry{
+				{Name: core.Resources_CPU, Value: "4"},
+				{Name: core.Resources_MEMORY, Value: "2Gi"},
+				{Name: core.Resources_GPU, Value: "1"},
+			},
+		},
+		task.GetTemplate().GetContainer().GetResources()), fmt.Sprintf("%+v", task.GetTemplate().GetContainer()))
+}
 func TestCreateSingleTaskExecution(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	var getCalledCount = 0
@@ -3,9 +3,13 @@ package impl
 import (
 	"context"
 	"strconv"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/flyteorg/flyte/flyteadmin/auth"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/util"
@@ -20,6 +24,7 @@ import (
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
 )
 
 type signalMetrics struct {
@@ -28,8 +33,9 @@ type signalMetrics struct {
 }
 
 type SignalManager struct {
-	db      repoInterfaces.Repository
-	metrics signalMetrics
+	db            repoInterfaces.Repository
+	storageClient *storage.DataStore
+	metrics       signalMetrics
 }
 
 func getSignalContext(ctx context.Context, identifier *core.SignalIdentifier) context.Context {
@@ -51,8 +57,36 @@ func (s *SignalManager) GetOrCreateSignal(ctx context.Context, request *admin.Si
 		return nil, err
 	}
 
-	err = s.db.SignalRepo().GetOrCreate(ctx, &signalModel)
-	if err != nil {
+	// the approval policy of a signal can't change once it's created, so it's only looked up for new signals
+	existingSignalModel, err := s.db.SignalRepo().Get(ctx, signalModel.SignalKey)
+	switch {
+	case err == nil:
+		signalModel = existingSignalModel
+	case errors.IsDoesNotExistError(err):
+		policy, err := s.getApprovalPolicy(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		// the policy of the request is only checked against the policy of the gate node, it's never stored
+		if request.GetApprovalPolicy() != nil && !proto.Equal(request.GetApprovalPolicy(), policy) {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"approval policy [%+v] doesn't match the approval policy [%+v] of the gate node of signal [%v]",
+				request.GetApprovalPolicy(), policy, request.GetId().GetSignalId())
+		}
+
+		if policy != nil {
+			signalModel, err = transformers.CreateApprovalSignalModel(request.GetId(), request.GetType(), policy)
+			if err != nil {
+				logger.Errorf(ctx, "Failed to transform signal with id [%+v] and policy [+%v] with err: %v", request.GetId(), policy, err)
+				return nil, err
+			}
+		}
+
+		if err = s.db.SignalRepo().GetOrCreate(ctx, &signalModel); err != nil {
+			return nil, err
+		}
+	default:
 		return nil, err
 	}
 
@@ -62,9 +96,72 @@ func (s *SignalManager) GetOrCreateSignal(ctx context.Context, request *admin.Si
 		return nil, err
 	}
 
+	if request.GetApprovalPolicy() != nil && !proto.Equal(request.GetApprovalPolicy(), signal.GetApprovalPolicy()) {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"approval policy [%+v] doesn't match the approval policy [%+v] of signal [%v]",
+			request.GetApprovalPolicy(), signal.GetApprovalPolicy(), request.GetId().GetSignalId())
+	}
+
 	return signal, nil
 }
 
+// getApprovalPolicy returns the approval policy of the gate node requesting the signal, read from the compiled workflow
+// of the execution so that callers can't weaken the policy of a gate. The gate nodes of dynamic workflows aren't part
+// of the compiled workflow, their signals have no approval policy.
+func (s *SignalManager) getApprovalPolicy(ctx context.Context, request *admin.SignalGetOrCreateRequest) (*core.ApprovalPolicy, error) {
+	executionModel, err := util.GetExecutionModel(ctx, s.db, request.GetId().GetExecutionId())
+	if err != nil {
+		return nil, err
+	}
+
+	executionClosure := &admin.ExecutionClosure{}
+	if err := proto.Unmarshal(executionModel.Closure, executionClosure); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to unmarshal the closure of execution [%v]", request.GetId().GetExecutionId().GetName())
+	}
+
+	workflow, err := util.GetWorkflow(ctx, s.db, s.storageClient, executionClosure.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+
+	compiledWorkflow := workflow.GetClosure().GetCompiledWorkflow()
+	for _, template := range append([]*core.CompiledWorkflow{compiledWorkflow.GetPrimary()}, compiledWorkflow.GetSubWorkflows()...) {
+		if approveCondition, found := findApproveCondition(template.GetTemplate().GetNodes(), request.GetId().GetSignalId()); found {
+			return approveCondition.GetPolicy(), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findApproveCondition looks up the approve condition requesting the signal among the nodes and the nodes nested in
+// their branches and arrays.
+func findApproveCondition(nodes []*core.Node, signalID string) (*core.ApproveCondition, bool) {
+	for _, node := range nodes {
+		if approveCondition := node.GetGateNode().GetApprove(); approveCondition != nil && approveCondition.GetSignalId() == signalID {
+			return approveCondition, true
+		}
+
+		var nestedNodes []*core.Node
+		if ifElse := node.GetBranchNode().GetIfElse(); ifElse != nil {
+			nestedNodes = append(nestedNodes, ifElse.GetCase().GetThenNode(), ifElse.GetElseNode())
+			for _, other := range ifElse.GetOther() {
+				nestedNodes = append(nestedNodes, other.GetThenNode())
+			}
+		}
+		if arrayNode := node.GetArrayNode(); arrayNode != nil {
+			nestedNodes = append(nestedNodes, arrayNode.GetNode())
+		}
+
+		if approveCondition, found := findApproveCondition(nestedNodes, signalID); found {
+			return approveCondition, true
+		}
+	}
+
+	return nil, false
+}
+
 func (s *SignalManager) ListSignals(ctx context.Context, request *admin.SignalListRequest) (*admin.SignalList, error) {
 	if err := validation.ValidateSignalListRequest(ctx, request); err != nil {
 		logger.Debugf(ctx, "ListSignals request [%+v] is invalid: %v", request, err)
@@ -121,7 +218,8 @@ func (s *SignalManager) ListSignals(ctx context.Context, request *admin.SignalLi
 }
 
 func (s *SignalManager) SetSignal(ctx context.Context, request *admin.SignalSetRequest) (*admin.SignalSetResponse, error) {
-	if err := validation.ValidateSignalSetRequest(ctx, s.db, request); err != nil {
+	lookupSignal, err := validation.ValidateSignalSetRequest(ctx, s.db, request)
+	if err != nil {
 		return nil, err
 	}
 	ctx = getSignalContext(ctx, request.GetId())
@@ -132,7 +230,11 @@ func (s *SignalManager) SetSignal(ctx context.Context, request *admin.SignalSetR
 		return nil, err
 	}
 
-	err = s.db.SignalRepo().Update(ctx, signalModel.SignalKey, signalModel.Value)
+	if lookupSignal.GetApprovalPolicy() != nil {
+		err = s.approveSignal(ctx, request, signalModel)
+	} else {
+		err = s.db.SignalRepo().Update(ctx, signalModel.SignalKey, signalModel.Value)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -141,8 +243,102 @@ func (s *SignalManager) SetSignal(ctx context.Context, request *admin.SignalSetR
 	return &admin.SignalSetResponse{}, nil
 }
 
+// approveSignal records the decision of the authenticated caller on a signal with an approval policy. The signal is
+// resolved once as many distinct identities approved, or rejected, as the policy requires.
+func (s *SignalManager) approveSignal(ctx context.Context, request *admin.SignalSetRequest, signalModel models.Signal) error {
+	identityContext := auth.IdentityContextFromContext(ctx)
+	identity := identityContext.UserID()
+	if len(identity) == 0 {
+		identity = identityContext.AppID()
+	}
+	if len(identity) == 0 {
+		return errors.NewFlyteAdminErrorf(codes.Unauthenticated,
+			"signal [%v] has an approval policy and can only be set by authenticated callers", request.GetId().GetSignalId())
+	}
+
+	approved := request.GetValue().GetScalar().GetPrimitive().GetBoolean()
+	return s.db.SignalRepo().Approve(ctx, signalModel.SignalKey, func(signal *models.Signal) error {
+		if len(signal.Value) > 0 {
+			return errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+				"signal [%v] has already been resolved", request.GetId().GetSignalId())
+		}
+
+		lookupSignal, err := transformers.FromSignalModel(*signal)
+		if err != nil {
+			return err
+		}
+
+		policy := lookupSignal.GetApprovalPolicy()
+		if !isAllowedApprover(policy, identity, identityContext.Groups()) {
+			return errors.NewFlyteAdminErrorf(codes.PermissionDenied,
+				"[%v] is not allowed to approve signal [%v]", identity, request.GetId().GetSignalId())
+		}
+
+		approvals, err := transformers.UnmarshalSignalApprovals(*signal)
+		if err != nil {
+			return err
+		}
+
+		approvalCount, rejectionCount := 0, 0
+		for _, approval := range approvals {
+			if approval.Identity == identity {
+				return errors.NewFlyteAdminErrorf(codes.AlreadyExists,
+					"[%v] has already decided on signal [%v]", identity, request.GetId().GetSignalId())
+			}
+			if approval.Approved {
+				approvalCount++
+			} else {
+				rejectionCount++
+			}
+		}
+
+		approvals = append(approvals, models.SignalApproval{
+			Identity:  identity,
+			Approved:  approved,
+			Reason:    request.GetReason(),
+			CreatedAt: time.Now(),
+		})
+		if signal.Approvals, err = transformers.MarshalSignalApprovals(approvals); err != nil {
+			return err
+		}
+
+		if approved {
+			approvalCount++
+		} else {
+			rejectionCount++
+		}
+
+		// only the count of the decision just made can reach the quorum, so the value of the request resolves the signal
+		quorum := int(max(policy.GetMinApprovals(), 1))
+		if approvalCount >= quorum || rejectionCount >= quorum {
+			signal.Value = signalModel.Value
+		}
+
+		logger.Infof(ctx, "[%v] decided [approved: %v] on signal [%v], [%d/%d] approvals, [%d/%d] rejections", identity,
+			approved, request.GetId().GetSignalId(), approvalCount, quorum, rejectionCount, quorum)
+		return nil
+	})
+}
+
+// isAllowedApprover checks the identity or one of its groups is allowed by the approval policy. Policies without
+// allowed identities and groups allow any identity.
+func isAllowedApprover(policy *core.ApprovalPolicy, identity string, groups sets.String) bool {
+	if len(policy.GetAllowedIdentities()) == 0 && len(policy.GetAllowedGroups()) == 0 {
+		return true
+	}
+
+	for _, allowedIdentity := range policy.GetAllowedIdentities() {
+		if allowedIdentity == identity {
+			return true
+		}
+	}
+
+	return groups.HasAny(policy.GetAllowedGroups()...)
+}
+
 func NewSignalManager(
 	db repoInterfaces.Repository,
+	storageClient *storage.DataStore,
 	scope promutils.Scope) interfaces.SignalInterface {
 	metrics := signalMetrics{
 		Scope: scope,
@@ -150,7 +346,8 @@ func NewSignalManager(
 	}
 
 	return &SignalManager{
-		db:      db,
-		metrics: metrics,
+		db:            db,
+		storageClient: storageClient,
+		metrics:       metrics,
 	}
 }
@@ -4,10 +4,18 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyte/flyteadmin/auth"
+	commonMocks "github.com/flyteorg/flyte/flyteadmin/pkg/common/mocks"
+	flyteAdminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
 
 	repositoryMocks "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
@@ -15,6 +23,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
 )
 
 var (
@@ -48,12 +57,79 @@ var (
 	}
 )
 
+// newSignalExecution returns a repository and a store with an execution of a workflow made of the given nodes, none
+// of its signals exist yet.
+func newSignalExecution(t *testing.T, nodes ...*core.Node) (interfaces.Repository, *storage.DataStore) {
+	executionClosure, err := proto.Marshal(&admin.ExecutionClosure{
+		WorkflowId: &core.Identifier{Project: "project", Domain: "domain", Name: "workflow", Version: "version"},
+	})
+	assert.NoError(t, err)
+
+	mockRepository := repositoryMocks.NewMockRepository()
+	mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).OnGetMatch(mock.Anything, mock.Anything).Return(
+		models.Signal{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "signal does not exist"))
+	mockRepository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{Closure: executionClosure}, nil
+		})
+	mockRepository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.Workflow, error) {
+			assert.Equal(t, "workflow", input.Name)
+			return models.Workflow{RemoteClosureIdentifier: "s3://bucket/closure"}, nil
+		})
+
+	mockStorage := commonMocks.GetMockStorageClient()
+	mockStorage.ComposedProtobufStore.(*commonMocks.TestDataStore).ReadProtobufCb = func(
+		ctx context.Context, reference storage.DataReference, msg proto.Message) error {
+		assert.Equal(t, storage.DataReference("s3://bucket/closure"), reference)
+		proto.Merge(msg, &admin.WorkflowClosure{
+			CompiledWorkflow: &core.CompiledWorkflowClosure{
+				Primary: &core.CompiledWorkflow{
+					Template: &core.WorkflowTemplate{Nodes: nodes},
+				},
+			},
+		})
+		return nil
+	}
+
+	return mockRepository, mockStorage
+}
+
 func TestGetOrCreateSignal(t *testing.T) {
+	policy := &core.ApprovalPolicy{
+		MinApprovals:  2,
+		AllowedGroups: []string{"reviewers"},
+	}
+
+	// the gate is nested in a branch to check nested nodes are searched as well
+	gateBranch := &core.Node{
+		Id: "branch",
+		Target: &core.Node_BranchNode{
+			BranchNode: &core.BranchNode{
+				IfElse: &core.IfElseBlock{
+					Case: &core.IfBlock{ThenNode: &core.Node{Id: "then"}},
+					Default: &core.IfElseBlock_ElseNode{
+						ElseNode: &core.Node{
+							Id: "gate",
+							Target: &core.Node_GateNode{
+								GateNode: &core.GateNode{
+									Condition: &core.GateNode_Approve{
+										Approve: &core.ApproveCondition{SignalId: signalID.GetSignalId(), Policy: policy},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
 	t.Run("Happy", func(t *testing.T) {
-		mockRepository := repositoryMocks.NewMockRepository()
+		mockRepository, mockStorage := newSignalExecution(t)
 		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).OnGetOrCreateMatch(mock.Anything, mock.Anything).Return(nil)
 
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, mockStorage, mockScope.NewTestScope())
 		request := &admin.SignalGetOrCreateRequest{
 			Id:   signalID,
 			Type: signalType,
@@ -68,9 +144,81 @@ func TestGetOrCreateSignal(t *testing.T) {
 		}, response))
 	})
 
+	t.Run("ApprovalPolicyOfGateNode", func(t *testing.T) {
+		mockRepository, mockStorage := newSignalExecution(t, gateBranch)
+		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).OnGetOrCreateMatch(mock.Anything,
+			mock.MatchedBy(func(signal *models.Signal) bool {
+				return len(signal.ApprovalPolicy) > 0
+			})).Return(nil)
+
+		signalManager := NewSignalManager(mockRepository, mockStorage, mockScope.NewTestScope())
+		request := &admin.SignalGetOrCreateRequest{
+			Id:   signalID,
+			Type: signalType,
+		}
+
+		response, err := signalManager.GetOrCreateSignal(context.Background(), request)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(policy, response.GetApprovalPolicy()))
+
+		request.ApprovalPolicy = policy
+		response, err = signalManager.GetOrCreateSignal(context.Background(), request)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(policy, response.GetApprovalPolicy()))
+	})
+
+	t.Run("MismatchedApprovalPolicy", func(t *testing.T) {
+		mockRepository, mockStorage := newSignalExecution(t, gateBranch)
+
+		signalManager := NewSignalManager(mockRepository, mockStorage, mockScope.NewTestScope())
+		request := &admin.SignalGetOrCreateRequest{
+			Id:             signalID,
+			Type:           signalType,
+			ApprovalPolicy: &core.ApprovalPolicy{MinApprovals: 1},
+		}
+
+		_, err := signalManager.GetOrCreateSignal(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).AssertNotCalled(t, "GetOrCreate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ApprovalPolicyNotInClosure", func(t *testing.T) {
+		mockRepository, mockStorage := newSignalExecution(t)
+
+		signalManager := NewSignalManager(mockRepository, mockStorage, mockScope.NewTestScope())
+		request := &admin.SignalGetOrCreateRequest{
+			Id:             signalID,
+			Type:           signalType,
+			ApprovalPolicy: &core.ApprovalPolicy{MinApprovals: 1},
+		}
+
+		_, err := signalManager.GetOrCreateSignal(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).AssertNotCalled(t, "GetOrCreate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Existing", func(t *testing.T) {
+		signalModel, err := transformers.CreateApprovalSignalModel(signalID, signalType, policy)
+		assert.NoError(t, err)
+
+		mockRepository := repositoryMocks.NewMockRepository()
+		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).OnGetMatch(mock.Anything, mock.Anything).Return(signalModel, nil)
+
+		signalManager := NewSignalManager(mockRepository, commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
+		request := &admin.SignalGetOrCreateRequest{
+			Id:   signalID,
+			Type: signalType,
+		}
+
+		response, err := signalManager.GetOrCreateSignal(context.Background(), request)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(policy, response.GetApprovalPolicy()))
+		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).AssertNotCalled(t, "GetOrCreate", mock.Anything, mock.Anything)
+	})
+
 	t.Run("ValidationError", func(t *testing.T) {
 		mockRepository := repositoryMocks.NewMockRepository()
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
 		request := &admin.SignalGetOrCreateRequest{
 			Type: signalType,
 		}
@@ -80,10 +228,10 @@ func TestGetOrCreateSignal(t *testing.T) {
 	})
 
 	t.Run("DBError", func(t *testing.T) {
-		mockRepository := repositoryMocks.NewMockRepository()
+		mockRepository, mockStorage := newSignalExecution(t)
 		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).OnGetOrCreateMatch(mock.Anything, mock.Anything).Return(errors.New("foo"))
 
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, mockStorage, mockScope.NewTestScope())
 		request := &admin.SignalGetOrCreateRequest{
 			Id:   signalID,
 			Type: signalType,
@@ -106,7 +254,7 @@ func TestListSignals(t *testing.T) {
 			nil,
 		)
 
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
 		request := &admin.SignalListRequest{
 			WorkflowExecutionId: &core.WorkflowExecutionIdentifier{
 				Project: "project",
@@ -134,7 +282,7 @@ func TestListSignals(t *testing.T) {
 
 	t.Run("ValidationError", func(t *testing.T) {
 		mockRepository := repositoryMocks.NewMockRepository()
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
 		request := &admin.SignalListRequest{
 			WorkflowExecutionId: &core.WorkflowExecutionIdentifier{
 				Project: "project",
@@ -152,7 +300,7 @@ func TestListSignals(t *testing.T) {
 		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).
 			OnListMatch(mock.Anything, mock.Anything).Return(nil, errors.New("foo"))
 
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
 		request := &admin.SignalListRequest{
 			WorkflowExecutionId: &core.WorkflowExecutionIdentifier{
 				Project: "project",
@@ -178,7 +326,7 @@ func TestSetSignal(t *testing.T) {
 		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).
 			OnUpdateMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
 		request := &admin.SignalSetRequest{
 			Id:    signalID,
 			Value: signalValue,
@@ -192,7 +340,7 @@ func TestSetSignal(t *testing.T) {
 
 	t.Run("ValidationError", func(t *testing.T) {
 		mockRepository := repositoryMocks.NewMockRepository()
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
 		request := &admin.SignalSetRequest{
 			Value: signalValue,
 		}
@@ -209,7 +357,7 @@ func TestSetSignal(t *testing.T) {
 			errors.New("foo"),
 		)
 
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
 		request := &admin.SignalSetRequest{
 			Id:    signalID,
 			Value: signalValue,
@@ -226,7 +374,7 @@ func TestSetSignal(t *testing.T) {
 		mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface).
 			OnUpdateMatch(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("foo"))
 
-		signalManager := NewSignalManager(mockRepository, mockScope.NewTestScope())
+		signalManager := NewSignalManager(mockRepository, commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
 		request := &admin.SignalSetRequest{
 			Id:    signalID,
 			Value: signalValue,
@@ -236,3 +384,139 @@ func TestSetSignal(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestSetApprovalSignal(t *testing.T) {
+	approvalSignalModel, err := transformers.CreateApprovalSignalModel(signalID, signalType, &core.ApprovalPolicy{
+		MinApprovals:  2,
+		AllowedGroups: []string{"reviewers"},
+	})
+	assert.NoError(t, err)
+
+	approve := &core.Literal{
+		Value: &core.Literal_Scalar{
+			Scalar: &core.Scalar{
+				Value: &core.Scalar_Primitive{
+					Primitive: &core.Primitive{
+						Value: &core.Primitive_Boolean{
+							Boolean: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	identityContext := func(t *testing.T, userID string, groups ...interface{}) context.Context {
+		identity, err := auth.NewIdentityContext("", userID, "", time.Now(), nil, nil, map[string]interface{}{"groups": groups})
+		assert.NoError(t, err)
+		return identity.WithContext(context.Background())
+	}
+
+	// newSignalRepository returns a repository applying the approvals to the given signal model
+	newSignalRepository := func(signal *models.Signal) interfaces.Repository {
+		mockRepository := repositoryMocks.NewMockRepository()
+		signalRepo := mockRepository.SignalRepo().(*repositoryMocks.SignalRepoInterface)
+		signalRepo.OnGetMatch(mock.Anything, mock.Anything).Return(approvalSignalModel, nil)
+		signalRepo.OnApproveMatch(mock.Anything, mock.Anything, mock.Anything).Call.Return(
+			func(_ context.Context, _ models.SignalKey, approve func(*models.Signal) error) error {
+				return approve(signal)
+			})
+		return mockRepository
+	}
+
+	t.Run("FourEyes", func(t *testing.T) {
+		signal := approvalSignalModel
+		signalManager := NewSignalManager(newSignalRepository(&signal), commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
+		request := &admin.SignalSetRequest{
+			Id:     signalID,
+			Value:  approve,
+			Reason: "looks good",
+		}
+
+		_, err := signalManager.SetSignal(identityContext(t, "alice", "reviewers"), request)
+		assert.NoError(t, err)
+		assert.Empty(t, signal.Value)
+
+		// the same identity can't approve twice
+		_, err = signalManager.SetSignal(identityContext(t, "alice", "reviewers"), request)
+		assert.Equal(t, codes.AlreadyExists, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+		_, err = signalManager.SetSignal(identityContext(t, "bob", "reviewers"), request)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, signal.Value)
+
+		response, err := transformers.FromSignalModel(signal)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), response.GetApprovalPolicy().GetMinApprovals())
+		assert.Len(t, response.GetApprovals(), 2)
+		assert.Equal(t, "alice", response.GetApprovals()[0].GetIdentity())
+		assert.Equal(t, "looks good", response.GetApprovals()[0].GetReason())
+		assert.True(t, response.GetApprovals()[1].GetApproved())
+
+		// resolved signals can't be changed anymore
+		_, err = signalManager.SetSignal(identityContext(t, "carol", "reviewers"), request)
+		assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		signal := approvalSignalModel
+		signalManager := NewSignalManager(newSignalRepository(&signal), commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
+		request := &admin.SignalSetRequest{
+			Id:     signalID,
+			Value:  signalValue,
+			Reason: "not ready",
+		}
+
+		// a single rejection doesn't resolve a signal needing two decisions
+		_, err := signalManager.SetSignal(identityContext(t, "alice", "reviewers"), request)
+		assert.NoError(t, err)
+		assert.Empty(t, signal.Value)
+
+		_, err = signalManager.SetSignal(identityContext(t, "bob", "reviewers"), &admin.SignalSetRequest{Id: signalID, Value: approve})
+		assert.NoError(t, err)
+		assert.Empty(t, signal.Value)
+
+		_, err = signalManager.SetSignal(identityContext(t, "carol", "reviewers"), request)
+		assert.NoError(t, err)
+
+		response, err := transformers.FromSignalModel(signal)
+		assert.NoError(t, err)
+		assert.False(t, response.GetValue().GetScalar().GetPrimitive().GetBoolean())
+		assert.NotNil(t, response.GetValue())
+		assert.Len(t, response.GetApprovals(), 3)
+		assert.False(t, response.GetApprovals()[0].GetApproved())
+	})
+
+	t.Run("NotAllowed", func(t *testing.T) {
+		signal := approvalSignalModel
+		signalManager := NewSignalManager(newSignalRepository(&signal), commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
+		request := &admin.SignalSetRequest{
+			Id:    signalID,
+			Value: approve,
+		}
+
+		_, err := signalManager.SetSignal(identityContext(t, "mallory", "developers"), request)
+		assert.Equal(t, codes.PermissionDenied, err.(flyteAdminErrors.FlyteAdminError).Code())
+		assert.Empty(t, signal.Approvals)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		signal := approvalSignalModel
+		signalManager := NewSignalManager(newSignalRepository(&signal), commonMocks.GetMockStorageClient(), mockScope.NewTestScope())
+		request := &admin.SignalSetRequest{
+			Id:    signalID,
+			Value: approve,
+		}
+
+		_, err := signalManager.SetSignal(context.Background(), request)
+		assert.Equal(t, codes.Unauthenticated, err.(flyteAdminErrors.FlyteAdminError).Code())
+	})
+}
+
+func TestIsAllowedApprover(t *testing.T) {
+	assert.True(t, isAllowedApprover(&core.ApprovalPolicy{}, "alice", sets.NewString()))
+	assert.True(t, isAllowedApprover(&core.ApprovalPolicy{AllowedIdentities: []string{"alice"}}, "alice", sets.NewString()))
+	assert.False(t, isAllowedApprover(&core.ApprovalPolicy{AllowedIdentities: []string{"alice"}}, "bob", sets.NewString()))
+	assert.True(t, isAllowedApprover(&core.ApprovalPolicy{AllowedGroups: []string{"ops"}}, "bob", sets.NewString("ops")))
+	assert.False(t, isAllowedApprover(&core.ApprovalPolicy{AllowedGroups: []string{"ops"}}, "bob", sets.NewString("dev")))
+}
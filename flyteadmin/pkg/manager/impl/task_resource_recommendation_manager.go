@@ -0,0 +1,203 @@
+package impl
+
+import (
+	"context"
+	"math"
+
+	"github.com/golang/protobuf/proto"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// AutoApplyResourceRecommendationTag is the task metadata tag through which a task opts into having its resource
+// recommendation applied to its executions, if set to "true".
+const AutoApplyResourceRecommendationTag = "auto-apply-resource-recommendation"
+
+const (
+	mebibyte        = 1024 * 1024
+	phaseColumn     = "phase"
+	createdAtColumn = "created_at"
+)
+
+// TaskResourceRecommendationManager recommends task resources based on the peak usage reported by the most recent
+// successful executions of a task version.
+type TaskResourceRecommendationManager struct {
+	db     repoInterfaces.Repository
+	config runtimeInterfaces.Configuration
+}
+
+// peakUsage is the peak usage of a single container, across task executions.
+type peakUsage struct {
+	cpuMillis   int64
+	memoryBytes int64
+}
+
+func (m *TaskResourceRecommendationManager) listPeakUsage(ctx context.Context, id *core.Identifier) (
+	usage peakUsage, sampleCount int32, err error) {
+	phaseFilter, err := util.GetSingleValueEqualityFilter(common.TaskExecution, phaseColumn,
+		core.TaskExecution_SUCCEEDED.String())
+	if err != nil {
+		return peakUsage{}, 0, err
+	}
+
+	// Task executions store the identifier of their task in the same columns as the task table, whereas inline filters
+	// on the task execution entity target the columns of the execution identifier.
+	taskFilter := common.NewMapFilter(map[string]interface{}{
+		shared.Project: id.GetProject(),
+		shared.Domain:  id.GetDomain(),
+		shared.Name:    id.GetName(),
+		shared.Version: id.GetVersion(),
+	})
+
+	sortParameter, err := common.NewSortParameter(&admin.Sort{
+		Key:       createdAtColumn,
+		Direction: admin.Sort_DESCENDING,
+	}, models.TaskExecutionColumns)
+	if err != nil {
+		return peakUsage{}, 0, err
+	}
+
+	output, err := m.db.TaskExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{phaseFilter},
+		MapFilters:    []common.MapFilter{taskFilter},
+		Limit:         m.config.TaskResourceConfiguration().GetRecommendations().MaxSamples,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list task executions of task [%+v] with err %v", id, err)
+		return peakUsage{}, 0, err
+	}
+
+	for _, taskExecution := range output.TaskExecutions {
+		closure := &admin.TaskExecutionClosure{}
+		if err := proto.Unmarshal(taskExecution.Closure, closure); err != nil {
+			logger.Warningf(ctx, "Failed to unmarshal closure of task execution of task [%+v] with err %v", id, err)
+			continue
+		}
+
+		entries := closure.GetMetadata().GetPeakResourceUsage()
+		if len(entries) == 0 {
+			continue
+		}
+
+		sampleCount++
+		for _, entry := range entries {
+			quantity, err := resource.ParseQuantity(entry.GetValue())
+			if err != nil {
+				logger.Warningf(ctx, "Failed to parse peak usage [%s] of task [%+v] with err %v", entry.GetValue(), id, err)
+				continue
+			}
+
+			switch entry.GetName() {
+			case core.Resources_CPU:
+				usage.cpuMillis = max(usage.cpuMillis, quantity.MilliValue())
+			case core.Resources_MEMORY:
+				usage.memoryBytes = max(usage.memoryBytes, quantity.Value())
+			}
+		}
+	}
+
+	return usage, sampleCount, nil
+}
+
+// withHeadroom adds the configured headroom on top of the usage, rounding memory up to the next mebibyte.
+func withHeadroom(usage peakUsage, headroom float64) peakUsage {
+	memoryBytes := int64(math.Ceil(float64(usage.memoryBytes) * (1 + headroom)))
+	return peakUsage{
+		cpuMillis:   int64(math.Ceil(float64(usage.cpuMillis) * (1 + headroom))),
+		memoryBytes: (memoryBytes + mebibyte - 1) / mebibyte * mebibyte,
+	}
+}
+
+func toTaskResourceSpec(usage peakUsage) *admin.TaskResourceSpec {
+	spec := &admin.TaskResourceSpec{}
+	if usage.cpuMillis > 0 {
+		spec.Cpu = resource.NewMilliQuantity(usage.cpuMillis, resource.DecimalSI).String()
+	}
+
+	if usage.memoryBytes > 0 {
+		spec.Memory = resource.NewQuantity(usage.memoryBytes, resource.BinarySI).String()
+	}
+
+	return spec
+}
+
+func (m *TaskResourceRecommendationManager) getRecommendation(ctx context.Context, id *core.Identifier) (
+	*admin.TaskResourceRecommendation, error) {
+	usage, sampleCount, err := m.listPeakUsage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendation := &admin.TaskResourceRecommendation{
+		Id:          id,
+		SampleCount: sampleCount,
+	}
+	if sampleCount == 0 {
+		return recommendation, nil
+	}
+
+	recommendation.Peak = toTaskResourceSpec(usage)
+	cfg := m.config.TaskResourceConfiguration().GetRecommendations()
+	if int(sampleCount) >= cfg.MinSamples {
+		recommendation.Recommended = toTaskResourceSpec(withHeadroom(usage, cfg.Headroom))
+	}
+
+	return recommendation, nil
+}
+
+func (m *TaskResourceRecommendationManager) GetTaskResourceRecommendation(ctx context.Context,
+	request *admin.ObjectGetRequest) (*admin.TaskResourceRecommendation, error) {
+	if err := validation.ValidateIdentifier(request.GetId(), common.Task); err != nil {
+		logger.Debugf(ctx, "invalid identifier [%+v]: %v", request.GetId(), err)
+		return nil, err
+	}
+	ctx = getTaskContext(ctx, request.GetId())
+	return m.getRecommendation(ctx, request.GetId())
+}
+
+// GetTaskResourceAttributes returns the recommendation of the task version as task resource attributes, if
+// recommendations are auto applied, the task opted into them through its tags and enough of its executions reported
+// their usage. Much like matchable attributes, the defaults of the attributes override the cpu and memory requests of
+// the task. The attributes never carry limits, so the limits of the task and the platform still cap the requests.
+func (m *TaskResourceRecommendationManager) GetTaskResourceAttributes(ctx context.Context, task *core.CompiledTask) (
+	*admin.TaskResourceAttributes, error) {
+	template := task.GetTemplate()
+	if !m.config.TaskResourceConfiguration().GetRecommendations().AutoApply || template.GetContainer() == nil ||
+		template.GetMetadata().GetTags()[AutoApplyResourceRecommendationTag] != "true" {
+		return nil, nil
+	}
+
+	recommendation, err := m.getRecommendation(ctx, template.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if recommendation.GetRecommended() == nil {
+		logger.Debugf(ctx, "Not enough samples [%d] to recommend resources for task [%+v]",
+			recommendation.GetSampleCount(), template.GetId())
+		return nil, nil
+	}
+
+	return &admin.TaskResourceAttributes{
+		Defaults: recommendation.GetRecommended(),
+	}, nil
+}
+
+func NewTaskResourceRecommendationManager(db repoInterfaces.Repository, config runtimeInterfaces.Configuration) interfaces.TaskResourceRecommendationInterface {
+	return &TaskResourceRecommendationManager{
+		db:     db,
+		config: config,
+	}
+}
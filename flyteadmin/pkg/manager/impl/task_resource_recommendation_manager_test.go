@@ -0,0 +1,203 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/testutils"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
+)
+
+func getMockConfigForRecommendationTest(autoApply bool) runtimeInterfaces.Configuration {
+	taskResourceConfig := runtimeMocks.NewMockTaskResourceConfiguration(runtimeInterfaces.TaskResourceSet{
+		CPU:    resource.MustParse("100m"),
+		Memory: resource.MustParse("100Mi"),
+		GPU:    resource.MustParse("1"),
+	}, runtimeInterfaces.TaskResourceSet{})
+	taskResourceConfig.(*runtimeMocks.MockTaskResourceConfiguration).Recommendations = runtimeInterfaces.TaskResourceRecommendationConfig{
+		Headroom:   0.2,
+		MinSamples: 2,
+		MaxSamples: 10,
+		AutoApply:  autoApply,
+	}
+	return runtimeMocks.NewMockConfigurationProvider(testutils.GetApplicationConfigWithDefaultDomains(), nil, nil,
+		taskResourceConfig, runtimeMocks.NewMockWhitelistConfiguration(), nil)
+}
+
+func getTaskExecutionModelWithPeakUsage(t *testing.T, usage ...*core.Resources_ResourceEntry) models.TaskExecution {
+	closure, err := proto.Marshal(&admin.TaskExecutionClosure{
+		Phase: core.TaskExecution_SUCCEEDED,
+		Metadata: &event.TaskExecutionMetadata{
+			PeakResourceUsage: usage,
+		},
+	})
+	assert.NoError(t, err)
+	return models.TaskExecution{
+		Phase:   core.TaskExecution_SUCCEEDED.String(),
+		Closure: closure,
+	}
+}
+
+func setMockTaskExecutionsWithPeakUsage(t *testing.T, repository interfaces.Repository, taskExecutions ...models.TaskExecution) {
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+		func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
+			assert.Equal(t, 10, input.Limit)
+			assert.Equal(t, "created_at desc", input.SortParameter.GetGormOrderExpr())
+			assert.Len(t, input.InlineFilters, 1)
+			queryExpr, _ := input.InlineFilters[0].GetGormQueryExpr()
+			assert.Equal(t, "phase = ?", queryExpr.Query)
+			assert.Equal(t, "SUCCEEDED", queryExpr.Args)
+			assert.Equal(t, map[string]interface{}{
+				"project": "project",
+				"domain":  "domain",
+				"name":    "name",
+				"version": "version",
+			}, input.MapFilters[0].GetFilter())
+			return interfaces.TaskExecutionCollectionOutput{TaskExecutions: taskExecutions}, nil
+		})
+}
+
+func TestGetTaskResourceRecommendation(t *testing.T) {
+	t.Run("recommended", func(t *testing.T) {
+		repository := repositoryMocks.NewMockRepository()
+		setMockTaskExecutionsWithPeakUsage(t, repository,
+			getTaskExecutionModelWithPeakUsage(t,
+				&core.Resources_ResourceEntry{Name: core.Resources_CPU, Value: "500m"},
+				&core.Resources_ResourceEntry{Name: core.Resources_MEMORY, Value: "1Gi"}),
+			getTaskExecutionModelWithPeakUsage(t,
+				&core.Resources_ResourceEntry{Name: core.Resources_CPU, Value: "1"},
+				&core.Resources_ResourceEntry{Name: core.Resources_MEMORY, Value: "512Mi"}),
+			getTaskExecutionModelWithPeakUsage(t))
+		manager := NewTaskResourceRecommendationManager(repository, getMockConfigForRecommendationTest(false))
+
+		recommendation, err := manager.GetTaskResourceRecommendation(context.Background(), &admin.ObjectGetRequest{
+			Id: &taskIdentifier,
+		})
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&admin.TaskResourceRecommendation{
+			Id:          &taskIdentifier,
+			Peak:        &admin.TaskResourceSpec{Cpu: "1", Memory: "1Gi"},
+			Recommended: &admin.TaskResourceSpec{Cpu: "1200m", Memory: "1229Mi"},
+			SampleCount: 2,
+		}, recommendation))
+	})
+
+	t.Run("not enough samples", func(t *testing.T) {
+		repository := repositoryMocks.NewMockRepository()
+		setMockTaskExecutionsWithPeakUsage(t, repository,
+			getTaskExecutionModelWithPeakUsage(t, &core.Resources_ResourceEntry{Name: core.Resources_MEMORY, Value: "1Gi"}))
+		manager := NewTaskResourceRecommendationManager(repository, getMockConfigForRecommendationTest(false))
+
+		recommendation, err := manager.GetTaskResourceRecommendation(context.Background(), &admin.ObjectGetRequest{
+			Id: &taskIdentifier,
+		})
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&admin.TaskResourceRecommendation{
+			Id:          &taskIdentifier,
+			Peak:        &admin.TaskResourceSpec{Memory: "1Gi"},
+			SampleCount: 1,
+		}, recommendation))
+	})
+
+	t.Run("invalid identifier", func(t *testing.T) {
+		manager := NewTaskResourceRecommendationManager(repositoryMocks.NewMockRepository(),
+			getMockConfigForRecommendationTest(false))
+
+		_, err := manager.GetTaskResourceRecommendation(context.Background(), &admin.ObjectGetRequest{
+			Id: &core.Identifier{ResourceType: core.ResourceType_TASK, Project: "project"},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func getCompiledTaskForRecommendationTest(tags map[string]string) *core.CompiledTask {
+	return &core.CompiledTask{
+		Template: &core.TaskTemplate{
+			Id:       &taskIdentifier,
+			Metadata: &core.TaskMetadata{Tags: tags},
+			Target: &core.TaskTemplate_Container{
+				Container: &core.Container{
+					Resources: &core.Resources{
+						Requests: []*core.Resources_ResourceEntry{
+							{Name: core.Resources_CPU, Value: "4"},
+							{Name: core.Resources_GPU, Value: "1"},
+						},
+						Limits: []*core.Resources_ResourceEntry{
+							{Name: core.Resources_CPU, Value: "4"},
+							{Name: core.Resources_MEMORY, Value: "16Gi"},
+							{Name: core.Resources_GPU, Value: "1"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetTaskResourceAttributes(t *testing.T) {
+	optedIn := map[string]string{AutoApplyResourceRecommendationTag: "true"}
+
+	t.Run("applied", func(t *testing.T) {
+		repository := repositoryMocks.NewMockRepository()
+		setMockTaskExecutionsWithPeakUsage(t, repository,
+			getTaskExecutionModelWithPeakUsage(t,
+				&core.Resources_ResourceEntry{Name: core.Resources_CPU, Value: "2"},
+				&core.Resources_ResourceEntry{Name: core.Resources_MEMORY, Value: "1Gi"}),
+			getTaskExecutionModelWithPeakUsage(t,
+				&core.Resources_ResourceEntry{Name: core.Resources_CPU, Value: "1"}))
+		manager := NewTaskResourceRecommendationManager(repository, getMockConfigForRecommendationTest(true))
+		task := getCompiledTaskForRecommendationTest(optedIn)
+
+		attributes, err := manager.GetTaskResourceAttributes(context.Background(), task)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&admin.TaskResourceAttributes{
+			Defaults: &admin.TaskResourceSpec{Cpu: "2400m", Memory: "1229Mi"},
+		}, attributes))
+		assert.True(t, proto.Equal(getCompiledTaskForRecommendationTest(optedIn), task))
+	})
+
+	t.Run("not enough samples", func(t *testing.T) {
+		repository := repositoryMocks.NewMockRepository()
+		setMockTaskExecutionsWithPeakUsage(t, repository)
+		manager := NewTaskResourceRecommendationManager(repository, getMockConfigForRecommendationTest(true))
+		task := getCompiledTaskForRecommendationTest(optedIn)
+
+		attributes, err := manager.GetTaskResourceAttributes(context.Background(), task)
+		assert.NoError(t, err)
+		assert.Nil(t, attributes)
+	})
+
+	for name, test := range map[string]struct {
+		autoApply bool
+		tags      map[string]string
+	}{
+		"not opted in":     {autoApply: true},
+		"auto apply unset": {tags: optedIn},
+	} {
+		t.Run(name, func(t *testing.T) {
+			repository := repositoryMocks.NewMockRepository()
+			repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetListCallback(
+				func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.TaskExecutionCollectionOutput, error) {
+					assert.Fail(t, "unexpected list")
+					return interfaces.TaskExecutionCollectionOutput{}, nil
+				})
+			manager := NewTaskResourceRecommendationManager(repository, getMockConfigForRecommendationTest(test.autoApply))
+			task := getCompiledTaskForRecommendationTest(test.tags)
+
+			attributes, err := manager.GetTaskResourceAttributes(context.Background(), task)
+			assert.NoError(t, err)
+			assert.Nil(t, attributes)
+		})
+	}
+}
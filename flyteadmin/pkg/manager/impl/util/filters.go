@@ -175,7 +175,9 @@ func ParseFilters(filterParams string, primaryEntity common.Entity) ([]common.In
 			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "'%s' entity is not allowed in filters", referencedEntity)
 		}
 
-		if !entityColumns[referencedEntity].Has(field) {
+		// JSON columns such as execution labels can only be filtered on by key, e.g. "labels.team".
+		if common.IsJSONColumn(referencedEntity, field) ||
+			(!entityColumns[referencedEntity].Has(field) && !common.IsJSONPathField(referencedEntity, field)) {
 			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "'%s.%s' is invalid filter", referencedEntity, field)
 		}
 
@@ -118,6 +118,35 @@ func Test_ParseFilters_InvalidFilter(t *testing.T) {
 	assert.EqualError(t, err, "'t.foo' is invalid filter")
 }
 
+func Test_ParseFilters_JSONPathFields(t *testing.T) {
+	filterExpression := "eq(labels.team, ml)+eq(input.date, 2024-01-01)+icontains(execution_name, Report)"
+
+	executionFilters, err := ParseFilters(filterExpression, common.Execution)
+	assert.NoError(t, err)
+	require.Len(t, executionFilters, 3)
+
+	actualFilterExpression, _ := executionFilters[0].GetGormQueryExpr()
+	assert.Equal(t, "labels ->> 'team' = ?", actualFilterExpression.Query)
+	assert.Equal(t, "ml", actualFilterExpression.Args)
+
+	actualFilterExpression, _ = executionFilters[1].GetGormQueryExpr()
+	assert.Equal(t, "inputs ->> 'date' = ?", actualFilterExpression.Query)
+	assert.Equal(t, "2024-01-01", actualFilterExpression.Args)
+
+	actualFilterExpression, _ = executionFilters[2].GetGormQueryExpr()
+	assert.Equal(t, "execution_name ILIKE ?", actualFilterExpression.Query)
+	assert.Equal(t, "%Report%", actualFilterExpression.Args)
+
+	_, err = ParseFilters("eq(labels, ml)", common.Execution)
+	assert.EqualError(t, err, "'e.labels' is invalid filter")
+
+	_, err = ParseFilters("eq(labels.team, ml)", common.Task)
+	assert.EqualError(t, err, "'t.labels.team' is invalid filter")
+
+	_, err = ParseFilters("eq(annotations.it's, ml)", common.Execution)
+	assert.EqualError(t, err, "invalid key in filter field: annotations.it's")
+}
+
 func TestGetEqualityFilter(t *testing.T) {
 	filter, err := GetSingleValueEqualityFilter(common.Task, "field", "value")
 	assert.NoError(t, err)
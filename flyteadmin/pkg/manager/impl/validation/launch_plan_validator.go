@@ -94,14 +94,13 @@ func validateSchedule(request *admin.LaunchPlanCreateRequest, expectedInputs *co
 		}
 
 		// validate cron expression
-		var cronExpression string
 		if schedule.GetCronExpression() != "" {
-			cronExpression = schedule.GetCronExpression()
-		} else if schedule.GetCronSchedule() != nil {
-			cronExpression = schedule.GetCronSchedule().GetSchedule()
-		}
-		if cronExpression != "" {
-			if _, err := cron.ParseStandard(cronExpression); err != nil {
+			if _, err := cron.ParseStandard(schedule.GetCronExpression()); err != nil {
+				return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "Invalid cron expression: %v", err)
+			}
+		} else if schedule.GetCronSchedule().GetSchedule() != "" {
+			// Cron schedules may be prefixed with timezone and exclusion calendar directives
+			if _, err := common.ParseCronSchedule(schedule.GetCronSchedule().GetSchedule()); err != nil {
 				return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "Invalid cron expression: %v", err)
 			}
 		}
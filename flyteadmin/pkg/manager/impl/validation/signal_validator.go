@@ -24,6 +24,10 @@ func ValidateSignalGetOrCreateRequest(ctx context.Context, request *admin.Signal
 	if request.GetType() == nil {
 		return shared.GetMissingArgumentError("type")
 	}
+	if request.GetApprovalPolicy() != nil && request.GetType().GetSimple() != core.SimpleType_BOOLEAN {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"signal [%v] with an approval policy must be of type boolean", request.GetId().GetSignalId())
+	}
 
 	return nil
 }
@@ -49,42 +53,43 @@ func ValidateSignalListRequest(ctx context.Context, request *admin.SignalListReq
 	return nil
 }
 
-func ValidateSignalSetRequest(ctx context.Context, db repositoryInterfaces.Repository, request *admin.SignalSetRequest) error {
+// ValidateSignalSetRequest validates the value of the request against the type of the existing signal, which is returned.
+func ValidateSignalSetRequest(ctx context.Context, db repositoryInterfaces.Repository, request *admin.SignalSetRequest) (*admin.Signal, error) {
 	if request.GetId() == nil {
-		return shared.GetMissingArgumentError("id")
+		return nil, shared.GetMissingArgumentError("id")
 	}
 	if err := ValidateSignalIdentifier(request.GetId()); err != nil {
-		return err
+		return nil, err
 	}
 	if request.GetValue() == nil {
-		return shared.GetMissingArgumentError("value")
+		return nil, shared.GetMissingArgumentError("value")
 	}
 
 	// validate that signal value matches type of existing signal
 	signalModel, err := transformers.CreateSignalModel(request.GetId(), nil, nil)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 	lookupSignalModel, err := db.SignalRepo().Get(ctx, signalModel.SignalKey)
 	if err != nil {
-		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"failed to validate that signal [%v] exists, err: [%+v]",
 			signalModel.SignalKey, err)
 	}
 	valueType := propellervalidators.LiteralTypeForLiteral(request.GetValue())
 	lookupSignal, err := transformers.FromSignalModel(lookupSignalModel)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = propellervalidators.ValidateLiteralType(valueType)
 	if err != nil {
-		return errors.NewInvalidLiteralTypeError("", err)
+		return nil, errors.NewInvalidLiteralTypeError("", err)
 	}
 	if !propellervalidators.AreTypesCastable(lookupSignal.GetType(), valueType) {
-		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"requested signal value [%v] is not castable to existing signal type [%v]",
 			request.GetValue(), lookupSignalModel.Type)
 	}
 
-	return nil
+	return lookupSignal, nil
 }
@@ -112,6 +112,28 @@ func TestValidateSignalGetOrCreateRequest(t *testing.T) {
 		}
 		assert.EqualError(t, ValidateSignalGetOrCreateRequest(ctx, request), "missing type")
 	})
+
+	t.Run("ApprovalPolicyNonBooleanType", func(t *testing.T) {
+		request := &admin.SignalGetOrCreateRequest{
+			Id: &core.SignalIdentifier{
+				ExecutionId: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+				SignalId: "signal",
+			},
+			Type: &core.LiteralType{
+				Type: &core.LiteralType_Simple{
+					Simple: core.SimpleType_STRING,
+				},
+			},
+			ApprovalPolicy: &core.ApprovalPolicy{
+				MinApprovals: 2,
+			},
+		}
+		assert.EqualError(t, ValidateSignalGetOrCreateRequest(ctx, request), "signal [signal] with an approval policy must be of type boolean")
+	})
 }
 
 func TestValidateSignalListrequest(t *testing.T) {
@@ -191,7 +213,9 @@ func TestValidateSignalUpdateRequest(t *testing.T) {
 				},
 			},
 		}
-		assert.NoError(t, ValidateSignalSetRequest(ctx, repo, request))
+		signal, err := ValidateSignalSetRequest(ctx, repo, request)
+		assert.NoError(t, err)
+		assert.NotNil(t, signal.GetType())
 	})
 
 	t.Run("MissingValue", func(t *testing.T) {
@@ -205,7 +229,8 @@ func TestValidateSignalUpdateRequest(t *testing.T) {
 				SignalId: "signal",
 			},
 		}
-		assert.EqualError(t, ValidateSignalSetRequest(ctx, repo, request), "missing value")
+		_, err := ValidateSignalSetRequest(ctx, repo, request)
+		assert.EqualError(t, err, "missing value")
 	})
 
 	t.Run("MissingSignal", func(t *testing.T) {
@@ -236,7 +261,8 @@ func TestValidateSignalUpdateRequest(t *testing.T) {
 				},
 			},
 		}
-		assert.EqualError(t, ValidateSignalSetRequest(ctx, repo, request),
+		_, err := ValidateSignalSetRequest(ctx, repo, request)
+		assert.EqualError(t, err,
 			"failed to validate that signal [{{project domain name} signal}] exists, err: [foo]")
 	})
 
@@ -280,8 +306,9 @@ func TestValidateSignalUpdateRequest(t *testing.T) {
 				},
 			},
 		}
+		_, err := ValidateSignalSetRequest(ctx, repo, request)
 		utils.AssertEqualWithSanitizedRegex(t,
-			"requested signal value [scalar:{ primitive:{ boolean:false } } ] is not castable to existing signal type [[8 1]]", ValidateSignalSetRequest(ctx, repo, request).Error())
+			"requested signal value [scalar:{ primitive:{ boolean:false } } ] is not castable to existing signal type [[8 1]]", err.Error())
 	})
 
 	t.Run("UnknownIDLType", func(t *testing.T) {
@@ -325,7 +352,7 @@ func TestValidateSignalUpdateRequest(t *testing.T) {
 		}
 
 		// Invoke the function and check for the expected error
-		err := ValidateSignalSetRequest(ctx, repo, &request)
+		_, err := ValidateSignalSetRequest(ctx, repo, &request)
 		assert.NotNil(t, err)
 
 		// Expected error message
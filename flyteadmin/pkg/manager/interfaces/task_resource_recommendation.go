@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+//go:generate mockery -name=TaskResourceRecommendationInterface -output=../mocks -case=underscore
+
+// Interface for recommending task resources based on the peak usage reported by past task executions.
+type TaskResourceRecommendationInterface interface {
+	GetTaskResourceRecommendation(ctx context.Context, request *admin.ObjectGetRequest) (
+		*admin.TaskResourceRecommendation, error)
+	GetTaskResourceAttributes(ctx context.Context, task *core.CompiledTask) (*admin.TaskResourceAttributes, error)
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"gorm.io/gorm"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/transformers"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// Number of executions read and updated at a time when backfilling columns.
+const executionBackfillBatchSize = 1000
+
+// BackfillExecutionFilterColumns populates the labels, annotations and inputs columns of the executions created before
+// they were added. Labels and annotations are resolved from the execution spec, falling back to the launch plan, and
+// the project labels are added. Values which came from matchable attributes at launch time can't be recovered. Only
+// the inputs still held in the closure are backfilled, inputs offloaded to the blob store aren't read.
+// Executions are read and updated in batches each committed on its own, so the backfill can run against a live
+// database and be resumed if interrupted.
+func BackfillExecutionFilterColumns(db *gorm.DB) error {
+	type Execution struct {
+		ID               uint
+		ExecutionProject string
+		LaunchPlanID     uint
+		Spec             []byte
+		Closure          []byte
+	}
+
+	launchPlanSpecs := make(map[uint]*admin.LaunchPlanSpec)
+	getLaunchPlanSpec := func(id uint) (*admin.LaunchPlanSpec, error) {
+		if spec, ok := launchPlanSpecs[id]; ok {
+			return spec, nil
+		}
+		var launchPlan models.LaunchPlan
+		spec := &admin.LaunchPlanSpec{}
+		if err := db.Select("spec").Where("id = ?", id).Take(&launchPlan).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+		} else if err := proto.Unmarshal(launchPlan.Spec, spec); err != nil {
+			return nil, err
+		}
+		launchPlanSpecs[id] = spec
+		return spec, nil
+	}
+
+	projectLabels := make(map[string]map[string]string)
+	getProjectLabels := func(identifier string) (map[string]string, error) {
+		if labels, ok := projectLabels[identifier]; ok {
+			return labels, nil
+		}
+		var project models.Project
+		projectProto := &admin.Project{}
+		if err := db.Select("labels").Where("identifier = ?", identifier).Take(&project).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+		} else if err := proto.Unmarshal(project.Labels, projectProto); err != nil {
+			return nil, err
+		}
+		projectLabels[identifier] = projectProto.GetLabels().GetValues()
+		return projectLabels[identifier], nil
+	}
+
+	var lastID uint
+	for {
+		var executions []Execution
+		if err := db.Table("executions").
+			Select("id, execution_project, launch_plan_id, spec, closure").
+			Where("id > ? AND labels IS NULL AND annotations IS NULL AND inputs IS NULL", lastID).
+			Order("id").
+			Limit(executionBackfillBatchSize).
+			Find(&executions).Error; err != nil {
+			return err
+		}
+		if len(executions) == 0 {
+			return nil
+		}
+
+		type executionUpdate struct {
+			id      uint
+			updates map[string]interface{}
+		}
+		var batch []executionUpdate
+		for _, execution := range executions {
+			lastID = execution.ID
+			spec := &admin.ExecutionSpec{}
+			if err := proto.Unmarshal(execution.Spec, spec); err != nil {
+				return fmt.Errorf("failed to unmarshal the spec of execution %d: %w", execution.ID, err)
+			}
+			closure := &admin.ExecutionClosure{}
+			if err := proto.Unmarshal(execution.Closure, closure); err != nil {
+				return fmt.Errorf("failed to unmarshal the closure of execution %d: %w", execution.ID, err)
+			}
+			launchPlanSpec, err := getLaunchPlanSpec(execution.LaunchPlanID)
+			if err != nil {
+				return err
+			}
+			defaultLabels, err := getProjectLabels(execution.ExecutionProject)
+			if err != nil {
+				return err
+			}
+
+			labels := make(map[string]string)
+			if spec.GetLabels().GetValues() != nil {
+				labels = spec.GetLabels().GetValues()
+			} else if launchPlanSpec.GetLabels().GetValues() != nil {
+				labels = launchPlanSpec.GetLabels().GetValues()
+			}
+			for key, value := range defaultLabels {
+				if _, ok := labels[key]; !ok {
+					labels[key] = value
+				}
+			}
+			annotations := spec.GetAnnotations().GetValues()
+			if annotations == nil {
+				annotations = launchPlanSpec.GetAnnotations().GetValues()
+			}
+
+			updates := make(map[string]interface{})
+			for column, values := range map[string]map[string]string{
+				"labels":      labels,
+				"annotations": annotations,
+				"inputs":      transformers.GetFilterableInputValues(closure.GetComputedInputs()),
+			} {
+				serialized, err := transformers.MarshalFilterableValues(values)
+				if err != nil {
+					return err
+				}
+				if serialized != nil {
+					updates[column] = serialized
+				}
+			}
+			if len(updates) == 0 {
+				continue
+			}
+			batch = append(batch, executionUpdate{id: execution.ID, updates: updates})
+		}
+
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			for _, update := range batch {
+				if err := tx.Table("executions").Where("id = ?", update.id).Updates(update.updates).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+func TestBackfillExecutionFilterColumns(t *testing.T) {
+	gormDb := GetDbForTest(t)
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	spec, err := proto.Marshal(&admin.ExecutionSpec{
+		Labels: &admin.Labels{Values: map[string]string{"team": "ml"}},
+	})
+	assert.NoError(t, err)
+	launchPlanSpec, err := proto.Marshal(&admin.LaunchPlanSpec{
+		Annotations: &admin.Annotations{Values: map[string]string{"owner": "alice"}},
+	})
+	assert.NoError(t, err)
+	closure, err := proto.Marshal(&admin.ExecutionClosure{
+		ComputedInputs: &core.LiteralMap{Literals: map[string]*core.Literal{
+			"region": coreutils.MustMakeLiteral("eu"),
+		}},
+	})
+	assert.NoError(t, err)
+	project, err := proto.Marshal(&admin.Project{
+		Labels: &admin.Labels{Values: map[string]string{"team": "platform", "cost-center": "42"}},
+	})
+	assert.NoError(t, err)
+
+	GlobalMock.NewMock().WithQuery(`SELECT id, execution_project, launch_plan_id, spec, closure FROM "executions" WHERE id > $1`).
+		OneTime().WithReply([]map[string]interface{}{
+		{"id": 1, "execution_project": "project", "launch_plan_id": 2, "spec": spec, "closure": closure},
+	})
+	GlobalMock.NewMock().WithQuery(`SELECT "spec" FROM "launch_plans" WHERE id = $1`).
+		WithReply([]map[string]interface{}{{"spec": launchPlanSpec}})
+	GlobalMock.NewMock().WithQuery(`SELECT "labels" FROM "projects" WHERE identifier = $1`).
+		WithReply([]map[string]interface{}{{"labels": project}})
+	update := GlobalMock.NewMock().WithQuery(`UPDATE "executions" SET "annotations"=$1,"inputs"=$2,"labels"=$3 WHERE id = $4`).
+		WithArgs([]byte(`{"owner":"alice"}`), []byte(`{"region":"eu"}`), []byte(`{"cost-center":"42","team":"ml"}`), int64(1))
+
+	assert.NoError(t, BackfillExecutionFilterColumns(gormDb))
+	assert.True(t, update.Triggered)
+}
@@ -1285,6 +1285,94 @@ var ContinuedMigrations = []*gormigrate.Migration{
 			return nil
 		},
 	},
+	{
+		ID: "2026-10-18-schedulable_entities-timezone-calendar",
+		Migrate: func(tx *gorm.DB) error {
+			type SchedulableEntityKey struct {
+				Project string `gorm:"primary_key"`
+				Domain  string `gorm:"primary_key"`
+				Name    string `gorm:"primary_key"`
+				Version string `gorm:"primary_key"`
+			}
+			type SchedulableEntity struct {
+				ID        uint `gorm:"index;autoIncrement;not null"`
+				CreatedAt time.Time
+				UpdatedAt time.Time
+				DeletedAt *time.Time `gorm:"index"`
+				SchedulableEntityKey
+				CronExpression      string
+				FixedRateValue      uint32
+				Unit                admin.FixedRateUnit
+				KickoffTimeInputArg string
+				Active              *bool
+				Timezone            string
+				ExclusionCalendar   string
+			}
+
+			return tx.AutoMigrate(&SchedulableEntity{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Model(&schedulerModels.SchedulableEntity{}).Migrator().DropColumn(&schedulerModels.SchedulableEntity{}, "timezone"); err != nil {
+				return err
+			}
+			return tx.Model(&schedulerModels.SchedulableEntity{}).Migrator().DropColumn(&schedulerModels.SchedulableEntity{}, "exclusion_calendar")
+		},
+	},
+	{
+		ID: "2026-10-18-executions-labels-annotations-inputs",
+		Migrate: func(tx *gorm.DB) error {
+			type Execution struct {
+				Labels      []byte `gorm:"type:jsonb"`
+				Annotations []byte `gorm:"type:jsonb"`
+				Inputs      []byte `gorm:"type:jsonb"`
+			}
+
+			for _, column := range []string{"labels", "annotations", "inputs"} {
+				if tx.Migrator().HasColumn(&Execution{}, column) {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(&Execution{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"labels", "annotations", "inputs"} {
+				if err := tx.Migrator().DropColumn(&models.Execution{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		ID: "2026-10-18-signals-approvals",
+		Migrate: func(tx *gorm.DB) error {
+			type Signal struct {
+				ApprovalPolicy []byte
+				Approvals      []byte
+			}
+
+			for _, column := range []string{"approval_policy", "approvals"} {
+				if tx.Migrator().HasColumn(&Signal{}, column) {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(&Signal{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"approval_policy", "approvals"} {
+				if err := tx.Migrator().DropColumn(&models.Signal{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
 }
 
 var m = append(LegacyMigrations, NoopMigrations...)
@@ -80,9 +80,24 @@ func ValidateListInput(input interfaces.ListResourceInput) adminErrors.FlyteAdmi
 	return nil
 }
 
+// getGormQueryExpr compiles the filter for the dialect of the database if it depends on it.
+func getGormQueryExpr(tx *gorm.DB, filter common.InlineFilter) (common.GormQueryExpr, error) {
+	if dialectFilter, ok := filter.(common.DialectInlineFilter); ok {
+		return dialectFilter.GetDialectGormQueryExpr(tx.Dialector.Name())
+	}
+	return filter.GetGormQueryExpr()
+}
+
+func getGormJoinTableQueryExpr(tx *gorm.DB, filter common.InlineFilter, tableName string) (common.GormQueryExpr, error) {
+	if dialectFilter, ok := filter.(common.DialectInlineFilter); ok {
+		return dialectFilter.GetDialectGormJoinTableQueryExpr(tx.Dialector.Name(), tableName)
+	}
+	return filter.GetGormJoinTableQueryExpr(tableName)
+}
+
 func applyFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFilters []common.MapFilter) (*gorm.DB, error) {
 	for _, filter := range inlineFilters {
-		gormQueryExpr, err := filter.GetGormQueryExpr()
+		gormQueryExpr, err := getGormQueryExpr(tx, filter)
 		if err != nil {
 			return nil, errors.GetInvalidInputError(err.Error())
 		}
@@ -101,7 +116,7 @@ func applyScopedFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFil
 			return nil, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument,
 				"unrecognized entity in filter expression: %v", filter.GetEntity())
 		}
-		gormQueryExpr, err := filter.GetGormJoinTableQueryExpr(tableName)
+		gormQueryExpr, err := getGormJoinTableQueryExpr(tx, filter, tableName)
 		if err != nil {
 			return nil, err
 		}
@@ -6,6 +6,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	adminerrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	flyteAdminDbErrors "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
@@ -96,6 +97,37 @@ func (s *SignalRepo) Update(ctx context.Context, input models.SignalKey, value [
 	return nil
 }
 
+// Approve applies a decision to a signal while holding a lock on its row
+func (s *SignalRepo) Approve(ctx context.Context, input models.SignalKey, approve func(signal *models.Signal) error) error {
+	timer := s.metrics.UpdateDuration.Start()
+	defer timer.Stop()
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var signal models.Signal
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&models.Signal{
+			SignalKey: input,
+		}).Take(&signal).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return adminerrors.NewFlyteAdminError(codes.NotFound, "signal does not exist")
+		}
+		if err != nil {
+			return s.errorTransformer.ToFlyteAdminError(err)
+		}
+
+		if err := approve(&signal); err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.Signal{SignalKey: input}).Select("value", "approvals").Updates(models.Signal{
+			Value:     signal.Value,
+			Approvals: signal.Approvals,
+		}).Error
+		if err != nil {
+			return s.errorTransformer.ToFlyteAdminError(err)
+		}
+		return nil
+	})
+}
+
 // Returns an instance of SignalRepoInterface
 func NewSignalRepo(
 	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.SignalRepoInterface {
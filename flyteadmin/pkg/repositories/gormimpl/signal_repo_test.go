@@ -8,8 +8,10 @@ import (
 
 	mocket "github.com/Selvatico/go-mocket"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	adminerrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
@@ -98,7 +100,7 @@ func TestGetOrCreateSignal(t *testing.T) {
 	// create initial signalModel
 	mockInsertQuery := GlobalMock.NewMock()
 	mockInsertQuery.WithQuery(
-		`INSERT INTO "signals" ("created_at","updated_at","deleted_at","execution_project","execution_domain","execution_name","signal_id","type","value","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`)
+		`INSERT INTO "signals" ("created_at","updated_at","deleted_at","execution_project","execution_domain","execution_name","signal_id","type","value","approval_policy","approvals","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)`)
 
 	err := signalRepo.GetOrCreate(ctx, signalModel)
 	assert.NoError(t, err)
@@ -181,3 +183,42 @@ func TestUpdateSignal(t *testing.T) {
 
 	assert.True(t, mockUpdateQuery.Triggered)
 }
+
+func TestApproveSignal(t *testing.T) {
+	ctx := context.Background()
+
+	signalRepo := NewSignalRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	lockedSignalModel := *signalModel
+	lockedSignalModel.Value = nil
+	mockSelectQuery := GlobalMock.NewMock()
+	mockSelectQuery.WithQuery(
+		`SELECT * FROM "signals" WHERE "signals"."execution_project" = $1 AND "signals"."execution_domain" = $2 AND "signals"."execution_name" = $3 AND "signals"."signal_id" = $4 LIMIT 1 FOR UPDATE`).
+		WithReply([]map[string]interface{}{toSignalMap(lockedSignalModel)})
+	mockUpdateQuery := GlobalMock.NewMock()
+	mockUpdateQuery.WithQuery(
+		`UPDATE "signals" SET "updated_at"=$1,"value"=$2,"approvals"=$3 WHERE "execution_project" = $4 AND "execution_domain" = $5 AND "execution_name" = $6 AND "signal_id" = $7`).WithRowsNum(1)
+
+	err := signalRepo.Approve(ctx, signalModel.SignalKey, func(signal *models.Signal) error {
+		assert.Empty(t, signal.Value)
+		signal.Value = []byte{3, 4}
+		signal.Approvals = []byte(`[{"identity":"user","approved":true}]`)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, mockSelectQuery.Triggered)
+	assert.True(t, mockUpdateQuery.Triggered)
+
+	// decisions rejected by the approve function are not saved
+	mockSelectQuery.Triggered = false
+	mockUpdateQuery.Triggered = false
+	err = signalRepo.Approve(ctx, signalModel.SignalKey, func(signal *models.Signal) error {
+		return adminerrors.NewFlyteAdminError(codes.PermissionDenied, "denied")
+	})
+	assert.Error(t, err)
+	assert.True(t, mockSelectQuery.Triggered)
+	assert.False(t, mockUpdateQuery.Triggered)
+}
@@ -19,6 +19,9 @@ type SignalRepoInterface interface {
 	List(ctx context.Context, input ListResourceInput) ([]models.Signal, error)
 	// Update sets the value on a signal in the database store.
 	Update(ctx context.Context, input models.SignalKey, value []byte) error
+	// Approve applies a decision to a signal in the database store. The approvals and value set by the approve function
+	// are saved atomically, concurrent decisions on the same signal are applied one after another.
+	Approve(ctx context.Context, input models.SignalKey, approve func(signal *models.Signal) error) error
 }
 
 type GetSignalInput struct {
@@ -16,6 +16,38 @@ type SignalRepoInterface struct {
 	mock.Mock
 }
 
+type SignalRepoInterface_Approve struct {
+	*mock.Call
+}
+
+func (_m SignalRepoInterface_Approve) Return(_a0 error) *SignalRepoInterface_Approve {
+	return &SignalRepoInterface_Approve{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *SignalRepoInterface) OnApprove(ctx context.Context, input models.SignalKey, approve func(*models.Signal) error) *SignalRepoInterface_Approve {
+	c_call := _m.On("Approve", ctx, input, approve)
+	return &SignalRepoInterface_Approve{Call: c_call}
+}
+
+func (_m *SignalRepoInterface) OnApproveMatch(matchers ...interface{}) *SignalRepoInterface_Approve {
+	c_call := _m.On("Approve", matchers...)
+	return &SignalRepoInterface_Approve{Call: c_call}
+}
+
+// Approve provides a mock function with given fields: ctx, input, approve
+func (_m *SignalRepoInterface) Approve(ctx context.Context, input models.SignalKey, approve func(*models.Signal) error) error {
+	ret := _m.Called(ctx, input, approve)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SignalKey, func(*models.Signal) error) error); ok {
+		r0 = rf(ctx, input, approve)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type SignalRepoInterface_Get struct {
 	*mock.Call
 }
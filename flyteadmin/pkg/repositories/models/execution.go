@@ -65,6 +65,12 @@ type Execution struct {
 	LaunchEntity string
 	// Tags associated with the execution
 	Tags []AdminTag `gorm:"many2many:execution_admin_tags;"`
+	// Labels and annotations of the execution as JSON objects.
+	// These are also stored in the spec but promoted as columns for filtering.
+	Labels      []byte `gorm:"type:jsonb"`
+	Annotations []byte `gorm:"type:jsonb"`
+	// Primitive input values as a JSON object of strings, promoted from the offloaded inputs for filtering.
+	Inputs []byte `gorm:"type:jsonb"`
 }
 
 type AdminTag struct {
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // Signal primary key
 type SignalKey struct {
 	ExecutionKey
@@ -12,6 +14,18 @@ type Signal struct {
 	SignalKey
 	Type  []byte `gorm:"not null"`
 	Value []byte
+	// Serialized approval policy of signals created by approve conditions with a policy.
+	ApprovalPolicy []byte
+	// Decisions recorded on the signal as a JSON array of SignalApproval.
+	Approvals []byte
+}
+
+// SignalApproval records the decision of a single identity on a signal with an approval policy.
+type SignalApproval struct {
+	Identity  string    `json:"identity"`
+	Approved  bool      `json:"approved"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 var SignalColumns = modelColumns(Signal{})
@@ -1,9 +1,12 @@
 package transformers
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -46,6 +49,10 @@ type CreateExecutionModelInput struct {
 	LaunchEntity          core.ResourceType
 	Namespace             string
 	Error                 error
+	// Resolved labels, annotations and inputs of the execution, promoted to columns for filtering.
+	Labels      map[string]string
+	Annotations map[string]string
+	Inputs      *core.LiteralMap
 }
 
 type ExecutionTransformerOptions struct {
@@ -143,10 +150,66 @@ func CreateExecutionModel(input CreateExecutionModelInput) (*models.Execution, e
 	if input.RequestSpec.GetMetadata() != nil {
 		executionModel.Mode = int32(input.RequestSpec.GetMetadata().GetMode())
 	}
+	if executionModel.Labels, err = MarshalFilterableValues(input.Labels); err != nil {
+		return nil, flyteErrs.NewFlyteAdminErrorf(codes.Internal, "Failed to serialize execution labels: %v", err)
+	}
+	if executionModel.Annotations, err = MarshalFilterableValues(input.Annotations); err != nil {
+		return nil, flyteErrs.NewFlyteAdminErrorf(codes.Internal, "Failed to serialize execution annotations: %v", err)
+	}
+	if executionModel.Inputs, err = MarshalFilterableValues(GetFilterableInputValues(input.Inputs)); err != nil {
+		return nil, flyteErrs.NewFlyteAdminErrorf(codes.Internal, "Failed to serialize execution inputs: %v", err)
+	}
 
 	return executionModel, nil
 }
 
+// MarshalFilterableValues serializes values as a JSON object, no values are stored as NULL.
+func MarshalFilterableValues(values map[string]string) ([]byte, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(values); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// GetFilterableInputValues returns the string representation of the primitive inputs which executions can be filtered
+// on. Datetimes at midnight UTC are formatted as dates, which is how dates are passed to executions, all other datetimes
+// use RFC3339.
+func GetFilterableInputValues(inputs *core.LiteralMap) map[string]string {
+	values := make(map[string]string)
+	for name, literal := range inputs.GetLiterals() {
+		primitive := literal.GetScalar().GetPrimitive()
+		if primitive == nil {
+			continue
+		}
+		switch value := primitive.GetValue().(type) {
+		case *core.Primitive_Integer:
+			values[name] = strconv.FormatInt(value.Integer, 10)
+		case *core.Primitive_FloatValue:
+			values[name] = strconv.FormatFloat(value.FloatValue, 'f', -1, 64)
+		case *core.Primitive_StringValue:
+			values[name] = value.StringValue
+		case *core.Primitive_Boolean:
+			values[name] = strconv.FormatBool(value.Boolean)
+		case *core.Primitive_Datetime:
+			datetime := value.Datetime.AsTime().UTC()
+			if datetime.Equal(datetime.Truncate(24 * time.Hour)) {
+				values[name] = datetime.Format(time.DateOnly)
+			} else {
+				values[name] = datetime.Format(time.RFC3339Nano)
+			}
+		case *core.Primitive_Duration:
+			values[name] = value.Duration.AsDuration().String()
+		}
+	}
+	return values
+}
+
 // CreateExecutionTagModel transforms a CreateExecutionModelInput to a ExecutionTag model
 func CreateExecutionTagModel(input CreateExecutionModelInput) ([]*models.ExecutionTag, error) {
 	tags := make([]*models.ExecutionTag, 0)
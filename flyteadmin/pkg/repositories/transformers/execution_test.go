@@ -20,6 +20,7 @@ import (
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/testutils"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
@@ -127,6 +128,35 @@ func TestCreateExecutionModel(t *testing.T) {
 			},
 		})
 		assert.Equal(t, expectedClosure, execution.Closure)
+		assert.Nil(t, execution.Labels)
+		assert.Nil(t, execution.Annotations)
+		assert.Nil(t, execution.Inputs)
+	})
+	t.Run("filterable labels, annotations and inputs", func(t *testing.T) {
+		execution, err := CreateExecutionModel(CreateExecutionModelInput{
+			WorkflowExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			RequestSpec:        execRequest.GetSpec(),
+			LaunchPlanID:       lpID,
+			WorkflowID:         wfID,
+			CreatedAt:          createdAt,
+			WorkflowIdentifier: workflowIdentifier,
+			LaunchEntity:       core.ResourceType_LAUNCH_PLAN,
+			Labels:             map[string]string{"team": "ml"},
+			Annotations:        map[string]string{"owner": "jane"},
+			Inputs: &core.LiteralMap{
+				Literals: map[string]*core.Literal{
+					"region": coreutils.MustMakeLiteral("eu"),
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"team": "ml"}`, string(execution.Labels))
+		assert.JSONEq(t, `{"owner": "jane"}`, string(execution.Annotations))
+		assert.JSONEq(t, `{"region": "eu"}`, string(execution.Inputs))
 	})
 	t.Run("failed with unknown error", func(t *testing.T) {
 		execErr := fmt.Errorf("bla-bla")
@@ -1103,3 +1133,30 @@ func TestTrimErrorMessage(t *testing.T) {
 	errMsgAreValidUTF8 := utf8.Valid([]byte(trimmedErrMessage))
 	assert.True(t, errMsgAreValidUTF8)
 }
+
+func TestGetFilterableInputValues(t *testing.T) {
+	inputs := &core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			"count":    coreutils.MustMakeLiteral(42),
+			"ratio":    coreutils.MustMakeLiteral(0.5),
+			"name":     coreutils.MustMakeLiteral("report"),
+			"dry_run":  coreutils.MustMakeLiteral(true),
+			"date":     coreutils.MustMakeLiteral(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			"since":    coreutils.MustMakeLiteral(time.Date(2024, time.January, 1, 12, 30, 0, 0, time.UTC)),
+			"timeout":  coreutils.MustMakeLiteral(90 * time.Second),
+			"ignored":  coreutils.MustMakeLiteral([]interface{}{"a", "b"}),
+			"metadata": coreutils.MustMakeLiteral(map[string]interface{}{"a": "b"}),
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"count":   "42",
+		"ratio":   "0.5",
+		"name":    "report",
+		"dry_run": "true",
+		"date":    "2024-01-01",
+		"since":   "2024-01-01T12:30:00Z",
+		"timeout": "1m30s",
+	}, GetFilterableInputValues(inputs))
+	assert.Empty(t, GetFilterableInputValues(nil))
+}
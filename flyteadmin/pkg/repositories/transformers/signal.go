@@ -1,8 +1,11 @@
 package transformers
 
 import (
+	"encoding/json"
+
 	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
@@ -53,6 +56,46 @@ func CreateSignalModel(signalID *core.SignalIdentifier, signalType *core.Literal
 	return signalModel, nil
 }
 
+// CreateApprovalSignalModel creates the model of a signal whose value is only set once its approval policy is satisfied.
+func CreateApprovalSignalModel(signalID *core.SignalIdentifier, signalType *core.LiteralType, policy *core.ApprovalPolicy) (models.Signal, error) {
+	signalModel, err := CreateSignalModel(signalID, signalType, nil)
+	if err != nil {
+		return models.Signal{}, err
+	}
+
+	policyBytes, err := proto.Marshal(policy)
+	if err != nil {
+		return models.Signal{}, errors.NewFlyteAdminError(codes.Internal, "Failed to serialize signal approval policy")
+	}
+
+	signalModel.ApprovalPolicy = policyBytes
+	return signalModel, nil
+}
+
+// UnmarshalSignalApprovals returns the decisions recorded on a signal model.
+func UnmarshalSignalApprovals(signalModel models.Signal) ([]models.SignalApproval, error) {
+	var approvals []models.SignalApproval
+	if len(signalModel.Approvals) == 0 {
+		return approvals, nil
+	}
+
+	if err := json.Unmarshal(signalModel.Approvals, &approvals); err != nil {
+		return nil, errors.NewFlyteAdminError(codes.Internal, "failed to unmarshal signal approvals")
+	}
+
+	return approvals, nil
+}
+
+// MarshalSignalApprovals serializes the decisions recorded on a signal model.
+func MarshalSignalApprovals(approvals []models.SignalApproval) ([]byte, error) {
+	approvalsBytes, err := json.Marshal(approvals)
+	if err != nil {
+		return nil, errors.NewFlyteAdminError(codes.Internal, "Failed to serialize signal approvals")
+	}
+
+	return approvalsBytes, nil
+}
+
 func initSignalIdentifier(id *core.SignalIdentifier) *core.SignalIdentifier {
 	if id == nil {
 		id = &core.SignalIdentifier{}
@@ -116,6 +159,28 @@ func FromSignalModel(signalModel models.Signal) (*admin.Signal, error) {
 		signal.Value = &valueDeserialized
 	}
 
+	if len(signalModel.ApprovalPolicy) > 0 {
+		var policyDeserialized core.ApprovalPolicy
+		err := proto.Unmarshal(signalModel.ApprovalPolicy, &policyDeserialized)
+		if err != nil {
+			return &admin.Signal{}, errors.NewFlyteAdminError(codes.Internal, "failed to unmarshal signal approval policy")
+		}
+		signal.ApprovalPolicy = &policyDeserialized
+	}
+
+	approvals, err := UnmarshalSignalApprovals(signalModel)
+	if err != nil {
+		return &admin.Signal{}, err
+	}
+	for _, approval := range approvals {
+		signal.Approvals = append(signal.Approvals, &admin.SignalApproval{
+			Identity:  approval.Identity,
+			Approved:  approval.Approved,
+			Reason:    approval.Reason,
+			CreatedAt: timestamppb.New(approval.CreatedAt),
+		})
+	}
+
 	return signal, nil
 }
 
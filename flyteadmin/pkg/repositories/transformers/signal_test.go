@@ -2,9 +2,11 @@ package transformers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
@@ -129,6 +131,38 @@ func TestFromSignalModel(t *testing.T) {
 	}
 }
 
+func TestSignalApprovals(t *testing.T) {
+	policy := &core.ApprovalPolicy{
+		MinApprovals:      2,
+		AllowedIdentities: []string{"alice", "bob"},
+	}
+
+	signalModel, err := CreateApprovalSignalModel(&signalID, &booleanType, policy)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, signalModel.ApprovalPolicy)
+
+	approvals, err := UnmarshalSignalApprovals(signalModel)
+	assert.NoError(t, err)
+	assert.Empty(t, approvals)
+
+	createdAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	signalModel.Approvals, err = MarshalSignalApprovals([]models.SignalApproval{
+		{Identity: "alice", Approved: true, Reason: "lgtm", CreatedAt: createdAt},
+	})
+	assert.NoError(t, err)
+
+	signal, err := FromSignalModel(signalModel)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&admin.Signal{
+		Id:             &signalID,
+		Type:           &booleanType,
+		ApprovalPolicy: policy,
+		Approvals: []*admin.SignalApproval{
+			{Identity: "alice", Approved: true, Reason: "lgtm", CreatedAt: timestamppb.New(createdAt)},
+		},
+	}, signal))
+}
+
 func TestFromSignalModels(t *testing.T) {
 	booleanTypeBytes, _ := proto.Marshal(&booleanType)
 	booleanValueBytes, _ := proto.Marshal(&booleanValue)
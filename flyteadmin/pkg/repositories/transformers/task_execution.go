@@ -368,6 +368,15 @@ func mergeMetadata(existing, latest *event.TaskExecutionMetadata) *event.TaskExe
 	if latest.GetInstanceClass() != event.TaskExecutionMetadata_DEFAULT && existing.GetInstanceClass() != latest.GetInstanceClass() {
 		existing.InstanceClass = latest.GetInstanceClass()
 	}
+	if len(latest.GetPeakResourceUsage()) > 0 {
+		existing.PeakResourceUsage = latest.GetPeakResourceUsage()
+	}
+	if latest.GetEscalatedResources() != nil {
+		existing.EscalatedResources = latest.GetEscalatedResources()
+	}
+	if latest.GetPreempted() {
+		existing.Preempted = true
+	}
 
 	return existing
 }
@@ -1881,6 +1881,74 @@ func TestMergeMetadata(t *testing.T) {
 			},
 			name: "all updates",
 		},
+		{
+			existing: &event.TaskExecutionMetadata{
+				PeakResourceUsage: []*core.Resources_ResourceEntry{
+					{Name: core.Resources_MEMORY, Value: "1Gi"},
+				},
+			},
+			latest: &event.TaskExecutionMetadata{},
+			expected: &event.TaskExecutionMetadata{
+				PeakResourceUsage: []*core.Resources_ResourceEntry{
+					{Name: core.Resources_MEMORY, Value: "1Gi"},
+				},
+			},
+			name: "keep peak resource usage",
+		},
+		{
+			existing: &event.TaskExecutionMetadata{
+				PeakResourceUsage: []*core.Resources_ResourceEntry{
+					{Name: core.Resources_MEMORY, Value: "1Gi"},
+				},
+			},
+			latest: &event.TaskExecutionMetadata{
+				PeakResourceUsage: []*core.Resources_ResourceEntry{
+					{Name: core.Resources_CPU, Value: "500m"},
+					{Name: core.Resources_MEMORY, Value: "2Gi"},
+				},
+			},
+			expected: &event.TaskExecutionMetadata{
+				PeakResourceUsage: []*core.Resources_ResourceEntry{
+					{Name: core.Resources_CPU, Value: "500m"},
+					{Name: core.Resources_MEMORY, Value: "2Gi"},
+				},
+			},
+			name: "update peak resource usage",
+		},
+		{
+			existing: &event.TaskExecutionMetadata{
+				EscalatedResources: &core.Resources{
+					Limits: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "2Gi"}},
+				},
+			},
+			latest: &event.TaskExecutionMetadata{},
+			expected: &event.TaskExecutionMetadata{
+				EscalatedResources: &core.Resources{
+					Limits: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "2Gi"}},
+				},
+			},
+			name: "keep escalated resources",
+		},
+		{
+			existing: &event.TaskExecutionMetadata{},
+			latest: &event.TaskExecutionMetadata{
+				Preempted: true,
+			},
+			expected: &event.TaskExecutionMetadata{
+				Preempted: true,
+			},
+			name: "preempted",
+		},
+		{
+			existing: &event.TaskExecutionMetadata{
+				Preempted: true,
+			},
+			latest: &event.TaskExecutionMetadata{},
+			expected: &event.TaskExecutionMetadata{
+				Preempted: true,
+			},
+			name: "keep preempted",
+		},
 	}
 
 	for _, mergeTestCase := range testCases {
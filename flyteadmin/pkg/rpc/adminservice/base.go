@@ -28,19 +28,20 @@ import (
 
 type AdminService struct {
 	service.UnimplementedAdminServiceServer
-	TaskManager              interfaces.TaskInterface
-	WorkflowManager          interfaces.WorkflowInterface
-	LaunchPlanManager        interfaces.LaunchPlanInterface
-	ExecutionManager         interfaces.ExecutionInterface
-	NodeExecutionManager     interfaces.NodeExecutionInterface
-	TaskExecutionManager     interfaces.TaskExecutionInterface
-	ProjectManager           interfaces.ProjectInterface
-	ResourceManager          interfaces.ResourceInterface
-	NamedEntityManager       interfaces.NamedEntityInterface
-	VersionManager           interfaces.VersionInterface
-	DescriptionEntityManager interfaces.DescriptionEntityInterface
-	MetricsManager           interfaces.MetricsInterface
-	Metrics                  AdminMetrics
+	TaskManager                       interfaces.TaskInterface
+	WorkflowManager                   interfaces.WorkflowInterface
+	LaunchPlanManager                 interfaces.LaunchPlanInterface
+	ExecutionManager                  interfaces.ExecutionInterface
+	NodeExecutionManager              interfaces.NodeExecutionInterface
+	TaskExecutionManager              interfaces.TaskExecutionInterface
+	ProjectManager                    interfaces.ProjectInterface
+	ResourceManager                   interfaces.ResourceInterface
+	NamedEntityManager                interfaces.NamedEntityInterface
+	VersionManager                    interfaces.VersionInterface
+	DescriptionEntityManager          interfaces.DescriptionEntityInterface
+	MetricsManager                    interfaces.MetricsInterface
+	TaskResourceRecommendationManager interfaces.TaskResourceRecommendationInterface
+	Metrics                           AdminMetrics
 }
 
 const defaultRetries = 3
@@ -164,6 +165,7 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		ResourceManager:          resources.NewResourceManager(repo, configuration.ApplicationConfiguration()),
 		MetricsManager: manager.NewMetricsManager(workflowManager, executionManager, nodeExecutionManager,
 			taskExecutionManager, adminScope.NewSubScope("metrics_manager")),
-		Metrics: InitMetrics(adminScope),
+		TaskResourceRecommendationManager: manager.NewTaskResourceRecommendationManager(repo, configuration),
+		Metrics:                           InitMetrics(adminScope),
 	}
 }
@@ -80,10 +80,11 @@ type attributeEndpointMetrics struct {
 type taskEndpointMetrics struct {
 	scope promutils.Scope
 
-	create  util.RequestMetrics
-	get     util.RequestMetrics
-	list    util.RequestMetrics
-	listIds util.RequestMetrics
+	create                    util.RequestMetrics
+	get                       util.RequestMetrics
+	list                      util.RequestMetrics
+	listIds                   util.RequestMetrics
+	getResourceRecommendation util.RequestMetrics
 }
 
 type taskExecutionEndpointMetrics struct {
@@ -98,10 +99,11 @@ type taskExecutionEndpointMetrics struct {
 type workflowEndpointMetrics struct {
 	scope promutils.Scope
 
-	create  util.RequestMetrics
-	get     util.RequestMetrics
-	list    util.RequestMetrics
-	listIds util.RequestMetrics
+	create                    util.RequestMetrics
+	get                       util.RequestMetrics
+	list                      util.RequestMetrics
+	listIds                   util.RequestMetrics
+	getResourceRecommendation util.RequestMetrics
 }
 
 type descriptionEntityEndpointMetrics struct {
@@ -208,11 +210,12 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			list:  util.NewRequestMetrics(adminScope, "list_matchable_resource_attrs"),
 		},
 		taskEndpointMetrics: taskEndpointMetrics{
-			scope:   adminScope,
-			create:  util.NewRequestMetrics(adminScope, "create_task"),
-			get:     util.NewRequestMetrics(adminScope, "get_task"),
-			list:    util.NewRequestMetrics(adminScope, "list_task"),
-			listIds: util.NewRequestMetrics(adminScope, "list_task_ids"),
+			scope:                     adminScope,
+			create:                    util.NewRequestMetrics(adminScope, "create_task"),
+			get:                       util.NewRequestMetrics(adminScope, "get_task"),
+			list:                      util.NewRequestMetrics(adminScope, "list_task"),
+			listIds:                   util.NewRequestMetrics(adminScope, "list_task_ids"),
+			getResourceRecommendation: util.NewRequestMetrics(adminScope, "get_task_resource_recommendation"),
 		},
 		taskExecutionEndpointMetrics: taskExecutionEndpointMetrics{
 			scope:       adminScope,
@@ -71,3 +71,23 @@ func (m *AdminService) ListTasks(ctx context.Context, request *admin.ResourceLis
 	m.Metrics.taskEndpointMetrics.list.Success()
 	return response, nil
 }
+
+func (m *AdminService) GetTaskResourceRecommendation(
+	ctx context.Context, request *admin.ObjectGetRequest) (*admin.TaskResourceRecommendation, error) {
+	// NOTE: When the Get HTTP endpoint is called the resource type is implicit (from the URL) so we must add it
+	// to the request.
+	if request.GetId() != nil && request.GetId().GetResourceType() == core.ResourceType_UNSPECIFIED {
+		logger.Infof(ctx, "Adding resource type for unspecified value in request: [%+v]", request)
+		request.Id.ResourceType = core.ResourceType_TASK
+	}
+	var response *admin.TaskResourceRecommendation
+	var err error
+	m.Metrics.taskEndpointMetrics.getResourceRecommendation.Time(func() {
+		response, err = m.TaskResourceRecommendationManager.GetTaskResourceRecommendation(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.taskEndpointMetrics.getResourceRecommendation)
+	}
+	m.Metrics.taskEndpointMetrics.getResourceRecommendation.Success()
+	return response, nil
+}
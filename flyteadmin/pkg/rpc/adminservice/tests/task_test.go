@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
@@ -92,3 +93,27 @@ func TestListUniqueTaskIds(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, resp)
 }
+
+func TestGetTaskResourceRecommendation(t *testing.T) {
+	ctx := context.Background()
+
+	mockRecommendationManager := &mocks.TaskResourceRecommendationInterface{}
+	mockRecommendationManager.OnGetTaskResourceRecommendationMatch(mock.Anything, mock.MatchedBy(
+		func(request *admin.ObjectGetRequest) bool {
+			return request.GetId().GetResourceType() == core.ResourceType_TASK
+		})).Return(&admin.TaskResourceRecommendation{SampleCount: 3}, nil)
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		taskResourceRecommendationManager: mockRecommendationManager,
+	})
+
+	resp, err := mockServer.GetTaskResourceRecommendation(ctx, &admin.ObjectGetRequest{
+		Id: &core.Identifier{
+			Project: "project",
+			Domain:  "staging",
+			Name:    "name",
+			Version: "version",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.GetSampleCount())
+}
@@ -15,6 +15,8 @@ type NewMockAdminServerInput struct {
 	taskManager          *mocks.MockTaskManager
 	workflowManager      *mocks.MockWorkflowManager
 	taskExecutionManager *mocks.MockTaskExecutionManager
+
+	taskResourceRecommendationManager *mocks.TaskResourceRecommendationInterface
 }
 
 func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminService {
@@ -29,5 +31,7 @@ func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminServic
 		WorkflowManager:      input.workflowManager,
 		TaskExecutionManager: input.taskExecutionManager,
 		Metrics:              adminservice.InitMetrics(testScope),
+
+		TaskResourceRecommendationManager: input.taskResourceRecommendationManager,
 	}
 }
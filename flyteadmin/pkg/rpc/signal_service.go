@@ -18,6 +18,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
 )
 
 type SignalMetrics struct {
@@ -44,7 +45,8 @@ type SignalService struct {
 	metrics       SignalMetrics
 }
 
-func NewSignalServer(ctx context.Context, configuration runtimeIfaces.Configuration, adminScope promutils.Scope) *SignalService {
+func NewSignalServer(ctx context.Context, configuration runtimeIfaces.Configuration, dataStorageClient *storage.DataStore,
+	adminScope promutils.Scope) *SignalService {
 	panicCounter := adminScope.MustNewCounter("initialization_panic",
 		"panics encountered initializing the signal service")
 
@@ -66,7 +68,7 @@ func NewSignalServer(ctx context.Context, configuration runtimeIfaces.Configurat
 	repo := repositories.NewGormRepo(
 		db, errors.NewPostgresErrorTransformer(adminScope.NewSubScope("errors")), dbScope)
 
-	signalManager := manager.NewSignalManager(repo, adminScope.NewSubScope("signal_manager"))
+	signalManager := manager.NewSignalManager(repo, dataStorageClient, adminScope.NewSubScope("signal_manager"))
 
 	logger.Info(ctx, "Initializing a new SignalService")
 	return &SignalService{
@@ -470,6 +470,9 @@ type FlyteWorkflowExecutorConfig struct {
 	AdminRateLimit *AdminRateLimit `json:"adminRateLimit"`
 	// Defaults to using user local timezone where the scheduler is deployed.
 	UseUTCTz bool `json:"useUTCTz"`
+	// Maps the exclusion calendar names referenced by schedules to files listing the excluded dates, one YYYY-MM-DD
+	// date per line. eg : a schedule "CALENDAR=holidays 0 9 * * *" skips the dates listed in the holidays calendar.
+	ExclusionCalendars map[string]string `json:"exclusionCalendars"`
 }
 
 func (f *FlyteWorkflowExecutorConfig) GetAdminRateLimit() *AdminRateLimit {
@@ -480,6 +483,10 @@ func (f *FlyteWorkflowExecutorConfig) GetUseUTCTz() bool {
 	return f.UseUTCTz
 }
 
+func (f *FlyteWorkflowExecutorConfig) GetExclusionCalendars() map[string]string {
+	return f.ExclusionCalendars
+}
+
 type AdminRateLimit struct {
 	Tps   rate.Limit `json:"tps"`
 	Burst int        `json:"burst"`
@@ -597,6 +604,35 @@ type NotificationsEmailerConfig struct {
 	Body string `json:"body"`
 }
 
+// This section handles the configuration of a webhook notifications are posted to, including Slack and MS Teams
+// incoming webhooks. Notifications of the types the webhook selects are posted to it instead of being emailed.
+type NotificationsWebhookConfig struct {
+	// The unique name of the webhook, used in its logs and metrics.
+	Name string `json:"name"`
+	// The types of the launch plan notifications posted to the webhook, any of email, slack and pager_duty.
+	NotificationTypes []string `json:"notificationTypes"`
+	// The format of the requests, one of webhook, slack or teams.
+	Type string `json:"type"`
+	// The URL notifications are posted to. Prefer URLSecretName since incoming webhook URLs embed credentials.
+	URL string `json:"url"`
+	// Name of the secret holding the URL notifications are posted to.
+	URLSecretName string `json:"urlSecretName"`
+	// The optionally templatized request body. For the webhook type this is a JSON document whose substituted
+	// values are JSON escaped, for slack and teams this is the text of the message.
+	Body string `json:"body"`
+	// Additional headers added to every request.
+	Headers map[string]string `json:"headers"`
+	// Name of the secret holding the key used to sign the requests with HMAC-SHA256. Requests aren't signed if empty.
+	SigningSecretName string `json:"signingSecretName"`
+	// Maximum number of attempts to post a notification. Requests failing with a 5xx or 429 status code or a
+	// connection error are retried.
+	MaxAttempts int `json:"maxAttempts"`
+	// Time to wait before the first retry, doubled after every attempt.
+	Backoff config.Duration `json:"backoff"`
+	// Timeout of a single request.
+	Timeout config.Duration `json:"timeout"`
+}
+
 // This section handles configuration for the workflow notifications pipeline.
 type EventsPublisherConfig struct {
 	// The topic which events should be published, e.g. node, task, workflow
@@ -661,6 +697,7 @@ type NotificationsConfig struct {
 	NotificationsPublisherConfig NotificationsPublisherConfig `json:"publisher"`
 	NotificationsProcessorConfig NotificationsProcessorConfig `json:"processor"`
 	NotificationsEmailerConfig   NotificationsEmailerConfig   `json:"emailer"`
+	NotificationsWebhooksConfig  []NotificationsWebhookConfig `json:"webhooks"`
 	// Number of times to attempt recreating a notifications processor client should there be any disruptions.
 	ReconnectAttempts int `json:"reconnectAttempts"`
 	// Specifies the time interval to wait before attempting to reconnect the notifications processor client.
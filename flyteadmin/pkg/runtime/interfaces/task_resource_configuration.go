@@ -9,8 +9,23 @@ type TaskResourceSet struct {
 	EphemeralStorage resource.Quantity `json:"ephemeralStorage"`
 }
 
+// TaskResourceRecommendationConfig configures the resources recommended for task versions based on the peak usage
+// reported by their past executions.
+type TaskResourceRecommendationConfig struct {
+	// Headroom added on top of the peak usage, as a fraction of it.
+	Headroom float64 `json:"headroom"`
+	// Minimum number of executions that reported their usage for a recommendation to be made.
+	MinSamples int `json:"minSamples"`
+	// Maximum number of the most recent executions the recommendation is based on.
+	MaxSamples int `json:"maxSamples"`
+	// Overrides the cpu and memory of the executions of tasks that opted in through their tags with the recommendation
+	// of their task version.
+	AutoApply bool `json:"autoApply"`
+}
+
 // Provides default values for task resource limits and defaults.
 type TaskResourceConfiguration interface {
 	GetDefaults() TaskResourceSet
 	GetLimits() TaskResourceSet
+	GetRecommendations() TaskResourceRecommendationConfig
 }
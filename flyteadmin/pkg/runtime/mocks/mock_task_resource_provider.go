@@ -3,8 +3,9 @@ package mocks
 import "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 
 type MockTaskResourceConfiguration struct {
-	Defaults interfaces.TaskResourceSet
-	Limits   interfaces.TaskResourceSet
+	Defaults        interfaces.TaskResourceSet
+	Limits          interfaces.TaskResourceSet
+	Recommendations interfaces.TaskResourceRecommendationConfig
 }
 
 func (c *MockTaskResourceConfiguration) GetDefaults() interfaces.TaskResourceSet {
@@ -13,6 +14,9 @@ func (c *MockTaskResourceConfiguration) GetDefaults() interfaces.TaskResourceSet
 func (c *MockTaskResourceConfiguration) GetLimits() interfaces.TaskResourceSet {
 	return c.Limits
 }
+func (c *MockTaskResourceConfiguration) GetRecommendations() interfaces.TaskResourceRecommendationConfig {
+	return c.Recommendations
+}
 
 func NewMockTaskResourceConfiguration(defaults, limits interfaces.TaskResourceSet) interfaces.TaskResourceConfiguration {
 	return &MockTaskResourceConfiguration{
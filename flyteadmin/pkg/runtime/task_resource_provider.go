@@ -19,11 +19,17 @@ var taskResourceConfig = config.MustRegisterSection(taskResourceKey, &TaskResour
 		Memory: resource.MustParse("1Gi"),
 		GPU:    resource.MustParse("1"),
 	},
+	Recommendations: interfaces.TaskResourceRecommendationConfig{
+		Headroom:   0.2,
+		MinSamples: 3,
+		MaxSamples: 20,
+	},
 })
 
 type TaskResourceSpec struct {
-	Defaults interfaces.TaskResourceSet `json:"defaults"`
-	Limits   interfaces.TaskResourceSet `json:"limits"`
+	Defaults        interfaces.TaskResourceSet                  `json:"defaults"`
+	Limits          interfaces.TaskResourceSet                  `json:"limits"`
+	Recommendations interfaces.TaskResourceRecommendationConfig `json:"recommendations"`
 }
 
 // Implementation of an interfaces.TaskResourceConfiguration
@@ -37,6 +43,10 @@ func (p *TaskResourceProvider) GetLimits() interfaces.TaskResourceSet {
 	return taskResourceConfig.GetConfig().(*TaskResourceSpec).Limits
 }
 
+func (p *TaskResourceProvider) GetRecommendations() interfaces.TaskResourceRecommendationConfig {
+	return taskResourceConfig.GetConfig().(*TaskResourceSpec).Recommendations
+}
+
 func NewTaskResourceProvider() interfaces.TaskResourceConfiguration {
 	return &TaskResourceProvider{}
 }
@@ -76,3 +76,14 @@ func SeedProjects(ctx context.Context, projects []config.SeedProject) error {
 		return nil
 	})
 }
+
+// BackfillExecutionFilterColumns populates the filterable labels, annotations and inputs of the existing executions
+func BackfillExecutionFilterColumns(ctx context.Context) error {
+	return withDB(ctx, func(db *gorm.DB) error {
+		if err := config.BackfillExecutionFilterColumns(db); err != nil {
+			return fmt.Errorf("could not backfill the execution filter columns with err: %v", err)
+		}
+		logger.Infof(ctx, "Successfully backfilled the execution filter columns")
+		return nil
+	})
+}
@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/core"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/executor"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/snapshoter"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// BackfillOptions configures a backfill run of a single schedule.
+type BackfillOptions struct {
+	From        time.Time
+	To          time.Time
+	Concurrency int
+	DryRun      bool
+	// AllowInactive permits backfilling a schedule whose launch plan is deactivated.
+	AllowInactive bool
+}
+
+// Backfill reads the schedule of the launch plan identified by key from the DB and fires an execution for every tick
+// of it in the requested time range. This is a blocking call which returns once all the executions are created.
+func Backfill(ctx context.Context, key models.SchedulableEntityKey, opts BackfillOptions) (core.BackfillResult, error) {
+	configuration := runtime.NewConfigurationProvider()
+	applicationConfiguration := configuration.ApplicationConfiguration().GetTopLevelConfig()
+	backfillScope := promutils.NewScope(applicationConfiguration.MetricsScope).NewSubScope("flytescheduler_backfill")
+
+	db, err := repositories.GetDB(ctx, configuration.ApplicationConfiguration().GetDbConfig(), logger.GetConfig())
+	if err != nil {
+		return core.BackfillResult{}, err
+	}
+
+	repo := repositories.NewGormRepo(
+		db, errors.NewPostgresErrorTransformer(backfillScope.NewSubScope("errors")), backfillScope.NewSubScope("database"))
+	schedule, err := repo.SchedulableEntityRepo().Get(ctx, key)
+	if err != nil {
+		logger.Errorf(ctx, "unable to read the schedule %+v from the db due to %v", key, err)
+		return core.BackfillResult{}, err
+	}
+
+	// The ticks of fixed rate schedules are chained from their last execution recorded by the scheduler.
+	snapshot, err := snapshoter.New(backfillScope, repo).Read(ctx, &snapshoter.VersionedSnapshot{Version: snapShotVersion})
+	if err != nil {
+		logger.Errorf(ctx, "unable to read the snapshot from the db due to %v", err)
+		return core.BackfillResult{}, err
+	}
+
+	// A dry run only lists the executions, there is no need to connect to admin.
+	var adminServiceClient service.AdminServiceClient
+	if !opts.DryRun {
+		clientSet, err := admin.ClientSetBuilder().WithConfig(admin.GetConfig(ctx)).Build(ctx)
+		if err != nil {
+			return core.BackfillResult{}, err
+		}
+
+		adminServiceClient = clientSet.AdminClient()
+	}
+
+	schedulerWorkflowExecutorConfig := configuration.ApplicationConfiguration().GetSchedulerConfig().
+		GetWorkflowExecutorConfig()
+	workflowExecutorConfig := schedulerWorkflowExecutorConfig.GetFlyteWorkflowExecutorConfig()
+	adminRateLimit := workflowExecutorConfig.GetAdminRateLimit()
+	rateLimiter := rate.NewLimiter(adminRateLimit.GetTps(), adminRateLimit.GetBurst())
+
+	calendars, err := core.LoadExclusionCalendars(workflowExecutorConfig.GetExclusionCalendars())
+	if err != nil {
+		return core.BackfillResult{}, err
+	}
+
+	// The scheduler is created without any schedules, it's only used to fire the backfilled executions.
+	gcronScheduler := core.NewGoCronSchedulerWithCalendars(ctx, nil, backfillScope, snapshot, rateLimiter,
+		executor.New(backfillScope, adminServiceClient), workflowExecutorConfig.UseUTCTz, calendars)
+
+	return gcronScheduler.Backfill(ctx, core.BackfillRequest{
+		Schedule:      schedule,
+		From:          opts.From,
+		To:            opts.To,
+		Concurrency:   opts.Concurrency,
+		DryRun:        opts.DryRun,
+		AllowInactive: opts.AllowInactive,
+	})
+}
@@ -0,0 +1,175 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/identifier"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+const defaultBackfillConcurrency = 1
+
+// BackfillRequest describes the range of schedule ticks to backfill for a single schedule.
+type BackfillRequest struct {
+	// Schedule of the launch plan to backfill.
+	Schedule models.SchedulableEntity
+	// From is the inclusive start of the range.
+	From time.Time
+	// To is the inclusive end of the range.
+	To time.Time
+	// Concurrency caps the number of executions being created at the same time. Defaults to 1.
+	Concurrency int
+	// DryRun only lists the ticks and the execution names that would be created.
+	DryRun bool
+	// AllowInactive backfills the schedule even if it's deactivated, e.g. to fill the gap left by a paused launch
+	// plan.
+	AllowInactive bool
+}
+
+// BackfillExecution is the outcome of backfilling a single tick of the schedule.
+type BackfillExecution struct {
+	ScheduledTime time.Time
+	// ExecutionName is deterministic for a schedule and time, firing the same tick twice is a no-op.
+	ExecutionName string
+	Err           error
+}
+
+// BackfillResult lists every tick in the requested range in chronological order.
+type BackfillResult struct {
+	Executions []BackfillExecution
+}
+
+// Failed returns the executions that couldn't be created.
+func (r BackfillResult) Failed() []BackfillExecution {
+	var failed []BackfillExecution
+	for _, e := range r.Executions {
+		if e.Err != nil {
+			failed = append(failed, e)
+		}
+	}
+
+	return failed
+}
+
+// GetBackfillTimes finds the list of timestamps in the inclusive range [from, to] for schedule s. Unlike
+// GetCatchUpTimes, a cron tick at exactly from is included. The ticks of fixed rate schedules are those the scheduler
+// fires, which are chained from anchor at the fixed rate, so backfilling a tick the scheduler fired is a no-op.
+func GetBackfillTimes(s models.SchedulableEntity, anchor time.Time, from time.Time, to time.Time) ([]time.Time, error) {
+	if from.After(to) {
+		return nil, fmt.Errorf("backfill start time %v is after the end time %v", from, to)
+	}
+
+	if len(s.CronExpression) > 0 {
+		// Cron resolution is a second, stepping back a nanosecond makes a tick at from eligible.
+		return GetCatchUpTimes(s, from.Add(-time.Nanosecond), to)
+	}
+
+	d, err := getFixedRateDurationFromSchedule(s.Unit, s.FixedRateValue)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like the scheduler, ticks are whole seconds. They're computed in seconds since the anchor can be further away
+	// than a time.Duration can represent.
+	delay := int64(d / time.Second)
+	if delay <= 0 {
+		return nil, fmt.Errorf("invalid fixed rate %v for schedule %+v", d, s.SchedulableEntityKey)
+	}
+
+	ticks := (from.Unix() - anchor.Unix() + delay - 1) / delay
+	if from.Unix() < anchor.Unix() {
+		// Integer division truncates towards zero, the first tick at or after from is already reached.
+		ticks = (from.Unix() - anchor.Unix()) / delay
+	}
+
+	var scheduledTimes []time.Time
+	for tick := anchor.Unix() + ticks*delay; tick <= to.Unix(); tick += delay {
+		if scheduledTime := time.Unix(tick, 0).In(from.Location()); !scheduledTime.Before(from) {
+			scheduledTimes = append(scheduledTimes, scheduledTime)
+		}
+	}
+
+	return scheduledTimes, nil
+}
+
+// Backfill fires an execution through the executor for every tick of the schedule in the requested range, skipping
+// the ticks excluded by the calendar of the schedule. Execution names are derived from the schedule and the tick so
+// rerunning a partially failed backfill only creates the missing executions.
+func (g *GoCronScheduler) Backfill(ctx context.Context, req BackfillRequest) (BackfillResult, error) {
+	if !req.AllowInactive && (req.Schedule.Active == nil || !*req.Schedule.Active) {
+		return BackfillResult{}, fmt.Errorf("schedule %+v is inactive, set AllowInactive to backfill it",
+			req.Schedule.SchedulableEntityKey)
+	}
+
+	// Fixed rate ticks are chained from the last execution of the schedule, as the scheduler does.
+	anchor := getLastExecTime(ctx, req.Schedule, g.snapshot)
+	scheduledTimes, err := GetBackfillTimes(req.Schedule, *anchor, req.From, req.To)
+	if err != nil {
+		return BackfillResult{}, err
+	}
+
+	result := BackfillResult{Executions: make([]BackfillExecution, 0, len(scheduledTimes))}
+	for _, scheduledTime := range scheduledTimes {
+		excluded, err := g.IsExcluded(req.Schedule, scheduledTime)
+		if err != nil {
+			return BackfillResult{}, err
+		}
+		if excluded {
+			continue
+		}
+
+		name, err := identifier.GetExecutionName(ctx, req.Schedule, scheduledTime)
+		if err != nil {
+			return BackfillResult{}, err
+		}
+
+		result.Executions = append(result.Executions, BackfillExecution{ScheduledTime: scheduledTime, ExecutionName: name})
+	}
+
+	if req.DryRun {
+		logger.Infof(ctx, "dry run of backfill for schedule %+v from %v to %v would create %v executions",
+			req.Schedule.SchedulableEntityKey, req.From, req.To, len(result.Executions))
+		return result, nil
+	}
+
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBackfillConcurrency
+	}
+
+	eg := errgroup.Group{}
+	eg.SetLimit(concurrency)
+	for i := range result.Executions {
+		execution := &result.Executions[i]
+		eg.Go(func() error {
+			if err := g.rateLimiter.Wait(ctx); err != nil {
+				execution.Err = err
+				return nil
+			}
+
+			if err := g.executor.Execute(ctx, execution.ScheduledTime, req.Schedule); err != nil {
+				g.metrics.BackfillErrCounter.Inc()
+				logger.Errorf(ctx, "unable to backfill the schedule %+v at %v time due to %v", req.Schedule,
+					execution.ScheduledTime, err)
+				execution.Err = err
+			}
+
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+	if failed := result.Failed(); len(failed) > 0 {
+		return result, fmt.Errorf("failed to backfill %v out of %v executions for schedule %+v", len(failed),
+			len(result.Executions), req.Schedule.SchedulableEntityKey)
+	}
+
+	logger.Infof(ctx, "backfilled %v executions for schedule %+v from %v to %v", len(result.Executions),
+		req.Schedule.SchedulableEntityKey, req.From, req.To)
+	return result, nil
+}
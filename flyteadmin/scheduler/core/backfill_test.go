@@ -0,0 +1,179 @@
+//go:build !race
+// +build !race
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/time/rate"
+
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/executor/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/identifier"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/snapshoter"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+func TestGetBackfillTimes(t *testing.T) {
+	from := time.Date(2022, time.January, 27, 19, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.January, 29, 19, 0, 0, 0, time.UTC)
+
+	t.Run("cron includes both ends", func(t *testing.T) {
+		s := models.SchedulableEntity{CronExpression: "0 19 * * *"}
+		backfillTimes, err := GetBackfillTimes(s, time.Time{}, from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{from, from.Add(24 * time.Hour), to}, backfillTimes)
+	})
+	t.Run("fixed rate anchored at a tick on from", func(t *testing.T) {
+		s := models.SchedulableEntity{FixedRateValue: 1, Unit: admin.FixedRateUnit_DAY}
+		backfillTimes, err := GetBackfillTimes(s, from.Add(-72*time.Hour), from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{from, from.Add(24 * time.Hour), to}, backfillTimes)
+	})
+	t.Run("fixed rate anchored before from", func(t *testing.T) {
+		s := models.SchedulableEntity{FixedRateValue: 1, Unit: admin.FixedRateUnit_DAY}
+		anchor := from.Add(-30*time.Minute + 500*time.Millisecond)
+		backfillTimes, err := GetBackfillTimes(s, anchor, from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{from.Add(23*time.Hour + 30*time.Minute), to.Add(-30 * time.Minute)}, backfillTimes)
+	})
+	t.Run("fixed rate anchored after to", func(t *testing.T) {
+		s := models.SchedulableEntity{FixedRateValue: 1, Unit: admin.FixedRateUnit_DAY}
+		backfillTimes, err := GetBackfillTimes(s, to.Add(36*time.Hour), from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Time{from.Add(12 * time.Hour), to.Add(-12 * time.Hour)}, backfillTimes)
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		s := models.SchedulableEntity{CronExpression: "0 19 * * *"}
+		_, err := GetBackfillTimes(s, time.Time{}, to, from)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestBackfill(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.January, 1, 9, 0, 0, 0, time.UTC)
+	active := true
+	inactive := false
+	scheduleFixed := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "fixed1",
+			Version: "version1",
+		},
+		FixedRateValue: 1,
+		Unit:           admin.FixedRateUnit_HOUR,
+		Active:         &active,
+	}
+	scheduleFixedDeactivated := scheduleFixed
+	scheduleFixedDeactivated.Active = &inactive
+	// The scheduler used for backfilling doesn't run any schedules so that only the backfilled executions reach the
+	// executor.
+	g := setupWithSchedules(t, "backfill", nil, true)
+	g.rateLimiter = rate.NewLimiter(rate.Inf, 1)
+
+	t.Run("dry run", func(t *testing.T) {
+		executor := new(mocks.Executor)
+		g.executor = executor
+		result, err := g.Backfill(ctx, BackfillRequest{Schedule: scheduleFixed, From: from, To: to, DryRun: true})
+		assert.Nil(t, err)
+		assert.Len(t, result.Executions, 10)
+		executor.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+
+		expectedName, err := identifier.GetExecutionName(ctx, scheduleFixed, from)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedName, result.Executions[0].ExecutionName)
+	})
+
+	t.Run("fires all ticks", func(t *testing.T) {
+		executor := new(mocks.Executor)
+		var lock sync.Mutex
+		var fired []time.Time
+		executor.OnExecuteMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			lock.Lock()
+			defer lock.Unlock()
+			fired = append(fired, args.Get(1).(time.Time))
+		})
+		g.executor = executor
+		result, err := g.Backfill(ctx, BackfillRequest{Schedule: scheduleFixed, From: from, To: to, Concurrency: 3})
+		assert.Nil(t, err)
+		assert.Len(t, result.Executions, 10)
+		assert.Len(t, fired, 10)
+		assert.Empty(t, result.Failed())
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		executor := new(mocks.Executor)
+		executor.OnExecuteMatch(mock.Anything, from, mock.Anything).Return(fmt.Errorf("failed"))
+		executor.OnExecuteMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		g.executor = executor
+		result, err := g.Backfill(ctx, BackfillRequest{Schedule: scheduleFixed, From: from, To: to, Concurrency: 2})
+		assert.NotNil(t, err)
+		if assert.Len(t, result.Failed(), 1) {
+			assert.Equal(t, from, result.Failed()[0].ScheduledTime)
+		}
+	})
+
+	t.Run("excluded dates skipped", func(t *testing.T) {
+		executor := new(mocks.Executor)
+		executor.OnExecuteMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		g.executor = executor
+		g.calendars = ExclusionCalendars{"holidays": NewExclusionCalendar(from)}
+		defer func() { g.calendars = nil }()
+
+		schedule := scheduleFixed
+		schedule.ExclusionCalendar = "holidays"
+		dayAfter := from.Add(24 * time.Hour)
+		result, err := g.Backfill(ctx, BackfillRequest{Schedule: schedule, From: from, To: dayAfter})
+		assert.Nil(t, err)
+		if assert.Len(t, result.Executions, 1) {
+			assert.Equal(t, dayAfter, result.Executions[0].ScheduledTime)
+		}
+
+		schedule.ExclusionCalendar = "unknown"
+		_, err = g.Backfill(ctx, BackfillRequest{Schedule: schedule, From: from, To: dayAfter})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("fixed rate anchored at the last execution", func(t *testing.T) {
+		lastExecTime := from.Add(-90 * time.Minute)
+		snapshot := g.snapshot
+		g.snapshot = &snapshoter.SnapshotV2{
+			LastTimes: map[string]*time.Time{identifier.GetScheduleName(ctx, scheduleFixed): &lastExecTime},
+		}
+		defer func() { g.snapshot = snapshot }()
+
+		result, err := g.Backfill(ctx, BackfillRequest{Schedule: scheduleFixed, From: from, To: to, DryRun: true})
+		assert.Nil(t, err)
+		if assert.Len(t, result.Executions, 9) {
+			assert.Equal(t, from.Add(30*time.Minute), result.Executions[0].ScheduledTime)
+			expectedName, err := identifier.GetExecutionName(ctx, scheduleFixed, from.Add(30*time.Minute))
+			assert.Nil(t, err)
+			assert.Equal(t, expectedName, result.Executions[0].ExecutionName)
+		}
+	})
+
+	t.Run("inactive schedule", func(t *testing.T) {
+		executor := new(mocks.Executor)
+		executor.OnExecuteMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		g.executor = executor
+		_, err := g.Backfill(ctx, BackfillRequest{Schedule: scheduleFixedDeactivated, From: from, To: to})
+		assert.NotNil(t, err)
+		executor.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+
+		result, err := g.Backfill(ctx, BackfillRequest{Schedule: scheduleFixedDeactivated, From: from, To: to,
+			AllowInactive: true})
+		assert.Nil(t, err)
+		assert.Len(t, result.Executions, 10)
+		executor.AssertNumberOfCalls(t, "Execute", 10)
+	})
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const calendarDateFormat = "2006-01-02"
+
+// ExclusionCalendar is a set of dates on which the schedules using the calendar don't fire.
+type ExclusionCalendar struct {
+	dates map[string]struct{}
+}
+
+// IsExcluded returns true if the date of t, in the location of t, is part of the calendar.
+func (c *ExclusionCalendar) IsExcluded(t time.Time) bool {
+	_, ok := c.dates[t.Format(calendarDateFormat)]
+	return ok
+}
+
+// NewExclusionCalendar creates a calendar excluding the given dates.
+func NewExclusionCalendar(dates ...time.Time) *ExclusionCalendar {
+	c := &ExclusionCalendar{dates: make(map[string]struct{}, len(dates))}
+	for _, d := range dates {
+		c.dates[d.Format(calendarDateFormat)] = struct{}{}
+	}
+
+	return c
+}
+
+// LoadExclusionCalendar reads a calendar file containing one YYYY-MM-DD date per line. Empty lines and everything
+// after a # are ignored, e.g.
+//
+//	2024-12-25 # Christmas Day
+func LoadExclusionCalendar(path string) (*ExclusionCalendar, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var dates []time.Time
+	scanner := bufio.NewScanner(f)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		d, err := time.Parse(calendarDateFormat, line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date on line %v of calendar %v: %w", lineNumber, path, err)
+		}
+
+		dates = append(dates, d)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewExclusionCalendar(dates...), nil
+}
+
+// ExclusionCalendars maps the calendar names referenced by the schedules to the calendars.
+type ExclusionCalendars map[string]*ExclusionCalendar
+
+// LoadExclusionCalendars loads the calendar files given by calendar name.
+func LoadExclusionCalendars(paths map[string]string) (ExclusionCalendars, error) {
+	calendars := make(ExclusionCalendars, len(paths))
+	for name, path := range paths {
+		c, err := LoadExclusionCalendar(path)
+		if err != nil {
+			return nil, err
+		}
+
+		calendars[name] = c
+	}
+
+	return calendars, nil
+}
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadExclusionCalendars(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "holidays")
+	assert.Nil(t, os.WriteFile(path, []byte("# public holidays\n2024-12-25 # Christmas Day\n\n2024-12-26\n"), 0600))
+
+	t.Run("valid calendar", func(t *testing.T) {
+		calendars, err := LoadExclusionCalendars(map[string]string{"holidays": path})
+		assert.Nil(t, err)
+		calendar := calendars["holidays"]
+		assert.True(t, calendar.IsExcluded(time.Date(2024, time.December, 25, 9, 0, 0, 0, time.UTC)))
+		assert.True(t, calendar.IsExcluded(time.Date(2024, time.December, 26, 23, 59, 0, 0, time.UTC)))
+		assert.False(t, calendar.IsExcluded(time.Date(2024, time.December, 27, 0, 0, 0, 0, time.UTC)))
+	})
+	t.Run("invalid date", func(t *testing.T) {
+		invalidPath := filepath.Join(dir, "invalid")
+		assert.Nil(t, os.WriteFile(invalidPath, []byte("2024-12-25\n25.12.2024\n"), 0600))
+		_, err := LoadExclusionCalendars(map[string]string{"invalid": invalidPath})
+		assert.NotNil(t, err)
+	})
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadExclusionCalendars(map[string]string{"missing": filepath.Join(dir, "missing")})
+		assert.NotNil(t, err)
+	})
+}
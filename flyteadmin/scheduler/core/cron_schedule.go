@@ -0,0 +1,62 @@
+package core
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+)
+
+const (
+	sameWallClockFmt = "2006-01-02 15:04:05"
+	// everyHour is the hour field of cron schedules firing at every hour of the day.
+	everyHour = 1<<24 - 1
+)
+
+// zonedSchedule evaluates a cron schedule firing at fixed hours of the day in a fixed location. Wall clock times that
+// don't exist because of a DST transition are moved forward by the cron library, wall clock times that occur twice
+// only fire the first time.
+type zonedSchedule struct {
+	cron.Schedule
+	location *time.Location
+}
+
+func (z zonedSchedule) Next(t time.Time) time.Time {
+	next := z.Schedule.Next(t)
+	if next.IsZero() {
+		return next
+	}
+
+	// When the clocks are turned back the same wall clock time repeats an hour later, skip the repetition.
+	if next.In(z.location).Format(sameWallClockFmt) == t.In(z.location).Format(sameWallClockFmt) {
+		return z.Schedule.Next(next)
+	}
+
+	return next
+}
+
+// getCronSchedule parses the cron expression, evaluating it in timezone if one is set.
+func getCronSchedule(cronString string, timezone string) (cron.Schedule, error) {
+	if len(timezone) == 0 {
+		return cron.ParseStandard(cronString)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := cron.ParseStandard(common.CronTzDirective + timezone + " " + cronString)
+	if err != nil {
+		return nil, err
+	}
+
+	// Schedules firing every hour (or more often) fire on every tick of the repeated hour, which are distinct
+	// instants.
+	if spec, ok := sched.(*cron.SpecSchedule); ok && spec.Hour&everyHour == everyHour {
+		return sched, nil
+	}
+
+	return zonedSchedule{Schedule: sched, location: location}, nil
+}
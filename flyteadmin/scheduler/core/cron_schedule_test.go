@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+)
+
+func TestGetScheduledTimeWithTimezone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.Nil(t, err)
+
+	t.Run("local wall clock across spring forward", func(t *testing.T) {
+		s := models.SchedulableEntity{CronExpression: "0 9 * * *", Timezone: "America/New_York"}
+		from := time.Date(2024, time.March, 9, 9, 0, 0, 0, newYork)
+		to := time.Date(2024, time.March, 11, 9, 0, 0, 0, newYork)
+		catchupTimes, err := GetCatchUpTimes(s, from, to)
+		assert.Nil(t, err)
+		if assert.Len(t, catchupTimes, 2) {
+			// 23 hours between the ticks when the clocks go forward.
+			assert.Equal(t, time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC), catchupTimes[0].UTC())
+			assert.Equal(t, time.Date(2024, time.March, 11, 13, 0, 0, 0, time.UTC), catchupTimes[1].UTC())
+		}
+	})
+
+	t.Run("repeated wall clock fires once", func(t *testing.T) {
+		s := models.SchedulableEntity{CronExpression: "30 1 * * *", Timezone: "America/New_York"}
+		from := time.Date(2024, time.November, 2, 12, 0, 0, 0, newYork)
+		to := time.Date(2024, time.November, 4, 12, 0, 0, 0, newYork)
+		catchupTimes, err := GetCatchUpTimes(s, from, to)
+		assert.Nil(t, err)
+		if assert.Len(t, catchupTimes, 2) {
+			assert.Equal(t, time.Date(2024, time.November, 3, 5, 30, 0, 0, time.UTC), catchupTimes[0].UTC())
+			assert.Equal(t, time.Date(2024, time.November, 4, 6, 30, 0, 0, time.UTC), catchupTimes[1].UTC())
+		}
+	})
+
+	t.Run("repeated hour fires every tick of hourly schedules", func(t *testing.T) {
+		s := models.SchedulableEntity{CronExpression: "30 * * * *", Timezone: "America/New_York"}
+		from := time.Date(2024, time.November, 3, 0, 45, 0, 0, newYork)
+		to := time.Date(2024, time.November, 3, 2, 45, 0, 0, newYork)
+		catchupTimes, err := GetCatchUpTimes(s, from, to)
+		assert.Nil(t, err)
+		if assert.Len(t, catchupTimes, 3) {
+			// 1:30 occurs in both EDT and EST.
+			assert.Equal(t, time.Date(2024, time.November, 3, 5, 30, 0, 0, time.UTC), catchupTimes[0].UTC())
+			assert.Equal(t, time.Date(2024, time.November, 3, 6, 30, 0, 0, time.UTC), catchupTimes[1].UTC())
+			assert.Equal(t, time.Date(2024, time.November, 3, 7, 30, 0, 0, time.UTC), catchupTimes[2].UTC())
+		}
+	})
+
+	t.Run("unknown timezone", func(t *testing.T) {
+		s := models.SchedulableEntity{CronExpression: "0 9 * * *", Timezone: "Mars/Olympus_Mons"}
+		_, err := GetScheduledTime(s, time.Now())
+		assert.NotNil(t, err)
+	})
+}
@@ -25,6 +25,8 @@ type goCronMetrics struct {
 	JobFuncPanicCounter       prometheus.Counter
 	JobScheduledFailedCounter prometheus.Counter
 	CatchupErrCounter         prometheus.Counter
+	BackfillErrCounter        prometheus.Counter
+	ExcludedTickCounter       prometheus.Counter
 }
 
 // GoCronScheduler this provides a scheduler functionality using the https://github.com/robfig/cron library.
@@ -35,13 +37,24 @@ type GoCronScheduler struct {
 	rateLimiter *rate.Limiter
 	executor    executor.Executor
 	snapshot    snapshoter.Snapshot
+	calendars   ExclusionCalendars
 }
 
 // GetTimedFuncWithSchedule returns the job function with scheduled time parameter
 func (g *GoCronScheduler) GetTimedFuncWithSchedule() TimedFuncWithSchedule {
 	return func(jobCtx context.Context, schedule models.SchedulableEntity, scheduleTime time.Time) error {
+		excluded, err := g.IsExcluded(schedule, scheduleTime)
+		if err != nil {
+			logger.Errorf(jobCtx, "unable to check the exclusion calendar of schedule %+v due to %v", schedule, err)
+			return err
+		}
+		if excluded {
+			logger.Infof(jobCtx, "skipping the schedule %+v at %v time excluded by calendar %v", schedule,
+				scheduleTime, schedule.ExclusionCalendar)
+			return nil
+		}
 		_ = g.rateLimiter.Wait(jobCtx)
-		err := g.executor.Execute(jobCtx, scheduleTime, schedule)
+		err = g.executor.Execute(jobCtx, scheduleTime, schedule)
 		if err != nil {
 			logger.Errorf(jobCtx, "unable to fire the schedule %+v at %v time due to %v", schedule, scheduleTime,
 				err)
@@ -59,17 +72,7 @@ func (g *GoCronScheduler) BootStrapSchedulesFromSnapShot(ctx context.Context, sc
 		schedule := s
 		if *s.Active {
 			funcRef := g.GetTimedFuncWithSchedule()
-			nameOfSchedule := identifier.GetScheduleName(ctx, s)
-			// Initialize the lastExectime as the updatedAt time
-			// Assumption here that schedule was activated and that the 0th execution of the schedule
-			// which will be used as a reference
-			lastExecTime := &schedule.UpdatedAt
-
-			fromSnapshot := snapshot.GetLastExecutionTime(nameOfSchedule)
-			// Use the latest time if available in the snapshot
-			if fromSnapshot != nil && fromSnapshot.After(schedule.UpdatedAt) {
-				lastExecTime = fromSnapshot
-			}
+			lastExecTime := getLastExecTime(ctx, schedule, snapshot)
 			err := g.ScheduleJob(ctx, schedule, funcRef, lastExecTime)
 			if err != nil {
 				g.metrics.JobScheduledFailedCounter.Inc()
@@ -79,6 +82,27 @@ func (g *GoCronScheduler) BootStrapSchedulesFromSnapShot(ctx context.Context, sc
 	}
 }
 
+// getLastExecTime returns the time of the last execution of schedule s recorded in the snapshot, or the time it was
+// activated if there is none.
+func getLastExecTime(ctx context.Context, s models.SchedulableEntity, snapshot snapshoter.Snapshot) *time.Time {
+	nameOfSchedule := identifier.GetScheduleName(ctx, s)
+	// Initialize the lastExectime as the updatedAt time
+	// Assumption here that schedule was activated and that the 0th execution of the schedule
+	// which will be used as a reference
+	lastExecTime := &s.UpdatedAt
+
+	fromSnapshot := snapshot.GetLastExecutionTime(nameOfSchedule)
+	// Use the latest time if available in the snapshot. A snapshot taken while the schedule ran in another
+	// timezone would catch up on ticks at the wrong wall clock times and hence is ignored.
+	if timezone, ok := snapshot.GetTimezone(nameOfSchedule); ok && timezone != s.Timezone {
+		logger.Warnf(ctx, "ignoring the snapshot of schedule %+v taken in timezone %q", s, timezone)
+	} else if fromSnapshot != nil && fromSnapshot.After(s.UpdatedAt) {
+		lastExecTime = fromSnapshot
+	}
+
+	return lastExecTime
+}
+
 // UpdateSchedules updates all the schedules in the schedulers job store
 func (g *GoCronScheduler) UpdateSchedules(ctx context.Context, schedules []models.SchedulableEntity) {
 	for _, s := range schedules {
@@ -105,6 +129,7 @@ func (g *GoCronScheduler) CalculateSnapshot(ctx context.Context) snapshoter.Snap
 		scheduleIdentifier := key.(string)
 		if job.lastExecTime != nil {
 			snapshot.UpdateLastExecutionTime(scheduleIdentifier, job.lastExecTime)
+			snapshot.UpdateTimezone(scheduleIdentifier, job.schedule.Timezone)
 		}
 		return true
 	})
@@ -123,6 +148,12 @@ func (g *GoCronScheduler) ScheduleJob(ctx context.Context, schedule models.Sched
 		return nil
 	}
 
+	if len(schedule.ExclusionCalendar) > 0 {
+		if _, ok := g.calendars[schedule.ExclusionCalendar]; !ok {
+			return fmt.Errorf("unknown exclusion calendar %v for schedule %+v", schedule.ExclusionCalendar, schedule)
+		}
+	}
+
 	// Update the catchupFrom time as the lastExecTime.
 	// Here lastExecTime is passed to this function only from BootStrapSchedulesFromSnapShot which is during bootup
 	// Once initialized we won't be changing the catchupTime until the next boot
@@ -209,8 +240,17 @@ func (g *GoCronScheduler) CatchUpSingleSchedule(ctx context.Context, s models.Sc
 	}
 	var catchupTime time.Time
 	for _, catchupTime = range catchUpTimes {
+		excluded, err := g.IsExcluded(s, catchupTime)
+		if err != nil {
+			return err
+		}
+		if excluded {
+			logger.Debugf(ctx, "skipping catchup of schedule %+v at %v time excluded by calendar %v", s, catchupTime,
+				s.ExclusionCalendar)
+			continue
+		}
 		_ = g.rateLimiter.Wait(ctx)
-		err := g.executor.Execute(ctx, catchupTime, s)
+		err = g.executor.Execute(ctx, catchupTime, s)
 		if err != nil {
 			g.metrics.CatchupErrCounter.Inc()
 			logger.Errorf(ctx, "unable to fire the schedule %+v at %v time due to %v", s, catchupTime, err)
@@ -241,13 +281,44 @@ func GetCatchUpTimes(s models.SchedulableEntity, from time.Time, to time.Time) (
 // GetScheduledTime find next schedule time for both cron and fixed rate scheduled entity given the fromTime
 func GetScheduledTime(s models.SchedulableEntity, fromTime time.Time) (time.Time, error) {
 	if len(s.CronExpression) > 0 {
-		return getCronScheduledTime(s.CronExpression, fromTime)
+		return getCronScheduledTime(s.CronExpression, s.Timezone, fromTime)
 	}
 	return getFixedIntervalScheduledTime(s.Unit, s.FixedRateValue, fromTime)
 }
 
-func getCronScheduledTime(cronString string, fromTime time.Time) (time.Time, error) {
-	sched, err := cron.ParseStandard(cronString)
+// IsExcluded returns true if the date of scheduledTime in the timezone of schedule s is part of its exclusion
+// calendar. Excluded ticks are skipped when firing, catching up and backfilling the schedule.
+func (g *GoCronScheduler) IsExcluded(s models.SchedulableEntity, scheduledTime time.Time) (bool, error) {
+	if len(s.ExclusionCalendar) == 0 {
+		return false, nil
+	}
+
+	calendar, ok := g.calendars[s.ExclusionCalendar]
+	if !ok {
+		return false, fmt.Errorf("unknown exclusion calendar %v for schedule %+v", s.ExclusionCalendar,
+			s.SchedulableEntityKey)
+	}
+
+	if len(s.Timezone) > 0 {
+		location, err := time.LoadLocation(s.Timezone)
+		if err != nil {
+			return false, err
+		}
+		scheduledTime = scheduledTime.In(location)
+	} else {
+		scheduledTime = scheduledTime.In(g.cron.Location())
+	}
+
+	if calendar.IsExcluded(scheduledTime) {
+		g.metrics.ExcludedTickCounter.Inc()
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func getCronScheduledTime(cronString string, timezone string, fromTime time.Time) (time.Time, error) {
+	sched, err := getCronSchedule(cronString, timezone)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -300,6 +371,22 @@ func (g *GoCronScheduler) AddCronJob(ctx context.Context, job *GoCronJob) error
 	var jobFunc cron.TimedFuncJob
 	jobFunc = job.Run
 
+	if len(job.schedule.Timezone) > 0 {
+		sched, err := getCronSchedule(job.schedule.CronExpression, job.schedule.Timezone)
+		if err != nil {
+			return err
+		}
+
+		var lastTime time.Time
+		if job.lastExecTime != nil {
+			lastTime = *job.lastExecTime
+		}
+		job.entryID = g.cron.ScheduleTimedJob(sched, jobFunc, lastTime)
+		logger.Infof(ctx, "successfully added the schedule %s to the scheduler in timezone %v for schedule %+v",
+			job.nameOfSchedule, job.schedule.Timezone, job.schedule)
+		return nil
+	}
+
 	entryID, err := g.cron.AddTimedJob(job.schedule.CronExpression, jobFunc)
 	// Update the entry id in the job which is handle to be used for removal
 	job.entryID = entryID
@@ -335,6 +422,14 @@ func getFixedRateDurationFromSchedule(unit admin.FixedRateUnit, fixedRateValue u
 
 func NewGoCronScheduler(ctx context.Context, schedules []models.SchedulableEntity, scope promutils.Scope,
 	snapshot snapshoter.Snapshot, rateLimiter *rate.Limiter, executor executor.Executor, useUtcTz bool) Scheduler {
+	return NewGoCronSchedulerWithCalendars(ctx, schedules, scope, snapshot, rateLimiter, executor, useUtcTz, nil)
+}
+
+// NewGoCronSchedulerWithCalendars creates a scheduler which skips the ticks of the schedules falling on the dates of
+// their exclusion calendar. Schedules referencing a calendar missing from calendars aren't scheduled.
+func NewGoCronSchedulerWithCalendars(ctx context.Context, schedules []models.SchedulableEntity, scope promutils.Scope,
+	snapshot snapshoter.Snapshot, rateLimiter *rate.Limiter, executor executor.Executor, useUtcTz bool,
+	calendars ExclusionCalendars) Scheduler {
 	// Create the new cron scheduler and start it off
 	var opts []cron.Option
 	if useUtcTz {
@@ -349,6 +444,7 @@ func NewGoCronScheduler(ctx context.Context, schedules []models.SchedulableEntit
 		rateLimiter: rateLimiter,
 		executor:    executor,
 		snapshot:    snapshot,
+		calendars:   calendars,
 	}
 	scheduler.BootStrapSchedulesFromSnapShot(ctx, schedules, snapshot)
 	return scheduler
@@ -363,5 +459,9 @@ func getCronMetrics(scope promutils.Scope) goCronMetrics {
 			"count of scheduling failures by the scheduler"),
 		CatchupErrCounter: scope.MustNewCounter("catchup_error_counter",
 			"count of unsuccessful attempts to catchup on the schedules"),
+		BackfillErrCounter: scope.MustNewCounter("backfill_error_counter",
+			"count of unsuccessful attempts to backfill the schedules"),
+		ExcludedTickCounter: scope.MustNewCounter("excluded_tick_counter",
+			"count of schedule ticks skipped because of an exclusion calendar"),
 	}
 }
@@ -198,7 +198,7 @@ func TestGetTimedFuncWithSchedule(t *testing.T) {
 
 func TestGetCronScheduledTime(t *testing.T) {
 	fromTime := time.Date(2022, time.January, 27, 19, 0, 0, 0, time.UTC)
-	nextTime, err := getCronScheduledTime("0 19 * * *", fromTime)
+	nextTime, err := getCronScheduledTime("0 19 * * *", "", fromTime)
 	assert.Nil(t, err)
 	expectedNextTime := time.Date(2022, time.January, 28, 19, 0, 0, 0, time.UTC)
 	assert.Equal(t, expectedNextTime, nextTime)
@@ -25,4 +25,6 @@ type Scheduler interface {
 	CalculateSnapshot(ctx context.Context) snapshoter.Snapshot
 	// CatchupAll catches up all the schedules in the schedulers job store to the until time
 	CatchupAll(ctx context.Context, until time.Time) bool
+	// Backfill fires executions for every tick of a schedule within the requested time range
+	Backfill(ctx context.Context, req BackfillRequest) (BackfillResult, error)
 }
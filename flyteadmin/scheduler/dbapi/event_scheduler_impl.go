@@ -6,6 +6,7 @@ import (
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/async/schedule/interfaces"
 	scheduleInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/async/schedule/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	repositoryInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
@@ -31,7 +32,7 @@ func (s *eventScheduler) CreateScheduleInput(ctx context.Context, appConfig *run
 
 func (s *eventScheduler) AddSchedule(ctx context.Context, input interfaces.AddScheduleInput) error {
 	logger.Infof(ctx, "Received call to add schedule [%+v]", input)
-	var cronString string
+	var cronSpec common.CronScheduleSpec
 	var fixedRateValue uint32
 	var fixedRateUnit admin.FixedRateUnit
 	switch v := input.ScheduleExpression.GetScheduleExpression().(type) {
@@ -39,17 +40,25 @@ func (s *eventScheduler) AddSchedule(ctx context.Context, input interfaces.AddSc
 		fixedRateValue = v.Rate.GetValue()
 		fixedRateUnit = v.Rate.GetUnit()
 	case *admin.Schedule_CronSchedule:
-		cronString = v.CronSchedule.GetSchedule()
+		// Split off the timezone and exclusion calendar directives so that they are stored in their own columns
+		var err error
+		cronSpec, err = common.ParseCronSchedule(v.CronSchedule.GetSchedule())
+		if err != nil {
+			return fmt.Errorf("failed adding schedule with invalid cron schedule %v due to %w",
+				v.CronSchedule.GetSchedule(), err)
+		}
 	default:
 		return fmt.Errorf("failed adding schedule for unknown schedule expression type %v", v)
 	}
 	active := true
 	modelInput := models.SchedulableEntity{
-		CronExpression:      cronString,
+		CronExpression:      cronSpec.Expression,
 		FixedRateValue:      fixedRateValue,
 		Unit:                fixedRateUnit,
 		KickoffTimeInputArg: input.ScheduleExpression.GetKickoffTimeInputArg(),
 		Active:              &active,
+		Timezone:            cronSpec.Timezone,
+		ExclusionCalendar:   cronSpec.ExclusionCalendar,
 		SchedulableEntityKey: models.SchedulableEntityKey{
 			Project: input.Identifier.GetProject(),
 			Domain:  input.Identifier.GetDomain(),
@@ -2,7 +2,6 @@ package executor
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -54,12 +53,7 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 	}
 
 	// Making the identifier deterministic using the hash of the identifier and scheduled time
-	executionIdentifier, err := identifier.GetExecutionIdentifier(ctx, &core.Identifier{
-		Project: s.Project,
-		Domain:  s.Domain,
-		Name:    s.Name,
-		Version: s.Version,
-	}, scheduledTime)
+	executionName, err := identifier.GetExecutionName(ctx, s, scheduledTime)
 
 	if err != nil {
 		logger.Errorf(ctx, "failed to generate execution identifier for schedule %+v due to %v", s, err)
@@ -69,7 +63,7 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 	executionRequest := &admin.ExecutionCreateRequest{
 		Project: s.Project,
 		Domain:  s.Domain,
-		Name:    "f" + strings.ReplaceAll(executionIdentifier.String(), "-", "")[:19],
+		Name:    executionName,
 		Spec: &admin.ExecutionSpec{
 			LaunchPlan: &core.Identifier{
 				ResourceType: core.ResourceType_LAUNCH_PLAN,
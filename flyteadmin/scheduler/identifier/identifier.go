@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -40,6 +41,22 @@ func GetExecutionIdentifier(ctx context.Context, identifier *core.Identifier, sc
 	return uuid.FromBytes(b)
 }
 
+// GetExecutionName returns the deterministic execution name used for the schedule s at scheduledTime. Firing the same
+// schedule for the same time multiple times always results in the same execution name which makes it idempotent.
+func GetExecutionName(ctx context.Context, s models.SchedulableEntity, scheduledTime time.Time) (string, error) {
+	executionIdentifier, err := GetExecutionIdentifier(ctx, &core.Identifier{
+		Project: s.Project,
+		Domain:  s.Domain,
+		Name:    s.Name,
+		Version: s.Version,
+	}, scheduledTime)
+	if err != nil {
+		return "", err
+	}
+
+	return "f" + strings.ReplaceAll(executionIdentifier.String(), "-", "")[:19], nil
+}
+
 // hashIdentifier returns the hash of the identifier
 func hashIdentifier(ctx context.Context, identifier *core.Identifier) uint64 {
 	h := fnv.New64()
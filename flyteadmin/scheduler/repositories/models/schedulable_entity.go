@@ -14,6 +14,10 @@ type SchedulableEntity struct {
 	Unit                admin.FixedRateUnit
 	KickoffTimeInputArg string
 	Active              *bool
+	// IANA name of the timezone the cron expression is evaluated in. Empty uses the scheduler's timezone.
+	Timezone string
+	// Name of the exclusion calendar whose dates are skipped by the schedule. Empty fires on every tick.
+	ExclusionCalendar string
 }
 
 // Schedulable entity primary key
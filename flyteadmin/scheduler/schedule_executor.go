@@ -21,7 +21,7 @@ import (
 const snapshotWriterDuration = 30 * time.Second
 const scheduleUpdaterDuration = 30 * time.Second
 
-const snapShotVersion = 1
+const snapShotVersion = 2
 
 // ScheduledExecutor used for executing the schedules saved by the native flyte scheduler in the database.
 type ScheduledExecutor struct {
@@ -63,12 +63,20 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 	// Set the executor to send executions to admin
 	executor := executor.New(w.scope, w.adminServiceClient)
 
+	// Load the exclusion calendars referenced by the schedules
+	calendars, err := core.LoadExclusionCalendars(w.workflowExecutorConfig.GetExclusionCalendars())
+	if err != nil {
+		logger.Errorf(ctx, "unable to load the exclusion calendars due to %v. Aborting", err)
+		return err
+	}
+
 	// Create the scheduler using GoCronScheduler implementation
 	// Also Bootstrap the schedules from the snapshot
 	bootStrapCtx, bootStrapCancel := context.WithCancel(ctx)
 	defer bootStrapCancel()
 	useUtcTz := w.workflowExecutorConfig.UseUTCTz
-	gcronScheduler := core.NewGoCronScheduler(bootStrapCtx, schedules, w.scope, snapshot, rateLimiter, executor, useUtcTz)
+	gcronScheduler := core.NewGoCronSchedulerWithCalendars(bootStrapCtx, schedules, w.scope, snapshot, rateLimiter,
+		executor, useUtcTz, calendars)
 	w.scheduler = gcronScheduler
 
 	// Start the go routine to write the update schedules periodically
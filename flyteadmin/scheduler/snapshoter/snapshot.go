@@ -10,6 +10,10 @@ type Snapshot interface {
 	GetLastExecutionTime(key string) *time.Time
 	// UpdateLastExecutionTime of the schedule given by key to the lastExecTime
 	UpdateLastExecutionTime(key string, lastExecTime *time.Time)
+	// GetTimezone the schedule given by the key was running in when the snapshot was taken
+	GetTimezone(key string) (string, bool)
+	// UpdateTimezone of the schedule given by the key
+	UpdateTimezone(key string, timezone string)
 	// CreateSnapshot creates the snapshot of all the schedules and there execution times.
 	Serialize() ([]byte, error)
 	// BootstrapFrom bootstraps the snapshot from a byte array
@@ -26,6 +26,15 @@ func (s *SnapshotV1) UpdateLastExecutionTime(key string, lastExecTime *time.Time
 	s.LastTimes[key] = lastExecTime
 }
 
+// GetTimezone isn't tracked by V1 snapshots.
+func (s *SnapshotV1) GetTimezone(key string) (string, bool) {
+	return "", false
+}
+
+// UpdateTimezone isn't tracked by V1 snapshots.
+func (s *SnapshotV1) UpdateTimezone(key string, timezone string) {
+}
+
 func (s *SnapshotV1) Serialize() ([]byte, error) {
 	var b bytes.Buffer
 	err := gob.NewEncoder(&b).Encode(s)
@@ -0,0 +1,70 @@
+package snapshoter
+
+import (
+	"bytes"
+	"encoding/gob"
+	"time"
+)
+
+// SnapshotV2 extends SnapshotV1 with the timezone each schedule was running in. The last execution times are
+// instants and don't depend on the timezone, but catching up on a schedule whose timezone changed since the snapshot
+// would fire at the wrong wall clock times.
+type SnapshotV2 struct {
+	// LastTimes map of the schedule name to last execution timestamp
+	LastTimes map[string]*time.Time
+	// Timezones map of the schedule name to the IANA timezone the schedule was running in. Empty for the scheduler's
+	// timezone.
+	Timezones map[string]string
+}
+
+func (s *SnapshotV2) GetLastExecutionTime(key string) *time.Time {
+	return s.LastTimes[key]
+}
+
+func (s *SnapshotV2) UpdateLastExecutionTime(key string, lastExecTime *time.Time) {
+	s.LastTimes[key] = lastExecTime
+}
+
+func (s *SnapshotV2) GetTimezone(key string) (string, bool) {
+	timezone, ok := s.Timezones[key]
+	return timezone, ok
+}
+
+func (s *SnapshotV2) UpdateTimezone(key string, timezone string) {
+	s.Timezones[key] = timezone
+}
+
+// hasTimezones returns true if any of the schedules runs in a timezone other than the scheduler's.
+func (s *SnapshotV2) hasTimezones() bool {
+	for _, timezone := range s.Timezones {
+		if len(timezone) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SnapshotV2) Serialize() ([]byte, error) {
+	var b bytes.Buffer
+	err := gob.NewEncoder(&b).Encode(s)
+	return b.Bytes(), err
+}
+
+func (s *SnapshotV2) Deserialize(snapshot []byte) error {
+	return gob.NewDecoder(bytes.NewBuffer(snapshot)).Decode(s)
+}
+
+func (s *SnapshotV2) IsEmpty() bool {
+	return len(s.LastTimes) == 0
+}
+
+func (s *SnapshotV2) GetVersion() int {
+	return 2
+}
+
+func (s *SnapshotV2) Create() Snapshot {
+	return &SnapshotV2{
+		LastTimes: map[string]*time.Time{},
+		Timezones: map[string]string{},
+	}
+}
@@ -16,6 +16,11 @@ type VersionedSnapshot struct {
 }
 
 func (s *VersionedSnapshot) WriteSnapshot(w io.Writer, snapshot Snapshot) error {
+	// Schedulers predating V2 fail to read it. Keep writing V1 until a schedule runs in its own timezone so that the
+	// scheduler can still be rolled back.
+	if v2, ok := snapshot.(*SnapshotV2); ok && !v2.hasTimezones() {
+		snapshot = &SnapshotV1{LastTimes: v2.LastTimes}
+	}
 	byteContents, err := snapshot.Serialize()
 	if err != nil {
 		return err
@@ -37,7 +42,21 @@ func (s *VersionedSnapshot) ReadSnapshot(r io.Reader) (Snapshot, error) {
 		if err != nil {
 			return nil, err
 		}
-		return &snapShotV1, nil
+		// Upgrade to V2 so that the snapshots written from now on carry the timezones of the schedules. V1 snapshots
+		// are only written while all the schedules run in the scheduler's timezone.
+		snapShotV2 := SnapshotV2{LastTimes: snapShotV1.LastTimes, Timezones: map[string]string{}}
+		for key := range snapShotV1.LastTimes {
+			snapShotV2.Timezones[key] = ""
+		}
+		return &snapShotV2, nil
+	}
+	if s.Version == 2 {
+		snapShotV2 := SnapshotV2{LastTimes: map[string]*time.Time{}, Timezones: map[string]string{}}
+		err = snapShotV2.Deserialize(s.Ser)
+		if err != nil {
+			return nil, err
+		}
+		return &snapShotV2, nil
 	}
 	return nil, fmt.Errorf("unsupported version %v", s.Version)
 }
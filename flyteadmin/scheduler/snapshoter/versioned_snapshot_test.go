@@ -28,6 +28,65 @@ func TestReadWriteSnapshot(t *testing.T) {
 		assert.NotNil(t, s.GetLastExecutionTime("schedule1"))
 	})
 
+	t.Run("successful read write v2", func(t *testing.T) {
+		var bytesArray []byte
+		f := bytes.NewBuffer(bytesArray)
+		writer := VersionedSnapshot{}
+		snapshot := (&SnapshotV2{}).Create()
+		currTime := time.Now()
+		snapshot.UpdateLastExecutionTime("schedule1", &currTime)
+		snapshot.UpdateTimezone("schedule1", "Europe/Berlin")
+		err := writer.WriteSnapshot(f, snapshot)
+		assert.Nil(t, err)
+		r := bytes.NewReader(f.Bytes())
+		reader := VersionedSnapshot{}
+		s, err := reader.ReadSnapshot(r)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, s.GetVersion())
+		assert.NotNil(t, s.GetLastExecutionTime("schedule1"))
+		timezone, ok := s.GetTimezone("schedule1")
+		assert.True(t, ok)
+		assert.Equal(t, "Europe/Berlin", timezone)
+	})
+
+	t.Run("v1 upgraded to v2 on read", func(t *testing.T) {
+		var bytesArray []byte
+		f := bytes.NewBuffer(bytesArray)
+		writer := VersionedSnapshot{}
+		currTime := time.Now()
+		snapshot := &SnapshotV1{
+			LastTimes: map[string]*time.Time{"schedule1": &currTime},
+		}
+		err := writer.WriteSnapshot(f, snapshot)
+		assert.Nil(t, err)
+		reader := VersionedSnapshot{}
+		s, err := reader.ReadSnapshot(bytes.NewReader(f.Bytes()))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, s.GetVersion())
+		assert.NotNil(t, s.GetLastExecutionTime("schedule1"))
+		timezone, ok := s.GetTimezone("schedule1")
+		assert.True(t, ok)
+		assert.Empty(t, timezone)
+	})
+
+	t.Run("v2 without timezones written as v1", func(t *testing.T) {
+		var bytesArray []byte
+		f := bytes.NewBuffer(bytesArray)
+		writer := VersionedSnapshot{}
+		snapshot := (&SnapshotV2{}).Create()
+		currTime := time.Now()
+		snapshot.UpdateLastExecutionTime("schedule1", &currTime)
+		snapshot.UpdateTimezone("schedule1", "")
+		err := writer.WriteSnapshot(f, snapshot)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, writer.Version)
+
+		// Schedulers predating V2 only know about V1 snapshots.
+		v1 := SnapshotV1{LastTimes: map[string]*time.Time{}}
+		assert.Nil(t, v1.Deserialize(writer.Ser))
+		assert.NotNil(t, v1.GetLastExecutionTime("schedule1"))
+	})
+
 	t.Run("successful write unsuccessful read", func(t *testing.T) {
 		var bytesArray []byte
 		f := bytes.NewBuffer(bytesArray)
@@ -0,0 +1,18 @@
+package cache
+
+//go:generate pflags DeleteConfig --default-var DefaultDeleteConfig --bind-default-var
+
+var DefaultDeleteConfig = &DeleteConfig{}
+
+// DeleteConfig stores the flags required by delete cache
+type DeleteConfig struct {
+	Endpoint       string            `json:"endpoint" pflag:",datacatalog endpoint to send the delete requests to."`
+	Insecure       bool              `json:"insecure" pflag:",use an insecure connection to datacatalog."`
+	UseAdminAuth   bool              `json:"useAdminAuth" pflag:",authenticate to datacatalog with the credentials of the flyteadmin client."`
+	DatasetName    string            `json:"datasetName" pflag:",name of the dataset to delete cached artifacts from."`
+	DatasetVersion string            `json:"datasetVersion" pflag:",version of the dataset to delete cached artifacts from."`
+	Tag            string            `json:"tag" pflag:",delete the artifacts with the given tag."`
+	Partitions     map[string]string `json:"partitions" pflag:",delete the artifacts matching all the given partition key values."`
+	All            bool              `json:"all" pflag:",delete the whole dataset along with all of its artifacts."`
+	DryRun         bool              `json:"dryRun" pflag:",print the artifacts that would be deleted without deleting them."`
+}
@@ -0,0 +1,273 @@
+package delete
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"sort"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/cache"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/pkce"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	cacheCmdShort = `Deletes cached artifacts and datasets from datacatalog.`
+	cacheCmdLong  = `
+Cached task outputs are stored as artifacts of a dataset in datacatalog. Deleting them forces the next execution of the task to recompute its outputs.
+The artifacts are deleted along with their tags, partitions and the offloaded data in blob storage.
+
+Delete single artifacts of a dataset by their IDs:
+
+::
+
+ flytectl delete cache 8f8d3fb0-7b54-4c8e-b7ac-0f6e5fbc8c6c -p flytesnacks -d development --datasetName flyte_task-core.basic.hello_world.say_hello --datasetVersion 1.0-cgSDqGS4-qBHtLtWp
+
+Delete all artifacts of a dataset with the given tag:
+
+::
+
+ flytectl delete cache -p flytesnacks -d development --datasetName flyte_task-core.basic.hello_world.say_hello --datasetVersion 1.0-cgSDqGS4-qBHtLtWp --tag flyte_cached-GQid5LjHbakcW68DS3P2jp80QLbiZNA3gNm
+
+Delete all artifacts of a dataset matching the given partitions:
+
+::
+
+ flytectl delete cache -p flytesnacks -d development --datasetName flyte_task-core.basic.hello_world.say_hello --datasetVersion 1.0-cgSDqGS4-qBHtLtWp --partitions region=us-east-1,day=2024-01-01
+
+Delete the whole dataset along with all of its artifacts:
+
+::
+
+ flytectl delete cache -p flytesnacks -d development --datasetName flyte_task-core.basic.hello_world.say_hello --datasetVersion 1.0-cgSDqGS4-qBHtLtWp --all
+
+Datacatalog is not exposed through the Flyte admin endpoint, the datacatalog endpoint to connect to is required:
+
+::
+
+ flytectl delete cache -p flytesnacks -d development --datasetName my_dataset --datasetVersion 1.0 --all --endpoint dns:///datacatalog.flyte:89 --insecure
+
+Datacatalog rejects all delete requests unless deletion is enabled in its configuration and it verifies the access tokens issued by the flyteadmin authorization server. Authenticate with the credentials of the flyteadmin client:
+
+::
+
+ flytectl delete cache -p flytesnacks -d development --datasetName my_dataset --datasetVersion 1.0 --all --endpoint dns:///datacatalog.example.com:443 --useAdminAuth
+
+Print the artifacts that would be deleted without deleting them:
+
+::
+
+ flytectl delete cache -p flytesnacks -d development --datasetName my_dataset --datasetVersion 1.0 --all --endpoint dns:///datacatalog.flyte:89 --insecure --dryRun
+
+Usage
+`
+)
+
+const listArtifactsPageSize = 100
+
+var artifactColumns = []printer.Column{
+	{Header: "Artifact ID", JSONPath: "$.id"},
+	{Header: "Dataset", JSONPath: "$.dataset.name"},
+	{Header: "Version", JSONPath: "$.dataset.version"},
+	{Header: "Created At", JSONPath: "$.createdAt"},
+}
+
+// dataCatalogClientFunc creates the datacatalog client for the delete cache requests. Overridden in tests.
+var dataCatalogClientFunc = newDataCatalogClient
+
+func newDataCatalogClient(ctx context.Context, cfg *cache.DeleteConfig) (datacatalog.DataCatalogClient, error) {
+	var opts []grpc.DialOption
+	if cfg.UseAdminAuth {
+		adminCfg := admin.GetConfig(ctx)
+		tokenCache := pkce.NewTokenCacheKeyringProvider(pkce.KeyRingServiceName,
+			fmt.Sprintf("%s:%s", adminCfg.Endpoint.String(), pkce.KeyRingServiceUser))
+		credentialsFuture := admin.NewPerRPCCredentialsFuture()
+		opts = append(opts,
+			grpc.WithChainUnaryInterceptor(admin.NewAuthInterceptor(adminCfg, tokenCache, credentialsFuture, nil)),
+			grpc.WithPerRPCCredentials(credentialsFuture))
+	}
+
+	if cfg.Insecure {
+		logger.Debug(ctx, "Establishing insecure connection to DataCatalog")
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	} else {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(pool, "")))
+	}
+
+	clientConn, err := grpc.Dial(cfg.Endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return datacatalog.NewDataCatalogClient(clientConn), nil
+}
+
+func deleteCacheFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	cfg := cache.DefaultDeleteConfig
+	if len(cfg.DatasetName) == 0 || len(cfg.DatasetVersion) == 0 {
+		return fmt.Errorf("datasetName and datasetVersion are required parameters")
+	}
+	if len(cfg.Endpoint) == 0 {
+		return fmt.Errorf("endpoint of datacatalog is a required parameter")
+	}
+
+	filter := partitionAndTagFilter(cfg.Tag, cfg.Partitions)
+	selectors := 0
+	for _, selected := range []bool{len(args) > 0, filter != nil, cfg.All} {
+		if selected {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		return fmt.Errorf("exactly one of artifact IDs, tag/partitions or all needs to be specified")
+	}
+
+	dataset := &datacatalog.DatasetID{
+		Project: config.GetConfig().Project,
+		Domain:  config.GetConfig().Domain,
+		Name:    cfg.DatasetName,
+		Version: cfg.DatasetVersion,
+	}
+
+	client, err := dataCatalogClientFunc(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.DryRun {
+		artifacts, err := getMatchingArtifacts(ctx, client, dataset, args, filter)
+		if err != nil {
+			return err
+		}
+		logger.Infof(ctx, "skipping delete cache request for dataset %v (dryRun), %d artifacts would be deleted", dataset, len(artifacts))
+		return printer.Printer{}.Print(config.GetConfig().MustOutputFormat(), artifactColumns, artifacts...)
+	}
+
+	switch {
+	case cfg.All:
+		if _, err := client.DeleteDataset(ctx, &datacatalog.DeleteDatasetRequest{Dataset: dataset}); err != nil {
+			logger.Errorf(ctx, "Failed to delete dataset %v due to %v ", dataset, err)
+			return err
+		}
+		logger.Infof(ctx, "Deleted dataset %v", dataset)
+	case filter != nil:
+		resp, err := client.DeleteArtifacts(ctx, &datacatalog.DeleteArtifactsRequest{Dataset: dataset, Filter: filter})
+		if err != nil {
+			logger.Errorf(ctx, "Failed to delete artifacts of dataset %v due to %v ", dataset, err)
+			return err
+		}
+		logger.Infof(ctx, "Deleted %d artifacts of dataset %v: %v", len(resp.GetArtifactIds()), dataset, resp.GetArtifactIds())
+		for _, deleteErr := range resp.GetErrors() {
+			logger.Errorf(ctx, "Failed to delete artifact %v due to %v ", deleteErr.GetArtifactId(), deleteErr.GetMessage())
+		}
+		if len(resp.GetErrors()) > 0 {
+			return fmt.Errorf("failed to delete %d artifacts of dataset %v", len(resp.GetErrors()), dataset)
+		}
+	default:
+		for _, artifactID := range args {
+			_, err := client.DeleteArtifact(ctx, &datacatalog.DeleteArtifactRequest{
+				Dataset:     dataset,
+				QueryHandle: &datacatalog.DeleteArtifactRequest_ArtifactId{ArtifactId: artifactID},
+			})
+			if err != nil {
+				logger.Errorf(ctx, "Failed to delete artifact %v due to %v ", artifactID, err)
+				return err
+			}
+			logger.Infof(ctx, "Deleted artifact %v", artifactID)
+		}
+	}
+
+	return nil
+}
+
+// getMatchingArtifacts fetches the artifacts with the given IDs, or lists the artifacts matching the filter. All
+// artifacts of the dataset are listed if neither IDs nor a filter are given.
+func getMatchingArtifacts(ctx context.Context, client datacatalog.DataCatalogClient, dataset *datacatalog.DatasetID,
+	artifactIDs []string, filter *datacatalog.FilterExpression) ([]proto.Message, error) {
+	artifacts := make([]proto.Message, 0, len(artifactIDs))
+	if len(artifactIDs) > 0 {
+		for _, artifactID := range artifactIDs {
+			resp, err := client.GetArtifact(ctx, &datacatalog.GetArtifactRequest{
+				Dataset:     dataset,
+				QueryHandle: &datacatalog.GetArtifactRequest_ArtifactId{ArtifactId: artifactID},
+			})
+			if err != nil {
+				logger.Errorf(ctx, "Failed to get artifact %v due to %v ", artifactID, err)
+				return nil, err
+			}
+			artifacts = append(artifacts, resp.GetArtifact())
+		}
+		return artifacts, nil
+	}
+
+	token := ""
+	for {
+		resp, err := client.ListArtifacts(ctx, &datacatalog.ListArtifactsRequest{
+			Dataset:    dataset,
+			Filter:     filter,
+			Pagination: &datacatalog.PaginationOptions{Limit: listArtifactsPageSize, Token: token},
+		})
+		if err != nil {
+			logger.Errorf(ctx, "Failed to list artifacts of dataset %v due to %v ", dataset, err)
+			return nil, err
+		}
+		for _, artifact := range resp.GetArtifacts() {
+			artifacts = append(artifacts, artifact)
+		}
+
+		token = resp.GetNextToken()
+		if len(resp.GetArtifacts()) < listArtifactsPageSize || len(token) == 0 {
+			return artifacts, nil
+		}
+	}
+}
+
+// partitionAndTagFilter builds the datacatalog filter matching the tag and all partitions, nil if neither is given
+func partitionAndTagFilter(tag string, partitions map[string]string) *datacatalog.FilterExpression {
+	filters := make([]*datacatalog.SinglePropertyFilter, 0, len(partitions)+1)
+	if len(tag) > 0 {
+		filters = append(filters, &datacatalog.SinglePropertyFilter{
+			PropertyFilter: &datacatalog.SinglePropertyFilter_TagFilter{
+				TagFilter: &datacatalog.TagPropertyFilter{
+					Property: &datacatalog.TagPropertyFilter_TagName{TagName: tag},
+				},
+			},
+		})
+	}
+
+	keys := make([]string, 0, len(partitions))
+	for key := range partitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		filters = append(filters, &datacatalog.SinglePropertyFilter{
+			PropertyFilter: &datacatalog.SinglePropertyFilter_PartitionFilter{
+				PartitionFilter: &datacatalog.PartitionPropertyFilter{
+					Property: &datacatalog.PartitionPropertyFilter_KeyVal{
+						KeyVal: &datacatalog.KeyValuePair{Key: key, Value: partitions[key]},
+					},
+				},
+			},
+		})
+	}
+
+	if len(filters) == 0 {
+		return nil
+	}
+	return &datacatalog.FilterExpression{Filters: filters}
+}
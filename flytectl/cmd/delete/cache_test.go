@@ -0,0 +1,178 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/cache"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/datacatalog/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func deleteCacheSetup() *mocks.DataCatalogClient {
+	cache.DefaultDeleteConfig = &cache.DeleteConfig{
+		Endpoint:       "dns:///datacatalog:89",
+		DatasetName:    "dataset",
+		DatasetVersion: "v1",
+	}
+	client := &mocks.DataCatalogClient{}
+	dataCatalogClientFunc = func(ctx context.Context, cfg *cache.DeleteConfig) (datacatalog.DataCatalogClient, error) {
+		return client, nil
+	}
+	return client
+}
+
+func testDatasetID() *datacatalog.DatasetID {
+	return &datacatalog.DatasetID{
+		Project: config.GetConfig().Project,
+		Domain:  config.GetConfig().Domain,
+		Name:    "dataset",
+		Version: "v1",
+	}
+}
+
+func TestDeleteCacheFunc(t *testing.T) {
+	t.Run("artifact IDs", func(t *testing.T) {
+		s := testutils.Setup(t)
+		client := deleteCacheSetup()
+		client.OnDeleteArtifactMatch(s.Ctx, mock.MatchedBy(func(req *datacatalog.DeleteArtifactRequest) bool {
+			return proto.Equal(req.GetDataset(), testDatasetID()) && (req.GetArtifactId() == "a1" || req.GetArtifactId() == "a2")
+		})).Return(&datacatalog.DeleteArtifactResponse{}, nil)
+
+		err := deleteCacheFunc(s.Ctx, []string{"a1", "a2"}, s.CmdCtx)
+		assert.Nil(t, err)
+		client.AssertNumberOfCalls(t, "DeleteArtifact", 2)
+	})
+
+	t.Run("tag and partitions", func(t *testing.T) {
+		s := testutils.Setup(t)
+		client := deleteCacheSetup()
+		cache.DefaultDeleteConfig.Tag = "tag1"
+		cache.DefaultDeleteConfig.Partitions = map[string]string{"b": "2", "a": "1"}
+		client.OnDeleteArtifactsMatch(s.Ctx, mock.MatchedBy(func(req *datacatalog.DeleteArtifactsRequest) bool {
+			filters := req.GetFilter().GetFilters()
+			return proto.Equal(req.GetDataset(), testDatasetID()) && len(filters) == 3 &&
+				filters[0].GetTagFilter().GetTagName() == "tag1" &&
+				filters[1].GetPartitionFilter().GetKeyVal().GetKey() == "a" &&
+				filters[2].GetPartitionFilter().GetKeyVal().GetKey() == "b"
+		})).Return(&datacatalog.DeleteArtifactsResponse{ArtifactIds: []string{"a1"}}, nil)
+
+		err := deleteCacheFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		client.AssertNumberOfCalls(t, "DeleteArtifacts", 1)
+	})
+
+	t.Run("failed artifacts", func(t *testing.T) {
+		s := testutils.Setup(t)
+		client := deleteCacheSetup()
+		cache.DefaultDeleteConfig.Tag = "tag1"
+		client.OnDeleteArtifactsMatch(s.Ctx, mock.Anything).Return(&datacatalog.DeleteArtifactsResponse{
+			ArtifactIds: []string{"a1"},
+			Errors:      []*datacatalog.DeleteArtifactError{{ArtifactId: "a2", Message: "failed"}},
+		}, nil)
+
+		err := deleteCacheFunc(s.Ctx, nil, s.CmdCtx)
+		assert.NotNil(t, err)
+		client.AssertNumberOfCalls(t, "DeleteArtifacts", 1)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		s := testutils.Setup(t)
+		client := deleteCacheSetup()
+		cache.DefaultDeleteConfig.All = true
+		client.OnDeleteDatasetMatch(s.Ctx, mock.MatchedBy(func(req *datacatalog.DeleteDatasetRequest) bool {
+			return proto.Equal(req.GetDataset(), testDatasetID())
+		})).Return(&datacatalog.DeleteDatasetResponse{}, nil)
+
+		err := deleteCacheFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		client.AssertNumberOfCalls(t, "DeleteDataset", 1)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		s := testutils.Setup(t)
+		client := deleteCacheSetup()
+		cache.DefaultDeleteConfig.All = true
+		cache.DefaultDeleteConfig.DryRun = true
+		artifacts := make([]*datacatalog.Artifact, listArtifactsPageSize)
+		for i := range artifacts {
+			artifacts[i] = &datacatalog.Artifact{Id: fmt.Sprintf("a%d", i), Dataset: testDatasetID()}
+		}
+		client.OnListArtifactsMatch(s.Ctx, mock.MatchedBy(func(req *datacatalog.ListArtifactsRequest) bool {
+			return proto.Equal(req.GetDataset(), testDatasetID()) && req.GetFilter() == nil && req.GetPagination().GetToken() == ""
+		})).Return(&datacatalog.ListArtifactsResponse{Artifacts: artifacts, NextToken: "100"}, nil)
+		client.OnListArtifactsMatch(s.Ctx, mock.MatchedBy(func(req *datacatalog.ListArtifactsRequest) bool {
+			return req.GetPagination().GetToken() == "100"
+		})).Return(&datacatalog.ListArtifactsResponse{Artifacts: []*datacatalog.Artifact{{Id: "last"}}}, nil)
+
+		err := deleteCacheFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		client.AssertNumberOfCalls(t, "ListArtifacts", 2)
+		client.AssertNotCalled(t, "DeleteDataset", mock.Anything, mock.Anything)
+	})
+
+	t.Run("dry run artifact IDs", func(t *testing.T) {
+		s := testutils.Setup(t)
+		client := deleteCacheSetup()
+		cache.DefaultDeleteConfig.DryRun = true
+		client.OnGetArtifactMatch(s.Ctx, mock.MatchedBy(func(req *datacatalog.GetArtifactRequest) bool {
+			return proto.Equal(req.GetDataset(), testDatasetID()) && req.GetArtifactId() == "a1"
+		})).Return(&datacatalog.GetArtifactResponse{Artifact: &datacatalog.Artifact{Id: "a1"}}, nil)
+
+		err := deleteCacheFunc(s.Ctx, []string{"a1"}, s.CmdCtx)
+		assert.Nil(t, err)
+		client.AssertNotCalled(t, "DeleteArtifact", mock.Anything, mock.Anything)
+	})
+
+	t.Run("delete failed", func(t *testing.T) {
+		s := testutils.Setup(t)
+		client := deleteCacheSetup()
+		client.OnDeleteArtifactMatch(s.Ctx, mock.Anything).Return(nil, errors.New("failed to delete"))
+
+		err := deleteCacheFunc(s.Ctx, []string{"a1", "a2"}, s.CmdCtx)
+		assert.Equal(t, errors.New("failed to delete"), err)
+		client.AssertNumberOfCalls(t, "DeleteArtifact", 1)
+	})
+
+	t.Run("missing dataset", func(t *testing.T) {
+		s := testutils.Setup(t)
+		deleteCacheSetup()
+		cache.DefaultDeleteConfig.DatasetVersion = ""
+
+		err := deleteCacheFunc(s.Ctx, []string{"a1"}, s.CmdCtx)
+		assert.Error(t, err)
+	})
+
+	t.Run("missing endpoint", func(t *testing.T) {
+		s := testutils.Setup(t)
+		deleteCacheSetup()
+		cache.DefaultDeleteConfig.Endpoint = ""
+
+		err := deleteCacheFunc(s.Ctx, []string{"a1"}, s.CmdCtx)
+		assert.Error(t, err)
+	})
+
+	t.Run("ambiguous selection", func(t *testing.T) {
+		s := testutils.Setup(t)
+		deleteCacheSetup()
+		cache.DefaultDeleteConfig.All = true
+
+		err := deleteCacheFunc(s.Ctx, []string{"a1"}, s.CmdCtx)
+		assert.Error(t, err)
+	})
+
+	t.Run("nothing selected", func(t *testing.T) {
+		s := testutils.Setup(t)
+		deleteCacheSetup()
+
+		err := deleteCacheFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Error(t, err)
+	})
+}
@@ -1,6 +1,7 @@
 package delete
 
 import (
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/cache"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/clusterresourceattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionclusterlabel"
@@ -51,6 +52,8 @@ func RemoteDeleteCommand() *cobra.Command {
 		"workflow-execution-config": {CmdFunc: deleteWorkflowExecutionConfig, Aliases: []string{"workflow-execution-config"},
 			Short: workflowExecutionConfigShort,
 			Long:  workflowExecutionConfigLong, PFlagProvider: workflowexecutionconfig.DefaultDelConfig, ProjectDomainNotRequired: true},
+		"cache": {CmdFunc: deleteCacheFunc, Aliases: []string{"caches"}, Short: cacheCmdShort,
+			Long: cacheCmdLong, PFlagProvider: cache.DefaultDeleteConfig, DisableFlyteClient: true},
 	}
 	cmdcore.AddCommands(deleteCmd, terminateResourcesFuncs)
 	return deleteCmd
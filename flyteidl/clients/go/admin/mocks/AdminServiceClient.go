@@ -1313,6 +1313,54 @@ func (_m *AdminServiceClient) GetTaskExecutionData(ctx context.Context, in *admi
 	return r0, r1
 }
 
+type AdminServiceClient_GetTaskResourceRecommendation struct {
+	*mock.Call
+}
+
+func (_m AdminServiceClient_GetTaskResourceRecommendation) Return(_a0 *admin.TaskResourceRecommendation, _a1 error) *AdminServiceClient_GetTaskResourceRecommendation {
+	return &AdminServiceClient_GetTaskResourceRecommendation{Call: _m.Call.Return(_a0, _a1)}
+}
+
+func (_m *AdminServiceClient) OnGetTaskResourceRecommendation(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption) *AdminServiceClient_GetTaskResourceRecommendation {
+	c_call := _m.On("GetTaskResourceRecommendation", ctx, in, opts)
+	return &AdminServiceClient_GetTaskResourceRecommendation{Call: c_call}
+}
+
+func (_m *AdminServiceClient) OnGetTaskResourceRecommendationMatch(matchers ...interface{}) *AdminServiceClient_GetTaskResourceRecommendation {
+	c_call := _m.On("GetTaskResourceRecommendation", matchers...)
+	return &AdminServiceClient_GetTaskResourceRecommendation{Call: c_call}
+}
+
+// GetTaskResourceRecommendation provides a mock function with given fields: ctx, in, opts
+func (_m *AdminServiceClient) GetTaskResourceRecommendation(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption) (*admin.TaskResourceRecommendation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *admin.TaskResourceRecommendation
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ObjectGetRequest, ...grpc.CallOption) *admin.TaskResourceRecommendation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.TaskResourceRecommendation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ObjectGetRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type AdminServiceClient_GetVersion struct {
 	*mock.Call
 }
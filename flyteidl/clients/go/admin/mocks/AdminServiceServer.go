@@ -1122,6 +1122,47 @@ func (_m *AdminServiceServer) GetTaskExecutionData(_a0 context.Context, _a1 *adm
 	return r0, r1
 }
 
+type AdminServiceServer_GetTaskResourceRecommendation struct {
+	*mock.Call
+}
+
+func (_m AdminServiceServer_GetTaskResourceRecommendation) Return(_a0 *admin.TaskResourceRecommendation, _a1 error) *AdminServiceServer_GetTaskResourceRecommendation {
+	return &AdminServiceServer_GetTaskResourceRecommendation{Call: _m.Call.Return(_a0, _a1)}
+}
+
+func (_m *AdminServiceServer) OnGetTaskResourceRecommendation(_a0 context.Context, _a1 *admin.ObjectGetRequest) *AdminServiceServer_GetTaskResourceRecommendation {
+	c_call := _m.On("GetTaskResourceRecommendation", _a0, _a1)
+	return &AdminServiceServer_GetTaskResourceRecommendation{Call: c_call}
+}
+
+func (_m *AdminServiceServer) OnGetTaskResourceRecommendationMatch(matchers ...interface{}) *AdminServiceServer_GetTaskResourceRecommendation {
+	c_call := _m.On("GetTaskResourceRecommendation", matchers...)
+	return &AdminServiceServer_GetTaskResourceRecommendation{Call: c_call}
+}
+
+// GetTaskResourceRecommendation provides a mock function with given fields: _a0, _a1
+func (_m *AdminServiceServer) GetTaskResourceRecommendation(_a0 context.Context, _a1 *admin.ObjectGetRequest) (*admin.TaskResourceRecommendation, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *admin.TaskResourceRecommendation
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ObjectGetRequest) *admin.TaskResourceRecommendation); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.TaskResourceRecommendation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ObjectGetRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type AdminServiceServer_GetVersion struct {
 	*mock.Call
 }
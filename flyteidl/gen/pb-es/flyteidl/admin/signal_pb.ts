@@ -4,9 +4,10 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
 import { SignalIdentifier, WorkflowExecutionIdentifier } from "../core/identifier_pb.js";
 import { LiteralType } from "../core/types_pb.js";
+import { ApprovalPolicy } from "../core/workflow_pb.js";
 import { Sort } from "./common_pb.js";
 import { Literal } from "../core/literals_pb.js";
 
@@ -31,6 +32,16 @@ export class SignalGetOrCreateRequest extends Message<SignalGetOrCreateRequest>
    */
   type?: LiteralType;
 
+  /**
+   * The policy of the approve condition creating this signal. Setting the value of a signal with an approval
+   * policy records an approval, the value is only set once the policy is satisfied. The policy of a new signal is
+   * read from the gate node of the execution's workflow and requests with a different policy are rejected.
+   * +optional
+   *
+   * @generated from field: flyteidl.core.ApprovalPolicy approval_policy = 3;
+   */
+  approvalPolicy?: ApprovalPolicy;
+
   constructor(data?: PartialMessage<SignalGetOrCreateRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -41,6 +52,7 @@ export class SignalGetOrCreateRequest extends Message<SignalGetOrCreateRequest>
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "message", T: SignalIdentifier },
     { no: 2, name: "type", kind: "message", T: LiteralType },
+    { no: 3, name: "approval_policy", kind: "message", T: ApprovalPolicy },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignalGetOrCreateRequest {
@@ -213,6 +225,14 @@ export class SignalSetRequest extends Message<SignalSetRequest> {
    */
   value?: Literal;
 
+  /**
+   * The reason for the decision, recorded with the approval of signals with an approval policy.
+   * +optional
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
   constructor(data?: PartialMessage<SignalSetRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -223,6 +243,7 @@ export class SignalSetRequest extends Message<SignalSetRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "message", T: SignalIdentifier },
     { no: 2, name: "value", kind: "message", T: Literal },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignalSetRequest {
@@ -307,6 +328,20 @@ export class Signal extends Message<Signal> {
    */
   value?: Literal;
 
+  /**
+   * The policy restricting who may approve this signal, if any.
+   *
+   * @generated from field: flyteidl.core.ApprovalPolicy approval_policy = 4;
+   */
+  approvalPolicy?: ApprovalPolicy;
+
+  /**
+   * The decisions recorded on this signal so far, in the order they were made.
+   *
+   * @generated from field: repeated flyteidl.admin.SignalApproval approvals = 5;
+   */
+  approvals: SignalApproval[] = [];
+
   constructor(data?: PartialMessage<Signal>) {
     super();
     proto3.util.initPartial(data, this);
@@ -318,6 +353,8 @@ export class Signal extends Message<Signal> {
     { no: 1, name: "id", kind: "message", T: SignalIdentifier },
     { no: 2, name: "type", kind: "message", T: LiteralType },
     { no: 3, name: "value", kind: "message", T: Literal },
+    { no: 4, name: "approval_policy", kind: "message", T: ApprovalPolicy },
+    { no: 5, name: "approvals", kind: "message", T: SignalApproval, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Signal {
@@ -337,3 +374,68 @@ export class Signal extends Message<Signal> {
   }
 }
 
+/**
+ * SignalApproval records the decision of a single identity on a signal with an approval policy.
+ *
+ * @generated from message flyteidl.admin.SignalApproval
+ */
+export class SignalApproval extends Message<SignalApproval> {
+  /**
+   * The identity of the authenticated caller.
+   *
+   * @generated from field: string identity = 1;
+   */
+  identity = "";
+
+  /**
+   * Whether the caller approved or rejected.
+   *
+   * @generated from field: bool approved = 2;
+   */
+  approved = false;
+
+  /**
+   * The reason given by the caller.
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * The time the decision was recorded.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<SignalApproval>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.SignalApproval";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "identity", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "approved", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignalApproval {
+    return new SignalApproval().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignalApproval {
+    return new SignalApproval().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignalApproval {
+    return new SignalApproval().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SignalApproval | PlainMessage<SignalApproval> | undefined, b: SignalApproval | PlainMessage<SignalApproval> | undefined): boolean {
+    return proto3.util.equals(SignalApproval, a, b);
+  }
+}
+
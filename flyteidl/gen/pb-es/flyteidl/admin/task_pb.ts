@@ -9,6 +9,7 @@ import { Identifier } from "../core/identifier_pb.js";
 import { TaskTemplate } from "../core/tasks_pb.js";
 import { DescriptionEntity } from "./description_entity_pb.js";
 import { CompiledTask } from "../core/compiler_pb.js";
+import { TaskResourceSpec } from "./matchable_resource_pb.js";
 
 /**
  * Represents a request structure to create a revision of a task.
@@ -306,3 +307,69 @@ export class TaskClosure extends Message<TaskClosure> {
   }
 }
 
+/**
+ * Resources recommended for a task version, based on the peak usage reported by its past successful executions.
+ *
+ * @generated from message flyteidl.admin.TaskResourceRecommendation
+ */
+export class TaskResourceRecommendation extends Message<TaskResourceRecommendation> {
+  /**
+   * Identifier of the task version.
+   *
+   * @generated from field: flyteidl.core.Identifier id = 1;
+   */
+  id?: Identifier;
+
+  /**
+   * Recommended resources, the peak usage increased by the configured headroom. Unset until enough executions
+   * reported their usage.
+   *
+   * @generated from field: flyteidl.admin.TaskResourceSpec recommended = 2;
+   */
+  recommended?: TaskResourceSpec;
+
+  /**
+   * Peak usage observed across the sampled executions.
+   *
+   * @generated from field: flyteidl.admin.TaskResourceSpec peak = 3;
+   */
+  peak?: TaskResourceSpec;
+
+  /**
+   * Number of executions the recommendation is based on.
+   *
+   * @generated from field: int32 sample_count = 4;
+   */
+  sampleCount = 0;
+
+  constructor(data?: PartialMessage<TaskResourceRecommendation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.TaskResourceRecommendation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "message", T: Identifier },
+    { no: 2, name: "recommended", kind: "message", T: TaskResourceSpec },
+    { no: 3, name: "peak", kind: "message", T: TaskResourceSpec },
+    { no: 4, name: "sample_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TaskResourceRecommendation {
+    return new TaskResourceRecommendation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TaskResourceRecommendation {
+    return new TaskResourceRecommendation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TaskResourceRecommendation {
+    return new TaskResourceRecommendation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TaskResourceRecommendation | PlainMessage<TaskResourceRecommendation> | undefined, b: TaskResourceRecommendation | PlainMessage<TaskResourceRecommendation> | undefined): boolean {
+    return proto3.util.equals(TaskResourceRecommendation, a, b);
+  }
+}
+
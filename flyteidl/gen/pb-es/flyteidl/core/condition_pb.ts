@@ -5,7 +5,8 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import { Primitive, Scalar } from "./literals_pb.js";
+import { LiteralCollection, Primitive, Scalar } from "./literals_pb.js";
+import { PromiseAttribute } from "./types_pb.js";
 
 /**
  * Defines a 2-level tree where the root is a comparison operator and Operands are primitives or known variables.
@@ -98,6 +99,50 @@ export enum ComparisonExpression_Operator {
    * @generated from enum value: LTE = 5;
    */
   LTE = 5,
+
+  /**
+   * The left value is an element of the right value, which has to be a collection.
+   *
+   * @generated from enum value: IN = 6;
+   */
+  IN = 6,
+
+  /**
+   * @generated from enum value: NOT_IN = 7;
+   */
+  NOT_IN = 7,
+
+  /**
+   * String operators, both values have to be strings.
+   *
+   * @generated from enum value: STARTS_WITH = 8;
+   */
+  STARTS_WITH = 8,
+
+  /**
+   * @generated from enum value: ENDS_WITH = 9;
+   */
+  ENDS_WITH = 9,
+
+  /**
+   * The left value matches the regular expression (RE2 syntax) in the right value. Use ^ and $ to match the
+   * whole string.
+   *
+   * @generated from enum value: MATCHES = 10;
+   */
+  MATCHES = 10,
+
+  /**
+   * Unary operators checking whether the left value is none/null. The right value is not set.
+   *
+   * @generated from enum value: IS_NULL = 11;
+   */
+  IS_NULL = 11,
+
+  /**
+   * @generated from enum value: IS_NOT_NULL = 12;
+   */
+  IS_NOT_NULL = 12,
 }
 // Retrieve enum metadata with: proto3.getEnumType(ComparisonExpression_Operator)
 proto3.util.setEnumType(ComparisonExpression_Operator, "flyteidl.core.ComparisonExpression.Operator", [
@@ -107,6 +152,13 @@ proto3.util.setEnumType(ComparisonExpression_Operator, "flyteidl.core.Comparison
   { no: 3, name: "GTE" },
   { no: 4, name: "LT" },
   { no: 5, name: "LTE" },
+  { no: 6, name: "IN" },
+  { no: 7, name: "NOT_IN" },
+  { no: 8, name: "STARTS_WITH" },
+  { no: 9, name: "ENDS_WITH" },
+  { no: 10, name: "MATCHES" },
+  { no: 11, name: "IS_NULL" },
+  { no: 12, name: "IS_NOT_NULL" },
 ]);
 
 /**
@@ -143,8 +195,24 @@ export class Operand extends Message<Operand> {
      */
     value: Scalar;
     case: "scalar";
+  } | {
+    /**
+     * Or a constant collection, e.g. the right value of an IN comparison
+     *
+     * @generated from field: flyteidl.core.LiteralCollection collection = 4;
+     */
+    value: LiteralCollection;
+    case: "collection";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
+  /**
+   * Attribute path into the value of var, e.g. ["a", "b", 0] to compare var.a["b"][0]. Resolves through maps,
+   * collections, structs and msgpack encoded binaries (dataclasses, pydantic models).
+   *
+   * @generated from field: repeated flyteidl.core.PromiseAttribute attr_path = 5;
+   */
+  attrPath: PromiseAttribute[] = [];
+
   constructor(data?: PartialMessage<Operand>) {
     super();
     proto3.util.initPartial(data, this);
@@ -156,6 +224,8 @@ export class Operand extends Message<Operand> {
     { no: 1, name: "primitive", kind: "message", T: Primitive, oneof: "val" },
     { no: 2, name: "var", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "val" },
     { no: 3, name: "scalar", kind: "message", T: Scalar, oneof: "val" },
+    { no: 4, name: "collection", kind: "message", T: LiteralCollection, oneof: "val" },
+    { no: 5, name: "attr_path", kind: "message", T: PromiseAttribute, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Operand {
@@ -197,6 +267,12 @@ export class BooleanExpression extends Message<BooleanExpression> {
      */
     value: ComparisonExpression;
     case: "comparison";
+  } | {
+    /**
+     * @generated from field: flyteidl.core.NegationExpression negation = 3;
+     */
+    value: NegationExpression;
+    case: "negation";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<BooleanExpression>) {
@@ -209,6 +285,7 @@ export class BooleanExpression extends Message<BooleanExpression> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "conjunction", kind: "message", T: ConjunctionExpression, oneof: "expr" },
     { no: 2, name: "comparison", kind: "message", T: ComparisonExpression, oneof: "expr" },
+    { no: 3, name: "negation", kind: "message", T: NegationExpression, oneof: "expr" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BooleanExpression {
@@ -228,6 +305,45 @@ export class BooleanExpression extends Message<BooleanExpression> {
   }
 }
 
+/**
+ * Defines the negation of a boolean expression.
+ *
+ * @generated from message flyteidl.core.NegationExpression
+ */
+export class NegationExpression extends Message<NegationExpression> {
+  /**
+   * @generated from field: flyteidl.core.BooleanExpression expression = 1;
+   */
+  expression?: BooleanExpression;
+
+  constructor(data?: PartialMessage<NegationExpression>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.core.NegationExpression";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "expression", kind: "message", T: BooleanExpression },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NegationExpression {
+    return new NegationExpression().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NegationExpression {
+    return new NegationExpression().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NegationExpression {
+    return new NegationExpression().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NegationExpression | PlainMessage<NegationExpression> | undefined, b: NegationExpression | PlainMessage<NegationExpression> | undefined): boolean {
+    return proto3.util.equals(NegationExpression, a, b);
+  }
+}
+
 /**
  * Defines a conjunction expression of two boolean expressions.
  *
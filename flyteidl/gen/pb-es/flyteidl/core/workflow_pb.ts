@@ -8,7 +8,7 @@ import { Duration, Message, proto3 } from "@bufbuild/protobuf";
 import { BooleanExpression } from "./condition_pb.js";
 import { Error, LiteralType } from "./types_pb.js";
 import { Identifier } from "./identifier_pb.js";
-import { Binding, LiteralMap, RetryStrategy } from "./literals_pb.js";
+import { Binding, Literal, LiteralMap, RetryStrategy } from "./literals_pb.js";
 import { QualityOfService } from "./execution_pb.js";
 import { TypedInterface } from "./interface_pb.js";
 import { ExtendedResources, Resources } from "./tasks_pb.js";
@@ -300,6 +300,14 @@ export class ApproveCondition extends Message<ApproveCondition> {
    */
   signalId = "";
 
+  /**
+   * An optional policy restricting who may approve and how many approvals are needed. Without a policy the first
+   * caller setting the signal resolves the condition.
+   *
+   * @generated from field: flyteidl.core.ApprovalPolicy policy = 2;
+   */
+  policy?: ApprovalPolicy;
+
   constructor(data?: PartialMessage<ApproveCondition>) {
     super();
     proto3.util.initPartial(data, this);
@@ -309,6 +317,7 @@ export class ApproveCondition extends Message<ApproveCondition> {
   static readonly typeName = "flyteidl.core.ApproveCondition";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "signal_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "policy", kind: "message", T: ApprovalPolicy },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveCondition {
@@ -328,6 +337,65 @@ export class ApproveCondition extends Message<ApproveCondition> {
   }
 }
 
+/**
+ * ApprovalPolicy restricts the identities allowed to approve a gate and the number of distinct approvals it needs.
+ *
+ * @generated from message flyteidl.core.ApprovalPolicy
+ */
+export class ApprovalPolicy extends Message<ApprovalPolicy> {
+  /**
+   * The number of distinct identities that need to approve, or reject, to resolve the gate. Defaults to a single
+   * decision.
+   *
+   * @generated from field: uint32 min_approvals = 1;
+   */
+  minApprovals = 0;
+
+  /**
+   * The identities allowed to approve, matched against the subject of the authenticated caller.
+   *
+   * @generated from field: repeated string allowed_identities = 2;
+   */
+  allowedIdentities: string[] = [];
+
+  /**
+   * The groups allowed to approve, matched against the groups claim of the authenticated caller. If neither
+   * identities nor groups are set any authenticated caller may approve.
+   *
+   * @generated from field: repeated string allowed_groups = 3;
+   */
+  allowedGroups: string[] = [];
+
+  constructor(data?: PartialMessage<ApprovalPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.core.ApprovalPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "min_approvals", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "allowed_identities", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "allowed_groups", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApprovalPolicy {
+    return new ApprovalPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApprovalPolicy {
+    return new ApprovalPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApprovalPolicy {
+    return new ApprovalPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApprovalPolicy | PlainMessage<ApprovalPolicy> | undefined, b: ApprovalPolicy | PlainMessage<ApprovalPolicy> | undefined): boolean {
+    return proto3.util.equals(ApprovalPolicy, a, b);
+  }
+}
+
 /**
  * SignalCondition represents a dependency on an signal.
  *
@@ -426,6 +494,330 @@ export class SleepCondition extends Message<SleepCondition> {
   }
 }
 
+/**
+ * GateTimeout configures how long a gate waits for its condition to be met.
+ *
+ * @generated from message flyteidl.core.GateTimeout
+ */
+export class GateTimeout extends Message<GateTimeout> {
+  /**
+   * The maximum duration to wait for, measured from the start of the gate.
+   *
+   * @generated from field: google.protobuf.Duration duration = 1;
+   */
+  duration?: Duration;
+
+  /**
+   * The output of the gate once the timeout is reached. If not set the gate fails once the timeout is reached.
+   *
+   * @generated from field: flyteidl.core.Literal default_output = 2;
+   */
+  defaultOutput?: Literal;
+
+  constructor(data?: PartialMessage<GateTimeout>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.core.GateTimeout";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "duration", kind: "message", T: Duration },
+    { no: 2, name: "default_output", kind: "message", T: Literal },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GateTimeout {
+    return new GateTimeout().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GateTimeout {
+    return new GateTimeout().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GateTimeout {
+    return new GateTimeout().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GateTimeout | PlainMessage<GateTimeout> | undefined, b: GateTimeout | PlainMessage<GateTimeout> | undefined): boolean {
+    return proto3.util.equals(GateTimeout, a, b);
+  }
+}
+
+/**
+ * WaitUntilCondition represents a dependency on waiting until the point in time provided by an input of the node.
+ *
+ * @generated from message flyteidl.core.WaitUntilCondition
+ */
+export class WaitUntilCondition extends Message<WaitUntilCondition> {
+  /**
+   * The name of the datetime input to wait until.
+   *
+   * @generated from field: string input_variable_name = 1;
+   */
+  inputVariableName = "";
+
+  /**
+   * The name of the optional boolean output, set to true once the point in time is reached.
+   *
+   * @generated from field: string output_variable_name = 2;
+   */
+  outputVariableName = "";
+
+  /**
+   * An optional timeout, for points in time that may lie too far in the future.
+   *
+   * @generated from field: flyteidl.core.GateTimeout timeout = 3;
+   */
+  timeout?: GateTimeout;
+
+  constructor(data?: PartialMessage<WaitUntilCondition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.core.WaitUntilCondition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "input_variable_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "output_variable_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "timeout", kind: "message", T: GateTimeout },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WaitUntilCondition {
+    return new WaitUntilCondition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WaitUntilCondition {
+    return new WaitUntilCondition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WaitUntilCondition {
+    return new WaitUntilCondition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WaitUntilCondition | PlainMessage<WaitUntilCondition> | undefined, b: WaitUntilCondition | PlainMessage<WaitUntilCondition> | undefined): boolean {
+    return proto3.util.equals(WaitUntilCondition, a, b);
+  }
+}
+
+/**
+ * StorageObjectCondition is met once an object exists in the blob store.
+ *
+ * @generated from message flyteidl.core.StorageObjectCondition
+ */
+export class StorageObjectCondition extends Message<StorageObjectCondition> {
+  /**
+   * @generated from oneof flyteidl.core.StorageObjectCondition.uri_source
+   */
+  uriSource: {
+    /**
+     * The uri of the object.
+     *
+     * @generated from field: string uri = 1;
+     */
+    value: string;
+    case: "uri";
+  } | {
+    /**
+     * The name of the string input providing the uri of the object.
+     *
+     * @generated from field: string uri_input_variable_name = 2;
+     */
+    value: string;
+    case: "uriInputVariableName";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<StorageObjectCondition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.core.StorageObjectCondition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "uri", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "uri_source" },
+    { no: 2, name: "uri_input_variable_name", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "uri_source" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StorageObjectCondition {
+    return new StorageObjectCondition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StorageObjectCondition {
+    return new StorageObjectCondition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StorageObjectCondition {
+    return new StorageObjectCondition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StorageObjectCondition | PlainMessage<StorageObjectCondition> | undefined, b: StorageObjectCondition | PlainMessage<StorageObjectCondition> | undefined): boolean {
+    return proto3.util.equals(StorageObjectCondition, a, b);
+  }
+}
+
+/**
+ * CatalogArtifactCondition is met once an artifact of a datacatalog dataset is tagged with the tag.
+ *
+ * @generated from message flyteidl.core.CatalogArtifactCondition
+ */
+export class CatalogArtifactCondition extends Message<CatalogArtifactCondition> {
+  /**
+   * The project of the dataset.
+   *
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * The domain of the dataset.
+   *
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  /**
+   * The name of the dataset.
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * The version of the dataset.
+   *
+   * @generated from field: string version = 4;
+   */
+  version = "";
+
+  /**
+   * The tag the artifact needs to be tagged with.
+   *
+   * @generated from field: string tag = 5;
+   */
+  tag = "";
+
+  constructor(data?: PartialMessage<CatalogArtifactCondition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.core.CatalogArtifactCondition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CatalogArtifactCondition {
+    return new CatalogArtifactCondition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CatalogArtifactCondition {
+    return new CatalogArtifactCondition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CatalogArtifactCondition {
+    return new CatalogArtifactCondition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CatalogArtifactCondition | PlainMessage<CatalogArtifactCondition> | undefined, b: CatalogArtifactCondition | PlainMessage<CatalogArtifactCondition> | undefined): boolean {
+    return proto3.util.equals(CatalogArtifactCondition, a, b);
+  }
+}
+
+/**
+ * ExternalCondition represents a dependency on a condition outside of the workflow, which is polled with exponential
+ * backoff.
+ *
+ * @generated from message flyteidl.core.ExternalCondition
+ */
+export class ExternalCondition extends Message<ExternalCondition> {
+  /**
+   * @generated from oneof flyteidl.core.ExternalCondition.condition
+   */
+  condition: {
+    /**
+     * StorageObjectCondition is met once an object exists in the blob store.
+     *
+     * @generated from field: flyteidl.core.StorageObjectCondition storage_object = 1;
+     */
+    value: StorageObjectCondition;
+    case: "storageObject";
+  } | {
+    /**
+     * CatalogArtifactCondition is met once an artifact of a datacatalog dataset is tagged with the tag.
+     *
+     * @generated from field: flyteidl.core.CatalogArtifactCondition catalog_artifact = 2;
+     */
+    value: CatalogArtifactCondition;
+    case: "catalogArtifact";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * The interval between the first two polls, doubled after every poll. Defaults to 10 seconds.
+   *
+   * @generated from field: google.protobuf.Duration poll_interval = 3;
+   */
+  pollInterval?: Duration;
+
+  /**
+   * The maximum interval between two polls. Defaults to 5 minutes.
+   *
+   * @generated from field: google.protobuf.Duration max_poll_interval = 4;
+   */
+  maxPollInterval?: Duration;
+
+  /**
+   * The name of the optional boolean output, set to true once the condition is met.
+   *
+   * @generated from field: string output_variable_name = 5;
+   */
+  outputVariableName = "";
+
+  /**
+   * An optional timeout, for conditions that may never be met.
+   *
+   * @generated from field: flyteidl.core.GateTimeout timeout = 6;
+   */
+  timeout?: GateTimeout;
+
+  constructor(data?: PartialMessage<ExternalCondition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.core.ExternalCondition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "storage_object", kind: "message", T: StorageObjectCondition, oneof: "condition" },
+    { no: 2, name: "catalog_artifact", kind: "message", T: CatalogArtifactCondition, oneof: "condition" },
+    { no: 3, name: "poll_interval", kind: "message", T: Duration },
+    { no: 4, name: "max_poll_interval", kind: "message", T: Duration },
+    { no: 5, name: "output_variable_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "timeout", kind: "message", T: GateTimeout },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExternalCondition {
+    return new ExternalCondition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExternalCondition {
+    return new ExternalCondition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExternalCondition {
+    return new ExternalCondition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExternalCondition | PlainMessage<ExternalCondition> | undefined, b: ExternalCondition | PlainMessage<ExternalCondition> | undefined): boolean {
+    return proto3.util.equals(ExternalCondition, a, b);
+  }
+}
+
 /**
  * GateNode refers to the condition that is required for the gate to successfully complete.
  *
@@ -459,6 +851,22 @@ export class GateNode extends Message<GateNode> {
      */
     value: SleepCondition;
     case: "sleep";
+  } | {
+    /**
+     * WaitUntilCondition represents a dependency on waiting until a point in time provided by an input.
+     *
+     * @generated from field: flyteidl.core.WaitUntilCondition wait_until = 4;
+     */
+    value: WaitUntilCondition;
+    case: "waitUntil";
+  } | {
+    /**
+     * ExternalCondition represents a dependency on a condition outside of the workflow.
+     *
+     * @generated from field: flyteidl.core.ExternalCondition external = 5;
+     */
+    value: ExternalCondition;
+    case: "external";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<GateNode>) {
@@ -472,6 +880,8 @@ export class GateNode extends Message<GateNode> {
     { no: 1, name: "approve", kind: "message", T: ApproveCondition, oneof: "condition" },
     { no: 2, name: "signal", kind: "message", T: SignalCondition, oneof: "condition" },
     { no: 3, name: "sleep", kind: "message", T: SleepCondition, oneof: "condition" },
+    { no: 4, name: "wait_until", kind: "message", T: WaitUntilCondition, oneof: "condition" },
+    { no: 5, name: "external", kind: "message", T: ExternalCondition, oneof: "condition" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GateNode {
@@ -554,6 +964,46 @@ export class ArrayNode extends Message<ArrayNode> {
    */
   executionMode = ArrayNode_ExecutionMode.MINIMAL_STATE;
 
+  /**
+   * partial_success, if set, types the outputs of the ArrayNode as collections of optional values with none at the
+   * index of every sub-node that failed, along with a collection describing each failure. Unless a success criteria
+   * is set, the ArrayNode succeeds regardless of the number of sub-nodes that failed.
+   *
+   * @generated from field: flyteidl.core.ArrayNode.PartialSuccess partial_success = 6;
+   */
+  partialSuccess?: ArrayNode_PartialSuccess;
+
+  /**
+   * @generated from oneof flyteidl.core.ArrayNode.retry_budget_option
+   */
+  retryBudgetOption: {
+    /**
+     * retry_budget is the total number of retries shared by all sub-nodes. Once it is used up, sub-nodes that fail
+     * are not retried anymore even if their retry strategy allows it.
+     *
+     * @generated from field: uint32 retry_budget = 7;
+     */
+    value: number;
+    case: "retryBudget";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * max_failures fails the ArrayNode, aborting the sub-nodes still running, as soon as this number of sub-nodes
+   * failed. 0 disables it.
+   *
+   * @generated from field: uint32 max_failures = 8;
+   */
+  maxFailures = 0;
+
+  /**
+   * adaptive_parallelism opts the ArrayNode into raising and lowering the number of concurrently running sub-nodes
+   * based on the feedback of the cluster, bounded by parallelism. It only takes effect if adaptive parallelism is
+   * enabled in the configuration of the platform.
+   *
+   * @generated from field: bool adaptive_parallelism = 9;
+   */
+  adaptiveParallelism = false;
+
   constructor(data?: PartialMessage<ArrayNode>) {
     super();
     proto3.util.initPartial(data, this);
@@ -567,6 +1017,10 @@ export class ArrayNode extends Message<ArrayNode> {
     { no: 3, name: "min_successes", kind: "scalar", T: 13 /* ScalarType.UINT32 */, oneof: "success_criteria" },
     { no: 4, name: "min_success_ratio", kind: "scalar", T: 2 /* ScalarType.FLOAT */, oneof: "success_criteria" },
     { no: 5, name: "execution_mode", kind: "enum", T: proto3.getEnumType(ArrayNode_ExecutionMode) },
+    { no: 6, name: "partial_success", kind: "message", T: ArrayNode_PartialSuccess },
+    { no: 7, name: "retry_budget", kind: "scalar", T: 13 /* ScalarType.UINT32 */, oneof: "retry_budget_option" },
+    { no: 8, name: "max_failures", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "adaptive_parallelism", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArrayNode {
@@ -612,6 +1066,48 @@ proto3.util.setEnumType(ArrayNode_ExecutionMode, "flyteidl.core.ArrayNode.Execut
   { no: 1, name: "FULL_STATE" },
 ]);
 
+/**
+ * PartialSuccess configures the ArrayNode to produce the outputs of the sub-nodes that succeeded even if others failed.
+ *
+ * @generated from message flyteidl.core.ArrayNode.PartialSuccess
+ */
+export class ArrayNode_PartialSuccess extends Message<ArrayNode_PartialSuccess> {
+  /**
+   * errors_output is the name of the output holding a collection with the error of every sub-node that failed and
+   * none for every sub-node that succeeded. Defaults to "errors".
+   *
+   * @generated from field: string errors_output = 1;
+   */
+  errorsOutput = "";
+
+  constructor(data?: PartialMessage<ArrayNode_PartialSuccess>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.core.ArrayNode.PartialSuccess";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "errors_output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArrayNode_PartialSuccess {
+    return new ArrayNode_PartialSuccess().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArrayNode_PartialSuccess {
+    return new ArrayNode_PartialSuccess().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArrayNode_PartialSuccess {
+    return new ArrayNode_PartialSuccess().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArrayNode_PartialSuccess | PlainMessage<ArrayNode_PartialSuccess> | undefined, b: ArrayNode_PartialSuccess | PlainMessage<ArrayNode_PartialSuccess> | undefined): boolean {
+    return proto3.util.equals(ArrayNode_PartialSuccess, a, b);
+  }
+}
+
 /**
  * Defines extra information about the Node.
  *
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AddTagRequest, AddTagResponse, CreateArtifactRequest, CreateArtifactResponse, CreateDatasetRequest, CreateDatasetResponse, GetArtifactRequest, GetArtifactResponse, GetDatasetRequest, GetDatasetResponse, GetOrExtendReservationRequest, GetOrExtendReservationResponse, ListArtifactsRequest, ListArtifactsResponse, ListDatasetsRequest, ListDatasetsResponse, ReleaseReservationRequest, ReleaseReservationResponse, UpdateArtifactRequest, UpdateArtifactResponse } from "./datacatalog_pb.js";
+import { AddTagRequest, AddTagResponse, CreateArtifactRequest, CreateArtifactResponse, CreateDatasetRequest, CreateDatasetResponse, DeleteArtifactRequest, DeleteArtifactResponse, DeleteArtifactsRequest, DeleteArtifactsResponse, DeleteDatasetRequest, DeleteDatasetResponse, GetArtifactRequest, GetArtifactResponse, GetDatasetRequest, GetDatasetResponse, GetOrExtendReservationRequest, GetOrExtendReservationResponse, ListArtifactsRequest, ListArtifactsResponse, ListDatasetsRequest, ListDatasetsResponse, ReleaseReservationRequest, ReleaseReservationResponse, UpdateArtifactRequest, UpdateArtifactResponse } from "./datacatalog_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -107,6 +107,40 @@ export const DataCatalog = {
       O: UpdateArtifactResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Deletes an existing artifact together with its tags, partitions and the artifact data stored in the underlying
+     * blob storage.
+     *
+     * @generated from rpc datacatalog.DataCatalog.DeleteArtifact
+     */
+    deleteArtifact: {
+      name: "DeleteArtifact",
+      I: DeleteArtifactRequest,
+      O: DeleteArtifactResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Deletes all artifacts of a dataset matching the given tag and partition filters.
+     *
+     * @generated from rpc datacatalog.DataCatalog.DeleteArtifacts
+     */
+    deleteArtifacts: {
+      name: "DeleteArtifacts",
+      I: DeleteArtifactsRequest,
+      O: DeleteArtifactsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Deletes an existing dataset together with all of its artifacts.
+     *
+     * @generated from rpc datacatalog.DataCatalog.DeleteDataset
+     */
+    deleteDataset: {
+      name: "DeleteDataset",
+      I: DeleteDatasetRequest,
+      O: DeleteDatasetResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Attempts to get or extend a reservation for the corresponding artifact. If one already exists
      * (ie. another entity owns the reservation) then that reservation is retrieved.
@@ -739,6 +739,335 @@ export class UpdateArtifactResponse extends Message<UpdateArtifactResponse> {
   }
 }
 
+/**
+ *
+ * Request message for deleting an Artifact and its associated ArtifactData.
+ *
+ * @generated from message datacatalog.DeleteArtifactRequest
+ */
+export class DeleteArtifactRequest extends Message<DeleteArtifactRequest> {
+  /**
+   * ID of dataset the artifact is associated with
+   *
+   * @generated from field: datacatalog.DatasetID dataset = 1;
+   */
+  dataset?: DatasetID;
+
+  /**
+   * Either ID of artifact or name of tag to retrieve existing artifact from
+   *
+   * @generated from oneof datacatalog.DeleteArtifactRequest.query_handle
+   */
+  queryHandle: {
+    /**
+     * @generated from field: string artifact_id = 2;
+     */
+    value: string;
+    case: "artifactId";
+  } | {
+    /**
+     * @generated from field: string tag_name = 3;
+     */
+    value: string;
+    case: "tagName";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<DeleteArtifactRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "datacatalog.DeleteArtifactRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dataset", kind: "message", T: DatasetID },
+    { no: 2, name: "artifact_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "query_handle" },
+    { no: 3, name: "tag_name", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "query_handle" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteArtifactRequest {
+    return new DeleteArtifactRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteArtifactRequest {
+    return new DeleteArtifactRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteArtifactRequest {
+    return new DeleteArtifactRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteArtifactRequest | PlainMessage<DeleteArtifactRequest> | undefined, b: DeleteArtifactRequest | PlainMessage<DeleteArtifactRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteArtifactRequest, a, b);
+  }
+}
+
+/**
+ *
+ * Response message for deleting an Artifact.
+ *
+ * @generated from message datacatalog.DeleteArtifactResponse
+ */
+export class DeleteArtifactResponse extends Message<DeleteArtifactResponse> {
+  /**
+   * The unique ID of the artifact deleted
+   *
+   * @generated from field: string artifact_id = 1;
+   */
+  artifactId = "";
+
+  constructor(data?: PartialMessage<DeleteArtifactResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "datacatalog.DeleteArtifactResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "artifact_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteArtifactResponse {
+    return new DeleteArtifactResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteArtifactResponse {
+    return new DeleteArtifactResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteArtifactResponse {
+    return new DeleteArtifactResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteArtifactResponse | PlainMessage<DeleteArtifactResponse> | undefined, b: DeleteArtifactResponse | PlainMessage<DeleteArtifactResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteArtifactResponse, a, b);
+  }
+}
+
+/**
+ *
+ * Request message for deleting all artifacts of a dataset matching a filter expression.
+ *
+ * @generated from message datacatalog.DeleteArtifactsRequest
+ */
+export class DeleteArtifactsRequest extends Message<DeleteArtifactsRequest> {
+  /**
+   * ID of dataset the artifacts are associated with
+   *
+   * @generated from field: datacatalog.DatasetID dataset = 1;
+   */
+  dataset?: DatasetID;
+
+  /**
+   * Tag or partition filters the deleted artifacts must match. At least one filter is required.
+   *
+   * @generated from field: datacatalog.FilterExpression filter = 2;
+   */
+  filter?: FilterExpression;
+
+  constructor(data?: PartialMessage<DeleteArtifactsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "datacatalog.DeleteArtifactsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dataset", kind: "message", T: DatasetID },
+    { no: 2, name: "filter", kind: "message", T: FilterExpression },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteArtifactsRequest {
+    return new DeleteArtifactsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteArtifactsRequest {
+    return new DeleteArtifactsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteArtifactsRequest {
+    return new DeleteArtifactsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteArtifactsRequest | PlainMessage<DeleteArtifactsRequest> | undefined, b: DeleteArtifactsRequest | PlainMessage<DeleteArtifactsRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteArtifactsRequest, a, b);
+  }
+}
+
+/**
+ *
+ * Response message for deleting artifacts matching a filter expression.
+ *
+ * @generated from message datacatalog.DeleteArtifactsResponse
+ */
+export class DeleteArtifactsResponse extends Message<DeleteArtifactsResponse> {
+  /**
+   * The unique IDs of the artifacts deleted
+   *
+   * @generated from field: repeated string artifact_ids = 1;
+   */
+  artifactIds: string[] = [];
+
+  /**
+   * The artifacts which matched the filter but failed to be deleted
+   *
+   * @generated from field: repeated datacatalog.DeleteArtifactError errors = 2;
+   */
+  errors: DeleteArtifactError[] = [];
+
+  constructor(data?: PartialMessage<DeleteArtifactsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "datacatalog.DeleteArtifactsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "artifact_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "errors", kind: "message", T: DeleteArtifactError, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteArtifactsResponse {
+    return new DeleteArtifactsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteArtifactsResponse {
+    return new DeleteArtifactsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteArtifactsResponse {
+    return new DeleteArtifactsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteArtifactsResponse | PlainMessage<DeleteArtifactsResponse> | undefined, b: DeleteArtifactsResponse | PlainMessage<DeleteArtifactsResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteArtifactsResponse, a, b);
+  }
+}
+
+/**
+ *
+ * The failure to delete a single artifact matching the filter of a DeleteArtifactsRequest.
+ *
+ * @generated from message datacatalog.DeleteArtifactError
+ */
+export class DeleteArtifactError extends Message<DeleteArtifactError> {
+  /**
+   * The unique ID of the artifact
+   *
+   * @generated from field: string artifact_id = 1;
+   */
+  artifactId = "";
+
+  /**
+   * The reason the artifact could not be deleted
+   *
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<DeleteArtifactError>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "datacatalog.DeleteArtifactError";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "artifact_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteArtifactError {
+    return new DeleteArtifactError().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteArtifactError {
+    return new DeleteArtifactError().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteArtifactError {
+    return new DeleteArtifactError().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteArtifactError | PlainMessage<DeleteArtifactError> | undefined, b: DeleteArtifactError | PlainMessage<DeleteArtifactError> | undefined): boolean {
+    return proto3.util.equals(DeleteArtifactError, a, b);
+  }
+}
+
+/**
+ *
+ * Request message for deleting a Dataset and all of its artifacts.
+ *
+ * @generated from message datacatalog.DeleteDatasetRequest
+ */
+export class DeleteDatasetRequest extends Message<DeleteDatasetRequest> {
+  /**
+   * @generated from field: datacatalog.DatasetID dataset = 1;
+   */
+  dataset?: DatasetID;
+
+  constructor(data?: PartialMessage<DeleteDatasetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "datacatalog.DeleteDatasetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dataset", kind: "message", T: DatasetID },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteDatasetRequest {
+    return new DeleteDatasetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteDatasetRequest {
+    return new DeleteDatasetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteDatasetRequest {
+    return new DeleteDatasetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteDatasetRequest | PlainMessage<DeleteDatasetRequest> | undefined, b: DeleteDatasetRequest | PlainMessage<DeleteDatasetRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteDatasetRequest, a, b);
+  }
+}
+
+/**
+ *
+ * Response message for deleting a Dataset.
+ *
+ * @generated from message datacatalog.DeleteDatasetResponse
+ */
+export class DeleteDatasetResponse extends Message<DeleteDatasetResponse> {
+  constructor(data?: PartialMessage<DeleteDatasetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "datacatalog.DeleteDatasetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteDatasetResponse {
+    return new DeleteDatasetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteDatasetResponse {
+    return new DeleteDatasetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteDatasetResponse {
+    return new DeleteDatasetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteDatasetResponse | PlainMessage<DeleteDatasetResponse> | undefined, b: DeleteDatasetResponse | PlainMessage<DeleteDatasetResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteDatasetResponse, a, b);
+  }
+}
+
 /**
  *
  * ReservationID message that is composed of several string fields.
@@ -10,6 +10,7 @@ import { ExecutionError, NodeExecution_Phase, TaskExecution_Phase, TaskLog, Work
 import { LiteralMap } from "../core/literals_pb.js";
 import { CatalogCacheStatus, CatalogMetadata, CatalogReservation_Status } from "../core/catalog_pb.js";
 import { CompiledWorkflowClosure } from "../core/compiler_pb.js";
+import { Resources, Resources_ResourceEntry } from "../core/tasks_pb.js";
 
 /**
  * @generated from message flyteidl.event.WorkflowExecutionEvent
@@ -1046,6 +1047,30 @@ export class TaskExecutionMetadata extends Message<TaskExecutionMetadata> {
    */
   pluginIdentifier = "";
 
+  /**
+   * Peak resource usage observed across the containers of this task execution. Reported once the task execution
+   * completes, if the plugin samples the usage of its resources.
+   *
+   * @generated from field: repeated flyteidl.core.Resources.ResourceEntry peak_resource_usage = 5;
+   */
+  peakResourceUsage: Resources_ResourceEntry[] = [];
+
+  /**
+   * Resources of this task execution escalated from the resources of its task, because previous attempts were
+   * OOMKilled. Only the escalated resources are set.
+   *
+   * @generated from field: flyteidl.core.Resources escalated_resources = 6;
+   */
+  escalatedResources?: Resources;
+
+  /**
+   * Whether this task execution was preempted, e.g. because its node was reclaimed, rather than failed. The following
+   * attempt resumes from the checkpoint of this task execution.
+   *
+   * @generated from field: bool preempted = 7;
+   */
+  preempted = false;
+
   /**
    * @generated from field: flyteidl.event.TaskExecutionMetadata.InstanceClass instance_class = 16;
    */
@@ -1063,6 +1088,9 @@ export class TaskExecutionMetadata extends Message<TaskExecutionMetadata> {
     { no: 2, name: "external_resources", kind: "message", T: ExternalResourceInfo, repeated: true },
     { no: 3, name: "resource_pool_info", kind: "message", T: ResourcePoolInfo, repeated: true },
     { no: 4, name: "plugin_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "peak_resource_usage", kind: "message", T: Resources_ResourceEntry, repeated: true },
+    { no: 6, name: "escalated_resources", kind: "message", T: Resources },
+    { no: 7, name: "preempted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 16, name: "instance_class", kind: "enum", T: proto3.getEnumType(TaskExecutionMetadata_InstanceClass) },
   ]);
 
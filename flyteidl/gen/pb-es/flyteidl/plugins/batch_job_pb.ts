@@ -0,0 +1,142 @@
+// @generated by protoc-gen-es v1.7.2 with parameter "target=ts"
+// @generated from file flyteidl/plugins/batch_job.proto (package flyteidl.plugins, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Int32Value, Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * Custom proto for the batch job plugin, running the task as a Kubernetes batch/v1 Job with indexed completions.
+ * Each pod receives its index in the JOB_COMPLETION_INDEX environment variable. JobSets are not supported.
+ *
+ * @generated from message flyteidl.plugins.BatchJob
+ */
+export class BatchJob extends Message<BatchJob> {
+  /**
+   * Number of indexes of the job, each index is run by one successful pod. Defaults to 1.
+   *
+   * @generated from field: int32 completions = 1;
+   */
+  completions = 0;
+
+  /**
+   * Maximum number of pods running in parallel. Defaults to the number of completions.
+   *
+   * @generated from field: int32 parallelism = 2;
+   */
+  parallelism = 0;
+
+  /**
+   * Number of retries of each index. If set, the failures of one index don't consume the retries of the other
+   * indexes and the job keeps running the remaining indexes until they all finished.
+   *
+   * @generated from field: google.protobuf.Int32Value backoff_limit_per_index = 3;
+   */
+  backoffLimitPerIndex?: number;
+
+  /**
+   * Maximum number of failed indexes before the whole job is failed. Only used with backoff_limit_per_index.
+   *
+   * @generated from field: google.protobuf.Int32Value max_failed_indexes = 4;
+   */
+  maxFailedIndexes?: number;
+
+  /**
+   * Number of retries of the whole job. Ignored if backoff_limit_per_index is set.
+   *
+   * @generated from field: int32 backoff_limit = 5;
+   */
+  backoffLimit = 0;
+
+  /**
+   * Defines when the job is considered successful before all indexes succeeded.
+   *
+   * @generated from field: flyteidl.plugins.BatchJobSuccessPolicy success_policy = 6;
+   */
+  successPolicy?: BatchJobSuccessPolicy;
+
+  constructor(data?: PartialMessage<BatchJob>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.plugins.BatchJob";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "completions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "parallelism", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "backoff_limit_per_index", kind: "message", T: Int32Value },
+    { no: 4, name: "max_failed_indexes", kind: "message", T: Int32Value },
+    { no: 5, name: "backoff_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "success_policy", kind: "message", T: BatchJobSuccessPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BatchJob {
+    return new BatchJob().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BatchJob {
+    return new BatchJob().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BatchJob {
+    return new BatchJob().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BatchJob | PlainMessage<BatchJob> | undefined, b: BatchJob | PlainMessage<BatchJob> | undefined): boolean {
+    return proto3.util.equals(BatchJob, a, b);
+  }
+}
+
+/**
+ * Success policy of a batch job. The job succeeds as soon as one of the set criteria is met, any pods still running
+ * are then terminated. The policy is evaluated by flytepropeller from the status of the job, it's not passed to the job
+ * controller.
+ *
+ * @generated from message flyteidl.plugins.BatchJobSuccessPolicy
+ */
+export class BatchJobSuccessPolicy extends Message<BatchJobSuccessPolicy> {
+  /**
+   * The job succeeds once all these indexes succeeded.
+   *
+   * @generated from field: repeated int32 succeeded_indexes = 1;
+   */
+  succeededIndexes: number[] = [];
+
+  /**
+   * The job succeeds once this many indexes succeeded.
+   *
+   * @generated from field: int32 succeeded_count = 2;
+   */
+  succeededCount = 0;
+
+  constructor(data?: PartialMessage<BatchJobSuccessPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.plugins.BatchJobSuccessPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "succeeded_indexes", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 2, name: "succeeded_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BatchJobSuccessPolicy {
+    return new BatchJobSuccessPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BatchJobSuccessPolicy {
+    return new BatchJobSuccessPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BatchJobSuccessPolicy {
+    return new BatchJobSuccessPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BatchJobSuccessPolicy | PlainMessage<BatchJobSuccessPolicy> | undefined, b: BatchJobSuccessPolicy | PlainMessage<BatchJobSuccessPolicy> | undefined): boolean {
+    return proto3.util.equals(BatchJobSuccessPolicy, a, b);
+  }
+}
+
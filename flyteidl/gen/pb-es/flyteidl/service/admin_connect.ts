@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { Task, TaskCreateRequest, TaskCreateResponse, TaskList } from "../admin/task_pb.js";
+import { Task, TaskCreateRequest, TaskCreateResponse, TaskList, TaskResourceRecommendation } from "../admin/task_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 import { NamedEntity, NamedEntityGetRequest, NamedEntityIdentifierList, NamedEntityIdentifierListRequest, NamedEntityList, NamedEntityListRequest, NamedEntityUpdateRequest, NamedEntityUpdateResponse, ObjectGetRequest, ResourceListRequest } from "../admin/common_pb.js";
 import { Workflow, WorkflowCreateRequest, WorkflowCreateResponse, WorkflowList } from "../admin/workflow_pb.js";
@@ -73,6 +73,18 @@ export const AdminService = {
       O: TaskList,
       kind: MethodKind.Unary,
     },
+    /**
+     * Fetch the :ref:`ref_flyteidl.admin.TaskResourceRecommendation` of a task version, based on the resources its past
+     * executions used.
+     *
+     * @generated from rpc flyteidl.service.AdminService.GetTaskResourceRecommendation
+     */
+    getTaskResourceRecommendation: {
+      name: "GetTaskResourceRecommendation",
+      I: ObjectGetRequest,
+      O: TaskResourceRecommendation,
+      kind: MethodKind.Unary,
+    },
     /**
      * Create and upload a :ref:`ref_flyteidl.admin.Workflow` definition
      *
@@ -32,25 +32,51 @@ const (
 	// Less Than
 	ComparisonExpression_LT  ComparisonExpression_Operator = 4
 	ComparisonExpression_LTE ComparisonExpression_Operator = 5
+	// The left value is an element of the right value, which has to be a collection.
+	ComparisonExpression_IN     ComparisonExpression_Operator = 6
+	ComparisonExpression_NOT_IN ComparisonExpression_Operator = 7
+	// String operators, both values have to be strings.
+	ComparisonExpression_STARTS_WITH ComparisonExpression_Operator = 8
+	ComparisonExpression_ENDS_WITH   ComparisonExpression_Operator = 9
+	// The left value matches the regular expression (RE2 syntax) in the right value. Use ^ and $ to match the
+	// whole string.
+	ComparisonExpression_MATCHES ComparisonExpression_Operator = 10
+	// Unary operators checking whether the left value is none/null. The right value is not set.
+	ComparisonExpression_IS_NULL     ComparisonExpression_Operator = 11
+	ComparisonExpression_IS_NOT_NULL ComparisonExpression_Operator = 12
 )
 
 // Enum value maps for ComparisonExpression_Operator.
 var (
 	ComparisonExpression_Operator_name = map[int32]string{
-		0: "EQ",
-		1: "NEQ",
-		2: "GT",
-		3: "GTE",
-		4: "LT",
-		5: "LTE",
+		0:  "EQ",
+		1:  "NEQ",
+		2:  "GT",
+		3:  "GTE",
+		4:  "LT",
+		5:  "LTE",
+		6:  "IN",
+		7:  "NOT_IN",
+		8:  "STARTS_WITH",
+		9:  "ENDS_WITH",
+		10: "MATCHES",
+		11: "IS_NULL",
+		12: "IS_NOT_NULL",
 	}
 	ComparisonExpression_Operator_value = map[string]int32{
-		"EQ":  0,
-		"NEQ": 1,
-		"GT":  2,
-		"GTE": 3,
-		"LT":  4,
-		"LTE": 5,
+		"EQ":          0,
+		"NEQ":         1,
+		"GT":          2,
+		"GTE":         3,
+		"LT":          4,
+		"LTE":         5,
+		"IN":          6,
+		"NOT_IN":      7,
+		"STARTS_WITH": 8,
+		"ENDS_WITH":   9,
+		"MATCHES":     10,
+		"IS_NULL":     11,
+		"IS_NOT_NULL": 12,
 	}
 )
 
@@ -127,7 +153,7 @@ func (x ConjunctionExpression_LogicalOperator) Number() protoreflect.EnumNumber
 
 // Deprecated: Use ConjunctionExpression_LogicalOperator.Descriptor instead.
 func (ConjunctionExpression_LogicalOperator) EnumDescriptor() ([]byte, []int) {
-	return file_flyteidl_core_condition_proto_rawDescGZIP(), []int{3, 0}
+	return file_flyteidl_core_condition_proto_rawDescGZIP(), []int{4, 0}
 }
 
 // Defines a 2-level tree where the root is a comparison operator and Operands are primitives or known variables.
@@ -206,7 +232,11 @@ type Operand struct {
 	//	*Operand_Primitive
 	//	*Operand_Var
 	//	*Operand_Scalar
+	//	*Operand_Collection
 	Val isOperand_Val `protobuf_oneof:"val"`
+	// Attribute path into the value of var, e.g. ["a", "b", 0] to compare var.a["b"][0]. Resolves through maps,
+	// collections, structs and msgpack encoded binaries (dataclasses, pydantic models).
+	AttrPath []*PromiseAttribute `protobuf:"bytes,5,rep,name=attr_path,json=attrPath,proto3" json:"attr_path,omitempty"`
 }
 
 func (x *Operand) Reset() {
@@ -270,6 +300,20 @@ func (x *Operand) GetScalar() *Scalar {
 	return nil
 }
 
+func (x *Operand) GetCollection() *LiteralCollection {
+	if x, ok := x.GetVal().(*Operand_Collection); ok {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *Operand) GetAttrPath() []*PromiseAttribute {
+	if x != nil {
+		return x.AttrPath
+	}
+	return nil
+}
+
 type isOperand_Val interface {
 	isOperand_Val()
 }
@@ -291,12 +335,19 @@ type Operand_Scalar struct {
 	Scalar *Scalar `protobuf:"bytes,3,opt,name=scalar,proto3,oneof"`
 }
 
+type Operand_Collection struct {
+	// Or a constant collection, e.g. the right value of an IN comparison
+	Collection *LiteralCollection `protobuf:"bytes,4,opt,name=collection,proto3,oneof"`
+}
+
 func (*Operand_Primitive) isOperand_Val() {}
 
 func (*Operand_Var) isOperand_Val() {}
 
 func (*Operand_Scalar) isOperand_Val() {}
 
+func (*Operand_Collection) isOperand_Val() {}
+
 // Defines a boolean expression tree. It can be a simple or a conjunction expression.
 // Multiple expressions can be combined using a conjunction or a disjunction to result in a final boolean result.
 type BooleanExpression struct {
@@ -308,6 +359,7 @@ type BooleanExpression struct {
 	//
 	//	*BooleanExpression_Conjunction
 	//	*BooleanExpression_Comparison
+	//	*BooleanExpression_Negation
 	Expr isBooleanExpression_Expr `protobuf_oneof:"expr"`
 }
 
@@ -364,6 +416,13 @@ func (x *BooleanExpression) GetComparison() *ComparisonExpression {
 	return nil
 }
 
+func (x *BooleanExpression) GetNegation() *NegationExpression {
+	if x, ok := x.GetExpr().(*BooleanExpression_Negation); ok {
+		return x.Negation
+	}
+	return nil
+}
+
 type isBooleanExpression_Expr interface {
 	isBooleanExpression_Expr()
 }
@@ -376,10 +435,64 @@ type BooleanExpression_Comparison struct {
 	Comparison *ComparisonExpression `protobuf:"bytes,2,opt,name=comparison,proto3,oneof"`
 }
 
+type BooleanExpression_Negation struct {
+	Negation *NegationExpression `protobuf:"bytes,3,opt,name=negation,proto3,oneof"`
+}
+
 func (*BooleanExpression_Conjunction) isBooleanExpression_Expr() {}
 
 func (*BooleanExpression_Comparison) isBooleanExpression_Expr() {}
 
+func (*BooleanExpression_Negation) isBooleanExpression_Expr() {}
+
+// Defines the negation of a boolean expression.
+type NegationExpression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expression *BooleanExpression `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *NegationExpression) Reset() {
+	*x = NegationExpression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_core_condition_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NegationExpression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NegationExpression) ProtoMessage() {}
+
+func (x *NegationExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_core_condition_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NegationExpression.ProtoReflect.Descriptor instead.
+func (*NegationExpression) Descriptor() ([]byte, []int) {
+	return file_flyteidl_core_condition_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NegationExpression) GetExpression() *BooleanExpression {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
 // Defines a conjunction expression of two boolean expressions.
 type ConjunctionExpression struct {
 	state         protoimpl.MessageState
@@ -394,7 +507,7 @@ type ConjunctionExpression struct {
 func (x *ConjunctionExpression) Reset() {
 	*x = ConjunctionExpression{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flyteidl_core_condition_proto_msgTypes[3]
+		mi := &file_flyteidl_core_condition_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -407,7 +520,7 @@ func (x *ConjunctionExpression) String() string {
 func (*ConjunctionExpression) ProtoMessage() {}
 
 func (x *ConjunctionExpression) ProtoReflect() protoreflect.Message {
-	mi := &file_flyteidl_core_condition_proto_msgTypes[3]
+	mi := &file_flyteidl_core_condition_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +533,7 @@ func (x *ConjunctionExpression) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConjunctionExpression.ProtoReflect.Descriptor instead.
 func (*ConjunctionExpression) Descriptor() ([]byte, []int) {
-	return file_flyteidl_core_condition_proto_rawDescGZIP(), []int{3}
+	return file_flyteidl_core_condition_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ConjunctionExpression) GetOperator() ConjunctionExpression_LogicalOperator {
@@ -451,75 +564,100 @@ var file_flyteidl_core_condition_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0d, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x1c,
 	0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x6c, 0x69,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x02, 0x0a,
-	0x14, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69,
-	0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
-	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x35, 0x0a, 0x0a, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x09, 0x6c, 0x65, 0x66,
-	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c,
-	0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x6e, 0x64, 0x52, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x3d, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x06, 0x0a, 0x02, 0x45,
-	0x51, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x51, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02,
-	0x47, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x45, 0x10, 0x03, 0x12, 0x06, 0x0a,
-	0x02, 0x4c, 0x54, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x54, 0x45, 0x10, 0x05, 0x22, 0x93,
-	0x01, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x72,
-	0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72,
-	0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x09, 0x70,
-	0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x76, 0x61, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x76, 0x61, 0x72, 0x12, 0x2f, 0x0a, 0x06,
-	0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66,
-	0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x61,
-	0x6c, 0x61, 0x72, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x42, 0x05, 0x0a,
-	0x03, 0x76, 0x61, 0x6c, 0x22, 0xac, 0x01, 0x0a, 0x11, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x43, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65,
-	0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
-	0x73, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x65,
-	0x78, 0x70, 0x72, 0x22, 0xa5, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x34, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x43, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x49, 0x0a, 0x0f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65,
-	0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x10, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x45, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x45, 0x78, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x63,
-	0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e,
-	0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x52, 0x10, 0x01, 0x42, 0xb4, 0x01, 0x0a, 0x11,
-	0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x42, 0x0e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x66, 0x6c, 0x79, 0x74, 0x65, 0x6f, 0x72, 0x67, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x2f, 0x66,
-	0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x2d, 0x67,
-	0x6f, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0xa2,
-	0x02, 0x03, 0x46, 0x43, 0x58, 0xaa, 0x02, 0x0d, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c,
-	0x2e, 0x43, 0x6f, 0x72, 0x65, 0xca, 0x02, 0x0d, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c,
-	0x5c, 0x43, 0x6f, 0x72, 0x65, 0xe2, 0x02, 0x19, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c,
-	0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x3a, 0x3a, 0x43, 0x6f,
-	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x66, 0x6c,
+	0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x02, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x48, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x65,
+	0x66, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64,
+	0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x0a,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x08, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x06, 0x0a, 0x02, 0x45, 0x51, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x4e, 0x45, 0x51, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x47, 0x54, 0x10, 0x02,
+	0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x45, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x54, 0x10,
+	0x04, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x54, 0x45, 0x10, 0x05, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x4e,
+	0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x10, 0x07, 0x12, 0x0f,
+	0x0a, 0x0b, 0x53, 0x54, 0x41, 0x52, 0x54, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x10, 0x08, 0x12,
+	0x0d, 0x0a, 0x09, 0x45, 0x4e, 0x44, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x10, 0x09, 0x12, 0x0b,
+	0x0a, 0x07, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x49,
+	0x53, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x53, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x0c, 0x22, 0x95, 0x02, 0x0a, 0x07, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65,
+	0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x42, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x03, 0x76, 0x61, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69,
+	0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x48, 0x00,
+	0x52, 0x06, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66,
+	0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x09,
+	0x61, 0x74, 0x74, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x72, 0x50, 0x61, 0x74, 0x68, 0x42, 0x05, 0x0a, 0x03, 0x76, 0x61,
+	0x6c, 0x22, 0xed, 0x01, 0x0a, 0x11, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6a, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66,
+	0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x45, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x6e, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x6c, 0x79,
+	0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4e, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x65, 0x78, 0x70,
+	0x72, 0x22, 0x56, 0x0a, 0x12, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6c,
+	0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x65, 0x61, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa5, 0x02, 0x0a, 0x15, 0x43, 0x6f,
+	0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x63, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x49, 0x0a, 0x0f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x4b, 0x0a, 0x10, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6c, 0x79,
+	0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65,
+	0x61, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a,
+	0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x52, 0x10,
+	0x01, 0x42, 0xb4, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69,
+	0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x0e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x6f, 0x72, 0x67, 0x2f, 0x66,
+	0x6c, 0x79, 0x74, 0x65, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x70, 0x62, 0x2d, 0x67, 0x6f, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x46, 0x43, 0x58, 0xaa, 0x02, 0x0d, 0x46, 0x6c,
+	0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0xca, 0x02, 0x0d, 0x46, 0x6c,
+	0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0xe2, 0x02, 0x19, 0x46, 0x6c,
+	0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69,
+	0x64, 0x6c, 0x3a, 0x3a, 0x43, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -535,33 +673,40 @@ func file_flyteidl_core_condition_proto_rawDescGZIP() []byte {
 }
 
 var file_flyteidl_core_condition_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_flyteidl_core_condition_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_flyteidl_core_condition_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_flyteidl_core_condition_proto_goTypes = []interface{}{
 	(ComparisonExpression_Operator)(0),         // 0: flyteidl.core.ComparisonExpression.Operator
 	(ConjunctionExpression_LogicalOperator)(0), // 1: flyteidl.core.ConjunctionExpression.LogicalOperator
 	(*ComparisonExpression)(nil),               // 2: flyteidl.core.ComparisonExpression
 	(*Operand)(nil),                            // 3: flyteidl.core.Operand
 	(*BooleanExpression)(nil),                  // 4: flyteidl.core.BooleanExpression
-	(*ConjunctionExpression)(nil),              // 5: flyteidl.core.ConjunctionExpression
-	(*Primitive)(nil),                          // 6: flyteidl.core.Primitive
-	(*Scalar)(nil),                             // 7: flyteidl.core.Scalar
+	(*NegationExpression)(nil),                 // 5: flyteidl.core.NegationExpression
+	(*ConjunctionExpression)(nil),              // 6: flyteidl.core.ConjunctionExpression
+	(*Primitive)(nil),                          // 7: flyteidl.core.Primitive
+	(*Scalar)(nil),                             // 8: flyteidl.core.Scalar
+	(*LiteralCollection)(nil),                  // 9: flyteidl.core.LiteralCollection
+	(*PromiseAttribute)(nil),                   // 10: flyteidl.core.PromiseAttribute
 }
 var file_flyteidl_core_condition_proto_depIdxs = []int32{
 	0,  // 0: flyteidl.core.ComparisonExpression.operator:type_name -> flyteidl.core.ComparisonExpression.Operator
 	3,  // 1: flyteidl.core.ComparisonExpression.left_value:type_name -> flyteidl.core.Operand
 	3,  // 2: flyteidl.core.ComparisonExpression.right_value:type_name -> flyteidl.core.Operand
-	6,  // 3: flyteidl.core.Operand.primitive:type_name -> flyteidl.core.Primitive
-	7,  // 4: flyteidl.core.Operand.scalar:type_name -> flyteidl.core.Scalar
-	5,  // 5: flyteidl.core.BooleanExpression.conjunction:type_name -> flyteidl.core.ConjunctionExpression
-	2,  // 6: flyteidl.core.BooleanExpression.comparison:type_name -> flyteidl.core.ComparisonExpression
-	1,  // 7: flyteidl.core.ConjunctionExpression.operator:type_name -> flyteidl.core.ConjunctionExpression.LogicalOperator
-	4,  // 8: flyteidl.core.ConjunctionExpression.left_expression:type_name -> flyteidl.core.BooleanExpression
-	4,  // 9: flyteidl.core.ConjunctionExpression.right_expression:type_name -> flyteidl.core.BooleanExpression
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	7,  // 3: flyteidl.core.Operand.primitive:type_name -> flyteidl.core.Primitive
+	8,  // 4: flyteidl.core.Operand.scalar:type_name -> flyteidl.core.Scalar
+	9,  // 5: flyteidl.core.Operand.collection:type_name -> flyteidl.core.LiteralCollection
+	10, // 6: flyteidl.core.Operand.attr_path:type_name -> flyteidl.core.PromiseAttribute
+	6,  // 7: flyteidl.core.BooleanExpression.conjunction:type_name -> flyteidl.core.ConjunctionExpression
+	2,  // 8: flyteidl.core.BooleanExpression.comparison:type_name -> flyteidl.core.ComparisonExpression
+	5,  // 9: flyteidl.core.BooleanExpression.negation:type_name -> flyteidl.core.NegationExpression
+	4,  // 10: flyteidl.core.NegationExpression.expression:type_name -> flyteidl.core.BooleanExpression
+	1,  // 11: flyteidl.core.ConjunctionExpression.operator:type_name -> flyteidl.core.ConjunctionExpression.LogicalOperator
+	4,  // 12: flyteidl.core.ConjunctionExpression.left_expression:type_name -> flyteidl.core.BooleanExpression
+	4,  // 13: flyteidl.core.ConjunctionExpression.right_expression:type_name -> flyteidl.core.BooleanExpression
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_flyteidl_core_condition_proto_init() }
@@ -570,6 +715,7 @@ func file_flyteidl_core_condition_proto_init() {
 		return
 	}
 	file_flyteidl_core_literals_proto_init()
+	file_flyteidl_core_types_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_flyteidl_core_condition_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ComparisonExpression); i {
@@ -608,6 +754,18 @@ func file_flyteidl_core_condition_proto_init() {
 			}
 		}
 		file_flyteidl_core_condition_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NegationExpression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flyteidl_core_condition_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConjunctionExpression); i {
 			case 0:
 				return &v.state
@@ -624,10 +782,12 @@ func file_flyteidl_core_condition_proto_init() {
 		(*Operand_Primitive)(nil),
 		(*Operand_Var)(nil),
 		(*Operand_Scalar)(nil),
+		(*Operand_Collection)(nil),
 	}
 	file_flyteidl_core_condition_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*BooleanExpression_Conjunction)(nil),
 		(*BooleanExpression_Comparison)(nil),
+		(*BooleanExpression_Negation)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -635,7 +795,7 @@ func file_flyteidl_core_condition_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_flyteidl_core_condition_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
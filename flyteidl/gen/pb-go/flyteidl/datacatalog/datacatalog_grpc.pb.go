@@ -27,6 +27,9 @@ const (
 	DataCatalog_ListArtifacts_FullMethodName          = "/datacatalog.DataCatalog/ListArtifacts"
 	DataCatalog_ListDatasets_FullMethodName           = "/datacatalog.DataCatalog/ListDatasets"
 	DataCatalog_UpdateArtifact_FullMethodName         = "/datacatalog.DataCatalog/UpdateArtifact"
+	DataCatalog_DeleteArtifact_FullMethodName         = "/datacatalog.DataCatalog/DeleteArtifact"
+	DataCatalog_DeleteArtifacts_FullMethodName        = "/datacatalog.DataCatalog/DeleteArtifacts"
+	DataCatalog_DeleteDataset_FullMethodName          = "/datacatalog.DataCatalog/DeleteDataset"
 	DataCatalog_GetOrExtendReservation_FullMethodName = "/datacatalog.DataCatalog/GetOrExtendReservation"
 	DataCatalog_ReleaseReservation_FullMethodName     = "/datacatalog.DataCatalog/ReleaseReservation"
 )
@@ -53,6 +56,13 @@ type DataCatalogClient interface {
 	ListDatasets(ctx context.Context, in *ListDatasetsRequest, opts ...grpc.CallOption) (*ListDatasetsResponse, error)
 	// Updates an existing artifact, overwriting the stored artifact data in the underlying blob storage.
 	UpdateArtifact(ctx context.Context, in *UpdateArtifactRequest, opts ...grpc.CallOption) (*UpdateArtifactResponse, error)
+	// Deletes an existing artifact together with its tags, partitions and the artifact data stored in the underlying
+	// blob storage.
+	DeleteArtifact(ctx context.Context, in *DeleteArtifactRequest, opts ...grpc.CallOption) (*DeleteArtifactResponse, error)
+	// Deletes all artifacts of a dataset matching the given tag and partition filters.
+	DeleteArtifacts(ctx context.Context, in *DeleteArtifactsRequest, opts ...grpc.CallOption) (*DeleteArtifactsResponse, error)
+	// Deletes an existing dataset together with all of its artifacts.
+	DeleteDataset(ctx context.Context, in *DeleteDatasetRequest, opts ...grpc.CallOption) (*DeleteDatasetResponse, error)
 	// Attempts to get or extend a reservation for the corresponding artifact. If one already exists
 	// (ie. another entity owns the reservation) then that reservation is retrieved.
 	// Once you acquire a reservation, you need to  periodically extend the reservation with an
@@ -150,6 +160,33 @@ func (c *dataCatalogClient) UpdateArtifact(ctx context.Context, in *UpdateArtifa
 	return out, nil
 }
 
+func (c *dataCatalogClient) DeleteArtifact(ctx context.Context, in *DeleteArtifactRequest, opts ...grpc.CallOption) (*DeleteArtifactResponse, error) {
+	out := new(DeleteArtifactResponse)
+	err := c.cc.Invoke(ctx, DataCatalog_DeleteArtifact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCatalogClient) DeleteArtifacts(ctx context.Context, in *DeleteArtifactsRequest, opts ...grpc.CallOption) (*DeleteArtifactsResponse, error) {
+	out := new(DeleteArtifactsResponse)
+	err := c.cc.Invoke(ctx, DataCatalog_DeleteArtifacts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCatalogClient) DeleteDataset(ctx context.Context, in *DeleteDatasetRequest, opts ...grpc.CallOption) (*DeleteDatasetResponse, error) {
+	out := new(DeleteDatasetResponse)
+	err := c.cc.Invoke(ctx, DataCatalog_DeleteDataset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCatalogClient) GetOrExtendReservation(ctx context.Context, in *GetOrExtendReservationRequest, opts ...grpc.CallOption) (*GetOrExtendReservationResponse, error) {
 	out := new(GetOrExtendReservationResponse)
 	err := c.cc.Invoke(ctx, DataCatalog_GetOrExtendReservation_FullMethodName, in, out, opts...)
@@ -190,6 +227,13 @@ type DataCatalogServer interface {
 	ListDatasets(context.Context, *ListDatasetsRequest) (*ListDatasetsResponse, error)
 	// Updates an existing artifact, overwriting the stored artifact data in the underlying blob storage.
 	UpdateArtifact(context.Context, *UpdateArtifactRequest) (*UpdateArtifactResponse, error)
+	// Deletes an existing artifact together with its tags, partitions and the artifact data stored in the underlying
+	// blob storage.
+	DeleteArtifact(context.Context, *DeleteArtifactRequest) (*DeleteArtifactResponse, error)
+	// Deletes all artifacts of a dataset matching the given tag and partition filters.
+	DeleteArtifacts(context.Context, *DeleteArtifactsRequest) (*DeleteArtifactsResponse, error)
+	// Deletes an existing dataset together with all of its artifacts.
+	DeleteDataset(context.Context, *DeleteDatasetRequest) (*DeleteDatasetResponse, error)
 	// Attempts to get or extend a reservation for the corresponding artifact. If one already exists
 	// (ie. another entity owns the reservation) then that reservation is retrieved.
 	// Once you acquire a reservation, you need to  periodically extend the reservation with an
@@ -235,6 +279,15 @@ func (UnimplementedDataCatalogServer) ListDatasets(context.Context, *ListDataset
 func (UnimplementedDataCatalogServer) UpdateArtifact(context.Context, *UpdateArtifactRequest) (*UpdateArtifactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateArtifact not implemented")
 }
+func (UnimplementedDataCatalogServer) DeleteArtifact(context.Context, *DeleteArtifactRequest) (*DeleteArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtifact not implemented")
+}
+func (UnimplementedDataCatalogServer) DeleteArtifacts(context.Context, *DeleteArtifactsRequest) (*DeleteArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtifacts not implemented")
+}
+func (UnimplementedDataCatalogServer) DeleteDataset(context.Context, *DeleteDatasetRequest) (*DeleteDatasetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDataset not implemented")
+}
 func (UnimplementedDataCatalogServer) GetOrExtendReservation(context.Context, *GetOrExtendReservationRequest) (*GetOrExtendReservationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrExtendReservation not implemented")
 }
@@ -397,6 +450,60 @@ func _DataCatalog_UpdateArtifact_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCatalog_DeleteArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCatalogServer).DeleteArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCatalog_DeleteArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCatalogServer).DeleteArtifact(ctx, req.(*DeleteArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCatalog_DeleteArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCatalogServer).DeleteArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCatalog_DeleteArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCatalogServer).DeleteArtifacts(ctx, req.(*DeleteArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCatalog_DeleteDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDatasetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCatalogServer).DeleteDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCatalog_DeleteDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCatalogServer).DeleteDataset(ctx, req.(*DeleteDatasetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCatalog_GetOrExtendReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOrExtendReservationRequest)
 	if err := dec(in); err != nil {
@@ -472,6 +579,18 @@ var DataCatalog_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateArtifact",
 			Handler:    _DataCatalog_UpdateArtifact_Handler,
 		},
+		{
+			MethodName: "DeleteArtifact",
+			Handler:    _DataCatalog_DeleteArtifact_Handler,
+		},
+		{
+			MethodName: "DeleteArtifacts",
+			Handler:    _DataCatalog_DeleteArtifacts_Handler,
+		},
+		{
+			MethodName: "DeleteDataset",
+			Handler:    _DataCatalog_DeleteDataset_Handler,
+		},
 		{
 			MethodName: "GetOrExtendReservation",
 			Handler:    _DataCatalog_GetOrExtendReservation_Handler,
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: flyteidl/plugins/batch_job.proto
+
+package plugins
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Custom proto for the batch job plugin, running the task as a Kubernetes batch/v1 Job with indexed completions.
+// Each pod receives its index in the JOB_COMPLETION_INDEX environment variable. JobSets are not supported.
+type BatchJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of indexes of the job, each index is run by one successful pod. Defaults to 1.
+	Completions int32 `protobuf:"varint,1,opt,name=completions,proto3" json:"completions,omitempty"`
+	// Maximum number of pods running in parallel. Defaults to the number of completions.
+	Parallelism int32 `protobuf:"varint,2,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	// Number of retries of each index. If set, the failures of one index don't consume the retries of the other
+	// indexes and the job keeps running the remaining indexes until they all finished.
+	BackoffLimitPerIndex *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=backoff_limit_per_index,json=backoffLimitPerIndex,proto3" json:"backoff_limit_per_index,omitempty"`
+	// Maximum number of failed indexes before the whole job is failed. Only used with backoff_limit_per_index.
+	MaxFailedIndexes *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=max_failed_indexes,json=maxFailedIndexes,proto3" json:"max_failed_indexes,omitempty"`
+	// Number of retries of the whole job. Ignored if backoff_limit_per_index is set.
+	BackoffLimit int32 `protobuf:"varint,5,opt,name=backoff_limit,json=backoffLimit,proto3" json:"backoff_limit,omitempty"`
+	// Defines when the job is considered successful before all indexes succeeded.
+	SuccessPolicy *BatchJobSuccessPolicy `protobuf:"bytes,6,opt,name=success_policy,json=successPolicy,proto3" json:"success_policy,omitempty"`
+}
+
+func (x *BatchJob) Reset() {
+	*x = BatchJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_plugins_batch_job_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchJob) ProtoMessage() {}
+
+func (x *BatchJob) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_plugins_batch_job_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchJob.ProtoReflect.Descriptor instead.
+func (*BatchJob) Descriptor() ([]byte, []int) {
+	return file_flyteidl_plugins_batch_job_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchJob) GetCompletions() int32 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *BatchJob) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *BatchJob) GetBackoffLimitPerIndex() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.BackoffLimitPerIndex
+	}
+	return nil
+}
+
+func (x *BatchJob) GetMaxFailedIndexes() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxFailedIndexes
+	}
+	return nil
+}
+
+func (x *BatchJob) GetBackoffLimit() int32 {
+	if x != nil {
+		return x.BackoffLimit
+	}
+	return 0
+}
+
+func (x *BatchJob) GetSuccessPolicy() *BatchJobSuccessPolicy {
+	if x != nil {
+		return x.SuccessPolicy
+	}
+	return nil
+}
+
+// Success policy of a batch job. The job succeeds as soon as one of the set criteria is met, any pods still running
+// are then terminated. The policy is evaluated by flytepropeller from the status of the job, it's not passed to the job
+// controller.
+type BatchJobSuccessPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The job succeeds once all these indexes succeeded.
+	SucceededIndexes []int32 `protobuf:"varint,1,rep,packed,name=succeeded_indexes,json=succeededIndexes,proto3" json:"succeeded_indexes,omitempty"`
+	// The job succeeds once this many indexes succeeded.
+	SucceededCount int32 `protobuf:"varint,2,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+}
+
+func (x *BatchJobSuccessPolicy) Reset() {
+	*x = BatchJobSuccessPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_plugins_batch_job_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchJobSuccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchJobSuccessPolicy) ProtoMessage() {}
+
+func (x *BatchJobSuccessPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_plugins_batch_job_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchJobSuccessPolicy.ProtoReflect.Descriptor instead.
+func (*BatchJobSuccessPolicy) Descriptor() ([]byte, []int) {
+	return file_flyteidl_plugins_batch_job_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchJobSuccessPolicy) GetSucceededIndexes() []int32 {
+	if x != nil {
+		return x.SucceededIndexes
+	}
+	return nil
+}
+
+func (x *BatchJobSuccessPolicy) GetSucceededCount() int32 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+var File_flyteidl_plugins_batch_job_proto protoreflect.FileDescriptor
+
+var file_flyteidl_plugins_batch_job_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6a, 0x6f, 0x62, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x10, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x02, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
+	0x62, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69,
+	0x73, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c,
+	0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x52, 0x0a, 0x17, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x50, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x49, 0x0a, 0x12, 0x6d, 0x61, 0x78,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4e, 0x0a, 0x0e, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x6d, 0x0a, 0x15, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xc5, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d,
+	0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x42, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66,
+	0x6c, 0x79, 0x74, 0x65, 0x6f, 0x72, 0x67, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x2f, 0x66, 0x6c,
+	0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x2d, 0x67, 0x6f,
+	0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0xa2, 0x02, 0x03, 0x46, 0x50, 0x58, 0xaa, 0x02, 0x10, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69,
+	0x64, 0x6c, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0xca, 0x02, 0x10, 0x46, 0x6c, 0x79,
+	0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0xe2, 0x02, 0x1c,
+	0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x46,
+	0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x3a, 0x3a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_flyteidl_plugins_batch_job_proto_rawDescOnce sync.Once
+	file_flyteidl_plugins_batch_job_proto_rawDescData = file_flyteidl_plugins_batch_job_proto_rawDesc
+)
+
+func file_flyteidl_plugins_batch_job_proto_rawDescGZIP() []byte {
+	file_flyteidl_plugins_batch_job_proto_rawDescOnce.Do(func() {
+		file_flyteidl_plugins_batch_job_proto_rawDescData = protoimpl.X.CompressGZIP(file_flyteidl_plugins_batch_job_proto_rawDescData)
+	})
+	return file_flyteidl_plugins_batch_job_proto_rawDescData
+}
+
+var file_flyteidl_plugins_batch_job_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_flyteidl_plugins_batch_job_proto_goTypes = []interface{}{
+	(*BatchJob)(nil),              // 0: flyteidl.plugins.BatchJob
+	(*BatchJobSuccessPolicy)(nil), // 1: flyteidl.plugins.BatchJobSuccessPolicy
+	(*wrapperspb.Int32Value)(nil), // 2: google.protobuf.Int32Value
+}
+var file_flyteidl_plugins_batch_job_proto_depIdxs = []int32{
+	2, // 0: flyteidl.plugins.BatchJob.backoff_limit_per_index:type_name -> google.protobuf.Int32Value
+	2, // 1: flyteidl.plugins.BatchJob.max_failed_indexes:type_name -> google.protobuf.Int32Value
+	1, // 2: flyteidl.plugins.BatchJob.success_policy:type_name -> flyteidl.plugins.BatchJobSuccessPolicy
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_flyteidl_plugins_batch_job_proto_init() }
+func file_flyteidl_plugins_batch_job_proto_init() {
+	if File_flyteidl_plugins_batch_job_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_flyteidl_plugins_batch_job_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flyteidl_plugins_batch_job_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchJobSuccessPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_flyteidl_plugins_batch_job_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_flyteidl_plugins_batch_job_proto_goTypes,
+		DependencyIndexes: file_flyteidl_plugins_batch_job_proto_depIdxs,
+		MessageInfos:      file_flyteidl_plugins_batch_job_proto_msgTypes,
+	}.Build()
+	File_flyteidl_plugins_batch_job_proto = out.File
+	file_flyteidl_plugins_batch_job_proto_rawDesc = nil
+	file_flyteidl_plugins_batch_job_proto_goTypes = nil
+	file_flyteidl_plugins_batch_job_proto_depIdxs = nil
+}
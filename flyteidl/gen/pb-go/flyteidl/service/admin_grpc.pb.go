@@ -24,6 +24,7 @@ const (
 	AdminService_GetTask_FullMethodName                       = "/flyteidl.service.AdminService/GetTask"
 	AdminService_ListTaskIds_FullMethodName                   = "/flyteidl.service.AdminService/ListTaskIds"
 	AdminService_ListTasks_FullMethodName                     = "/flyteidl.service.AdminService/ListTasks"
+	AdminService_GetTaskResourceRecommendation_FullMethodName = "/flyteidl.service.AdminService/GetTaskResourceRecommendation"
 	AdminService_CreateWorkflow_FullMethodName                = "/flyteidl.service.AdminService/CreateWorkflow"
 	AdminService_GetWorkflow_FullMethodName                   = "/flyteidl.service.AdminService/GetWorkflow"
 	AdminService_ListWorkflowIds_FullMethodName               = "/flyteidl.service.AdminService/ListWorkflowIds"
@@ -90,6 +91,9 @@ type AdminServiceClient interface {
 	ListTaskIds(ctx context.Context, in *admin.NamedEntityIdentifierListRequest, opts ...grpc.CallOption) (*admin.NamedEntityIdentifierList, error)
 	// Fetch a list of :ref:`ref_flyteidl.admin.Task` definitions.
 	ListTasks(ctx context.Context, in *admin.ResourceListRequest, opts ...grpc.CallOption) (*admin.TaskList, error)
+	// Fetch the :ref:`ref_flyteidl.admin.TaskResourceRecommendation` of a task version, based on the resources its past
+	// executions used.
+	GetTaskResourceRecommendation(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption) (*admin.TaskResourceRecommendation, error)
 	// Create and upload a :ref:`ref_flyteidl.admin.Workflow` definition
 	CreateWorkflow(ctx context.Context, in *admin.WorkflowCreateRequest, opts ...grpc.CallOption) (*admin.WorkflowCreateResponse, error)
 	// Fetch a :ref:`ref_flyteidl.admin.Workflow` definition.
@@ -244,6 +248,15 @@ func (c *adminServiceClient) ListTasks(ctx context.Context, in *admin.ResourceLi
 	return out, nil
 }
 
+func (c *adminServiceClient) GetTaskResourceRecommendation(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption) (*admin.TaskResourceRecommendation, error) {
+	out := new(admin.TaskResourceRecommendation)
+	err := c.cc.Invoke(ctx, AdminService_GetTaskResourceRecommendation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateWorkflow(ctx context.Context, in *admin.WorkflowCreateRequest, opts ...grpc.CallOption) (*admin.WorkflowCreateResponse, error) {
 	out := new(admin.WorkflowCreateResponse)
 	err := c.cc.Invoke(ctx, AdminService_CreateWorkflow_FullMethodName, in, out, opts...)
@@ -724,6 +737,9 @@ type AdminServiceServer interface {
 	ListTaskIds(context.Context, *admin.NamedEntityIdentifierListRequest) (*admin.NamedEntityIdentifierList, error)
 	// Fetch a list of :ref:`ref_flyteidl.admin.Task` definitions.
 	ListTasks(context.Context, *admin.ResourceListRequest) (*admin.TaskList, error)
+	// Fetch the :ref:`ref_flyteidl.admin.TaskResourceRecommendation` of a task version, based on the resources its past
+	// executions used.
+	GetTaskResourceRecommendation(context.Context, *admin.ObjectGetRequest) (*admin.TaskResourceRecommendation, error)
 	// Create and upload a :ref:`ref_flyteidl.admin.Workflow` definition
 	CreateWorkflow(context.Context, *admin.WorkflowCreateRequest) (*admin.WorkflowCreateResponse, error)
 	// Fetch a :ref:`ref_flyteidl.admin.Workflow` definition.
@@ -850,6 +866,9 @@ func (UnimplementedAdminServiceServer) ListTaskIds(context.Context, *admin.Named
 func (UnimplementedAdminServiceServer) ListTasks(context.Context, *admin.ResourceListRequest) (*admin.TaskList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
+func (UnimplementedAdminServiceServer) GetTaskResourceRecommendation(context.Context, *admin.ObjectGetRequest) (*admin.TaskResourceRecommendation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskResourceRecommendation not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateWorkflow(context.Context, *admin.WorkflowCreateRequest) (*admin.WorkflowCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkflow not implemented")
 }
@@ -1090,6 +1109,24 @@ func _AdminService_ListTasks_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetTaskResourceRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.ObjectGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetTaskResourceRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetTaskResourceRecommendation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetTaskResourceRecommendation(ctx, req.(*admin.ObjectGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(admin.WorkflowCreateRequest)
 	if err := dec(in); err != nil {
@@ -2049,6 +2086,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTasks",
 			Handler:    _AdminService_ListTasks_Handler,
 		},
+		{
+			MethodName: "GetTaskResourceRecommendation",
+			Handler:    _AdminService_GetTaskResourceRecommendation_Handler,
+		},
 		{
 			MethodName: "CreateWorkflow",
 			Handler:    _AdminService_CreateWorkflow_Handler,
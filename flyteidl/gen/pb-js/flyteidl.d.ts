@@ -4339,6 +4339,9 @@ export namespace flyteidl {
 
             /** ApproveCondition signalId */
             signalId?: (string|null);
+
+            /** ApproveCondition policy */
+            policy?: (flyteidl.core.IApprovalPolicy|null);
         }
 
         /** Represents an ApproveCondition. */
@@ -4353,6 +4356,9 @@ export namespace flyteidl {
             /** ApproveCondition signalId. */
             public signalId: string;
 
+            /** ApproveCondition policy. */
+            public policy?: (flyteidl.core.IApprovalPolicy|null);
+
             /**
              * Creates a new ApproveCondition instance using the specified properties.
              * @param [properties] Properties to set
@@ -4386,6 +4392,70 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of an ApprovalPolicy. */
+        interface IApprovalPolicy {
+
+            /** ApprovalPolicy minApprovals */
+            minApprovals?: (number|null);
+
+            /** ApprovalPolicy allowedIdentities */
+            allowedIdentities?: (string[]|null);
+
+            /** ApprovalPolicy allowedGroups */
+            allowedGroups?: (string[]|null);
+        }
+
+        /** Represents an ApprovalPolicy. */
+        class ApprovalPolicy implements IApprovalPolicy {
+
+            /**
+             * Constructs a new ApprovalPolicy.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.core.IApprovalPolicy);
+
+            /** ApprovalPolicy minApprovals. */
+            public minApprovals: number;
+
+            /** ApprovalPolicy allowedIdentities. */
+            public allowedIdentities: string[];
+
+            /** ApprovalPolicy allowedGroups. */
+            public allowedGroups: string[];
+
+            /**
+             * Creates a new ApprovalPolicy instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ApprovalPolicy instance
+             */
+            public static create(properties?: flyteidl.core.IApprovalPolicy): flyteidl.core.ApprovalPolicy;
+
+            /**
+             * Encodes the specified ApprovalPolicy message. Does not implicitly {@link flyteidl.core.ApprovalPolicy.verify|verify} messages.
+             * @param message ApprovalPolicy message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.core.IApprovalPolicy, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes an ApprovalPolicy message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ApprovalPolicy
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.core.ApprovalPolicy;
+
+            /**
+             * Verifies an ApprovalPolicy message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** Properties of a SignalCondition. */
         interface ISignalCondition {
 
@@ -4502,6 +4572,350 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of a GateTimeout. */
+        interface IGateTimeout {
+
+            /** GateTimeout duration */
+            duration?: (google.protobuf.IDuration|null);
+
+            /** GateTimeout defaultOutput */
+            defaultOutput?: (flyteidl.core.ILiteral|null);
+        }
+
+        /** Represents a GateTimeout. */
+        class GateTimeout implements IGateTimeout {
+
+            /**
+             * Constructs a new GateTimeout.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.core.IGateTimeout);
+
+            /** GateTimeout duration. */
+            public duration?: (google.protobuf.IDuration|null);
+
+            /** GateTimeout defaultOutput. */
+            public defaultOutput?: (flyteidl.core.ILiteral|null);
+
+            /**
+             * Creates a new GateTimeout instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns GateTimeout instance
+             */
+            public static create(properties?: flyteidl.core.IGateTimeout): flyteidl.core.GateTimeout;
+
+            /**
+             * Encodes the specified GateTimeout message. Does not implicitly {@link flyteidl.core.GateTimeout.verify|verify} messages.
+             * @param message GateTimeout message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.core.IGateTimeout, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a GateTimeout message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns GateTimeout
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.core.GateTimeout;
+
+            /**
+             * Verifies a GateTimeout message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a WaitUntilCondition. */
+        interface IWaitUntilCondition {
+
+            /** WaitUntilCondition inputVariableName */
+            inputVariableName?: (string|null);
+
+            /** WaitUntilCondition outputVariableName */
+            outputVariableName?: (string|null);
+
+            /** WaitUntilCondition timeout */
+            timeout?: (flyteidl.core.IGateTimeout|null);
+        }
+
+        /** Represents a WaitUntilCondition. */
+        class WaitUntilCondition implements IWaitUntilCondition {
+
+            /**
+             * Constructs a new WaitUntilCondition.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.core.IWaitUntilCondition);
+
+            /** WaitUntilCondition inputVariableName. */
+            public inputVariableName: string;
+
+            /** WaitUntilCondition outputVariableName. */
+            public outputVariableName: string;
+
+            /** WaitUntilCondition timeout. */
+            public timeout?: (flyteidl.core.IGateTimeout|null);
+
+            /**
+             * Creates a new WaitUntilCondition instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns WaitUntilCondition instance
+             */
+            public static create(properties?: flyteidl.core.IWaitUntilCondition): flyteidl.core.WaitUntilCondition;
+
+            /**
+             * Encodes the specified WaitUntilCondition message. Does not implicitly {@link flyteidl.core.WaitUntilCondition.verify|verify} messages.
+             * @param message WaitUntilCondition message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.core.IWaitUntilCondition, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a WaitUntilCondition message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns WaitUntilCondition
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.core.WaitUntilCondition;
+
+            /**
+             * Verifies a WaitUntilCondition message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a StorageObjectCondition. */
+        interface IStorageObjectCondition {
+
+            /** StorageObjectCondition uri */
+            uri?: (string|null);
+
+            /** StorageObjectCondition uriInputVariableName */
+            uriInputVariableName?: (string|null);
+        }
+
+        /** Represents a StorageObjectCondition. */
+        class StorageObjectCondition implements IStorageObjectCondition {
+
+            /**
+             * Constructs a new StorageObjectCondition.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.core.IStorageObjectCondition);
+
+            /** StorageObjectCondition uri. */
+            public uri: string;
+
+            /** StorageObjectCondition uriInputVariableName. */
+            public uriInputVariableName: string;
+
+            /** StorageObjectCondition uriSource. */
+            public uriSource?: ("uri"|"uriInputVariableName");
+
+            /**
+             * Creates a new StorageObjectCondition instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns StorageObjectCondition instance
+             */
+            public static create(properties?: flyteidl.core.IStorageObjectCondition): flyteidl.core.StorageObjectCondition;
+
+            /**
+             * Encodes the specified StorageObjectCondition message. Does not implicitly {@link flyteidl.core.StorageObjectCondition.verify|verify} messages.
+             * @param message StorageObjectCondition message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.core.IStorageObjectCondition, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a StorageObjectCondition message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns StorageObjectCondition
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.core.StorageObjectCondition;
+
+            /**
+             * Verifies a StorageObjectCondition message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a CatalogArtifactCondition. */
+        interface ICatalogArtifactCondition {
+
+            /** CatalogArtifactCondition project */
+            project?: (string|null);
+
+            /** CatalogArtifactCondition domain */
+            domain?: (string|null);
+
+            /** CatalogArtifactCondition name */
+            name?: (string|null);
+
+            /** CatalogArtifactCondition version */
+            version?: (string|null);
+
+            /** CatalogArtifactCondition tag */
+            tag?: (string|null);
+        }
+
+        /** Represents a CatalogArtifactCondition. */
+        class CatalogArtifactCondition implements ICatalogArtifactCondition {
+
+            /**
+             * Constructs a new CatalogArtifactCondition.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.core.ICatalogArtifactCondition);
+
+            /** CatalogArtifactCondition project. */
+            public project: string;
+
+            /** CatalogArtifactCondition domain. */
+            public domain: string;
+
+            /** CatalogArtifactCondition name. */
+            public name: string;
+
+            /** CatalogArtifactCondition version. */
+            public version: string;
+
+            /** CatalogArtifactCondition tag. */
+            public tag: string;
+
+            /**
+             * Creates a new CatalogArtifactCondition instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns CatalogArtifactCondition instance
+             */
+            public static create(properties?: flyteidl.core.ICatalogArtifactCondition): flyteidl.core.CatalogArtifactCondition;
+
+            /**
+             * Encodes the specified CatalogArtifactCondition message. Does not implicitly {@link flyteidl.core.CatalogArtifactCondition.verify|verify} messages.
+             * @param message CatalogArtifactCondition message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.core.ICatalogArtifactCondition, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a CatalogArtifactCondition message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns CatalogArtifactCondition
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.core.CatalogArtifactCondition;
+
+            /**
+             * Verifies a CatalogArtifactCondition message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of an ExternalCondition. */
+        interface IExternalCondition {
+
+            /** ExternalCondition storageObject */
+            storageObject?: (flyteidl.core.IStorageObjectCondition|null);
+
+            /** ExternalCondition catalogArtifact */
+            catalogArtifact?: (flyteidl.core.ICatalogArtifactCondition|null);
+
+            /** ExternalCondition pollInterval */
+            pollInterval?: (google.protobuf.IDuration|null);
+
+            /** ExternalCondition maxPollInterval */
+            maxPollInterval?: (google.protobuf.IDuration|null);
+
+            /** ExternalCondition outputVariableName */
+            outputVariableName?: (string|null);
+
+            /** ExternalCondition timeout */
+            timeout?: (flyteidl.core.IGateTimeout|null);
+        }
+
+        /** Represents an ExternalCondition. */
+        class ExternalCondition implements IExternalCondition {
+
+            /**
+             * Constructs a new ExternalCondition.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.core.IExternalCondition);
+
+            /** ExternalCondition storageObject. */
+            public storageObject?: (flyteidl.core.IStorageObjectCondition|null);
+
+            /** ExternalCondition catalogArtifact. */
+            public catalogArtifact?: (flyteidl.core.ICatalogArtifactCondition|null);
+
+            /** ExternalCondition pollInterval. */
+            public pollInterval?: (google.protobuf.IDuration|null);
+
+            /** ExternalCondition maxPollInterval. */
+            public maxPollInterval?: (google.protobuf.IDuration|null);
+
+            /** ExternalCondition outputVariableName. */
+            public outputVariableName: string;
+
+            /** ExternalCondition timeout. */
+            public timeout?: (flyteidl.core.IGateTimeout|null);
+
+            /** ExternalCondition condition. */
+            public condition?: ("storageObject"|"catalogArtifact");
+
+            /**
+             * Creates a new ExternalCondition instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ExternalCondition instance
+             */
+            public static create(properties?: flyteidl.core.IExternalCondition): flyteidl.core.ExternalCondition;
+
+            /**
+             * Encodes the specified ExternalCondition message. Does not implicitly {@link flyteidl.core.ExternalCondition.verify|verify} messages.
+             * @param message ExternalCondition message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.core.IExternalCondition, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes an ExternalCondition message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ExternalCondition
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.core.ExternalCondition;
+
+            /**
+             * Verifies an ExternalCondition message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** Properties of a GateNode. */
         interface IGateNode {
 
@@ -4513,6 +4927,12 @@ export namespace flyteidl {
 
             /** GateNode sleep */
             sleep?: (flyteidl.core.ISleepCondition|null);
+
+            /** GateNode waitUntil */
+            waitUntil?: (flyteidl.core.IWaitUntilCondition|null);
+
+            /** GateNode external */
+            external?: (flyteidl.core.IExternalCondition|null);
         }
 
         /** Represents a GateNode. */
@@ -4533,8 +4953,14 @@ export namespace flyteidl {
             /** GateNode sleep. */
             public sleep?: (flyteidl.core.ISleepCondition|null);
 
+            /** GateNode waitUntil. */
+            public waitUntil?: (flyteidl.core.IWaitUntilCondition|null);
+
+            /** GateNode external. */
+            public external?: (flyteidl.core.IExternalCondition|null);
+
             /** GateNode condition. */
-            public condition?: ("approve"|"signal"|"sleep");
+            public condition?: ("approve"|"signal"|"sleep"|"waitUntil"|"external");
 
             /**
              * Creates a new GateNode instance using the specified properties.
@@ -4586,6 +5012,18 @@ export namespace flyteidl {
 
             /** ArrayNode executionMode */
             executionMode?: (flyteidl.core.ArrayNode.ExecutionMode|null);
+
+            /** ArrayNode partialSuccess */
+            partialSuccess?: (flyteidl.core.ArrayNode.IPartialSuccess|null);
+
+            /** ArrayNode retryBudget */
+            retryBudget?: (number|null);
+
+            /** ArrayNode maxFailures */
+            maxFailures?: (number|null);
+
+            /** ArrayNode adaptiveParallelism */
+            adaptiveParallelism?: (boolean|null);
         }
 
         /** Represents an ArrayNode. */
@@ -4612,12 +5050,27 @@ export namespace flyteidl {
             /** ArrayNode executionMode. */
             public executionMode: flyteidl.core.ArrayNode.ExecutionMode;
 
+            /** ArrayNode partialSuccess. */
+            public partialSuccess?: (flyteidl.core.ArrayNode.IPartialSuccess|null);
+
+            /** ArrayNode retryBudget. */
+            public retryBudget: number;
+
+            /** ArrayNode maxFailures. */
+            public maxFailures: number;
+
+            /** ArrayNode adaptiveParallelism. */
+            public adaptiveParallelism: boolean;
+
             /** ArrayNode parallelismOption. */
             public parallelismOption?: "parallelism";
 
             /** ArrayNode successCriteria. */
             public successCriteria?: ("minSuccesses"|"minSuccessRatio");
 
+            /** ArrayNode retryBudgetOption. */
+            public retryBudgetOption?: "retryBudget";
+
             /**
              * Creates a new ArrayNode instance using the specified properties.
              * @param [properties] Properties to set
@@ -4658,6 +5111,58 @@ export namespace flyteidl {
                 MINIMAL_STATE = 0,
                 FULL_STATE = 1
             }
+
+            /** Properties of a PartialSuccess. */
+            interface IPartialSuccess {
+
+                /** PartialSuccess errorsOutput */
+                errorsOutput?: (string|null);
+            }
+
+            /** Represents a PartialSuccess. */
+            class PartialSuccess implements IPartialSuccess {
+
+                /**
+                 * Constructs a new PartialSuccess.
+                 * @param [properties] Properties to set
+                 */
+                constructor(properties?: flyteidl.core.ArrayNode.IPartialSuccess);
+
+                /** PartialSuccess errorsOutput. */
+                public errorsOutput: string;
+
+                /**
+                 * Creates a new PartialSuccess instance using the specified properties.
+                 * @param [properties] Properties to set
+                 * @returns PartialSuccess instance
+                 */
+                public static create(properties?: flyteidl.core.ArrayNode.IPartialSuccess): flyteidl.core.ArrayNode.PartialSuccess;
+
+                /**
+                 * Encodes the specified PartialSuccess message. Does not implicitly {@link flyteidl.core.ArrayNode.PartialSuccess.verify|verify} messages.
+                 * @param message PartialSuccess message or plain object to encode
+                 * @param [writer] Writer to encode to
+                 * @returns Writer
+                 */
+                public static encode(message: flyteidl.core.ArrayNode.IPartialSuccess, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                /**
+                 * Decodes a PartialSuccess message from the specified reader or buffer.
+                 * @param reader Reader or buffer to decode from
+                 * @param [length] Message length if known beforehand
+                 * @returns PartialSuccess
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.core.ArrayNode.PartialSuccess;
+
+                /**
+                 * Verifies a PartialSuccess message.
+                 * @param message Plain object to verify
+                 * @returns `null` if valid, otherwise the reason why it is not
+                 */
+                public static verify(message: { [k: string]: any }): (string|null);
+            }
         }
 
         /** Properties of a NodeMetadata. */
@@ -5341,7 +5846,14 @@ export namespace flyteidl {
                 GT = 2,
                 GTE = 3,
                 LT = 4,
-                LTE = 5
+                LTE = 5,
+                IN = 6,
+                NOT_IN = 7,
+                STARTS_WITH = 8,
+                ENDS_WITH = 9,
+                MATCHES = 10,
+                IS_NULL = 11,
+                IS_NOT_NULL = 12
             }
         }
 
@@ -5356,6 +5868,12 @@ export namespace flyteidl {
 
             /** Operand scalar */
             scalar?: (flyteidl.core.IScalar|null);
+
+            /** Operand collection */
+            collection?: (flyteidl.core.ILiteralCollection|null);
+
+            /** Operand attrPath */
+            attrPath?: (flyteidl.core.IPromiseAttribute[]|null);
         }
 
         /** Represents an Operand. */
@@ -5376,8 +5894,14 @@ export namespace flyteidl {
             /** Operand scalar. */
             public scalar?: (flyteidl.core.IScalar|null);
 
+            /** Operand collection. */
+            public collection?: (flyteidl.core.ILiteralCollection|null);
+
+            /** Operand attrPath. */
+            public attrPath: flyteidl.core.IPromiseAttribute[];
+
             /** Operand val. */
-            public val?: ("primitive"|"var"|"scalar");
+            public val?: ("primitive"|"var"|"scalar"|"collection");
 
             /**
              * Creates a new Operand instance using the specified properties.
@@ -5420,6 +5944,9 @@ export namespace flyteidl {
 
             /** BooleanExpression comparison */
             comparison?: (flyteidl.core.IComparisonExpression|null);
+
+            /** BooleanExpression negation */
+            negation?: (flyteidl.core.INegationExpression|null);
         }
 
         /** Represents a BooleanExpression. */
@@ -5437,8 +5964,11 @@ export namespace flyteidl {
             /** BooleanExpression comparison. */
             public comparison?: (flyteidl.core.IComparisonExpression|null);
 
+            /** BooleanExpression negation. */
+            public negation?: (flyteidl.core.INegationExpression|null);
+
             /** BooleanExpression expr. */
-            public expr?: ("conjunction"|"comparison");
+            public expr?: ("conjunction"|"comparison"|"negation");
 
             /**
              * Creates a new BooleanExpression instance using the specified properties.
@@ -5473,6 +6003,58 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of a NegationExpression. */
+        interface INegationExpression {
+
+            /** NegationExpression expression */
+            expression?: (flyteidl.core.IBooleanExpression|null);
+        }
+
+        /** Represents a NegationExpression. */
+        class NegationExpression implements INegationExpression {
+
+            /**
+             * Constructs a new NegationExpression.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.core.INegationExpression);
+
+            /** NegationExpression expression. */
+            public expression?: (flyteidl.core.IBooleanExpression|null);
+
+            /**
+             * Creates a new NegationExpression instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns NegationExpression instance
+             */
+            public static create(properties?: flyteidl.core.INegationExpression): flyteidl.core.NegationExpression;
+
+            /**
+             * Encodes the specified NegationExpression message. Does not implicitly {@link flyteidl.core.NegationExpression.verify|verify} messages.
+             * @param message NegationExpression message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.core.INegationExpression, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a NegationExpression message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns NegationExpression
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.core.NegationExpression;
+
+            /**
+             * Verifies a NegationExpression message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** Properties of a ConjunctionExpression. */
         interface IConjunctionExpression {
 
@@ -9319,6 +9901,15 @@ export namespace flyteidl {
             /** TaskExecutionMetadata pluginIdentifier */
             pluginIdentifier?: (string|null);
 
+            /** TaskExecutionMetadata peakResourceUsage */
+            peakResourceUsage?: (flyteidl.core.Resources.IResourceEntry[]|null);
+
+            /** TaskExecutionMetadata escalatedResources */
+            escalatedResources?: (flyteidl.core.IResources|null);
+
+            /** TaskExecutionMetadata preempted */
+            preempted?: (boolean|null);
+
             /** TaskExecutionMetadata instanceClass */
             instanceClass?: (flyteidl.event.TaskExecutionMetadata.InstanceClass|null);
         }
@@ -9344,6 +9935,15 @@ export namespace flyteidl {
             /** TaskExecutionMetadata pluginIdentifier. */
             public pluginIdentifier: string;
 
+            /** TaskExecutionMetadata peakResourceUsage. */
+            public peakResourceUsage: flyteidl.core.Resources.IResourceEntry[];
+
+            /** TaskExecutionMetadata escalatedResources. */
+            public escalatedResources?: (flyteidl.core.IResources|null);
+
+            /** TaskExecutionMetadata preempted. */
+            public preempted: boolean;
+
             /** TaskExecutionMetadata instanceClass. */
             public instanceClass: flyteidl.event.TaskExecutionMetadata.InstanceClass;
 
@@ -19217,6 +19817,9 @@ export namespace flyteidl {
 
             /** SignalGetOrCreateRequest type */
             type?: (flyteidl.core.ILiteralType|null);
+
+            /** SignalGetOrCreateRequest approvalPolicy */
+            approvalPolicy?: (flyteidl.core.IApprovalPolicy|null);
         }
 
         /** Represents a SignalGetOrCreateRequest. */
@@ -19234,6 +19837,9 @@ export namespace flyteidl {
             /** SignalGetOrCreateRequest type. */
             public type?: (flyteidl.core.ILiteralType|null);
 
+            /** SignalGetOrCreateRequest approvalPolicy. */
+            public approvalPolicy?: (flyteidl.core.IApprovalPolicy|null);
+
             /**
              * Creates a new SignalGetOrCreateRequest instance using the specified properties.
              * @param [properties] Properties to set
@@ -19409,6 +20015,9 @@ export namespace flyteidl {
 
             /** SignalSetRequest value */
             value?: (flyteidl.core.ILiteral|null);
+
+            /** SignalSetRequest reason */
+            reason?: (string|null);
         }
 
         /** Represents a SignalSetRequest. */
@@ -19426,6 +20035,9 @@ export namespace flyteidl {
             /** SignalSetRequest value. */
             public value?: (flyteidl.core.ILiteral|null);
 
+            /** SignalSetRequest reason. */
+            public reason: string;
+
             /**
              * Creates a new SignalSetRequest instance using the specified properties.
              * @param [properties] Properties to set
@@ -19516,6 +20128,12 @@ export namespace flyteidl {
 
             /** Signal value */
             value?: (flyteidl.core.ILiteral|null);
+
+            /** Signal approvalPolicy */
+            approvalPolicy?: (flyteidl.core.IApprovalPolicy|null);
+
+            /** Signal approvals */
+            approvals?: (flyteidl.admin.ISignalApproval[]|null);
         }
 
         /** Represents a Signal. */
@@ -19536,6 +20154,12 @@ export namespace flyteidl {
             /** Signal value. */
             public value?: (flyteidl.core.ILiteral|null);
 
+            /** Signal approvalPolicy. */
+            public approvalPolicy?: (flyteidl.core.IApprovalPolicy|null);
+
+            /** Signal approvals. */
+            public approvals: flyteidl.admin.ISignalApproval[];
+
             /**
              * Creates a new Signal instance using the specified properties.
              * @param [properties] Properties to set
@@ -19569,6 +20193,76 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of a SignalApproval. */
+        interface ISignalApproval {
+
+            /** SignalApproval identity */
+            identity?: (string|null);
+
+            /** SignalApproval approved */
+            approved?: (boolean|null);
+
+            /** SignalApproval reason */
+            reason?: (string|null);
+
+            /** SignalApproval createdAt */
+            createdAt?: (google.protobuf.ITimestamp|null);
+        }
+
+        /** Represents a SignalApproval. */
+        class SignalApproval implements ISignalApproval {
+
+            /**
+             * Constructs a new SignalApproval.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.ISignalApproval);
+
+            /** SignalApproval identity. */
+            public identity: string;
+
+            /** SignalApproval approved. */
+            public approved: boolean;
+
+            /** SignalApproval reason. */
+            public reason: string;
+
+            /** SignalApproval createdAt. */
+            public createdAt?: (google.protobuf.ITimestamp|null);
+
+            /**
+             * Creates a new SignalApproval instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns SignalApproval instance
+             */
+            public static create(properties?: flyteidl.admin.ISignalApproval): flyteidl.admin.SignalApproval;
+
+            /**
+             * Encodes the specified SignalApproval message. Does not implicitly {@link flyteidl.admin.SignalApproval.verify|verify} messages.
+             * @param message SignalApproval message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.ISignalApproval, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a SignalApproval message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns SignalApproval
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.SignalApproval;
+
+            /**
+             * Verifies a SignalApproval message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** Properties of a TaskCreateRequest. */
         interface ITaskCreateRequest {
 
@@ -19911,6 +20605,76 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of a TaskResourceRecommendation. */
+        interface ITaskResourceRecommendation {
+
+            /** TaskResourceRecommendation id */
+            id?: (flyteidl.core.IIdentifier|null);
+
+            /** TaskResourceRecommendation recommended */
+            recommended?: (flyteidl.admin.ITaskResourceSpec|null);
+
+            /** TaskResourceRecommendation peak */
+            peak?: (flyteidl.admin.ITaskResourceSpec|null);
+
+            /** TaskResourceRecommendation sampleCount */
+            sampleCount?: (number|null);
+        }
+
+        /** Represents a TaskResourceRecommendation. */
+        class TaskResourceRecommendation implements ITaskResourceRecommendation {
+
+            /**
+             * Constructs a new TaskResourceRecommendation.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.ITaskResourceRecommendation);
+
+            /** TaskResourceRecommendation id. */
+            public id?: (flyteidl.core.IIdentifier|null);
+
+            /** TaskResourceRecommendation recommended. */
+            public recommended?: (flyteidl.admin.ITaskResourceSpec|null);
+
+            /** TaskResourceRecommendation peak. */
+            public peak?: (flyteidl.admin.ITaskResourceSpec|null);
+
+            /** TaskResourceRecommendation sampleCount. */
+            public sampleCount: number;
+
+            /**
+             * Creates a new TaskResourceRecommendation instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns TaskResourceRecommendation instance
+             */
+            public static create(properties?: flyteidl.admin.ITaskResourceRecommendation): flyteidl.admin.TaskResourceRecommendation;
+
+            /**
+             * Encodes the specified TaskResourceRecommendation message. Does not implicitly {@link flyteidl.admin.TaskResourceRecommendation.verify|verify} messages.
+             * @param message TaskResourceRecommendation message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.ITaskResourceRecommendation, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a TaskResourceRecommendation message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns TaskResourceRecommendation
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.TaskResourceRecommendation;
+
+            /**
+             * Verifies a TaskResourceRecommendation message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** Properties of a TaskExecutionGetRequest. */
         interface ITaskExecutionGetRequest {
 
@@ -21671,6 +22435,20 @@ export namespace flyteidl {
              */
             public listTasks(request: flyteidl.admin.IResourceListRequest): Promise<flyteidl.admin.TaskList>;
 
+            /**
+             * Calls GetTaskResourceRecommendation.
+             * @param request ObjectGetRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and TaskResourceRecommendation
+             */
+            public getTaskResourceRecommendation(request: flyteidl.admin.IObjectGetRequest, callback: flyteidl.service.AdminService.GetTaskResourceRecommendationCallback): void;
+
+            /**
+             * Calls GetTaskResourceRecommendation.
+             * @param request ObjectGetRequest message or plain object
+             * @returns Promise
+             */
+            public getTaskResourceRecommendation(request: flyteidl.admin.IObjectGetRequest): Promise<flyteidl.admin.TaskResourceRecommendation>;
+
             /**
              * Calls CreateWorkflow.
              * @param request WorkflowCreateRequest message or plain object
@@ -22430,6 +23208,13 @@ export namespace flyteidl {
              */
             type ListTasksCallback = (error: (Error|null), response?: flyteidl.admin.TaskList) => void;
 
+            /**
+             * Callback as used by {@link flyteidl.service.AdminService#getTaskResourceRecommendation}.
+             * @param error Error, if any
+             * @param [response] TaskResourceRecommendation
+             */
+            type GetTaskResourceRecommendationCallback = (error: (Error|null), response?: flyteidl.admin.TaskResourceRecommendation) => void;
+
             /**
              * Callback as used by {@link flyteidl.service.AdminService#createWorkflow}.
              * @param error Error, if any
@@ -10432,6 +10432,7 @@
                  * @memberof flyteidl.core
                  * @interface IApproveCondition
                  * @property {string|null} [signalId] ApproveCondition signalId
+                 * @property {flyteidl.core.IApprovalPolicy|null} [policy] ApproveCondition policy
                  */
     
                 /**
@@ -10457,6 +10458,14 @@
                  */
                 ApproveCondition.prototype.signalId = "";
     
+                /**
+                 * ApproveCondition policy.
+                 * @member {flyteidl.core.IApprovalPolicy|null|undefined} policy
+                 * @memberof flyteidl.core.ApproveCondition
+                 * @instance
+                 */
+                ApproveCondition.prototype.policy = null;
+    
                 /**
                  * Creates a new ApproveCondition instance using the specified properties.
                  * @function create
@@ -10483,6 +10492,8 @@
                         writer = $Writer.create();
                     if (message.signalId != null && message.hasOwnProperty("signalId"))
                         writer.uint32(/* id 1, wireType 2 =*/10).string(message.signalId);
+                    if (message.policy != null && message.hasOwnProperty("policy"))
+                        $root.flyteidl.core.ApprovalPolicy.encode(message.policy, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
                     return writer;
                 };
     
@@ -10507,6 +10518,9 @@
                         case 1:
                             message.signalId = reader.string();
                             break;
+                        case 2:
+                            message.policy = $root.flyteidl.core.ApprovalPolicy.decode(reader, reader.uint32());
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -10529,12 +10543,177 @@
                     if (message.signalId != null && message.hasOwnProperty("signalId"))
                         if (!$util.isString(message.signalId))
                             return "signalId: string expected";
+                    if (message.policy != null && message.hasOwnProperty("policy")) {
+                        var error = $root.flyteidl.core.ApprovalPolicy.verify(message.policy);
+                        if (error)
+                            return "policy." + error;
+                    }
                     return null;
                 };
     
                 return ApproveCondition;
             })();
     
+            core.ApprovalPolicy = (function() {
+    
+                /**
+                 * Properties of an ApprovalPolicy.
+                 * @memberof flyteidl.core
+                 * @interface IApprovalPolicy
+                 * @property {number|null} [minApprovals] ApprovalPolicy minApprovals
+                 * @property {Array.<string>|null} [allowedIdentities] ApprovalPolicy allowedIdentities
+                 * @property {Array.<string>|null} [allowedGroups] ApprovalPolicy allowedGroups
+                 */
+    
+                /**
+                 * Constructs a new ApprovalPolicy.
+                 * @memberof flyteidl.core
+                 * @classdesc Represents an ApprovalPolicy.
+                 * @implements IApprovalPolicy
+                 * @constructor
+                 * @param {flyteidl.core.IApprovalPolicy=} [properties] Properties to set
+                 */
+                function ApprovalPolicy(properties) {
+                    this.allowedIdentities = [];
+                    this.allowedGroups = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ApprovalPolicy minApprovals.
+                 * @member {number} minApprovals
+                 * @memberof flyteidl.core.ApprovalPolicy
+                 * @instance
+                 */
+                ApprovalPolicy.prototype.minApprovals = 0;
+    
+                /**
+                 * ApprovalPolicy allowedIdentities.
+                 * @member {Array.<string>} allowedIdentities
+                 * @memberof flyteidl.core.ApprovalPolicy
+                 * @instance
+                 */
+                ApprovalPolicy.prototype.allowedIdentities = $util.emptyArray;
+    
+                /**
+                 * ApprovalPolicy allowedGroups.
+                 * @member {Array.<string>} allowedGroups
+                 * @memberof flyteidl.core.ApprovalPolicy
+                 * @instance
+                 */
+                ApprovalPolicy.prototype.allowedGroups = $util.emptyArray;
+    
+                /**
+                 * Creates a new ApprovalPolicy instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.core.ApprovalPolicy
+                 * @static
+                 * @param {flyteidl.core.IApprovalPolicy=} [properties] Properties to set
+                 * @returns {flyteidl.core.ApprovalPolicy} ApprovalPolicy instance
+                 */
+                ApprovalPolicy.create = function create(properties) {
+                    return new ApprovalPolicy(properties);
+                };
+    
+                /**
+                 * Encodes the specified ApprovalPolicy message. Does not implicitly {@link flyteidl.core.ApprovalPolicy.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.core.ApprovalPolicy
+                 * @static
+                 * @param {flyteidl.core.IApprovalPolicy} message ApprovalPolicy message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ApprovalPolicy.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.minApprovals != null && message.hasOwnProperty("minApprovals"))
+                        writer.uint32(/* id 1, wireType 0 =*/8).uint32(message.minApprovals);
+                    if (message.allowedIdentities != null && message.allowedIdentities.length)
+                        for (var i = 0; i < message.allowedIdentities.length; ++i)
+                            writer.uint32(/* id 2, wireType 2 =*/18).string(message.allowedIdentities[i]);
+                    if (message.allowedGroups != null && message.allowedGroups.length)
+                        for (var i = 0; i < message.allowedGroups.length; ++i)
+                            writer.uint32(/* id 3, wireType 2 =*/26).string(message.allowedGroups[i]);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes an ApprovalPolicy message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.core.ApprovalPolicy
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.core.ApprovalPolicy} ApprovalPolicy
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ApprovalPolicy.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.core.ApprovalPolicy();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.minApprovals = reader.uint32();
+                            break;
+                        case 2:
+                            if (!(message.allowedIdentities && message.allowedIdentities.length))
+                                message.allowedIdentities = [];
+                            message.allowedIdentities.push(reader.string());
+                            break;
+                        case 3:
+                            if (!(message.allowedGroups && message.allowedGroups.length))
+                                message.allowedGroups = [];
+                            message.allowedGroups.push(reader.string());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies an ApprovalPolicy message.
+                 * @function verify
+                 * @memberof flyteidl.core.ApprovalPolicy
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ApprovalPolicy.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.minApprovals != null && message.hasOwnProperty("minApprovals"))
+                        if (!$util.isInteger(message.minApprovals))
+                            return "minApprovals: integer expected";
+                    if (message.allowedIdentities != null && message.hasOwnProperty("allowedIdentities")) {
+                        if (!Array.isArray(message.allowedIdentities))
+                            return "allowedIdentities: array expected";
+                        for (var i = 0; i < message.allowedIdentities.length; ++i)
+                            if (!$util.isString(message.allowedIdentities[i]))
+                                return "allowedIdentities: string[] expected";
+                    }
+                    if (message.allowedGroups != null && message.hasOwnProperty("allowedGroups")) {
+                        if (!Array.isArray(message.allowedGroups))
+                            return "allowedGroups: array expected";
+                        for (var i = 0; i < message.allowedGroups.length; ++i)
+                            if (!$util.isString(message.allowedGroups[i]))
+                                return "allowedGroups: string[] expected";
+                    }
+                    return null;
+                };
+    
+                return ApprovalPolicy;
+            })();
+    
             core.SignalCondition = (function() {
     
                 /**
@@ -10793,6 +10972,837 @@
                 return SleepCondition;
             })();
     
+            core.GateTimeout = (function() {
+    
+                /**
+                 * Properties of a GateTimeout.
+                 * @memberof flyteidl.core
+                 * @interface IGateTimeout
+                 * @property {google.protobuf.IDuration|null} [duration] GateTimeout duration
+                 * @property {flyteidl.core.ILiteral|null} [defaultOutput] GateTimeout defaultOutput
+                 */
+    
+                /**
+                 * Constructs a new GateTimeout.
+                 * @memberof flyteidl.core
+                 * @classdesc Represents a GateTimeout.
+                 * @implements IGateTimeout
+                 * @constructor
+                 * @param {flyteidl.core.IGateTimeout=} [properties] Properties to set
+                 */
+                function GateTimeout(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * GateTimeout duration.
+                 * @member {google.protobuf.IDuration|null|undefined} duration
+                 * @memberof flyteidl.core.GateTimeout
+                 * @instance
+                 */
+                GateTimeout.prototype.duration = null;
+    
+                /**
+                 * GateTimeout defaultOutput.
+                 * @member {flyteidl.core.ILiteral|null|undefined} defaultOutput
+                 * @memberof flyteidl.core.GateTimeout
+                 * @instance
+                 */
+                GateTimeout.prototype.defaultOutput = null;
+    
+                /**
+                 * Creates a new GateTimeout instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.core.GateTimeout
+                 * @static
+                 * @param {flyteidl.core.IGateTimeout=} [properties] Properties to set
+                 * @returns {flyteidl.core.GateTimeout} GateTimeout instance
+                 */
+                GateTimeout.create = function create(properties) {
+                    return new GateTimeout(properties);
+                };
+    
+                /**
+                 * Encodes the specified GateTimeout message. Does not implicitly {@link flyteidl.core.GateTimeout.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.core.GateTimeout
+                 * @static
+                 * @param {flyteidl.core.IGateTimeout} message GateTimeout message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                GateTimeout.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.duration != null && message.hasOwnProperty("duration"))
+                        $root.google.protobuf.Duration.encode(message.duration, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.defaultOutput != null && message.hasOwnProperty("defaultOutput"))
+                        $root.flyteidl.core.Literal.encode(message.defaultOutput, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a GateTimeout message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.core.GateTimeout
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.core.GateTimeout} GateTimeout
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                GateTimeout.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.core.GateTimeout();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.duration = $root.google.protobuf.Duration.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.defaultOutput = $root.flyteidl.core.Literal.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a GateTimeout message.
+                 * @function verify
+                 * @memberof flyteidl.core.GateTimeout
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                GateTimeout.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.duration != null && message.hasOwnProperty("duration")) {
+                        var error = $root.google.protobuf.Duration.verify(message.duration);
+                        if (error)
+                            return "duration." + error;
+                    }
+                    if (message.defaultOutput != null && message.hasOwnProperty("defaultOutput")) {
+                        var error = $root.flyteidl.core.Literal.verify(message.defaultOutput);
+                        if (error)
+                            return "defaultOutput." + error;
+                    }
+                    return null;
+                };
+    
+                return GateTimeout;
+            })();
+    
+            core.WaitUntilCondition = (function() {
+    
+                /**
+                 * Properties of a WaitUntilCondition.
+                 * @memberof flyteidl.core
+                 * @interface IWaitUntilCondition
+                 * @property {string|null} [inputVariableName] WaitUntilCondition inputVariableName
+                 * @property {string|null} [outputVariableName] WaitUntilCondition outputVariableName
+                 * @property {flyteidl.core.IGateTimeout|null} [timeout] WaitUntilCondition timeout
+                 */
+    
+                /**
+                 * Constructs a new WaitUntilCondition.
+                 * @memberof flyteidl.core
+                 * @classdesc Represents a WaitUntilCondition.
+                 * @implements IWaitUntilCondition
+                 * @constructor
+                 * @param {flyteidl.core.IWaitUntilCondition=} [properties] Properties to set
+                 */
+                function WaitUntilCondition(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * WaitUntilCondition inputVariableName.
+                 * @member {string} inputVariableName
+                 * @memberof flyteidl.core.WaitUntilCondition
+                 * @instance
+                 */
+                WaitUntilCondition.prototype.inputVariableName = "";
+    
+                /**
+                 * WaitUntilCondition outputVariableName.
+                 * @member {string} outputVariableName
+                 * @memberof flyteidl.core.WaitUntilCondition
+                 * @instance
+                 */
+                WaitUntilCondition.prototype.outputVariableName = "";
+    
+                /**
+                 * WaitUntilCondition timeout.
+                 * @member {flyteidl.core.IGateTimeout|null|undefined} timeout
+                 * @memberof flyteidl.core.WaitUntilCondition
+                 * @instance
+                 */
+                WaitUntilCondition.prototype.timeout = null;
+    
+                /**
+                 * Creates a new WaitUntilCondition instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.core.WaitUntilCondition
+                 * @static
+                 * @param {flyteidl.core.IWaitUntilCondition=} [properties] Properties to set
+                 * @returns {flyteidl.core.WaitUntilCondition} WaitUntilCondition instance
+                 */
+                WaitUntilCondition.create = function create(properties) {
+                    return new WaitUntilCondition(properties);
+                };
+    
+                /**
+                 * Encodes the specified WaitUntilCondition message. Does not implicitly {@link flyteidl.core.WaitUntilCondition.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.core.WaitUntilCondition
+                 * @static
+                 * @param {flyteidl.core.IWaitUntilCondition} message WaitUntilCondition message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                WaitUntilCondition.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.inputVariableName != null && message.hasOwnProperty("inputVariableName"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.inputVariableName);
+                    if (message.outputVariableName != null && message.hasOwnProperty("outputVariableName"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.outputVariableName);
+                    if (message.timeout != null && message.hasOwnProperty("timeout"))
+                        $root.flyteidl.core.GateTimeout.encode(message.timeout, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a WaitUntilCondition message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.core.WaitUntilCondition
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.core.WaitUntilCondition} WaitUntilCondition
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                WaitUntilCondition.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.core.WaitUntilCondition();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.inputVariableName = reader.string();
+                            break;
+                        case 2:
+                            message.outputVariableName = reader.string();
+                            break;
+                        case 3:
+                            message.timeout = $root.flyteidl.core.GateTimeout.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a WaitUntilCondition message.
+                 * @function verify
+                 * @memberof flyteidl.core.WaitUntilCondition
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                WaitUntilCondition.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.inputVariableName != null && message.hasOwnProperty("inputVariableName"))
+                        if (!$util.isString(message.inputVariableName))
+                            return "inputVariableName: string expected";
+                    if (message.outputVariableName != null && message.hasOwnProperty("outputVariableName"))
+                        if (!$util.isString(message.outputVariableName))
+                            return "outputVariableName: string expected";
+                    if (message.timeout != null && message.hasOwnProperty("timeout")) {
+                        var error = $root.flyteidl.core.GateTimeout.verify(message.timeout);
+                        if (error)
+                            return "timeout." + error;
+                    }
+                    return null;
+                };
+    
+                return WaitUntilCondition;
+            })();
+    
+            core.StorageObjectCondition = (function() {
+    
+                /**
+                 * Properties of a StorageObjectCondition.
+                 * @memberof flyteidl.core
+                 * @interface IStorageObjectCondition
+                 * @property {string|null} [uri] StorageObjectCondition uri
+                 * @property {string|null} [uriInputVariableName] StorageObjectCondition uriInputVariableName
+                 */
+    
+                /**
+                 * Constructs a new StorageObjectCondition.
+                 * @memberof flyteidl.core
+                 * @classdesc Represents a StorageObjectCondition.
+                 * @implements IStorageObjectCondition
+                 * @constructor
+                 * @param {flyteidl.core.IStorageObjectCondition=} [properties] Properties to set
+                 */
+                function StorageObjectCondition(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * StorageObjectCondition uri.
+                 * @member {string} uri
+                 * @memberof flyteidl.core.StorageObjectCondition
+                 * @instance
+                 */
+                StorageObjectCondition.prototype.uri = "";
+    
+                /**
+                 * StorageObjectCondition uriInputVariableName.
+                 * @member {string} uriInputVariableName
+                 * @memberof flyteidl.core.StorageObjectCondition
+                 * @instance
+                 */
+                StorageObjectCondition.prototype.uriInputVariableName = "";
+    
+                // OneOf field names bound to virtual getters and setters
+                var $oneOfFields;
+    
+                /**
+                 * StorageObjectCondition uriSource.
+                 * @member {"uri"|"uriInputVariableName"|undefined} uriSource
+                 * @memberof flyteidl.core.StorageObjectCondition
+                 * @instance
+                 */
+                Object.defineProperty(StorageObjectCondition.prototype, "uriSource", {
+                    get: $util.oneOfGetter($oneOfFields = ["uri", "uriInputVariableName"]),
+                    set: $util.oneOfSetter($oneOfFields)
+                });
+    
+                /**
+                 * Creates a new StorageObjectCondition instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.core.StorageObjectCondition
+                 * @static
+                 * @param {flyteidl.core.IStorageObjectCondition=} [properties] Properties to set
+                 * @returns {flyteidl.core.StorageObjectCondition} StorageObjectCondition instance
+                 */
+                StorageObjectCondition.create = function create(properties) {
+                    return new StorageObjectCondition(properties);
+                };
+    
+                /**
+                 * Encodes the specified StorageObjectCondition message. Does not implicitly {@link flyteidl.core.StorageObjectCondition.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.core.StorageObjectCondition
+                 * @static
+                 * @param {flyteidl.core.IStorageObjectCondition} message StorageObjectCondition message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                StorageObjectCondition.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.uri != null && message.hasOwnProperty("uri"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.uri);
+                    if (message.uriInputVariableName != null && message.hasOwnProperty("uriInputVariableName"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.uriInputVariableName);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a StorageObjectCondition message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.core.StorageObjectCondition
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.core.StorageObjectCondition} StorageObjectCondition
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                StorageObjectCondition.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.core.StorageObjectCondition();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.uri = reader.string();
+                            break;
+                        case 2:
+                            message.uriInputVariableName = reader.string();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a StorageObjectCondition message.
+                 * @function verify
+                 * @memberof flyteidl.core.StorageObjectCondition
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                StorageObjectCondition.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    var properties = {};
+                    if (message.uri != null && message.hasOwnProperty("uri")) {
+                        properties.uriSource = 1;
+                        if (!$util.isString(message.uri))
+                            return "uri: string expected";
+                    }
+                    if (message.uriInputVariableName != null && message.hasOwnProperty("uriInputVariableName")) {
+                        if (properties.uriSource === 1)
+                            return "uriSource: multiple values";
+                        properties.uriSource = 1;
+                        if (!$util.isString(message.uriInputVariableName))
+                            return "uriInputVariableName: string expected";
+                    }
+                    return null;
+                };
+    
+                return StorageObjectCondition;
+            })();
+    
+            core.CatalogArtifactCondition = (function() {
+    
+                /**
+                 * Properties of a CatalogArtifactCondition.
+                 * @memberof flyteidl.core
+                 * @interface ICatalogArtifactCondition
+                 * @property {string|null} [project] CatalogArtifactCondition project
+                 * @property {string|null} [domain] CatalogArtifactCondition domain
+                 * @property {string|null} [name] CatalogArtifactCondition name
+                 * @property {string|null} [version] CatalogArtifactCondition version
+                 * @property {string|null} [tag] CatalogArtifactCondition tag
+                 */
+    
+                /**
+                 * Constructs a new CatalogArtifactCondition.
+                 * @memberof flyteidl.core
+                 * @classdesc Represents a CatalogArtifactCondition.
+                 * @implements ICatalogArtifactCondition
+                 * @constructor
+                 * @param {flyteidl.core.ICatalogArtifactCondition=} [properties] Properties to set
+                 */
+                function CatalogArtifactCondition(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * CatalogArtifactCondition project.
+                 * @member {string} project
+                 * @memberof flyteidl.core.CatalogArtifactCondition
+                 * @instance
+                 */
+                CatalogArtifactCondition.prototype.project = "";
+    
+                /**
+                 * CatalogArtifactCondition domain.
+                 * @member {string} domain
+                 * @memberof flyteidl.core.CatalogArtifactCondition
+                 * @instance
+                 */
+                CatalogArtifactCondition.prototype.domain = "";
+    
+                /**
+                 * CatalogArtifactCondition name.
+                 * @member {string} name
+                 * @memberof flyteidl.core.CatalogArtifactCondition
+                 * @instance
+                 */
+                CatalogArtifactCondition.prototype.name = "";
+    
+                /**
+                 * CatalogArtifactCondition version.
+                 * @member {string} version
+                 * @memberof flyteidl.core.CatalogArtifactCondition
+                 * @instance
+                 */
+                CatalogArtifactCondition.prototype.version = "";
+    
+                /**
+                 * CatalogArtifactCondition tag.
+                 * @member {string} tag
+                 * @memberof flyteidl.core.CatalogArtifactCondition
+                 * @instance
+                 */
+                CatalogArtifactCondition.prototype.tag = "";
+    
+                /**
+                 * Creates a new CatalogArtifactCondition instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.core.CatalogArtifactCondition
+                 * @static
+                 * @param {flyteidl.core.ICatalogArtifactCondition=} [properties] Properties to set
+                 * @returns {flyteidl.core.CatalogArtifactCondition} CatalogArtifactCondition instance
+                 */
+                CatalogArtifactCondition.create = function create(properties) {
+                    return new CatalogArtifactCondition(properties);
+                };
+    
+                /**
+                 * Encodes the specified CatalogArtifactCondition message. Does not implicitly {@link flyteidl.core.CatalogArtifactCondition.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.core.CatalogArtifactCondition
+                 * @static
+                 * @param {flyteidl.core.ICatalogArtifactCondition} message CatalogArtifactCondition message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                CatalogArtifactCondition.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.project != null && message.hasOwnProperty("project"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.project);
+                    if (message.domain != null && message.hasOwnProperty("domain"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.domain);
+                    if (message.name != null && message.hasOwnProperty("name"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.name);
+                    if (message.version != null && message.hasOwnProperty("version"))
+                        writer.uint32(/* id 4, wireType 2 =*/34).string(message.version);
+                    if (message.tag != null && message.hasOwnProperty("tag"))
+                        writer.uint32(/* id 5, wireType 2 =*/42).string(message.tag);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a CatalogArtifactCondition message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.core.CatalogArtifactCondition
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.core.CatalogArtifactCondition} CatalogArtifactCondition
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                CatalogArtifactCondition.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.core.CatalogArtifactCondition();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.project = reader.string();
+                            break;
+                        case 2:
+                            message.domain = reader.string();
+                            break;
+                        case 3:
+                            message.name = reader.string();
+                            break;
+                        case 4:
+                            message.version = reader.string();
+                            break;
+                        case 5:
+                            message.tag = reader.string();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a CatalogArtifactCondition message.
+                 * @function verify
+                 * @memberof flyteidl.core.CatalogArtifactCondition
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                CatalogArtifactCondition.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.project != null && message.hasOwnProperty("project"))
+                        if (!$util.isString(message.project))
+                            return "project: string expected";
+                    if (message.domain != null && message.hasOwnProperty("domain"))
+                        if (!$util.isString(message.domain))
+                            return "domain: string expected";
+                    if (message.name != null && message.hasOwnProperty("name"))
+                        if (!$util.isString(message.name))
+                            return "name: string expected";
+                    if (message.version != null && message.hasOwnProperty("version"))
+                        if (!$util.isString(message.version))
+                            return "version: string expected";
+                    if (message.tag != null && message.hasOwnProperty("tag"))
+                        if (!$util.isString(message.tag))
+                            return "tag: string expected";
+                    return null;
+                };
+    
+                return CatalogArtifactCondition;
+            })();
+    
+            core.ExternalCondition = (function() {
+    
+                /**
+                 * Properties of an ExternalCondition.
+                 * @memberof flyteidl.core
+                 * @interface IExternalCondition
+                 * @property {flyteidl.core.IStorageObjectCondition|null} [storageObject] ExternalCondition storageObject
+                 * @property {flyteidl.core.ICatalogArtifactCondition|null} [catalogArtifact] ExternalCondition catalogArtifact
+                 * @property {google.protobuf.IDuration|null} [pollInterval] ExternalCondition pollInterval
+                 * @property {google.protobuf.IDuration|null} [maxPollInterval] ExternalCondition maxPollInterval
+                 * @property {string|null} [outputVariableName] ExternalCondition outputVariableName
+                 * @property {flyteidl.core.IGateTimeout|null} [timeout] ExternalCondition timeout
+                 */
+    
+                /**
+                 * Constructs a new ExternalCondition.
+                 * @memberof flyteidl.core
+                 * @classdesc Represents an ExternalCondition.
+                 * @implements IExternalCondition
+                 * @constructor
+                 * @param {flyteidl.core.IExternalCondition=} [properties] Properties to set
+                 */
+                function ExternalCondition(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ExternalCondition storageObject.
+                 * @member {flyteidl.core.IStorageObjectCondition|null|undefined} storageObject
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @instance
+                 */
+                ExternalCondition.prototype.storageObject = null;
+    
+                /**
+                 * ExternalCondition catalogArtifact.
+                 * @member {flyteidl.core.ICatalogArtifactCondition|null|undefined} catalogArtifact
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @instance
+                 */
+                ExternalCondition.prototype.catalogArtifact = null;
+    
+                /**
+                 * ExternalCondition pollInterval.
+                 * @member {google.protobuf.IDuration|null|undefined} pollInterval
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @instance
+                 */
+                ExternalCondition.prototype.pollInterval = null;
+    
+                /**
+                 * ExternalCondition maxPollInterval.
+                 * @member {google.protobuf.IDuration|null|undefined} maxPollInterval
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @instance
+                 */
+                ExternalCondition.prototype.maxPollInterval = null;
+    
+                /**
+                 * ExternalCondition outputVariableName.
+                 * @member {string} outputVariableName
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @instance
+                 */
+                ExternalCondition.prototype.outputVariableName = "";
+    
+                /**
+                 * ExternalCondition timeout.
+                 * @member {flyteidl.core.IGateTimeout|null|undefined} timeout
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @instance
+                 */
+                ExternalCondition.prototype.timeout = null;
+    
+                // OneOf field names bound to virtual getters and setters
+                var $oneOfFields;
+    
+                /**
+                 * ExternalCondition condition.
+                 * @member {"storageObject"|"catalogArtifact"|undefined} condition
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @instance
+                 */
+                Object.defineProperty(ExternalCondition.prototype, "condition", {
+                    get: $util.oneOfGetter($oneOfFields = ["storageObject", "catalogArtifact"]),
+                    set: $util.oneOfSetter($oneOfFields)
+                });
+    
+                /**
+                 * Creates a new ExternalCondition instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @static
+                 * @param {flyteidl.core.IExternalCondition=} [properties] Properties to set
+                 * @returns {flyteidl.core.ExternalCondition} ExternalCondition instance
+                 */
+                ExternalCondition.create = function create(properties) {
+                    return new ExternalCondition(properties);
+                };
+    
+                /**
+                 * Encodes the specified ExternalCondition message. Does not implicitly {@link flyteidl.core.ExternalCondition.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @static
+                 * @param {flyteidl.core.IExternalCondition} message ExternalCondition message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ExternalCondition.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.storageObject != null && message.hasOwnProperty("storageObject"))
+                        $root.flyteidl.core.StorageObjectCondition.encode(message.storageObject, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.catalogArtifact != null && message.hasOwnProperty("catalogArtifact"))
+                        $root.flyteidl.core.CatalogArtifactCondition.encode(message.catalogArtifact, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.pollInterval != null && message.hasOwnProperty("pollInterval"))
+                        $root.google.protobuf.Duration.encode(message.pollInterval, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.maxPollInterval != null && message.hasOwnProperty("maxPollInterval"))
+                        $root.google.protobuf.Duration.encode(message.maxPollInterval, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.outputVariableName != null && message.hasOwnProperty("outputVariableName"))
+                        writer.uint32(/* id 5, wireType 2 =*/42).string(message.outputVariableName);
+                    if (message.timeout != null && message.hasOwnProperty("timeout"))
+                        $root.flyteidl.core.GateTimeout.encode(message.timeout, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes an ExternalCondition message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.core.ExternalCondition} ExternalCondition
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ExternalCondition.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.core.ExternalCondition();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.storageObject = $root.flyteidl.core.StorageObjectCondition.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.catalogArtifact = $root.flyteidl.core.CatalogArtifactCondition.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.pollInterval = $root.google.protobuf.Duration.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.maxPollInterval = $root.google.protobuf.Duration.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            message.outputVariableName = reader.string();
+                            break;
+                        case 6:
+                            message.timeout = $root.flyteidl.core.GateTimeout.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies an ExternalCondition message.
+                 * @function verify
+                 * @memberof flyteidl.core.ExternalCondition
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ExternalCondition.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    var properties = {};
+                    if (message.storageObject != null && message.hasOwnProperty("storageObject")) {
+                        properties.condition = 1;
+                        {
+                            var error = $root.flyteidl.core.StorageObjectCondition.verify(message.storageObject);
+                            if (error)
+                                return "storageObject." + error;
+                        }
+                    }
+                    if (message.catalogArtifact != null && message.hasOwnProperty("catalogArtifact")) {
+                        if (properties.condition === 1)
+                            return "condition: multiple values";
+                        properties.condition = 1;
+                        {
+                            var error = $root.flyteidl.core.CatalogArtifactCondition.verify(message.catalogArtifact);
+                            if (error)
+                                return "catalogArtifact." + error;
+                        }
+                    }
+                    if (message.pollInterval != null && message.hasOwnProperty("pollInterval")) {
+                        var error = $root.google.protobuf.Duration.verify(message.pollInterval);
+                        if (error)
+                            return "pollInterval." + error;
+                    }
+                    if (message.maxPollInterval != null && message.hasOwnProperty("maxPollInterval")) {
+                        var error = $root.google.protobuf.Duration.verify(message.maxPollInterval);
+                        if (error)
+                            return "maxPollInterval." + error;
+                    }
+                    if (message.outputVariableName != null && message.hasOwnProperty("outputVariableName"))
+                        if (!$util.isString(message.outputVariableName))
+                            return "outputVariableName: string expected";
+                    if (message.timeout != null && message.hasOwnProperty("timeout")) {
+                        var error = $root.flyteidl.core.GateTimeout.verify(message.timeout);
+                        if (error)
+                            return "timeout." + error;
+                    }
+                    return null;
+                };
+    
+                return ExternalCondition;
+            })();
+    
             core.GateNode = (function() {
     
                 /**
@@ -10802,6 +11812,8 @@
                  * @property {flyteidl.core.IApproveCondition|null} [approve] GateNode approve
                  * @property {flyteidl.core.ISignalCondition|null} [signal] GateNode signal
                  * @property {flyteidl.core.ISleepCondition|null} [sleep] GateNode sleep
+                 * @property {flyteidl.core.IWaitUntilCondition|null} [waitUntil] GateNode waitUntil
+                 * @property {flyteidl.core.IExternalCondition|null} [external] GateNode external
                  */
     
                 /**
@@ -10843,17 +11855,33 @@
                  */
                 GateNode.prototype.sleep = null;
     
+                /**
+                 * GateNode waitUntil.
+                 * @member {flyteidl.core.IWaitUntilCondition|null|undefined} waitUntil
+                 * @memberof flyteidl.core.GateNode
+                 * @instance
+                 */
+                GateNode.prototype.waitUntil = null;
+    
+                /**
+                 * GateNode external.
+                 * @member {flyteidl.core.IExternalCondition|null|undefined} external
+                 * @memberof flyteidl.core.GateNode
+                 * @instance
+                 */
+                GateNode.prototype.external = null;
+    
                 // OneOf field names bound to virtual getters and setters
                 var $oneOfFields;
     
                 /**
                  * GateNode condition.
-                 * @member {"approve"|"signal"|"sleep"|undefined} condition
+                 * @member {"approve"|"signal"|"sleep"|"waitUntil"|"external"|undefined} condition
                  * @memberof flyteidl.core.GateNode
                  * @instance
                  */
                 Object.defineProperty(GateNode.prototype, "condition", {
-                    get: $util.oneOfGetter($oneOfFields = ["approve", "signal", "sleep"]),
+                    get: $util.oneOfGetter($oneOfFields = ["approve", "signal", "sleep", "waitUntil", "external"]),
                     set: $util.oneOfSetter($oneOfFields)
                 });
     
@@ -10887,6 +11915,10 @@
                         $root.flyteidl.core.SignalCondition.encode(message.signal, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
                     if (message.sleep != null && message.hasOwnProperty("sleep"))
                         $root.flyteidl.core.SleepCondition.encode(message.sleep, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.waitUntil != null && message.hasOwnProperty("waitUntil"))
+                        $root.flyteidl.core.WaitUntilCondition.encode(message.waitUntil, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.external != null && message.hasOwnProperty("external"))
+                        $root.flyteidl.core.ExternalCondition.encode(message.external, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
                     return writer;
                 };
     
@@ -10917,6 +11949,12 @@
                         case 3:
                             message.sleep = $root.flyteidl.core.SleepCondition.decode(reader, reader.uint32());
                             break;
+                        case 4:
+                            message.waitUntil = $root.flyteidl.core.WaitUntilCondition.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            message.external = $root.flyteidl.core.ExternalCondition.decode(reader, reader.uint32());
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -10965,6 +12003,26 @@
                                 return "sleep." + error;
                         }
                     }
+                    if (message.waitUntil != null && message.hasOwnProperty("waitUntil")) {
+                        if (properties.condition === 1)
+                            return "condition: multiple values";
+                        properties.condition = 1;
+                        {
+                            var error = $root.flyteidl.core.WaitUntilCondition.verify(message.waitUntil);
+                            if (error)
+                                return "waitUntil." + error;
+                        }
+                    }
+                    if (message.external != null && message.hasOwnProperty("external")) {
+                        if (properties.condition === 1)
+                            return "condition: multiple values";
+                        properties.condition = 1;
+                        {
+                            var error = $root.flyteidl.core.ExternalCondition.verify(message.external);
+                            if (error)
+                                return "external." + error;
+                        }
+                    }
                     return null;
                 };
     
@@ -10982,6 +12040,10 @@
                  * @property {number|null} [minSuccesses] ArrayNode minSuccesses
                  * @property {number|null} [minSuccessRatio] ArrayNode minSuccessRatio
                  * @property {flyteidl.core.ArrayNode.ExecutionMode|null} [executionMode] ArrayNode executionMode
+                 * @property {flyteidl.core.ArrayNode.IPartialSuccess|null} [partialSuccess] ArrayNode partialSuccess
+                 * @property {number|null} [retryBudget] ArrayNode retryBudget
+                 * @property {number|null} [maxFailures] ArrayNode maxFailures
+                 * @property {boolean|null} [adaptiveParallelism] ArrayNode adaptiveParallelism
                  */
     
                 /**
@@ -11039,6 +12101,38 @@
                  */
                 ArrayNode.prototype.executionMode = 0;
     
+                /**
+                 * ArrayNode partialSuccess.
+                 * @member {flyteidl.core.ArrayNode.IPartialSuccess|null|undefined} partialSuccess
+                 * @memberof flyteidl.core.ArrayNode
+                 * @instance
+                 */
+                ArrayNode.prototype.partialSuccess = null;
+    
+                /**
+                 * ArrayNode retryBudget.
+                 * @member {number} retryBudget
+                 * @memberof flyteidl.core.ArrayNode
+                 * @instance
+                 */
+                ArrayNode.prototype.retryBudget = 0;
+    
+                /**
+                 * ArrayNode maxFailures.
+                 * @member {number} maxFailures
+                 * @memberof flyteidl.core.ArrayNode
+                 * @instance
+                 */
+                ArrayNode.prototype.maxFailures = 0;
+    
+                /**
+                 * ArrayNode adaptiveParallelism.
+                 * @member {boolean} adaptiveParallelism
+                 * @memberof flyteidl.core.ArrayNode
+                 * @instance
+                 */
+                ArrayNode.prototype.adaptiveParallelism = false;
+    
                 // OneOf field names bound to virtual getters and setters
                 var $oneOfFields;
     
@@ -11064,6 +12158,17 @@
                     set: $util.oneOfSetter($oneOfFields)
                 });
     
+                /**
+                 * ArrayNode retryBudgetOption.
+                 * @member {"retryBudget"|undefined} retryBudgetOption
+                 * @memberof flyteidl.core.ArrayNode
+                 * @instance
+                 */
+                Object.defineProperty(ArrayNode.prototype, "retryBudgetOption", {
+                    get: $util.oneOfGetter($oneOfFields = ["retryBudget"]),
+                    set: $util.oneOfSetter($oneOfFields)
+                });
+    
                 /**
                  * Creates a new ArrayNode instance using the specified properties.
                  * @function create
@@ -11098,6 +12203,14 @@
                         writer.uint32(/* id 4, wireType 5 =*/37).float(message.minSuccessRatio);
                     if (message.executionMode != null && message.hasOwnProperty("executionMode"))
                         writer.uint32(/* id 5, wireType 0 =*/40).int32(message.executionMode);
+                    if (message.partialSuccess != null && message.hasOwnProperty("partialSuccess"))
+                        $root.flyteidl.core.ArrayNode.PartialSuccess.encode(message.partialSuccess, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
+                    if (message.retryBudget != null && message.hasOwnProperty("retryBudget"))
+                        writer.uint32(/* id 7, wireType 0 =*/56).uint32(message.retryBudget);
+                    if (message.maxFailures != null && message.hasOwnProperty("maxFailures"))
+                        writer.uint32(/* id 8, wireType 0 =*/64).uint32(message.maxFailures);
+                    if (message.adaptiveParallelism != null && message.hasOwnProperty("adaptiveParallelism"))
+                        writer.uint32(/* id 9, wireType 0 =*/72).bool(message.adaptiveParallelism);
                     return writer;
                 };
     
@@ -11134,6 +12247,18 @@
                         case 5:
                             message.executionMode = reader.int32();
                             break;
+                        case 6:
+                            message.partialSuccess = $root.flyteidl.core.ArrayNode.PartialSuccess.decode(reader, reader.uint32());
+                            break;
+                        case 7:
+                            message.retryBudget = reader.uint32();
+                            break;
+                        case 8:
+                            message.maxFailures = reader.uint32();
+                            break;
+                        case 9:
+                            message.adaptiveParallelism = reader.bool();
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -11184,6 +12309,22 @@
                         case 1:
                             break;
                         }
+                    if (message.partialSuccess != null && message.hasOwnProperty("partialSuccess")) {
+                        var error = $root.flyteidl.core.ArrayNode.PartialSuccess.verify(message.partialSuccess);
+                        if (error)
+                            return "partialSuccess." + error;
+                    }
+                    if (message.retryBudget != null && message.hasOwnProperty("retryBudget")) {
+                        properties.retryBudgetOption = 1;
+                        if (!$util.isInteger(message.retryBudget))
+                            return "retryBudget: integer expected";
+                    }
+                    if (message.maxFailures != null && message.hasOwnProperty("maxFailures"))
+                        if (!$util.isInteger(message.maxFailures))
+                            return "maxFailures: integer expected";
+                    if (message.adaptiveParallelism != null && message.hasOwnProperty("adaptiveParallelism"))
+                        if (typeof message.adaptiveParallelism !== "boolean")
+                            return "adaptiveParallelism: boolean expected";
                     return null;
                 };
     
@@ -11201,6 +12342,116 @@
                     return values;
                 })();
     
+                ArrayNode.PartialSuccess = (function() {
+    
+                    /**
+                     * Properties of a PartialSuccess.
+                     * @memberof flyteidl.core.ArrayNode
+                     * @interface IPartialSuccess
+                     * @property {string|null} [errorsOutput] PartialSuccess errorsOutput
+                     */
+    
+                    /**
+                     * Constructs a new PartialSuccess.
+                     * @memberof flyteidl.core.ArrayNode
+                     * @classdesc Represents a PartialSuccess.
+                     * @implements IPartialSuccess
+                     * @constructor
+                     * @param {flyteidl.core.ArrayNode.IPartialSuccess=} [properties] Properties to set
+                     */
+                    function PartialSuccess(properties) {
+                        if (properties)
+                            for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                                if (properties[keys[i]] != null)
+                                    this[keys[i]] = properties[keys[i]];
+                    }
+    
+                    /**
+                     * PartialSuccess errorsOutput.
+                     * @member {string} errorsOutput
+                     * @memberof flyteidl.core.ArrayNode.PartialSuccess
+                     * @instance
+                     */
+                    PartialSuccess.prototype.errorsOutput = "";
+    
+                    /**
+                     * Creates a new PartialSuccess instance using the specified properties.
+                     * @function create
+                     * @memberof flyteidl.core.ArrayNode.PartialSuccess
+                     * @static
+                     * @param {flyteidl.core.ArrayNode.IPartialSuccess=} [properties] Properties to set
+                     * @returns {flyteidl.core.ArrayNode.PartialSuccess} PartialSuccess instance
+                     */
+                    PartialSuccess.create = function create(properties) {
+                        return new PartialSuccess(properties);
+                    };
+    
+                    /**
+                     * Encodes the specified PartialSuccess message. Does not implicitly {@link flyteidl.core.ArrayNode.PartialSuccess.verify|verify} messages.
+                     * @function encode
+                     * @memberof flyteidl.core.ArrayNode.PartialSuccess
+                     * @static
+                     * @param {flyteidl.core.ArrayNode.IPartialSuccess} message PartialSuccess message or plain object to encode
+                     * @param {$protobuf.Writer} [writer] Writer to encode to
+                     * @returns {$protobuf.Writer} Writer
+                     */
+                    PartialSuccess.encode = function encode(message, writer) {
+                        if (!writer)
+                            writer = $Writer.create();
+                        if (message.errorsOutput != null && message.hasOwnProperty("errorsOutput"))
+                            writer.uint32(/* id 1, wireType 2 =*/10).string(message.errorsOutput);
+                        return writer;
+                    };
+    
+                    /**
+                     * Decodes a PartialSuccess message from the specified reader or buffer.
+                     * @function decode
+                     * @memberof flyteidl.core.ArrayNode.PartialSuccess
+                     * @static
+                     * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                     * @param {number} [length] Message length if known beforehand
+                     * @returns {flyteidl.core.ArrayNode.PartialSuccess} PartialSuccess
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    PartialSuccess.decode = function decode(reader, length) {
+                        if (!(reader instanceof $Reader))
+                            reader = $Reader.create(reader);
+                        var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.core.ArrayNode.PartialSuccess();
+                        while (reader.pos < end) {
+                            var tag = reader.uint32();
+                            switch (tag >>> 3) {
+                            case 1:
+                                message.errorsOutput = reader.string();
+                                break;
+                            default:
+                                reader.skipType(tag & 7);
+                                break;
+                            }
+                        }
+                        return message;
+                    };
+    
+                    /**
+                     * Verifies a PartialSuccess message.
+                     * @function verify
+                     * @memberof flyteidl.core.ArrayNode.PartialSuccess
+                     * @static
+                     * @param {Object.<string,*>} message Plain object to verify
+                     * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                     */
+                    PartialSuccess.verify = function verify(message) {
+                        if (typeof message !== "object" || message === null)
+                            return "object expected";
+                        if (message.errorsOutput != null && message.hasOwnProperty("errorsOutput"))
+                            if (!$util.isString(message.errorsOutput))
+                                return "errorsOutput: string expected";
+                        return null;
+                    };
+    
+                    return PartialSuccess;
+                })();
+    
                 return ArrayNode;
             })();
     
@@ -12910,6 +14161,13 @@
                         case 3:
                         case 4:
                         case 5:
+                        case 6:
+                        case 7:
+                        case 8:
+                        case 9:
+                        case 10:
+                        case 11:
+                        case 12:
                             break;
                         }
                     if (message.leftValue != null && message.hasOwnProperty("leftValue")) {
@@ -12935,6 +14193,13 @@
                  * @property {number} GTE=3 GTE value
                  * @property {number} LT=4 LT value
                  * @property {number} LTE=5 LTE value
+                 * @property {number} IN=6 IN value
+                 * @property {number} NOT_IN=7 NOT_IN value
+                 * @property {number} STARTS_WITH=8 STARTS_WITH value
+                 * @property {number} ENDS_WITH=9 ENDS_WITH value
+                 * @property {number} MATCHES=10 MATCHES value
+                 * @property {number} IS_NULL=11 IS_NULL value
+                 * @property {number} IS_NOT_NULL=12 IS_NOT_NULL value
                  */
                 ComparisonExpression.Operator = (function() {
                     var valuesById = {}, values = Object.create(valuesById);
@@ -12944,6 +14209,13 @@
                     values[valuesById[3] = "GTE"] = 3;
                     values[valuesById[4] = "LT"] = 4;
                     values[valuesById[5] = "LTE"] = 5;
+                    values[valuesById[6] = "IN"] = 6;
+                    values[valuesById[7] = "NOT_IN"] = 7;
+                    values[valuesById[8] = "STARTS_WITH"] = 8;
+                    values[valuesById[9] = "ENDS_WITH"] = 9;
+                    values[valuesById[10] = "MATCHES"] = 10;
+                    values[valuesById[11] = "IS_NULL"] = 11;
+                    values[valuesById[12] = "IS_NOT_NULL"] = 12;
                     return values;
                 })();
     
@@ -12959,6 +14231,8 @@
                  * @property {flyteidl.core.IPrimitive|null} [primitive] Operand primitive
                  * @property {string|null} ["var"] Operand var
                  * @property {flyteidl.core.IScalar|null} [scalar] Operand scalar
+                 * @property {flyteidl.core.ILiteralCollection|null} [collection] Operand collection
+                 * @property {Array.<flyteidl.core.IPromiseAttribute>|null} [attrPath] Operand attrPath
                  */
     
                 /**
@@ -12970,6 +14244,7 @@
                  * @param {flyteidl.core.IOperand=} [properties] Properties to set
                  */
                 function Operand(properties) {
+                    this.attrPath = [];
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -13000,17 +14275,33 @@
                  */
                 Operand.prototype.scalar = null;
     
+                /**
+                 * Operand collection.
+                 * @member {flyteidl.core.ILiteralCollection|null|undefined} collection
+                 * @memberof flyteidl.core.Operand
+                 * @instance
+                 */
+                Operand.prototype.collection = null;
+    
+                /**
+                 * Operand attrPath.
+                 * @member {Array.<flyteidl.core.IPromiseAttribute>} attrPath
+                 * @memberof flyteidl.core.Operand
+                 * @instance
+                 */
+                Operand.prototype.attrPath = $util.emptyArray;
+    
                 // OneOf field names bound to virtual getters and setters
                 var $oneOfFields;
     
                 /**
                  * Operand val.
-                 * @member {"primitive"|"var"|"scalar"|undefined} val
+                 * @member {"primitive"|"var"|"scalar"|"collection"|undefined} val
                  * @memberof flyteidl.core.Operand
                  * @instance
                  */
                 Object.defineProperty(Operand.prototype, "val", {
-                    get: $util.oneOfGetter($oneOfFields = ["primitive", "var", "scalar"]),
+                    get: $util.oneOfGetter($oneOfFields = ["primitive", "var", "scalar", "collection"]),
                     set: $util.oneOfSetter($oneOfFields)
                 });
     
@@ -13044,6 +14335,11 @@
                         writer.uint32(/* id 2, wireType 2 =*/18).string(message["var"]);
                     if (message.scalar != null && message.hasOwnProperty("scalar"))
                         $root.flyteidl.core.Scalar.encode(message.scalar, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.collection != null && message.hasOwnProperty("collection"))
+                        $root.flyteidl.core.LiteralCollection.encode(message.collection, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.attrPath != null && message.attrPath.length)
+                        for (var i = 0; i < message.attrPath.length; ++i)
+                            $root.flyteidl.core.PromiseAttribute.encode(message.attrPath[i], writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
                     return writer;
                 };
     
@@ -13074,6 +14370,14 @@
                         case 3:
                             message.scalar = $root.flyteidl.core.Scalar.decode(reader, reader.uint32());
                             break;
+                        case 4:
+                            message.collection = $root.flyteidl.core.LiteralCollection.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            if (!(message.attrPath && message.attrPath.length))
+                                message.attrPath = [];
+                            message.attrPath.push($root.flyteidl.core.PromiseAttribute.decode(reader, reader.uint32()));
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -13119,6 +14423,25 @@
                                 return "scalar." + error;
                         }
                     }
+                    if (message.collection != null && message.hasOwnProperty("collection")) {
+                        if (properties.val === 1)
+                            return "val: multiple values";
+                        properties.val = 1;
+                        {
+                            var error = $root.flyteidl.core.LiteralCollection.verify(message.collection);
+                            if (error)
+                                return "collection." + error;
+                        }
+                    }
+                    if (message.attrPath != null && message.hasOwnProperty("attrPath")) {
+                        if (!Array.isArray(message.attrPath))
+                            return "attrPath: array expected";
+                        for (var i = 0; i < message.attrPath.length; ++i) {
+                            var error = $root.flyteidl.core.PromiseAttribute.verify(message.attrPath[i]);
+                            if (error)
+                                return "attrPath." + error;
+                        }
+                    }
                     return null;
                 };
     
@@ -13133,6 +14456,7 @@
                  * @interface IBooleanExpression
                  * @property {flyteidl.core.IConjunctionExpression|null} [conjunction] BooleanExpression conjunction
                  * @property {flyteidl.core.IComparisonExpression|null} [comparison] BooleanExpression comparison
+                 * @property {flyteidl.core.INegationExpression|null} [negation] BooleanExpression negation
                  */
     
                 /**
@@ -13166,17 +14490,25 @@
                  */
                 BooleanExpression.prototype.comparison = null;
     
+                /**
+                 * BooleanExpression negation.
+                 * @member {flyteidl.core.INegationExpression|null|undefined} negation
+                 * @memberof flyteidl.core.BooleanExpression
+                 * @instance
+                 */
+                BooleanExpression.prototype.negation = null;
+    
                 // OneOf field names bound to virtual getters and setters
                 var $oneOfFields;
     
                 /**
                  * BooleanExpression expr.
-                 * @member {"conjunction"|"comparison"|undefined} expr
+                 * @member {"conjunction"|"comparison"|"negation"|undefined} expr
                  * @memberof flyteidl.core.BooleanExpression
                  * @instance
                  */
                 Object.defineProperty(BooleanExpression.prototype, "expr", {
-                    get: $util.oneOfGetter($oneOfFields = ["conjunction", "comparison"]),
+                    get: $util.oneOfGetter($oneOfFields = ["conjunction", "comparison", "negation"]),
                     set: $util.oneOfSetter($oneOfFields)
                 });
     
@@ -13208,6 +14540,8 @@
                         $root.flyteidl.core.ConjunctionExpression.encode(message.conjunction, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
                     if (message.comparison != null && message.hasOwnProperty("comparison"))
                         $root.flyteidl.core.ComparisonExpression.encode(message.comparison, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.negation != null && message.hasOwnProperty("negation"))
+                        $root.flyteidl.core.NegationExpression.encode(message.negation, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
                     return writer;
                 };
     
@@ -13235,6 +14569,9 @@
                         case 2:
                             message.comparison = $root.flyteidl.core.ComparisonExpression.decode(reader, reader.uint32());
                             break;
+                        case 3:
+                            message.negation = $root.flyteidl.core.NegationExpression.decode(reader, reader.uint32());
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -13273,12 +14610,134 @@
                                 return "comparison." + error;
                         }
                     }
+                    if (message.negation != null && message.hasOwnProperty("negation")) {
+                        if (properties.expr === 1)
+                            return "expr: multiple values";
+                        properties.expr = 1;
+                        {
+                            var error = $root.flyteidl.core.NegationExpression.verify(message.negation);
+                            if (error)
+                                return "negation." + error;
+                        }
+                    }
                     return null;
                 };
     
                 return BooleanExpression;
             })();
     
+            core.NegationExpression = (function() {
+    
+                /**
+                 * Properties of a NegationExpression.
+                 * @memberof flyteidl.core
+                 * @interface INegationExpression
+                 * @property {flyteidl.core.IBooleanExpression|null} [expression] NegationExpression expression
+                 */
+    
+                /**
+                 * Constructs a new NegationExpression.
+                 * @memberof flyteidl.core
+                 * @classdesc Represents a NegationExpression.
+                 * @implements INegationExpression
+                 * @constructor
+                 * @param {flyteidl.core.INegationExpression=} [properties] Properties to set
+                 */
+                function NegationExpression(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * NegationExpression expression.
+                 * @member {flyteidl.core.IBooleanExpression|null|undefined} expression
+                 * @memberof flyteidl.core.NegationExpression
+                 * @instance
+                 */
+                NegationExpression.prototype.expression = null;
+    
+                /**
+                 * Creates a new NegationExpression instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.core.NegationExpression
+                 * @static
+                 * @param {flyteidl.core.INegationExpression=} [properties] Properties to set
+                 * @returns {flyteidl.core.NegationExpression} NegationExpression instance
+                 */
+                NegationExpression.create = function create(properties) {
+                    return new NegationExpression(properties);
+                };
+    
+                /**
+                 * Encodes the specified NegationExpression message. Does not implicitly {@link flyteidl.core.NegationExpression.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.core.NegationExpression
+                 * @static
+                 * @param {flyteidl.core.INegationExpression} message NegationExpression message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                NegationExpression.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.expression != null && message.hasOwnProperty("expression"))
+                        $root.flyteidl.core.BooleanExpression.encode(message.expression, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a NegationExpression message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.core.NegationExpression
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.core.NegationExpression} NegationExpression
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                NegationExpression.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.core.NegationExpression();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.expression = $root.flyteidl.core.BooleanExpression.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a NegationExpression message.
+                 * @function verify
+                 * @memberof flyteidl.core.NegationExpression
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                NegationExpression.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.expression != null && message.hasOwnProperty("expression")) {
+                        var error = $root.flyteidl.core.BooleanExpression.verify(message.expression);
+                        if (error)
+                            return "expression." + error;
+                    }
+                    return null;
+                };
+    
+                return NegationExpression;
+            })();
+    
             core.ConjunctionExpression = (function() {
     
                 /**
@@ -22852,6 +24311,9 @@
                  * @property {Array.<flyteidl.event.IExternalResourceInfo>|null} [externalResources] TaskExecutionMetadata externalResources
                  * @property {Array.<flyteidl.event.IResourcePoolInfo>|null} [resourcePoolInfo] TaskExecutionMetadata resourcePoolInfo
                  * @property {string|null} [pluginIdentifier] TaskExecutionMetadata pluginIdentifier
+                 * @property {Array.<flyteidl.core.Resources.IResourceEntry>|null} [peakResourceUsage] TaskExecutionMetadata peakResourceUsage
+                 * @property {flyteidl.core.IResources|null} [escalatedResources] TaskExecutionMetadata escalatedResources
+                 * @property {boolean|null} [preempted] TaskExecutionMetadata preempted
                  * @property {flyteidl.event.TaskExecutionMetadata.InstanceClass|null} [instanceClass] TaskExecutionMetadata instanceClass
                  */
     
@@ -22866,6 +24328,7 @@
                 function TaskExecutionMetadata(properties) {
                     this.externalResources = [];
                     this.resourcePoolInfo = [];
+                    this.peakResourceUsage = [];
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -22904,6 +24367,30 @@
                  */
                 TaskExecutionMetadata.prototype.pluginIdentifier = "";
     
+                /**
+                 * TaskExecutionMetadata peakResourceUsage.
+                 * @member {Array.<flyteidl.core.Resources.IResourceEntry>} peakResourceUsage
+                 * @memberof flyteidl.event.TaskExecutionMetadata
+                 * @instance
+                 */
+                TaskExecutionMetadata.prototype.peakResourceUsage = $util.emptyArray;
+    
+                /**
+                 * TaskExecutionMetadata escalatedResources.
+                 * @member {flyteidl.core.IResources|null|undefined} escalatedResources
+                 * @memberof flyteidl.event.TaskExecutionMetadata
+                 * @instance
+                 */
+                TaskExecutionMetadata.prototype.escalatedResources = null;
+    
+                /**
+                 * TaskExecutionMetadata preempted.
+                 * @member {boolean} preempted
+                 * @memberof flyteidl.event.TaskExecutionMetadata
+                 * @instance
+                 */
+                TaskExecutionMetadata.prototype.preempted = false;
+    
                 /**
                  * TaskExecutionMetadata instanceClass.
                  * @member {flyteidl.event.TaskExecutionMetadata.InstanceClass} instanceClass
@@ -22946,6 +24433,13 @@
                             $root.flyteidl.event.ResourcePoolInfo.encode(message.resourcePoolInfo[i], writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
                     if (message.pluginIdentifier != null && message.hasOwnProperty("pluginIdentifier"))
                         writer.uint32(/* id 4, wireType 2 =*/34).string(message.pluginIdentifier);
+                    if (message.peakResourceUsage != null && message.peakResourceUsage.length)
+                        for (var i = 0; i < message.peakResourceUsage.length; ++i)
+                            $root.flyteidl.core.Resources.ResourceEntry.encode(message.peakResourceUsage[i], writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
+                    if (message.escalatedResources != null && message.hasOwnProperty("escalatedResources"))
+                        $root.flyteidl.core.Resources.encode(message.escalatedResources, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
+                    if (message.preempted != null && message.hasOwnProperty("preempted"))
+                        writer.uint32(/* id 7, wireType 0 =*/56).bool(message.preempted);
                     if (message.instanceClass != null && message.hasOwnProperty("instanceClass"))
                         writer.uint32(/* id 16, wireType 0 =*/128).int32(message.instanceClass);
                     return writer;
@@ -22985,6 +24479,17 @@
                         case 4:
                             message.pluginIdentifier = reader.string();
                             break;
+                        case 5:
+                            if (!(message.peakResourceUsage && message.peakResourceUsage.length))
+                                message.peakResourceUsage = [];
+                            message.peakResourceUsage.push($root.flyteidl.core.Resources.ResourceEntry.decode(reader, reader.uint32()));
+                            break;
+                        case 6:
+                            message.escalatedResources = $root.flyteidl.core.Resources.decode(reader, reader.uint32());
+                            break;
+                        case 7:
+                            message.preempted = reader.bool();
+                            break;
                         case 16:
                             message.instanceClass = reader.int32();
                             break;
@@ -23031,6 +24536,23 @@
                     if (message.pluginIdentifier != null && message.hasOwnProperty("pluginIdentifier"))
                         if (!$util.isString(message.pluginIdentifier))
                             return "pluginIdentifier: string expected";
+                    if (message.peakResourceUsage != null && message.hasOwnProperty("peakResourceUsage")) {
+                        if (!Array.isArray(message.peakResourceUsage))
+                            return "peakResourceUsage: array expected";
+                        for (var i = 0; i < message.peakResourceUsage.length; ++i) {
+                            var error = $root.flyteidl.core.Resources.ResourceEntry.verify(message.peakResourceUsage[i]);
+                            if (error)
+                                return "peakResourceUsage." + error;
+                        }
+                    }
+                    if (message.escalatedResources != null && message.hasOwnProperty("escalatedResources")) {
+                        var error = $root.flyteidl.core.Resources.verify(message.escalatedResources);
+                        if (error)
+                            return "escalatedResources." + error;
+                    }
+                    if (message.preempted != null && message.hasOwnProperty("preempted"))
+                        if (typeof message.preempted !== "boolean")
+                            return "preempted: boolean expected";
                     if (message.instanceClass != null && message.hasOwnProperty("instanceClass"))
                         switch (message.instanceClass) {
                         default:
@@ -46300,6 +47822,7 @@
                  * @interface ISignalGetOrCreateRequest
                  * @property {flyteidl.core.ISignalIdentifier|null} [id] SignalGetOrCreateRequest id
                  * @property {flyteidl.core.ILiteralType|null} [type] SignalGetOrCreateRequest type
+                 * @property {flyteidl.core.IApprovalPolicy|null} [approvalPolicy] SignalGetOrCreateRequest approvalPolicy
                  */
     
                 /**
@@ -46333,6 +47856,14 @@
                  */
                 SignalGetOrCreateRequest.prototype.type = null;
     
+                /**
+                 * SignalGetOrCreateRequest approvalPolicy.
+                 * @member {flyteidl.core.IApprovalPolicy|null|undefined} approvalPolicy
+                 * @memberof flyteidl.admin.SignalGetOrCreateRequest
+                 * @instance
+                 */
+                SignalGetOrCreateRequest.prototype.approvalPolicy = null;
+    
                 /**
                  * Creates a new SignalGetOrCreateRequest instance using the specified properties.
                  * @function create
@@ -46361,6 +47892,8 @@
                         $root.flyteidl.core.SignalIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
                     if (message.type != null && message.hasOwnProperty("type"))
                         $root.flyteidl.core.LiteralType.encode(message.type, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.approvalPolicy != null && message.hasOwnProperty("approvalPolicy"))
+                        $root.flyteidl.core.ApprovalPolicy.encode(message.approvalPolicy, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
                     return writer;
                 };
     
@@ -46388,6 +47921,9 @@
                         case 2:
                             message.type = $root.flyteidl.core.LiteralType.decode(reader, reader.uint32());
                             break;
+                        case 3:
+                            message.approvalPolicy = $root.flyteidl.core.ApprovalPolicy.decode(reader, reader.uint32());
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -46417,6 +47953,11 @@
                         if (error)
                             return "type." + error;
                     }
+                    if (message.approvalPolicy != null && message.hasOwnProperty("approvalPolicy")) {
+                        var error = $root.flyteidl.core.ApprovalPolicy.verify(message.approvalPolicy);
+                        if (error)
+                            return "approvalPolicy." + error;
+                    }
                     return null;
                 };
     
@@ -46750,6 +48291,7 @@
                  * @interface ISignalSetRequest
                  * @property {flyteidl.core.ISignalIdentifier|null} [id] SignalSetRequest id
                  * @property {flyteidl.core.ILiteral|null} [value] SignalSetRequest value
+                 * @property {string|null} [reason] SignalSetRequest reason
                  */
     
                 /**
@@ -46783,6 +48325,14 @@
                  */
                 SignalSetRequest.prototype.value = null;
     
+                /**
+                 * SignalSetRequest reason.
+                 * @member {string} reason
+                 * @memberof flyteidl.admin.SignalSetRequest
+                 * @instance
+                 */
+                SignalSetRequest.prototype.reason = "";
+    
                 /**
                  * Creates a new SignalSetRequest instance using the specified properties.
                  * @function create
@@ -46811,6 +48361,8 @@
                         $root.flyteidl.core.SignalIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
                     if (message.value != null && message.hasOwnProperty("value"))
                         $root.flyteidl.core.Literal.encode(message.value, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.reason != null && message.hasOwnProperty("reason"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.reason);
                     return writer;
                 };
     
@@ -46838,6 +48390,9 @@
                         case 2:
                             message.value = $root.flyteidl.core.Literal.decode(reader, reader.uint32());
                             break;
+                        case 3:
+                            message.reason = reader.string();
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -46867,6 +48422,9 @@
                         if (error)
                             return "value." + error;
                     }
+                    if (message.reason != null && message.hasOwnProperty("reason"))
+                        if (!$util.isString(message.reason))
+                            return "reason: string expected";
                     return null;
                 };
     
@@ -46975,6 +48533,8 @@
                  * @property {flyteidl.core.ISignalIdentifier|null} [id] Signal id
                  * @property {flyteidl.core.ILiteralType|null} [type] Signal type
                  * @property {flyteidl.core.ILiteral|null} [value] Signal value
+                 * @property {flyteidl.core.IApprovalPolicy|null} [approvalPolicy] Signal approvalPolicy
+                 * @property {Array.<flyteidl.admin.ISignalApproval>|null} [approvals] Signal approvals
                  */
     
                 /**
@@ -46986,6 +48546,7 @@
                  * @param {flyteidl.admin.ISignal=} [properties] Properties to set
                  */
                 function Signal(properties) {
+                    this.approvals = [];
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -47016,6 +48577,22 @@
                  */
                 Signal.prototype.value = null;
     
+                /**
+                 * Signal approvalPolicy.
+                 * @member {flyteidl.core.IApprovalPolicy|null|undefined} approvalPolicy
+                 * @memberof flyteidl.admin.Signal
+                 * @instance
+                 */
+                Signal.prototype.approvalPolicy = null;
+    
+                /**
+                 * Signal approvals.
+                 * @member {Array.<flyteidl.admin.ISignalApproval>} approvals
+                 * @memberof flyteidl.admin.Signal
+                 * @instance
+                 */
+                Signal.prototype.approvals = $util.emptyArray;
+    
                 /**
                  * Creates a new Signal instance using the specified properties.
                  * @function create
@@ -47046,6 +48623,11 @@
                         $root.flyteidl.core.LiteralType.encode(message.type, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
                     if (message.value != null && message.hasOwnProperty("value"))
                         $root.flyteidl.core.Literal.encode(message.value, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.approvalPolicy != null && message.hasOwnProperty("approvalPolicy"))
+                        $root.flyteidl.core.ApprovalPolicy.encode(message.approvalPolicy, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.approvals != null && message.approvals.length)
+                        for (var i = 0; i < message.approvals.length; ++i)
+                            $root.flyteidl.admin.SignalApproval.encode(message.approvals[i], writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
                     return writer;
                 };
     
@@ -47076,6 +48658,14 @@
                         case 3:
                             message.value = $root.flyteidl.core.Literal.decode(reader, reader.uint32());
                             break;
+                        case 4:
+                            message.approvalPolicy = $root.flyteidl.core.ApprovalPolicy.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            if (!(message.approvals && message.approvals.length))
+                                message.approvals = [];
+                            message.approvals.push($root.flyteidl.admin.SignalApproval.decode(reader, reader.uint32()));
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -47110,12 +48700,189 @@
                         if (error)
                             return "value." + error;
                     }
+                    if (message.approvalPolicy != null && message.hasOwnProperty("approvalPolicy")) {
+                        var error = $root.flyteidl.core.ApprovalPolicy.verify(message.approvalPolicy);
+                        if (error)
+                            return "approvalPolicy." + error;
+                    }
+                    if (message.approvals != null && message.hasOwnProperty("approvals")) {
+                        if (!Array.isArray(message.approvals))
+                            return "approvals: array expected";
+                        for (var i = 0; i < message.approvals.length; ++i) {
+                            var error = $root.flyteidl.admin.SignalApproval.verify(message.approvals[i]);
+                            if (error)
+                                return "approvals." + error;
+                        }
+                    }
                     return null;
                 };
     
                 return Signal;
             })();
     
+            admin.SignalApproval = (function() {
+    
+                /**
+                 * Properties of a SignalApproval.
+                 * @memberof flyteidl.admin
+                 * @interface ISignalApproval
+                 * @property {string|null} [identity] SignalApproval identity
+                 * @property {boolean|null} [approved] SignalApproval approved
+                 * @property {string|null} [reason] SignalApproval reason
+                 * @property {google.protobuf.ITimestamp|null} [createdAt] SignalApproval createdAt
+                 */
+    
+                /**
+                 * Constructs a new SignalApproval.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a SignalApproval.
+                 * @implements ISignalApproval
+                 * @constructor
+                 * @param {flyteidl.admin.ISignalApproval=} [properties] Properties to set
+                 */
+                function SignalApproval(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * SignalApproval identity.
+                 * @member {string} identity
+                 * @memberof flyteidl.admin.SignalApproval
+                 * @instance
+                 */
+                SignalApproval.prototype.identity = "";
+    
+                /**
+                 * SignalApproval approved.
+                 * @member {boolean} approved
+                 * @memberof flyteidl.admin.SignalApproval
+                 * @instance
+                 */
+                SignalApproval.prototype.approved = false;
+    
+                /**
+                 * SignalApproval reason.
+                 * @member {string} reason
+                 * @memberof flyteidl.admin.SignalApproval
+                 * @instance
+                 */
+                SignalApproval.prototype.reason = "";
+    
+                /**
+                 * SignalApproval createdAt.
+                 * @member {google.protobuf.ITimestamp|null|undefined} createdAt
+                 * @memberof flyteidl.admin.SignalApproval
+                 * @instance
+                 */
+                SignalApproval.prototype.createdAt = null;
+    
+                /**
+                 * Creates a new SignalApproval instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.SignalApproval
+                 * @static
+                 * @param {flyteidl.admin.ISignalApproval=} [properties] Properties to set
+                 * @returns {flyteidl.admin.SignalApproval} SignalApproval instance
+                 */
+                SignalApproval.create = function create(properties) {
+                    return new SignalApproval(properties);
+                };
+    
+                /**
+                 * Encodes the specified SignalApproval message. Does not implicitly {@link flyteidl.admin.SignalApproval.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.SignalApproval
+                 * @static
+                 * @param {flyteidl.admin.ISignalApproval} message SignalApproval message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                SignalApproval.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.identity != null && message.hasOwnProperty("identity"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.identity);
+                    if (message.approved != null && message.hasOwnProperty("approved"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).bool(message.approved);
+                    if (message.reason != null && message.hasOwnProperty("reason"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.reason);
+                    if (message.createdAt != null && message.hasOwnProperty("createdAt"))
+                        $root.google.protobuf.Timestamp.encode(message.createdAt, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a SignalApproval message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.SignalApproval
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.SignalApproval} SignalApproval
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                SignalApproval.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.SignalApproval();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.identity = reader.string();
+                            break;
+                        case 2:
+                            message.approved = reader.bool();
+                            break;
+                        case 3:
+                            message.reason = reader.string();
+                            break;
+                        case 4:
+                            message.createdAt = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a SignalApproval message.
+                 * @function verify
+                 * @memberof flyteidl.admin.SignalApproval
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                SignalApproval.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.identity != null && message.hasOwnProperty("identity"))
+                        if (!$util.isString(message.identity))
+                            return "identity: string expected";
+                    if (message.approved != null && message.hasOwnProperty("approved"))
+                        if (typeof message.approved !== "boolean")
+                            return "approved: boolean expected";
+                    if (message.reason != null && message.hasOwnProperty("reason"))
+                        if (!$util.isString(message.reason))
+                            return "reason: string expected";
+                    if (message.createdAt != null && message.hasOwnProperty("createdAt")) {
+                        var error = $root.google.protobuf.Timestamp.verify(message.createdAt);
+                        if (error)
+                            return "createdAt." + error;
+                    }
+                    return null;
+                };
+    
+                return SignalApproval;
+            })();
+    
             admin.TaskCreateRequest = (function() {
     
                 /**
@@ -47887,6 +49654,173 @@
                 return TaskClosure;
             })();
     
+            admin.TaskResourceRecommendation = (function() {
+    
+                /**
+                 * Properties of a TaskResourceRecommendation.
+                 * @memberof flyteidl.admin
+                 * @interface ITaskResourceRecommendation
+                 * @property {flyteidl.core.IIdentifier|null} [id] TaskResourceRecommendation id
+                 * @property {flyteidl.admin.ITaskResourceSpec|null} [recommended] TaskResourceRecommendation recommended
+                 * @property {flyteidl.admin.ITaskResourceSpec|null} [peak] TaskResourceRecommendation peak
+                 * @property {number|null} [sampleCount] TaskResourceRecommendation sampleCount
+                 */
+    
+                /**
+                 * Constructs a new TaskResourceRecommendation.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a TaskResourceRecommendation.
+                 * @implements ITaskResourceRecommendation
+                 * @constructor
+                 * @param {flyteidl.admin.ITaskResourceRecommendation=} [properties] Properties to set
+                 */
+                function TaskResourceRecommendation(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * TaskResourceRecommendation id.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.TaskResourceRecommendation
+                 * @instance
+                 */
+                TaskResourceRecommendation.prototype.id = null;
+    
+                /**
+                 * TaskResourceRecommendation recommended.
+                 * @member {flyteidl.admin.ITaskResourceSpec|null|undefined} recommended
+                 * @memberof flyteidl.admin.TaskResourceRecommendation
+                 * @instance
+                 */
+                TaskResourceRecommendation.prototype.recommended = null;
+    
+                /**
+                 * TaskResourceRecommendation peak.
+                 * @member {flyteidl.admin.ITaskResourceSpec|null|undefined} peak
+                 * @memberof flyteidl.admin.TaskResourceRecommendation
+                 * @instance
+                 */
+                TaskResourceRecommendation.prototype.peak = null;
+    
+                /**
+                 * TaskResourceRecommendation sampleCount.
+                 * @member {number} sampleCount
+                 * @memberof flyteidl.admin.TaskResourceRecommendation
+                 * @instance
+                 */
+                TaskResourceRecommendation.prototype.sampleCount = 0;
+    
+                /**
+                 * Creates a new TaskResourceRecommendation instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.TaskResourceRecommendation
+                 * @static
+                 * @param {flyteidl.admin.ITaskResourceRecommendation=} [properties] Properties to set
+                 * @returns {flyteidl.admin.TaskResourceRecommendation} TaskResourceRecommendation instance
+                 */
+                TaskResourceRecommendation.create = function create(properties) {
+                    return new TaskResourceRecommendation(properties);
+                };
+    
+                /**
+                 * Encodes the specified TaskResourceRecommendation message. Does not implicitly {@link flyteidl.admin.TaskResourceRecommendation.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.TaskResourceRecommendation
+                 * @static
+                 * @param {flyteidl.admin.ITaskResourceRecommendation} message TaskResourceRecommendation message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                TaskResourceRecommendation.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.Identifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.recommended != null && message.hasOwnProperty("recommended"))
+                        $root.flyteidl.admin.TaskResourceSpec.encode(message.recommended, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.peak != null && message.hasOwnProperty("peak"))
+                        $root.flyteidl.admin.TaskResourceSpec.encode(message.peak, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.sampleCount != null && message.hasOwnProperty("sampleCount"))
+                        writer.uint32(/* id 4, wireType 0 =*/32).int32(message.sampleCount);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a TaskResourceRecommendation message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.TaskResourceRecommendation
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.TaskResourceRecommendation} TaskResourceRecommendation
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                TaskResourceRecommendation.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.TaskResourceRecommendation();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.id = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.recommended = $root.flyteidl.admin.TaskResourceSpec.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.peak = $root.flyteidl.admin.TaskResourceSpec.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.sampleCount = reader.int32();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a TaskResourceRecommendation message.
+                 * @function verify
+                 * @memberof flyteidl.admin.TaskResourceRecommendation
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                TaskResourceRecommendation.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    if (message.recommended != null && message.hasOwnProperty("recommended")) {
+                        var error = $root.flyteidl.admin.TaskResourceSpec.verify(message.recommended);
+                        if (error)
+                            return "recommended." + error;
+                    }
+                    if (message.peak != null && message.hasOwnProperty("peak")) {
+                        var error = $root.flyteidl.admin.TaskResourceSpec.verify(message.peak);
+                        if (error)
+                            return "peak." + error;
+                    }
+                    if (message.sampleCount != null && message.hasOwnProperty("sampleCount"))
+                        if (!$util.isInteger(message.sampleCount))
+                            return "sampleCount: integer expected";
+                    return null;
+                };
+    
+                return TaskResourceRecommendation;
+            })();
+    
             admin.TaskExecutionGetRequest = (function() {
     
                 /**
@@ -52003,6 +53937,39 @@
                  * @variation 2
                  */
     
+                /**
+                 * Callback as used by {@link flyteidl.service.AdminService#getTaskResourceRecommendation}.
+                 * @memberof flyteidl.service.AdminService
+                 * @typedef GetTaskResourceRecommendationCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.admin.TaskResourceRecommendation} [response] TaskResourceRecommendation
+                 */
+    
+                /**
+                 * Calls GetTaskResourceRecommendation.
+                 * @function getTaskResourceRecommendation
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IObjectGetRequest} request ObjectGetRequest message or plain object
+                 * @param {flyteidl.service.AdminService.GetTaskResourceRecommendationCallback} callback Node-style callback called with the error, if any, and TaskResourceRecommendation
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(AdminService.prototype.getTaskResourceRecommendation = function getTaskResourceRecommendation(request, callback) {
+                    return this.rpcCall(getTaskResourceRecommendation, $root.flyteidl.admin.ObjectGetRequest, $root.flyteidl.admin.TaskResourceRecommendation, request, callback);
+                }, "name", { value: "GetTaskResourceRecommendation" });
+    
+                /**
+                 * Calls GetTaskResourceRecommendation.
+                 * @function getTaskResourceRecommendation
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IObjectGetRequest} request ObjectGetRequest message or plain object
+                 * @returns {Promise<flyteidl.admin.TaskResourceRecommendation>} Promise
+                 * @variation 2
+                 */
+    
                 /**
                  * Callback as used by {@link flyteidl.service.AdminService#createWorkflow}.
                  * @memberof flyteidl.service.AdminService
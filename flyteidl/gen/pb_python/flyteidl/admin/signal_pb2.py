@@ -15,9 +15,11 @@ from flyteidl.admin import common_pb2 as flyteidl_dot_admin_dot_common__pb2
 from flyteidl.core import identifier_pb2 as flyteidl_dot_core_dot_identifier__pb2
 from flyteidl.core import literals_pb2 as flyteidl_dot_core_dot_literals__pb2
 from flyteidl.core import types_pb2 as flyteidl_dot_core_dot_types__pb2
+from flyteidl.core import workflow_pb2 as flyteidl_dot_core_dot_workflow__pb2
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1b\x66lyteidl/admin/signal.proto\x12\x0e\x66lyteidl.admin\x1a\x1b\x66lyteidl/admin/common.proto\x1a\x1e\x66lyteidl/core/identifier.proto\x1a\x1c\x66lyteidl/core/literals.proto\x1a\x19\x66lyteidl/core/types.proto\"{\n\x18SignalGetOrCreateRequest\x12/\n\x02id\x18\x01 \x01(\x0b\x32\x1f.flyteidl.core.SignalIdentifierR\x02id\x12.\n\x04type\x18\x02 \x01(\x0b\x32\x1a.flyteidl.core.LiteralTypeR\x04type\"\xe8\x01\n\x11SignalListRequest\x12^\n\x15workflow_execution_id\x18\x01 \x01(\x0b\x32*.flyteidl.core.WorkflowExecutionIdentifierR\x13workflowExecutionId\x12\x14\n\x05limit\x18\x02 \x01(\rR\x05limit\x12\x14\n\x05token\x18\x03 \x01(\tR\x05token\x12\x18\n\x07\x66ilters\x18\x04 \x01(\tR\x07\x66ilters\x12-\n\x07sort_by\x18\x05 \x01(\x0b\x32\x14.flyteidl.admin.SortR\x06sortBy\"T\n\nSignalList\x12\x30\n\x07signals\x18\x01 \x03(\x0b\x32\x16.flyteidl.admin.SignalR\x07signals\x12\x14\n\x05token\x18\x02 \x01(\tR\x05token\"q\n\x10SignalSetRequest\x12/\n\x02id\x18\x01 \x01(\x0b\x32\x1f.flyteidl.core.SignalIdentifierR\x02id\x12,\n\x05value\x18\x02 \x01(\x0b\x32\x16.flyteidl.core.LiteralR\x05value\"\x13\n\x11SignalSetResponse\"\x97\x01\n\x06Signal\x12/\n\x02id\x18\x01 \x01(\x0b\x32\x1f.flyteidl.core.SignalIdentifierR\x02id\x12.\n\x04type\x18\x02 \x01(\x0b\x32\x1a.flyteidl.core.LiteralTypeR\x04type\x12,\n\x05value\x18\x03 \x01(\x0b\x32\x16.flyteidl.core.LiteralR\x05valueB\xb7\x01\n\x12\x63om.flyteidl.adminB\x0bSignalProtoP\x01Z;github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin\xa2\x02\x03\x46\x41X\xaa\x02\x0e\x46lyteidl.Admin\xca\x02\x0e\x46lyteidl\\Admin\xe2\x02\x1a\x46lyteidl\\Admin\\GPBMetadata\xea\x02\x0f\x46lyteidl::Adminb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1b\x66lyteidl/admin/signal.proto\x12\x0e\x66lyteidl.admin\x1a\x1b\x66lyteidl/admin/common.proto\x1a\x1e\x66lyteidl/core/identifier.proto\x1a\x1c\x66lyteidl/core/literals.proto\x1a\x19\x66lyteidl/core/types.proto\x1a\x1c\x66lyteidl/core/workflow.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x01\n\x18SignalGetOrCreateRequest\x12/\n\x02id\x18\x01 \x01(\x0b\x32\x1f.flyteidl.core.SignalIdentifierR\x02id\x12.\n\x04type\x18\x02 \x01(\x0b\x32\x1a.flyteidl.core.LiteralTypeR\x04type\x12\x46\n\x0f\x61pproval_policy\x18\x03 \x01(\x0b\x32\x1d.flyteidl.core.ApprovalPolicyR\x0e\x61pprovalPolicy\"\xe8\x01\n\x11SignalListRequest\x12^\n\x15workflow_execution_id\x18\x01 \x01(\x0b\x32*.flyteidl.core.WorkflowExecutionIdentifierR\x13workflowExecutionId\x12\x14\n\x05limit\x18\x02 \x01(\rR\x05limit\x12\x14\n\x05token\x18\x03 \x01(\tR\x05token\x12\x18\n\x07\x66ilters\x18\x04 \x01(\tR\x07\x66ilters\x12-\n\x07sort_by\x18\x05 \x01(\x0b\x32\x14.flyteidl.admin.SortR\x06sortBy\"T\n\nSignalList\x12\x30\n\x07signals\x18\x01 \x03(\x0b\x32\x16.flyteidl.admin.SignalR\x07signals\x12\x14\n\x05token\x18\x02 \x01(\tR\x05token\"\x89\x01\n\x10SignalSetRequest\x12/\n\x02id\x18\x01 \x01(\x0b\x32\x1f.flyteidl.core.SignalIdentifierR\x02id\x12,\n\x05value\x18\x02 \x01(\x0b\x32\x16.flyteidl.core.LiteralR\x05value\x12\x16\n\x06reason\x18\x03 \x01(\tR\x06reason\"\x13\n\x11SignalSetResponse\"\x9d\x02\n\x06Signal\x12/\n\x02id\x18\x01 \x01(\x0b\x32\x1f.flyteidl.core.SignalIdentifierR\x02id\x12.\n\x04type\x18\x02 \x01(\x0b\x32\x1a.flyteidl.core.LiteralTypeR\x04type\x12,\n\x05value\x18\x03 \x01(\x0b\x32\x16.flyteidl.core.LiteralR\x05value\x12\x46\n\x0f\x61pproval_policy\x18\x04 \x01(\x0b\x32\x1d.flyteidl.core.ApprovalPolicyR\x0e\x61pprovalPolicy\x12<\n\tapprovals\x18\x05 \x03(\x0b\x32\x1e.flyteidl.admin.SignalApprovalR\tapprovals\"\x9b\x01\n\x0eSignalApproval\x12\x1a\n\x08identity\x18\x01 \x01(\tR\x08identity\x12\x1a\n\x08\x61pproved\x18\x02 \x01(\x08R\x08\x61pproved\x12\x16\n\x06reason\x18\x03 \x01(\tR\x06reason\x12\x39\n\ncreated_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAtB\xb7\x01\n\x12\x63om.flyteidl.adminB\x0bSignalProtoP\x01Z;github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin\xa2\x02\x03\x46\x41X\xaa\x02\x0e\x46lyteidl.Admin\xca\x02\x0e\x46lyteidl\\Admin\xe2\x02\x1a\x46lyteidl\\Admin\\GPBMetadata\xea\x02\x0f\x46lyteidl::Adminb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -26,16 +28,18 @@ if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'\n\022com.flyteidl.adminB\013SignalProtoP\001Z;github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin\242\002\003FAX\252\002\016Flyteidl.Admin\312\002\016Flyteidl\\Admin\342\002\032Flyteidl\\Admin\\GPBMetadata\352\002\017Flyteidl::Admin'
-  _globals['_SIGNALGETORCREATEREQUEST']._serialized_start=165
-  _globals['_SIGNALGETORCREATEREQUEST']._serialized_end=288
-  _globals['_SIGNALLISTREQUEST']._serialized_start=291
-  _globals['_SIGNALLISTREQUEST']._serialized_end=523
-  _globals['_SIGNALLIST']._serialized_start=525
-  _globals['_SIGNALLIST']._serialized_end=609
-  _globals['_SIGNALSETREQUEST']._serialized_start=611
-  _globals['_SIGNALSETREQUEST']._serialized_end=724
-  _globals['_SIGNALSETRESPONSE']._serialized_start=726
-  _globals['_SIGNALSETRESPONSE']._serialized_end=745
-  _globals['_SIGNAL']._serialized_start=748
-  _globals['_SIGNAL']._serialized_end=899
+  _globals['_SIGNALGETORCREATEREQUEST']._serialized_start=229
+  _globals['_SIGNALGETORCREATEREQUEST']._serialized_end=424
+  _globals['_SIGNALLISTREQUEST']._serialized_start=427
+  _globals['_SIGNALLISTREQUEST']._serialized_end=659
+  _globals['_SIGNALLIST']._serialized_start=661
+  _globals['_SIGNALLIST']._serialized_end=745
+  _globals['_SIGNALSETREQUEST']._serialized_start=748
+  _globals['_SIGNALSETREQUEST']._serialized_end=885
+  _globals['_SIGNALSETRESPONSE']._serialized_start=887
+  _globals['_SIGNALSETRESPONSE']._serialized_end=906
+  _globals['_SIGNAL']._serialized_start=909
+  _globals['_SIGNAL']._serialized_end=1194
+  _globals['_SIGNALAPPROVAL']._serialized_start=1197
+  _globals['_SIGNALAPPROVAL']._serialized_end=1352
 # @@protoc_insertion_point(module_scope)
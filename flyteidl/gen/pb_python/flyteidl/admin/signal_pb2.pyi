@@ -2,6 +2,8 @@ from flyteidl.admin import common_pb2 as _common_pb2
 from flyteidl.core import identifier_pb2 as _identifier_pb2
 from flyteidl.core import literals_pb2 as _literals_pb2
 from flyteidl.core import types_pb2 as _types_pb2
+from flyteidl.core import workflow_pb2 as _workflow_pb2
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
@@ -10,12 +12,14 @@ from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Map
 DESCRIPTOR: _descriptor.FileDescriptor
 
 class SignalGetOrCreateRequest(_message.Message):
-    __slots__ = ["id", "type"]
+    __slots__ = ["id", "type", "approval_policy"]
     ID_FIELD_NUMBER: _ClassVar[int]
     TYPE_FIELD_NUMBER: _ClassVar[int]
+    APPROVAL_POLICY_FIELD_NUMBER: _ClassVar[int]
     id: _identifier_pb2.SignalIdentifier
     type: _types_pb2.LiteralType
-    def __init__(self, id: _Optional[_Union[_identifier_pb2.SignalIdentifier, _Mapping]] = ..., type: _Optional[_Union[_types_pb2.LiteralType, _Mapping]] = ...) -> None: ...
+    approval_policy: _workflow_pb2.ApprovalPolicy
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.SignalIdentifier, _Mapping]] = ..., type: _Optional[_Union[_types_pb2.LiteralType, _Mapping]] = ..., approval_policy: _Optional[_Union[_workflow_pb2.ApprovalPolicy, _Mapping]] = ...) -> None: ...
 
 class SignalListRequest(_message.Message):
     __slots__ = ["workflow_execution_id", "limit", "token", "filters", "sort_by"]
@@ -40,23 +44,41 @@ class SignalList(_message.Message):
     def __init__(self, signals: _Optional[_Iterable[_Union[Signal, _Mapping]]] = ..., token: _Optional[str] = ...) -> None: ...
 
 class SignalSetRequest(_message.Message):
-    __slots__ = ["id", "value"]
+    __slots__ = ["id", "value", "reason"]
     ID_FIELD_NUMBER: _ClassVar[int]
     VALUE_FIELD_NUMBER: _ClassVar[int]
+    REASON_FIELD_NUMBER: _ClassVar[int]
     id: _identifier_pb2.SignalIdentifier
     value: _literals_pb2.Literal
-    def __init__(self, id: _Optional[_Union[_identifier_pb2.SignalIdentifier, _Mapping]] = ..., value: _Optional[_Union[_literals_pb2.Literal, _Mapping]] = ...) -> None: ...
+    reason: str
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.SignalIdentifier, _Mapping]] = ..., value: _Optional[_Union[_literals_pb2.Literal, _Mapping]] = ..., reason: _Optional[str] = ...) -> None: ...
 
 class SignalSetResponse(_message.Message):
     __slots__ = []
     def __init__(self) -> None: ...
 
 class Signal(_message.Message):
-    __slots__ = ["id", "type", "value"]
+    __slots__ = ["id", "type", "value", "approval_policy", "approvals"]
     ID_FIELD_NUMBER: _ClassVar[int]
     TYPE_FIELD_NUMBER: _ClassVar[int]
     VALUE_FIELD_NUMBER: _ClassVar[int]
+    APPROVAL_POLICY_FIELD_NUMBER: _ClassVar[int]
+    APPROVALS_FIELD_NUMBER: _ClassVar[int]
     id: _identifier_pb2.SignalIdentifier
     type: _types_pb2.LiteralType
     value: _literals_pb2.Literal
-    def __init__(self, id: _Optional[_Union[_identifier_pb2.SignalIdentifier, _Mapping]] = ..., type: _Optional[_Union[_types_pb2.LiteralType, _Mapping]] = ..., value: _Optional[_Union[_literals_pb2.Literal, _Mapping]] = ...) -> None: ...
+    approval_policy: _workflow_pb2.ApprovalPolicy
+    approvals: _containers.RepeatedCompositeFieldContainer[SignalApproval]
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.SignalIdentifier, _Mapping]] = ..., type: _Optional[_Union[_types_pb2.LiteralType, _Mapping]] = ..., value: _Optional[_Union[_literals_pb2.Literal, _Mapping]] = ..., approval_policy: _Optional[_Union[_workflow_pb2.ApprovalPolicy, _Mapping]] = ..., approvals: _Optional[_Iterable[_Union[SignalApproval, _Mapping]]] = ...) -> None: ...
+
+class SignalApproval(_message.Message):
+    __slots__ = ["identity", "approved", "reason", "created_at"]
+    IDENTITY_FIELD_NUMBER: _ClassVar[int]
+    APPROVED_FIELD_NUMBER: _ClassVar[int]
+    REASON_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    identity: str
+    approved: bool
+    reason: str
+    created_at: _timestamp_pb2.Timestamp
+    def __init__(self, identity: _Optional[str] = ..., approved: bool = ..., reason: _Optional[str] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
@@ -2,6 +2,7 @@ from flyteidl.core import identifier_pb2 as _identifier_pb2
 from flyteidl.core import tasks_pb2 as _tasks_pb2
 from flyteidl.core import compiler_pb2 as _compiler_pb2
 from flyteidl.admin import description_entity_pb2 as _description_entity_pb2
+from flyteidl.admin import matchable_resource_pb2 as _matchable_resource_pb2
 from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf import descriptor as _descriptor
@@ -55,3 +56,15 @@ class TaskClosure(_message.Message):
     compiled_task: _compiler_pb2.CompiledTask
     created_at: _timestamp_pb2.Timestamp
     def __init__(self, compiled_task: _Optional[_Union[_compiler_pb2.CompiledTask, _Mapping]] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class TaskResourceRecommendation(_message.Message):
+    __slots__ = ["id", "recommended", "peak", "sample_count"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    RECOMMENDED_FIELD_NUMBER: _ClassVar[int]
+    PEAK_FIELD_NUMBER: _ClassVar[int]
+    SAMPLE_COUNT_FIELD_NUMBER: _ClassVar[int]
+    id: _identifier_pb2.Identifier
+    recommended: _matchable_resource_pb2.TaskResourceSpec
+    peak: _matchable_resource_pb2.TaskResourceSpec
+    sample_count: int
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.Identifier, _Mapping]] = ..., recommended: _Optional[_Union[_matchable_resource_pb2.TaskResourceSpec, _Mapping]] = ..., peak: _Optional[_Union[_matchable_resource_pb2.TaskResourceSpec, _Mapping]] = ..., sample_count: _Optional[int] = ...) -> None: ...
@@ -1,8 +1,10 @@
 from flyteidl.core import literals_pb2 as _literals_pb2
+from flyteidl.core import types_pb2 as _types_pb2
+from google.protobuf.internal import containers as _containers
 from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
-from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Optional, Union as _Union
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
 
 DESCRIPTOR: _descriptor.FileDescriptor
 
@@ -16,12 +18,26 @@ class ComparisonExpression(_message.Message):
         GTE: _ClassVar[ComparisonExpression.Operator]
         LT: _ClassVar[ComparisonExpression.Operator]
         LTE: _ClassVar[ComparisonExpression.Operator]
+        IN: _ClassVar[ComparisonExpression.Operator]
+        NOT_IN: _ClassVar[ComparisonExpression.Operator]
+        STARTS_WITH: _ClassVar[ComparisonExpression.Operator]
+        ENDS_WITH: _ClassVar[ComparisonExpression.Operator]
+        MATCHES: _ClassVar[ComparisonExpression.Operator]
+        IS_NULL: _ClassVar[ComparisonExpression.Operator]
+        IS_NOT_NULL: _ClassVar[ComparisonExpression.Operator]
     EQ: ComparisonExpression.Operator
     NEQ: ComparisonExpression.Operator
     GT: ComparisonExpression.Operator
     GTE: ComparisonExpression.Operator
     LT: ComparisonExpression.Operator
     LTE: ComparisonExpression.Operator
+    IN: ComparisonExpression.Operator
+    NOT_IN: ComparisonExpression.Operator
+    STARTS_WITH: ComparisonExpression.Operator
+    ENDS_WITH: ComparisonExpression.Operator
+    MATCHES: ComparisonExpression.Operator
+    IS_NULL: ComparisonExpression.Operator
+    IS_NOT_NULL: ComparisonExpression.Operator
     OPERATOR_FIELD_NUMBER: _ClassVar[int]
     LEFT_VALUE_FIELD_NUMBER: _ClassVar[int]
     RIGHT_VALUE_FIELD_NUMBER: _ClassVar[int]
@@ -31,22 +47,34 @@ class ComparisonExpression(_message.Message):
     def __init__(self, operator: _Optional[_Union[ComparisonExpression.Operator, str]] = ..., left_value: _Optional[_Union[Operand, _Mapping]] = ..., right_value: _Optional[_Union[Operand, _Mapping]] = ...) -> None: ...
 
 class Operand(_message.Message):
-    __slots__ = ["primitive", "var", "scalar"]
+    __slots__ = ["primitive", "var", "scalar", "collection", "attr_path"]
     PRIMITIVE_FIELD_NUMBER: _ClassVar[int]
     VAR_FIELD_NUMBER: _ClassVar[int]
     SCALAR_FIELD_NUMBER: _ClassVar[int]
+    COLLECTION_FIELD_NUMBER: _ClassVar[int]
+    ATTR_PATH_FIELD_NUMBER: _ClassVar[int]
     primitive: _literals_pb2.Primitive
     var: str
     scalar: _literals_pb2.Scalar
-    def __init__(self, primitive: _Optional[_Union[_literals_pb2.Primitive, _Mapping]] = ..., var: _Optional[str] = ..., scalar: _Optional[_Union[_literals_pb2.Scalar, _Mapping]] = ...) -> None: ...
+    collection: _literals_pb2.LiteralCollection
+    attr_path: _containers.RepeatedCompositeFieldContainer[_types_pb2.PromiseAttribute]
+    def __init__(self, primitive: _Optional[_Union[_literals_pb2.Primitive, _Mapping]] = ..., var: _Optional[str] = ..., scalar: _Optional[_Union[_literals_pb2.Scalar, _Mapping]] = ..., collection: _Optional[_Union[_literals_pb2.LiteralCollection, _Mapping]] = ..., attr_path: _Optional[_Iterable[_Union[_types_pb2.PromiseAttribute, _Mapping]]] = ...) -> None: ...
 
 class BooleanExpression(_message.Message):
-    __slots__ = ["conjunction", "comparison"]
+    __slots__ = ["conjunction", "comparison", "negation"]
     CONJUNCTION_FIELD_NUMBER: _ClassVar[int]
     COMPARISON_FIELD_NUMBER: _ClassVar[int]
+    NEGATION_FIELD_NUMBER: _ClassVar[int]
     conjunction: ConjunctionExpression
     comparison: ComparisonExpression
-    def __init__(self, conjunction: _Optional[_Union[ConjunctionExpression, _Mapping]] = ..., comparison: _Optional[_Union[ComparisonExpression, _Mapping]] = ...) -> None: ...
+    negation: NegationExpression
+    def __init__(self, conjunction: _Optional[_Union[ConjunctionExpression, _Mapping]] = ..., comparison: _Optional[_Union[ComparisonExpression, _Mapping]] = ..., negation: _Optional[_Union[NegationExpression, _Mapping]] = ...) -> None: ...
+
+class NegationExpression(_message.Message):
+    __slots__ = ["expression"]
+    EXPRESSION_FIELD_NUMBER: _ClassVar[int]
+    expression: BooleanExpression
+    def __init__(self, expression: _Optional[_Union[BooleanExpression, _Mapping]] = ...) -> None: ...
 
 class ConjunctionExpression(_message.Message):
     __slots__ = ["operator", "left_expression", "right_expression"]
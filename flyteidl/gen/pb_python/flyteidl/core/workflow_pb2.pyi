@@ -59,10 +59,22 @@ class WorkflowNode(_message.Message):
     def __init__(self, launchplan_ref: _Optional[_Union[_identifier_pb2.Identifier, _Mapping]] = ..., sub_workflow_ref: _Optional[_Union[_identifier_pb2.Identifier, _Mapping]] = ...) -> None: ...
 
 class ApproveCondition(_message.Message):
-    __slots__ = ["signal_id"]
+    __slots__ = ["signal_id", "policy"]
     SIGNAL_ID_FIELD_NUMBER: _ClassVar[int]
+    POLICY_FIELD_NUMBER: _ClassVar[int]
     signal_id: str
-    def __init__(self, signal_id: _Optional[str] = ...) -> None: ...
+    policy: ApprovalPolicy
+    def __init__(self, signal_id: _Optional[str] = ..., policy: _Optional[_Union[ApprovalPolicy, _Mapping]] = ...) -> None: ...
+
+class ApprovalPolicy(_message.Message):
+    __slots__ = ["min_approvals", "allowed_identities", "allowed_groups"]
+    MIN_APPROVALS_FIELD_NUMBER: _ClassVar[int]
+    ALLOWED_IDENTITIES_FIELD_NUMBER: _ClassVar[int]
+    ALLOWED_GROUPS_FIELD_NUMBER: _ClassVar[int]
+    min_approvals: int
+    allowed_identities: _containers.RepeatedScalarFieldContainer[str]
+    allowed_groups: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, min_approvals: _Optional[int] = ..., allowed_identities: _Optional[_Iterable[str]] = ..., allowed_groups: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class SignalCondition(_message.Message):
     __slots__ = ["signal_id", "type", "output_variable_name"]
@@ -80,35 +92,108 @@ class SleepCondition(_message.Message):
     duration: _duration_pb2.Duration
     def __init__(self, duration: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ...) -> None: ...
 
+class GateTimeout(_message.Message):
+    __slots__ = ["duration", "default_output"]
+    DURATION_FIELD_NUMBER: _ClassVar[int]
+    DEFAULT_OUTPUT_FIELD_NUMBER: _ClassVar[int]
+    duration: _duration_pb2.Duration
+    default_output: _literals_pb2.Literal
+    def __init__(self, duration: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., default_output: _Optional[_Union[_literals_pb2.Literal, _Mapping]] = ...) -> None: ...
+
+class WaitUntilCondition(_message.Message):
+    __slots__ = ["input_variable_name", "output_variable_name", "timeout"]
+    INPUT_VARIABLE_NAME_FIELD_NUMBER: _ClassVar[int]
+    OUTPUT_VARIABLE_NAME_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    input_variable_name: str
+    output_variable_name: str
+    timeout: GateTimeout
+    def __init__(self, input_variable_name: _Optional[str] = ..., output_variable_name: _Optional[str] = ..., timeout: _Optional[_Union[GateTimeout, _Mapping]] = ...) -> None: ...
+
+class StorageObjectCondition(_message.Message):
+    __slots__ = ["uri", "uri_input_variable_name"]
+    URI_FIELD_NUMBER: _ClassVar[int]
+    URI_INPUT_VARIABLE_NAME_FIELD_NUMBER: _ClassVar[int]
+    uri: str
+    uri_input_variable_name: str
+    def __init__(self, uri: _Optional[str] = ..., uri_input_variable_name: _Optional[str] = ...) -> None: ...
+
+class CatalogArtifactCondition(_message.Message):
+    __slots__ = ["project", "domain", "name", "version", "tag"]
+    PROJECT_FIELD_NUMBER: _ClassVar[int]
+    DOMAIN_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    VERSION_FIELD_NUMBER: _ClassVar[int]
+    TAG_FIELD_NUMBER: _ClassVar[int]
+    project: str
+    domain: str
+    name: str
+    version: str
+    tag: str
+    def __init__(self, project: _Optional[str] = ..., domain: _Optional[str] = ..., name: _Optional[str] = ..., version: _Optional[str] = ..., tag: _Optional[str] = ...) -> None: ...
+
+class ExternalCondition(_message.Message):
+    __slots__ = ["storage_object", "catalog_artifact", "poll_interval", "max_poll_interval", "output_variable_name", "timeout"]
+    STORAGE_OBJECT_FIELD_NUMBER: _ClassVar[int]
+    CATALOG_ARTIFACT_FIELD_NUMBER: _ClassVar[int]
+    POLL_INTERVAL_FIELD_NUMBER: _ClassVar[int]
+    MAX_POLL_INTERVAL_FIELD_NUMBER: _ClassVar[int]
+    OUTPUT_VARIABLE_NAME_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    storage_object: StorageObjectCondition
+    catalog_artifact: CatalogArtifactCondition
+    poll_interval: _duration_pb2.Duration
+    max_poll_interval: _duration_pb2.Duration
+    output_variable_name: str
+    timeout: GateTimeout
+    def __init__(self, storage_object: _Optional[_Union[StorageObjectCondition, _Mapping]] = ..., catalog_artifact: _Optional[_Union[CatalogArtifactCondition, _Mapping]] = ..., poll_interval: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., max_poll_interval: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., output_variable_name: _Optional[str] = ..., timeout: _Optional[_Union[GateTimeout, _Mapping]] = ...) -> None: ...
+
 class GateNode(_message.Message):
-    __slots__ = ["approve", "signal", "sleep"]
+    __slots__ = ["approve", "signal", "sleep", "wait_until", "external"]
     APPROVE_FIELD_NUMBER: _ClassVar[int]
     SIGNAL_FIELD_NUMBER: _ClassVar[int]
     SLEEP_FIELD_NUMBER: _ClassVar[int]
+    WAIT_UNTIL_FIELD_NUMBER: _ClassVar[int]
+    EXTERNAL_FIELD_NUMBER: _ClassVar[int]
     approve: ApproveCondition
     signal: SignalCondition
     sleep: SleepCondition
-    def __init__(self, approve: _Optional[_Union[ApproveCondition, _Mapping]] = ..., signal: _Optional[_Union[SignalCondition, _Mapping]] = ..., sleep: _Optional[_Union[SleepCondition, _Mapping]] = ...) -> None: ...
+    wait_until: WaitUntilCondition
+    external: ExternalCondition
+    def __init__(self, approve: _Optional[_Union[ApproveCondition, _Mapping]] = ..., signal: _Optional[_Union[SignalCondition, _Mapping]] = ..., sleep: _Optional[_Union[SleepCondition, _Mapping]] = ..., wait_until: _Optional[_Union[WaitUntilCondition, _Mapping]] = ..., external: _Optional[_Union[ExternalCondition, _Mapping]] = ...) -> None: ...
 
 class ArrayNode(_message.Message):
-    __slots__ = ["node", "parallelism", "min_successes", "min_success_ratio", "execution_mode"]
+    __slots__ = ["node", "parallelism", "min_successes", "min_success_ratio", "execution_mode", "partial_success", "retry_budget", "max_failures", "adaptive_parallelism"]
     class ExecutionMode(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
         __slots__ = []
         MINIMAL_STATE: _ClassVar[ArrayNode.ExecutionMode]
         FULL_STATE: _ClassVar[ArrayNode.ExecutionMode]
     MINIMAL_STATE: ArrayNode.ExecutionMode
     FULL_STATE: ArrayNode.ExecutionMode
+    class PartialSuccess(_message.Message):
+        __slots__ = ["errors_output"]
+        ERRORS_OUTPUT_FIELD_NUMBER: _ClassVar[int]
+        errors_output: str
+        def __init__(self, errors_output: _Optional[str] = ...) -> None: ...
     NODE_FIELD_NUMBER: _ClassVar[int]
     PARALLELISM_FIELD_NUMBER: _ClassVar[int]
     MIN_SUCCESSES_FIELD_NUMBER: _ClassVar[int]
     MIN_SUCCESS_RATIO_FIELD_NUMBER: _ClassVar[int]
     EXECUTION_MODE_FIELD_NUMBER: _ClassVar[int]
+    PARTIAL_SUCCESS_FIELD_NUMBER: _ClassVar[int]
+    RETRY_BUDGET_FIELD_NUMBER: _ClassVar[int]
+    MAX_FAILURES_FIELD_NUMBER: _ClassVar[int]
+    ADAPTIVE_PARALLELISM_FIELD_NUMBER: _ClassVar[int]
     node: Node
     parallelism: int
     min_successes: int
     min_success_ratio: float
     execution_mode: ArrayNode.ExecutionMode
-    def __init__(self, node: _Optional[_Union[Node, _Mapping]] = ..., parallelism: _Optional[int] = ..., min_successes: _Optional[int] = ..., min_success_ratio: _Optional[float] = ..., execution_mode: _Optional[_Union[ArrayNode.ExecutionMode, str]] = ...) -> None: ...
+    partial_success: ArrayNode.PartialSuccess
+    retry_budget: int
+    max_failures: int
+    adaptive_parallelism: bool
+    def __init__(self, node: _Optional[_Union[Node, _Mapping]] = ..., parallelism: _Optional[int] = ..., min_successes: _Optional[int] = ..., min_success_ratio: _Optional[float] = ..., execution_mode: _Optional[_Union[ArrayNode.ExecutionMode, str]] = ..., partial_success: _Optional[_Union[ArrayNode.PartialSuccess, _Mapping]] = ..., retry_budget: _Optional[int] = ..., max_failures: _Optional[int] = ..., adaptive_parallelism: bool = ...) -> None: ...
 
 class NodeMetadata(_message.Message):
     __slots__ = ["name", "timeout", "retries", "interruptible", "cacheable", "cache_version", "cache_serializable"]
@@ -121,6 +121,56 @@ class UpdateArtifactResponse(_message.Message):
     artifact_id: str
     def __init__(self, artifact_id: _Optional[str] = ...) -> None: ...
 
+class DeleteArtifactRequest(_message.Message):
+    __slots__ = ["dataset", "artifact_id", "tag_name"]
+    DATASET_FIELD_NUMBER: _ClassVar[int]
+    ARTIFACT_ID_FIELD_NUMBER: _ClassVar[int]
+    TAG_NAME_FIELD_NUMBER: _ClassVar[int]
+    dataset: DatasetID
+    artifact_id: str
+    tag_name: str
+    def __init__(self, dataset: _Optional[_Union[DatasetID, _Mapping]] = ..., artifact_id: _Optional[str] = ..., tag_name: _Optional[str] = ...) -> None: ...
+
+class DeleteArtifactResponse(_message.Message):
+    __slots__ = ["artifact_id"]
+    ARTIFACT_ID_FIELD_NUMBER: _ClassVar[int]
+    artifact_id: str
+    def __init__(self, artifact_id: _Optional[str] = ...) -> None: ...
+
+class DeleteArtifactsRequest(_message.Message):
+    __slots__ = ["dataset", "filter"]
+    DATASET_FIELD_NUMBER: _ClassVar[int]
+    FILTER_FIELD_NUMBER: _ClassVar[int]
+    dataset: DatasetID
+    filter: FilterExpression
+    def __init__(self, dataset: _Optional[_Union[DatasetID, _Mapping]] = ..., filter: _Optional[_Union[FilterExpression, _Mapping]] = ...) -> None: ...
+
+class DeleteArtifactsResponse(_message.Message):
+    __slots__ = ["artifact_ids", "errors"]
+    ARTIFACT_IDS_FIELD_NUMBER: _ClassVar[int]
+    ERRORS_FIELD_NUMBER: _ClassVar[int]
+    artifact_ids: _containers.RepeatedScalarFieldContainer[str]
+    errors: _containers.RepeatedCompositeFieldContainer[DeleteArtifactError]
+    def __init__(self, artifact_ids: _Optional[_Iterable[str]] = ..., errors: _Optional[_Iterable[_Union[DeleteArtifactError, _Mapping]]] = ...) -> None: ...
+
+class DeleteArtifactError(_message.Message):
+    __slots__ = ["artifact_id", "message"]
+    ARTIFACT_ID_FIELD_NUMBER: _ClassVar[int]
+    MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    artifact_id: str
+    message: str
+    def __init__(self, artifact_id: _Optional[str] = ..., message: _Optional[str] = ...) -> None: ...
+
+class DeleteDatasetRequest(_message.Message):
+    __slots__ = ["dataset"]
+    DATASET_FIELD_NUMBER: _ClassVar[int]
+    dataset: DatasetID
+    def __init__(self, dataset: _Optional[_Union[DatasetID, _Mapping]] = ...) -> None: ...
+
+class DeleteDatasetResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
+
 class ReservationID(_message.Message):
     __slots__ = ["dataset_id", "tag_name"]
     DATASET_ID_FIELD_NUMBER: _ClassVar[int]
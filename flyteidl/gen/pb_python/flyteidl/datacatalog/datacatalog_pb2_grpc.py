@@ -58,6 +58,21 @@ class DataCatalogStub(object):
                 request_serializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.UpdateArtifactRequest.SerializeToString,
                 response_deserializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.UpdateArtifactResponse.FromString,
                 )
+        self.DeleteArtifact = channel.unary_unary(
+                '/datacatalog.DataCatalog/DeleteArtifact',
+                request_serializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactResponse.FromString,
+                )
+        self.DeleteArtifacts = channel.unary_unary(
+                '/datacatalog.DataCatalog/DeleteArtifacts',
+                request_serializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactsRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactsResponse.FromString,
+                )
+        self.DeleteDataset = channel.unary_unary(
+                '/datacatalog.DataCatalog/DeleteDataset',
+                request_serializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteDatasetRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteDatasetResponse.FromString,
+                )
         self.GetOrExtendReservation = channel.unary_unary(
                 '/datacatalog.DataCatalog/GetOrExtendReservation',
                 request_serializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.GetOrExtendReservationRequest.SerializeToString,
@@ -135,6 +150,28 @@ class DataCatalogServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def DeleteArtifact(self, request, context):
+        """Deletes an existing artifact together with its tags, partitions and the artifact data stored in the underlying
+        blob storage.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteArtifacts(self, request, context):
+        """Deletes all artifacts of a dataset matching the given tag and partition filters.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteDataset(self, request, context):
+        """Deletes an existing dataset together with all of its artifacts.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetOrExtendReservation(self, request, context):
         """Attempts to get or extend a reservation for the corresponding artifact. If one already exists
         (ie. another entity owns the reservation) then that reservation is retrieved.
@@ -203,6 +240,21 @@ def add_DataCatalogServicer_to_server(servicer, server):
                     request_deserializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.UpdateArtifactRequest.FromString,
                     response_serializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.UpdateArtifactResponse.SerializeToString,
             ),
+            'DeleteArtifact': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteArtifact,
+                    request_deserializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactRequest.FromString,
+                    response_serializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactResponse.SerializeToString,
+            ),
+            'DeleteArtifacts': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteArtifacts,
+                    request_deserializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactsRequest.FromString,
+                    response_serializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactsResponse.SerializeToString,
+            ),
+            'DeleteDataset': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteDataset,
+                    request_deserializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteDatasetRequest.FromString,
+                    response_serializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteDatasetResponse.SerializeToString,
+            ),
             'GetOrExtendReservation': grpc.unary_unary_rpc_method_handler(
                     servicer.GetOrExtendReservation,
                     request_deserializer=flyteidl_dot_datacatalog_dot_datacatalog__pb2.GetOrExtendReservationRequest.FromString,
@@ -363,6 +415,57 @@ class DataCatalog(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def DeleteArtifact(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/datacatalog.DataCatalog/DeleteArtifact',
+            flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactRequest.SerializeToString,
+            flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteArtifacts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/datacatalog.DataCatalog/DeleteArtifacts',
+            flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactsRequest.SerializeToString,
+            flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteArtifactsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteDataset(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/datacatalog.DataCatalog/DeleteDataset',
+            flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteDatasetRequest.SerializeToString,
+            flyteidl_dot_datacatalog_dot_datacatalog__pb2.DeleteDatasetResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetOrExtendReservation(request,
             target,
@@ -3,6 +3,7 @@ from flyteidl.core import compiler_pb2 as _compiler_pb2
 from flyteidl.core import execution_pb2 as _execution_pb2
 from flyteidl.core import identifier_pb2 as _identifier_pb2
 from flyteidl.core import catalog_pb2 as _catalog_pb2
+from flyteidl.core import tasks_pb2 as _tasks_pb2
 from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from google.protobuf import struct_pb2 as _struct_pb2
 from google.protobuf.internal import containers as _containers
@@ -202,7 +203,7 @@ class ResourcePoolInfo(_message.Message):
     def __init__(self, allocation_token: _Optional[str] = ..., namespace: _Optional[str] = ...) -> None: ...
 
 class TaskExecutionMetadata(_message.Message):
-    __slots__ = ["generated_name", "external_resources", "resource_pool_info", "plugin_identifier", "instance_class"]
+    __slots__ = ["generated_name", "external_resources", "resource_pool_info", "plugin_identifier", "peak_resource_usage", "escalated_resources", "preempted", "instance_class"]
     class InstanceClass(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
         __slots__ = []
         DEFAULT: _ClassVar[TaskExecutionMetadata.InstanceClass]
@@ -213,10 +214,16 @@ class TaskExecutionMetadata(_message.Message):
     EXTERNAL_RESOURCES_FIELD_NUMBER: _ClassVar[int]
     RESOURCE_POOL_INFO_FIELD_NUMBER: _ClassVar[int]
     PLUGIN_IDENTIFIER_FIELD_NUMBER: _ClassVar[int]
+    PEAK_RESOURCE_USAGE_FIELD_NUMBER: _ClassVar[int]
+    ESCALATED_RESOURCES_FIELD_NUMBER: _ClassVar[int]
+    PREEMPTED_FIELD_NUMBER: _ClassVar[int]
     INSTANCE_CLASS_FIELD_NUMBER: _ClassVar[int]
     generated_name: str
     external_resources: _containers.RepeatedCompositeFieldContainer[ExternalResourceInfo]
     resource_pool_info: _containers.RepeatedCompositeFieldContainer[ResourcePoolInfo]
     plugin_identifier: str
+    peak_resource_usage: _containers.RepeatedCompositeFieldContainer[_tasks_pb2.Resources.ResourceEntry]
+    escalated_resources: _tasks_pb2.Resources
+    preempted: bool
     instance_class: TaskExecutionMetadata.InstanceClass
-    def __init__(self, generated_name: _Optional[str] = ..., external_resources: _Optional[_Iterable[_Union[ExternalResourceInfo, _Mapping]]] = ..., resource_pool_info: _Optional[_Iterable[_Union[ResourcePoolInfo, _Mapping]]] = ..., plugin_identifier: _Optional[str] = ..., instance_class: _Optional[_Union[TaskExecutionMetadata.InstanceClass, str]] = ...) -> None: ...
+    def __init__(self, generated_name: _Optional[str] = ..., external_resources: _Optional[_Iterable[_Union[ExternalResourceInfo, _Mapping]]] = ..., resource_pool_info: _Optional[_Iterable[_Union[ResourcePoolInfo, _Mapping]]] = ..., plugin_identifier: _Optional[str] = ..., peak_resource_usage: _Optional[_Iterable[_Union[_tasks_pb2.Resources.ResourceEntry, _Mapping]]] = ..., escalated_resources: _Optional[_Union[_tasks_pb2.Resources, _Mapping]] = ..., preempted: bool = ..., instance_class: _Optional[_Union[TaskExecutionMetadata.InstanceClass, str]] = ...) -> None: ...
@@ -0,0 +1,30 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: flyteidl/plugins/batch_job.proto
+"""Generated protocol buffer code."""
+from google.protobuf.internal import builder as _builder
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import symbol_database as _symbol_database
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import wrappers_pb2 as google_dot_protobuf_dot_wrappers__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n flyteidl/plugins/batch_job.proto\x12\x10\x66lyteidl.plugins\x1a\x1egoogle/protobuf/wrappers.proto\"\xe2\x02\n\x08\x42\x61tchJob\x12 \n\x0b\x63ompletions\x18\x01 \x01(\x05R\x0b\x63ompletions\x12 \n\x0bparallelism\x18\x02 \x01(\x05R\x0bparallelism\x12R\n\x17\x62\x61\x63koff_limit_per_index\x18\x03 \x01(\x0b\x32\x1b.google.protobuf.Int32ValueR\x14\x62\x61\x63koffLimitPerIndex\x12I\n\x12max_failed_indexes\x18\x04 \x01(\x0b\x32\x1b.google.protobuf.Int32ValueR\x10maxFailedIndexes\x12#\n\rbackoff_limit\x18\x05 \x01(\x05R\x0c\x62\x61\x63koffLimit\x12N\n\x0esuccess_policy\x18\x06 \x01(\x0b\x32\'.flyteidl.plugins.BatchJobSuccessPolicyR\rsuccessPolicy\"m\n\x15\x42\x61tchJobSuccessPolicy\x12+\n\x11succeeded_indexes\x18\x01 \x03(\x05R\x10succeededIndexes\x12\'\n\x0fsucceeded_count\x18\x02 \x01(\x05R\x0esucceededCountB\xc5\x01\n\x14\x63om.flyteidl.pluginsB\rBatchJobProtoP\x01Z=github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/plugins\xa2\x02\x03\x46PX\xaa\x02\x10\x46lyteidl.Plugins\xca\x02\x10\x46lyteidl\\Plugins\xe2\x02\x1c\x46lyteidl\\Plugins\\GPBMetadata\xea\x02\x11\x46lyteidl::Pluginsb\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'flyteidl.plugins.batch_job_pb2', _globals)
+if _descriptor._USE_C_DESCRIPTORS == False:
+
+  DESCRIPTOR._options = None
+  DESCRIPTOR._serialized_options = b'\n\024com.flyteidl.pluginsB\rBatchJobProtoP\001Z=github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/plugins\242\002\003FPX\252\002\020Flyteidl.Plugins\312\002\020Flyteidl\\Plugins\342\002\034Flyteidl\\Plugins\\GPBMetadata\352\002\021Flyteidl::Plugins'
+  _globals['_BATCHJOB']._serialized_start=87
+  _globals['_BATCHJOB']._serialized_end=441
+  _globals['_BATCHJOBSUCCESSPOLICY']._serialized_start=443
+  _globals['_BATCHJOBSUCCESSPOLICY']._serialized_end=552
+# @@protoc_insertion_point(module_scope)
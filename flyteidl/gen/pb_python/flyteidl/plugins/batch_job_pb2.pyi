@@ -0,0 +1,31 @@
+from google.protobuf import wrappers_pb2 as _wrappers_pb2
+from google.protobuf.internal import containers as _containers
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class BatchJob(_message.Message):
+    __slots__ = ["completions", "parallelism", "backoff_limit_per_index", "max_failed_indexes", "backoff_limit", "success_policy"]
+    COMPLETIONS_FIELD_NUMBER: _ClassVar[int]
+    PARALLELISM_FIELD_NUMBER: _ClassVar[int]
+    BACKOFF_LIMIT_PER_INDEX_FIELD_NUMBER: _ClassVar[int]
+    MAX_FAILED_INDEXES_FIELD_NUMBER: _ClassVar[int]
+    BACKOFF_LIMIT_FIELD_NUMBER: _ClassVar[int]
+    SUCCESS_POLICY_FIELD_NUMBER: _ClassVar[int]
+    completions: int
+    parallelism: int
+    backoff_limit_per_index: _wrappers_pb2.Int32Value
+    max_failed_indexes: _wrappers_pb2.Int32Value
+    backoff_limit: int
+    success_policy: BatchJobSuccessPolicy
+    def __init__(self, completions: _Optional[int] = ..., parallelism: _Optional[int] = ..., backoff_limit_per_index: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., max_failed_indexes: _Optional[_Union[_wrappers_pb2.Int32Value, _Mapping]] = ..., backoff_limit: _Optional[int] = ..., success_policy: _Optional[_Union[BatchJobSuccessPolicy, _Mapping]] = ...) -> None: ...
+
+class BatchJobSuccessPolicy(_message.Message):
+    __slots__ = ["succeeded_indexes", "succeeded_count"]
+    SUCCEEDED_INDEXES_FIELD_NUMBER: _ClassVar[int]
+    SUCCEEDED_COUNT_FIELD_NUMBER: _ClassVar[int]
+    succeeded_indexes: _containers.RepeatedScalarFieldContainer[int]
+    succeeded_count: int
+    def __init__(self, succeeded_indexes: _Optional[_Iterable[int]] = ..., succeeded_count: _Optional[int] = ...) -> None: ...
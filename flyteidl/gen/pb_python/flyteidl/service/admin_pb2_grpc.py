@@ -50,6 +50,11 @@ class AdminServiceStub(object):
                 request_serializer=flyteidl_dot_admin_dot_common__pb2.ResourceListRequest.SerializeToString,
                 response_deserializer=flyteidl_dot_admin_dot_task__pb2.TaskList.FromString,
                 )
+        self.GetTaskResourceRecommendation = channel.unary_unary(
+                '/flyteidl.service.AdminService/GetTaskResourceRecommendation',
+                request_serializer=flyteidl_dot_admin_dot_common__pb2.ObjectGetRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_admin_dot_task__pb2.TaskResourceRecommendation.FromString,
+                )
         self.CreateWorkflow = channel.unary_unary(
                 '/flyteidl.service.AdminService/CreateWorkflow',
                 request_serializer=flyteidl_dot_admin_dot_workflow__pb2.WorkflowCreateRequest.SerializeToString,
@@ -345,6 +350,14 @@ class AdminServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetTaskResourceRecommendation(self, request, context):
+        """Fetch the :ref:`ref_flyteidl.admin.TaskResourceRecommendation` of a task version, based on the resources its past
+        executions used.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CreateWorkflow(self, request, context):
         """Create and upload a :ref:`ref_flyteidl.admin.Workflow` definition
         """
@@ -736,6 +749,11 @@ def add_AdminServiceServicer_to_server(servicer, server):
                     request_deserializer=flyteidl_dot_admin_dot_common__pb2.ResourceListRequest.FromString,
                     response_serializer=flyteidl_dot_admin_dot_task__pb2.TaskList.SerializeToString,
             ),
+            'GetTaskResourceRecommendation': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetTaskResourceRecommendation,
+                    request_deserializer=flyteidl_dot_admin_dot_common__pb2.ObjectGetRequest.FromString,
+                    response_serializer=flyteidl_dot_admin_dot_task__pb2.TaskResourceRecommendation.SerializeToString,
+            ),
             'CreateWorkflow': grpc.unary_unary_rpc_method_handler(
                     servicer.CreateWorkflow,
                     request_deserializer=flyteidl_dot_admin_dot_workflow__pb2.WorkflowCreateRequest.FromString,
@@ -1076,6 +1094,23 @@ class AdminService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetTaskResourceRecommendation(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/flyteidl.service.AdminService/GetTaskResourceRecommendation',
+            flyteidl_dot_admin_dot_common__pb2.ObjectGetRequest.SerializeToString,
+            flyteidl_dot_admin_dot_task__pb2.TaskResourceRecommendation.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def CreateWorkflow(request,
             target,
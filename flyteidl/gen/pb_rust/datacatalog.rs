@@ -180,6 +180,89 @@ pub struct UpdateArtifactResponse {
     pub artifact_id: ::prost::alloc::string::String,
 }
 ///
+/// Request message for deleting an Artifact and its associated ArtifactData.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeleteArtifactRequest {
+    /// ID of dataset the artifact is associated with
+    #[prost(message, optional, tag="1")]
+    pub dataset: ::core::option::Option<DatasetId>,
+    /// Either ID of artifact or name of tag to retrieve existing artifact from
+    #[prost(oneof="delete_artifact_request::QueryHandle", tags="2, 3")]
+    pub query_handle: ::core::option::Option<delete_artifact_request::QueryHandle>,
+}
+/// Nested message and enum types in `DeleteArtifactRequest`.
+pub mod delete_artifact_request {
+    /// Either ID of artifact or name of tag to retrieve existing artifact from
+    #[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Oneof)]
+    pub enum QueryHandle {
+        #[prost(string, tag="2")]
+        ArtifactId(::prost::alloc::string::String),
+        #[prost(string, tag="3")]
+        TagName(::prost::alloc::string::String),
+    }
+}
+///
+/// Response message for deleting an Artifact.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeleteArtifactResponse {
+    /// The unique ID of the artifact deleted
+    #[prost(string, tag="1")]
+    pub artifact_id: ::prost::alloc::string::String,
+}
+///
+/// Request message for deleting all artifacts of a dataset matching a filter expression.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeleteArtifactsRequest {
+    /// ID of dataset the artifacts are associated with
+    #[prost(message, optional, tag="1")]
+    pub dataset: ::core::option::Option<DatasetId>,
+    /// Tag or partition filters the deleted artifacts must match. At least one filter is required.
+    #[prost(message, optional, tag="2")]
+    pub filter: ::core::option::Option<FilterExpression>,
+}
+///
+/// Response message for deleting artifacts matching a filter expression.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeleteArtifactsResponse {
+    /// The unique IDs of the artifacts deleted
+    #[prost(string, repeated, tag="1")]
+    pub artifact_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// The artifacts which matched the filter but failed to be deleted
+    #[prost(message, repeated, tag="2")]
+    pub errors: ::prost::alloc::vec::Vec<DeleteArtifactError>,
+}
+///
+/// The failure to delete a single artifact matching the filter of a DeleteArtifactsRequest.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeleteArtifactError {
+    /// The unique ID of the artifact
+    #[prost(string, tag="1")]
+    pub artifact_id: ::prost::alloc::string::String,
+    /// The reason the artifact could not be deleted
+    #[prost(string, tag="2")]
+    pub message: ::prost::alloc::string::String,
+}
+///
+/// Request message for deleting a Dataset and all of its artifacts.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeleteDatasetRequest {
+    #[prost(message, optional, tag="1")]
+    pub dataset: ::core::option::Option<DatasetId>,
+}
+///
+/// Response message for deleting a Dataset.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct DeleteDatasetResponse {
+}
+///
 /// ReservationID message that is composed of several string fields.
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
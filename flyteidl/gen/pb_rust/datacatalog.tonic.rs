@@ -281,6 +281,81 @@ pub mod data_catalog_client {
                 .insert(GrpcMethod::new("datacatalog.DataCatalog", "UpdateArtifact"));
             self.inner.unary(req, path, codec).await
         }
+        pub async fn delete_artifact(
+            &mut self,
+            request: impl tonic::IntoRequest<super::DeleteArtifactRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::DeleteArtifactResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/datacatalog.DataCatalog/DeleteArtifact",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("datacatalog.DataCatalog", "DeleteArtifact"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn delete_artifacts(
+            &mut self,
+            request: impl tonic::IntoRequest<super::DeleteArtifactsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::DeleteArtifactsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/datacatalog.DataCatalog/DeleteArtifacts",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("datacatalog.DataCatalog", "DeleteArtifacts"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn delete_dataset(
+            &mut self,
+            request: impl tonic::IntoRequest<super::DeleteDatasetRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::DeleteDatasetResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/datacatalog.DataCatalog/DeleteDataset",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("datacatalog.DataCatalog", "DeleteDataset"));
+            self.inner.unary(req, path, codec).await
+        }
         pub async fn get_or_extend_reservation(
             &mut self,
             request: impl tonic::IntoRequest<super::GetOrExtendReservationRequest>,
@@ -397,6 +472,27 @@ pub mod data_catalog_server {
             tonic::Response<super::UpdateArtifactResponse>,
             tonic::Status,
         >;
+        async fn delete_artifact(
+            &self,
+            request: tonic::Request<super::DeleteArtifactRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::DeleteArtifactResponse>,
+            tonic::Status,
+        >;
+        async fn delete_artifacts(
+            &self,
+            request: tonic::Request<super::DeleteArtifactsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::DeleteArtifactsResponse>,
+            tonic::Status,
+        >;
+        async fn delete_dataset(
+            &self,
+            request: tonic::Request<super::DeleteDatasetRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::DeleteDatasetResponse>,
+            tonic::Status,
+        >;
         async fn get_or_extend_reservation(
             &self,
             request: tonic::Request<super::GetOrExtendReservationRequest>,
@@ -859,6 +955,144 @@ pub mod data_catalog_server {
                     };
                     Box::pin(fut)
                 }
+                "/datacatalog.DataCatalog/DeleteArtifact" => {
+                    #[allow(non_camel_case_types)]
+                    struct DeleteArtifactSvc<T: DataCatalog>(pub Arc<T>);
+                    impl<
+                        T: DataCatalog,
+                    > tonic::server::UnaryService<super::DeleteArtifactRequest>
+                    for DeleteArtifactSvc<T> {
+                        type Response = super::DeleteArtifactResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::DeleteArtifactRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as DataCatalog>::delete_artifact(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = DeleteArtifactSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/datacatalog.DataCatalog/DeleteArtifacts" => {
+                    #[allow(non_camel_case_types)]
+                    struct DeleteArtifactsSvc<T: DataCatalog>(pub Arc<T>);
+                    impl<
+                        T: DataCatalog,
+                    > tonic::server::UnaryService<super::DeleteArtifactsRequest>
+                    for DeleteArtifactsSvc<T> {
+                        type Response = super::DeleteArtifactsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::DeleteArtifactsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as DataCatalog>::delete_artifacts(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = DeleteArtifactsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/datacatalog.DataCatalog/DeleteDataset" => {
+                    #[allow(non_camel_case_types)]
+                    struct DeleteDatasetSvc<T: DataCatalog>(pub Arc<T>);
+                    impl<
+                        T: DataCatalog,
+                    > tonic::server::UnaryService<super::DeleteDatasetRequest>
+                    for DeleteDatasetSvc<T> {
+                        type Response = super::DeleteDatasetResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::DeleteDatasetRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as DataCatalog>::delete_dataset(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = DeleteDatasetSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/datacatalog.DataCatalog/GetOrExtendReservation" => {
                     #[allow(non_camel_case_types)]
                     struct GetOrExtendReservationSvc<T: DataCatalog>(pub Arc<T>);
@@ -2848,6 +2848,12 @@ pub struct SignalGetOrCreateRequest {
     /// A type denoting the required value type for this signal.
     #[prost(message, optional, tag="2")]
     pub r#type: ::core::option::Option<super::core::LiteralType>,
+    /// The policy of the approve condition creating this signal. Setting the value of a signal with an approval
+    /// policy records an approval, the value is only set once the policy is satisfied. The policy of a new signal is
+    /// read from the gate node of the execution's workflow and requests with a different policy are rejected.
+    /// +optional
+    #[prost(message, optional, tag="3")]
+    pub approval_policy: ::core::option::Option<super::core::ApprovalPolicy>,
 }
 /// SignalListRequest represents a request structure to retrieve a collection of signals.
 /// See :ref:`ref_flyteidl.admin.Signal` for more details
@@ -2901,6 +2907,10 @@ pub struct SignalSetRequest {
     /// The value of this signal, must match the defining signal type.
     #[prost(message, optional, tag="2")]
     pub value: ::core::option::Option<super::core::Literal>,
+    /// The reason for the decision, recorded with the approval of signals with an approval policy.
+    /// +optional
+    #[prost(string, tag="3")]
+    pub reason: ::prost::alloc::string::String,
 }
 /// SignalSetResponse represents a response structure if signal setting succeeds.
 ///
@@ -2925,6 +2935,29 @@ pub struct Signal {
     /// the defined the type.
     #[prost(message, optional, tag="3")]
     pub value: ::core::option::Option<super::core::Literal>,
+    /// The policy restricting who may approve this signal, if any.
+    #[prost(message, optional, tag="4")]
+    pub approval_policy: ::core::option::Option<super::core::ApprovalPolicy>,
+    /// The decisions recorded on this signal so far, in the order they were made.
+    #[prost(message, repeated, tag="5")]
+    pub approvals: ::prost::alloc::vec::Vec<SignalApproval>,
+}
+/// SignalApproval records the decision of a single identity on a signal with an approval policy.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SignalApproval {
+    /// The identity of the authenticated caller.
+    #[prost(string, tag="1")]
+    pub identity: ::prost::alloc::string::String,
+    /// Whether the caller approved or rejected.
+    #[prost(bool, tag="2")]
+    pub approved: bool,
+    /// The reason given by the caller.
+    #[prost(string, tag="3")]
+    pub reason: ::prost::alloc::string::String,
+    /// The time the decision was recorded.
+    #[prost(message, optional, tag="4")]
+    pub created_at: ::core::option::Option<::prost_types::Timestamp>,
 }
 /// Represents a request structure to create a revision of a task.
 /// See :ref:`ref_flyteidl.admin.Task` for more details
@@ -2999,6 +3032,24 @@ pub struct TaskClosure {
     #[prost(message, optional, tag="2")]
     pub created_at: ::core::option::Option<::prost_types::Timestamp>,
 }
+/// Resources recommended for a task version, based on the peak usage reported by its past successful executions.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TaskResourceRecommendation {
+    /// Identifier of the task version.
+    #[prost(message, optional, tag="1")]
+    pub id: ::core::option::Option<super::core::Identifier>,
+    /// Recommended resources, the peak usage increased by the configured headroom. Unset until enough executions
+    /// reported their usage.
+    #[prost(message, optional, tag="2")]
+    pub recommended: ::core::option::Option<TaskResourceSpec>,
+    /// Peak usage observed across the sampled executions.
+    #[prost(message, optional, tag="3")]
+    pub peak: ::core::option::Option<TaskResourceSpec>,
+    /// Number of executions the recommendation is based on.
+    #[prost(int32, tag="4")]
+    pub sample_count: i32,
+}
 /// A message used to fetch a single task execution entity.
 /// See :ref:`ref_flyteidl.admin.TaskExecution` for more details
 #[allow(clippy::derive_partial_eq_without_eq)]
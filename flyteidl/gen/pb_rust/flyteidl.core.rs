@@ -1824,6 +1824,18 @@ pub mod comparison_expression {
         /// Less Than
         Lt = 4,
         Lte = 5,
+        /// The left value is an element of the right value, which has to be a collection.
+        In = 6,
+        NotIn = 7,
+        /// String operators, both values have to be strings.
+        StartsWith = 8,
+        EndsWith = 9,
+        /// The left value matches the regular expression (RE2 syntax) in the right value. Use ^ and $ to match the
+        /// whole string.
+        Matches = 10,
+        /// Unary operators checking whether the left value is none/null. The right value is not set.
+        IsNull = 11,
+        IsNotNull = 12,
     }
     impl Operator {
         /// String value of the enum field names used in the ProtoBuf definition.
@@ -1838,6 +1850,13 @@ pub mod comparison_expression {
                 Operator::Gte => "GTE",
                 Operator::Lt => "LT",
                 Operator::Lte => "LTE",
+                Operator::In => "IN",
+                Operator::NotIn => "NOT_IN",
+                Operator::StartsWith => "STARTS_WITH",
+                Operator::EndsWith => "ENDS_WITH",
+                Operator::Matches => "MATCHES",
+                Operator::IsNull => "IS_NULL",
+                Operator::IsNotNull => "IS_NOT_NULL",
             }
         }
         /// Creates an enum from field names used in the ProtoBuf definition.
@@ -1849,6 +1868,13 @@ pub mod comparison_expression {
                 "GTE" => Some(Self::Gte),
                 "LT" => Some(Self::Lt),
                 "LTE" => Some(Self::Lte),
+                "IN" => Some(Self::In),
+                "NOT_IN" => Some(Self::NotIn),
+                "STARTS_WITH" => Some(Self::StartsWith),
+                "ENDS_WITH" => Some(Self::EndsWith),
+                "MATCHES" => Some(Self::Matches),
+                "IS_NULL" => Some(Self::IsNull),
+                "IS_NOT_NULL" => Some(Self::IsNotNull),
                 _ => None,
             }
         }
@@ -1858,7 +1884,11 @@ pub mod comparison_expression {
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Operand {
-    #[prost(oneof="operand::Val", tags="1, 2, 3")]
+    /// Attribute path into the value of var, e.g. \["a", "b", 0\] to compare var.a["b"][0]. Resolves through maps,
+    /// collections, structs and msgpack encoded binaries (dataclasses, pydantic models).
+    #[prost(message, repeated, tag="5")]
+    pub attr_path: ::prost::alloc::vec::Vec<PromiseAttribute>,
+    #[prost(oneof="operand::Val", tags="1, 2, 3, 4")]
     pub val: ::core::option::Option<operand::Val>,
 }
 /// Nested message and enum types in `Operand`.
@@ -1875,6 +1905,9 @@ pub mod operand {
         /// Replace the primitive field
         #[prost(message, tag="3")]
         Scalar(super::Scalar),
+        /// Or a constant collection, e.g. the right value of an IN comparison
+        #[prost(message, tag="4")]
+        Collection(super::LiteralCollection),
     }
 }
 /// Defines a boolean expression tree. It can be a simple or a conjunction expression.
@@ -1882,7 +1915,7 @@ pub mod operand {
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct BooleanExpression {
-    #[prost(oneof="boolean_expression::Expr", tags="1, 2")]
+    #[prost(oneof="boolean_expression::Expr", tags="1, 2, 3")]
     pub expr: ::core::option::Option<boolean_expression::Expr>,
 }
 /// Nested message and enum types in `BooleanExpression`.
@@ -1894,8 +1927,17 @@ pub mod boolean_expression {
         Conjunction(::prost::alloc::boxed::Box<super::ConjunctionExpression>),
         #[prost(message, tag="2")]
         Comparison(super::ComparisonExpression),
+        #[prost(message, tag="3")]
+        Negation(::prost::alloc::boxed::Box<super::NegationExpression>),
     }
 }
+/// Defines the negation of a boolean expression.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct NegationExpression {
+    #[prost(message, optional, boxed, tag="1")]
+    pub expression: ::core::option::Option<::prost::alloc::boxed::Box<BooleanExpression>>,
+}
 /// Defines a conjunction expression of two boolean expressions.
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -2373,6 +2415,26 @@ pub struct ApproveCondition {
     /// A unique identifier for the requested boolean signal.
     #[prost(string, tag="1")]
     pub signal_id: ::prost::alloc::string::String,
+    /// An optional policy restricting who may approve and how many approvals are needed. Without a policy the first
+    /// caller setting the signal resolves the condition.
+    #[prost(message, optional, tag="2")]
+    pub policy: ::core::option::Option<ApprovalPolicy>,
+}
+/// ApprovalPolicy restricts the identities allowed to approve a gate and the number of distinct approvals it needs.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ApprovalPolicy {
+    /// The number of distinct identities that need to approve, or reject, to resolve the gate. Defaults to a single
+    /// decision.
+    #[prost(uint32, tag="1")]
+    pub min_approvals: u32,
+    /// The identities allowed to approve, matched against the subject of the authenticated caller.
+    #[prost(string, repeated, tag="2")]
+    pub allowed_identities: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// The groups allowed to approve, matched against the groups claim of the authenticated caller. If neither
+    /// identities nor groups are set any authenticated caller may approve.
+    #[prost(string, repeated, tag="3")]
+    pub allowed_groups: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
 }
 /// SignalCondition represents a dependency on an signal.
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -2396,11 +2458,109 @@ pub struct SleepCondition {
     #[prost(message, optional, tag="1")]
     pub duration: ::core::option::Option<::prost_types::Duration>,
 }
+/// GateTimeout configures how long a gate waits for its condition to be met.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GateTimeout {
+    /// The maximum duration to wait for, measured from the start of the gate.
+    #[prost(message, optional, tag="1")]
+    pub duration: ::core::option::Option<::prost_types::Duration>,
+    /// The output of the gate once the timeout is reached. If not set the gate fails once the timeout is reached.
+    #[prost(message, optional, tag="2")]
+    pub default_output: ::core::option::Option<Literal>,
+}
+/// WaitUntilCondition represents a dependency on waiting until the point in time provided by an input of the node.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WaitUntilCondition {
+    /// The name of the datetime input to wait until.
+    #[prost(string, tag="1")]
+    pub input_variable_name: ::prost::alloc::string::String,
+    /// The name of the optional boolean output, set to true once the point in time is reached.
+    #[prost(string, tag="2")]
+    pub output_variable_name: ::prost::alloc::string::String,
+    /// An optional timeout, for points in time that may lie too far in the future.
+    #[prost(message, optional, tag="3")]
+    pub timeout: ::core::option::Option<GateTimeout>,
+}
+/// StorageObjectCondition is met once an object exists in the blob store.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StorageObjectCondition {
+    #[prost(oneof="storage_object_condition::UriSource", tags="1, 2")]
+    pub uri_source: ::core::option::Option<storage_object_condition::UriSource>,
+}
+/// Nested message and enum types in `StorageObjectCondition`.
+pub mod storage_object_condition {
+    #[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Oneof)]
+    pub enum UriSource {
+        /// The uri of the object.
+        #[prost(string, tag="1")]
+        Uri(::prost::alloc::string::String),
+        /// The name of the string input providing the uri of the object.
+        #[prost(string, tag="2")]
+        UriInputVariableName(::prost::alloc::string::String),
+    }
+}
+/// CatalogArtifactCondition is met once an artifact of a datacatalog dataset is tagged with the tag.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CatalogArtifactCondition {
+    /// The project of the dataset.
+    #[prost(string, tag="1")]
+    pub project: ::prost::alloc::string::String,
+    /// The domain of the dataset.
+    #[prost(string, tag="2")]
+    pub domain: ::prost::alloc::string::String,
+    /// The name of the dataset.
+    #[prost(string, tag="3")]
+    pub name: ::prost::alloc::string::String,
+    /// The version of the dataset.
+    #[prost(string, tag="4")]
+    pub version: ::prost::alloc::string::String,
+    /// The tag the artifact needs to be tagged with.
+    #[prost(string, tag="5")]
+    pub tag: ::prost::alloc::string::String,
+}
+/// ExternalCondition represents a dependency on a condition outside of the workflow, which is polled with exponential
+/// backoff.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExternalCondition {
+    /// The interval between the first two polls, doubled after every poll. Defaults to 10 seconds.
+    #[prost(message, optional, tag="3")]
+    pub poll_interval: ::core::option::Option<::prost_types::Duration>,
+    /// The maximum interval between two polls. Defaults to 5 minutes.
+    #[prost(message, optional, tag="4")]
+    pub max_poll_interval: ::core::option::Option<::prost_types::Duration>,
+    /// The name of the optional boolean output, set to true once the condition is met.
+    #[prost(string, tag="5")]
+    pub output_variable_name: ::prost::alloc::string::String,
+    /// An optional timeout, for conditions that may never be met.
+    #[prost(message, optional, tag="6")]
+    pub timeout: ::core::option::Option<GateTimeout>,
+    #[prost(oneof="external_condition::Condition", tags="1, 2")]
+    pub condition: ::core::option::Option<external_condition::Condition>,
+}
+/// Nested message and enum types in `ExternalCondition`.
+pub mod external_condition {
+    #[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Oneof)]
+    pub enum Condition {
+        /// StorageObjectCondition is met once an object exists in the blob store.
+        #[prost(message, tag="1")]
+        StorageObject(super::StorageObjectCondition),
+        /// CatalogArtifactCondition is met once an artifact of a datacatalog dataset is tagged with the tag.
+        #[prost(message, tag="2")]
+        CatalogArtifact(super::CatalogArtifactCondition),
+    }
+}
 /// GateNode refers to the condition that is required for the gate to successfully complete.
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct GateNode {
-    #[prost(oneof="gate_node::Condition", tags="1, 2, 3")]
+    #[prost(oneof="gate_node::Condition", tags="1, 2, 3, 4, 5")]
     pub condition: ::core::option::Option<gate_node::Condition>,
 }
 /// Nested message and enum types in `GateNode`.
@@ -2417,6 +2577,12 @@ pub mod gate_node {
         /// SleepCondition represents a dependency on waiting for the specified duration.
         #[prost(message, tag="3")]
         Sleep(super::SleepCondition),
+        /// WaitUntilCondition represents a dependency on waiting until a point in time provided by an input.
+        #[prost(message, tag="4")]
+        WaitUntil(super::WaitUntilCondition),
+        /// ExternalCondition represents a dependency on a condition outside of the workflow.
+        #[prost(message, tag="5")]
+        External(super::ExternalCondition),
     }
 }
 /// ArrayNode is a Flyte node type that simplifies the execution of a sub-node over a list of input
@@ -2431,13 +2597,38 @@ pub struct ArrayNode {
     /// execution_mode determines the execution path for ArrayNode.
     #[prost(enumeration="array_node::ExecutionMode", tag="5")]
     pub execution_mode: i32,
+    /// partial_success, if set, types the outputs of the ArrayNode as collections of optional values with none at the
+    /// index of every sub-node that failed, along with a collection describing each failure. Unless a success criteria
+    /// is set, the ArrayNode succeeds regardless of the number of sub-nodes that failed.
+    #[prost(message, optional, tag="6")]
+    pub partial_success: ::core::option::Option<array_node::PartialSuccess>,
+    /// max_failures fails the ArrayNode, aborting the sub-nodes still running, as soon as this number of sub-nodes
+    /// failed. 0 disables it.
+    #[prost(uint32, tag="8")]
+    pub max_failures: u32,
+    /// adaptive_parallelism opts the ArrayNode into raising and lowering the number of concurrently running sub-nodes
+    /// based on the feedback of the cluster, bounded by parallelism. It only takes effect if adaptive parallelism is
+    /// enabled in the configuration of the platform.
+    #[prost(bool, tag="9")]
+    pub adaptive_parallelism: bool,
     #[prost(oneof="array_node::ParallelismOption", tags="2")]
     pub parallelism_option: ::core::option::Option<array_node::ParallelismOption>,
     #[prost(oneof="array_node::SuccessCriteria", tags="3, 4")]
     pub success_criteria: ::core::option::Option<array_node::SuccessCriteria>,
+    #[prost(oneof="array_node::RetryBudgetOption", tags="7")]
+    pub retry_budget_option: ::core::option::Option<array_node::RetryBudgetOption>,
 }
 /// Nested message and enum types in `ArrayNode`.
 pub mod array_node {
+    /// PartialSuccess configures the ArrayNode to produce the outputs of the sub-nodes that succeeded even if others failed.
+    #[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+    pub struct PartialSuccess {
+        /// errors_output is the name of the output holding a collection with the error of every sub-node that failed and
+        /// none for every sub-node that succeeded. Defaults to "errors".
+        #[prost(string, tag="1")]
+        pub errors_output: ::prost::alloc::string::String,
+    }
     #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
     #[repr(i32)]
     pub enum ExecutionMode {
@@ -2492,6 +2683,14 @@ pub mod array_node {
         #[prost(float, tag="4")]
         MinSuccessRatio(f32),
     }
+    #[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, Copy, PartialEq, ::prost::Oneof)]
+    pub enum RetryBudgetOption {
+        /// retry_budget is the total number of retries shared by all sub-nodes. Once it is used up, sub-nodes that fail
+        /// are not retried anymore even if their retry strategy allows it.
+        #[prost(uint32, tag="7")]
+        RetryBudget(u32),
+    }
 }
 /// Defines extra information about the Node.
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -364,6 +364,18 @@ pub struct TaskExecutionMetadata {
     /// The identifier of the plugin used to execute this task.
     #[prost(string, tag="4")]
     pub plugin_identifier: ::prost::alloc::string::String,
+    /// Peak resource usage observed across the containers of this task execution. Reported once the task execution
+    /// completes, if the plugin samples the usage of its resources.
+    #[prost(message, repeated, tag="5")]
+    pub peak_resource_usage: ::prost::alloc::vec::Vec<super::core::resources::ResourceEntry>,
+    /// Resources of this task execution escalated from the resources of its task, because previous attempts were
+    /// OOMKilled. Only the escalated resources are set.
+    #[prost(message, optional, tag="6")]
+    pub escalated_resources: ::core::option::Option<super::core::Resources>,
+    /// Whether this task execution was preempted, e.g. because its node was reclaimed, rather than failed. The following
+    /// attempt resumes from the checkpoint of this task execution.
+    #[prost(bool, tag="7")]
+    pub preempted: bool,
     #[prost(enumeration="task_execution_metadata::InstanceClass", tag="16")]
     pub instance_class: i32,
 }
@@ -34,6 +34,44 @@ pub mod array_job {
         MinSuccessRatio(f32),
     }
 }
+/// Custom proto for the batch job plugin, running the task as a Kubernetes batch/v1 Job with indexed completions.
+/// Each pod receives its index in the JOB_COMPLETION_INDEX environment variable. JobSets are not supported.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchJob {
+    /// Number of indexes of the job, each index is run by one successful pod. Defaults to 1.
+    #[prost(int32, tag="1")]
+    pub completions: i32,
+    /// Maximum number of pods running in parallel. Defaults to the number of completions.
+    #[prost(int32, tag="2")]
+    pub parallelism: i32,
+    /// Number of retries of each index. If set, the failures of one index don't consume the retries of the other
+    /// indexes and the job keeps running the remaining indexes until they all finished.
+    #[prost(message, optional, tag="3")]
+    pub backoff_limit_per_index: ::core::option::Option<i32>,
+    /// Maximum number of failed indexes before the whole job is failed. Only used with backoff_limit_per_index.
+    #[prost(message, optional, tag="4")]
+    pub max_failed_indexes: ::core::option::Option<i32>,
+    /// Number of retries of the whole job. Ignored if backoff_limit_per_index is set.
+    #[prost(int32, tag="5")]
+    pub backoff_limit: i32,
+    /// Defines when the job is considered successful before all indexes succeeded.
+    #[prost(message, optional, tag="6")]
+    pub success_policy: ::core::option::Option<BatchJobSuccessPolicy>,
+}
+/// Success policy of a batch job. The job succeeds as soon as one of the set criteria is met, any pods still running
+/// are then terminated. The policy is evaluated by flytepropeller from the status of the job, it's not passed to the job
+/// controller.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchJobSuccessPolicy {
+    /// The job succeeds once all these indexes succeeded.
+    #[prost(int32, repeated, tag="1")]
+    pub succeeded_indexes: ::prost::alloc::vec::Vec<i32>,
+    /// The job succeeds once this many indexes succeeded.
+    #[prost(int32, tag="2")]
+    pub succeeded_count: i32,
+}
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct CommonReplicaSpec {
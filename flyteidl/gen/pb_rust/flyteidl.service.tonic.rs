@@ -197,6 +197,39 @@ pub mod admin_service_client {
                 .insert(GrpcMethod::new("flyteidl.service.AdminService", "ListTasks"));
             self.inner.unary(req, path, codec).await
         }
+        /** Fetch the :ref:`ref_flyteidl.admin.TaskResourceRecommendation` of a task version, based on the resources its past
+ executions used.
+*/
+        pub async fn get_task_resource_recommendation(
+            &mut self,
+            request: impl tonic::IntoRequest<super::super::admin::ObjectGetRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::TaskResourceRecommendation>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.AdminService/GetTaskResourceRecommendation",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "flyteidl.service.AdminService",
+                        "GetTaskResourceRecommendation",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
         /** Create and upload a :ref:`ref_flyteidl.admin.Workflow` definition
 */
         pub async fn create_workflow(
@@ -1887,6 +1920,16 @@ pub mod admin_service_server {
             tonic::Response<super::super::admin::TaskList>,
             tonic::Status,
         >;
+        /** Fetch the :ref:`ref_flyteidl.admin.TaskResourceRecommendation` of a task version, based on the resources its past
+ executions used.
+*/
+        async fn get_task_resource_recommendation(
+            &self,
+            request: tonic::Request<super::super::admin::ObjectGetRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::TaskResourceRecommendation>,
+            tonic::Status,
+        >;
         /** Create and upload a :ref:`ref_flyteidl.admin.Workflow` definition
 */
         async fn create_workflow(
@@ -2648,6 +2691,58 @@ pub mod admin_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/flyteidl.service.AdminService/GetTaskResourceRecommendation" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetTaskResourceRecommendationSvc<T: AdminService>(pub Arc<T>);
+                    impl<
+                        T: AdminService,
+                    > tonic::server::UnaryService<super::super::admin::ObjectGetRequest>
+                    for GetTaskResourceRecommendationSvc<T> {
+                        type Response = super::super::admin::TaskResourceRecommendation;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::super::admin::ObjectGetRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as AdminService>::get_task_resource_recommendation(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetTaskResourceRecommendationSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/flyteidl.service.AdminService/CreateWorkflow" => {
                     #[allow(non_camel_case_types)]
                     struct CreateWorkflowSvc<T: AdminService>(pub Arc<T>);
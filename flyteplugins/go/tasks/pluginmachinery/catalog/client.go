@@ -146,6 +146,9 @@ type Client interface {
 	Update(ctx context.Context, key Key, reader io.OutputReader, metadata Metadata) (Status, error)
 	// ReleaseReservation releases an acquired reservation for the given key and owner ID.
 	ReleaseReservation(ctx context.Context, key Key, ownerID string) error
+	// LookupArtifact returns the artifact of the given dataset with the given tag. Unlike Get, it returns the artifact
+	// regardless of its age.
+	LookupArtifact(ctx context.Context, datasetID *datacatalog.DatasetID, tag string) (*datacatalog.Artifact, error)
 }
 
 func IsNotFound(err error) bool {
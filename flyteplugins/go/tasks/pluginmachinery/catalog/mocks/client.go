@@ -101,6 +101,47 @@ func (_m *Client) GetOrExtendReservation(ctx context.Context, key catalog.Key, o
 	return r0, r1
 }
 
+type Client_LookupArtifact struct {
+	*mock.Call
+}
+
+func (_m Client_LookupArtifact) Return(_a0 *datacatalog.Artifact, _a1 error) *Client_LookupArtifact {
+	return &Client_LookupArtifact{Call: _m.Call.Return(_a0, _a1)}
+}
+
+func (_m *Client) OnLookupArtifact(ctx context.Context, datasetID *datacatalog.DatasetID, tag string) *Client_LookupArtifact {
+	c_call := _m.On("LookupArtifact", ctx, datasetID, tag)
+	return &Client_LookupArtifact{Call: c_call}
+}
+
+func (_m *Client) OnLookupArtifactMatch(matchers ...interface{}) *Client_LookupArtifact {
+	c_call := _m.On("LookupArtifact", matchers...)
+	return &Client_LookupArtifact{Call: c_call}
+}
+
+// LookupArtifact provides a mock function with given fields: ctx, datasetID, tag
+func (_m *Client) LookupArtifact(ctx context.Context, datasetID *datacatalog.DatasetID, tag string) (*datacatalog.Artifact, error) {
+	ret := _m.Called(ctx, datasetID, tag)
+
+	var r0 *datacatalog.Artifact
+	if rf, ok := ret.Get(0).(func(context.Context, *datacatalog.DatasetID, string) *datacatalog.Artifact); ok {
+		r0 = rf(ctx, datasetID, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datacatalog.Artifact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datacatalog.DatasetID, string) error); ok {
+		r1 = rf(ctx, datasetID, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type Client_Put struct {
 	*mock.Call
 }
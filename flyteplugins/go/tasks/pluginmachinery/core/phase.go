@@ -111,6 +111,8 @@ type TaskInfo struct {
 	ExternalResources []*ExternalResource
 	// Additional reasons for this case. Note, these are not included in the phase state.
 	AdditionalReasons []ReasonInfo
+	// Peak resource usage observed across the containers of the task execution, reported once it completes.
+	PeakResourceUsage []*core.Resources_ResourceEntry
 }
 
 func (t *TaskInfo) String() string {
@@ -66,6 +66,20 @@ var (
 		},
 		UpdateBaseBackoffDuration: 10,
 		UpdateBackoffRetries:      5,
+		GangScheduling: GangSchedulingConfig{
+			VolcanoSchedulerName: "volcano",
+		},
+		ResourceUsage: ResourceUsageConfig{
+			SamplingInterval: config2.Duration{
+				Duration: 30 * time.Second,
+			},
+		},
+		Preemption: PreemptionConfig{
+			Signal: "SIGTERM",
+			GracePeriod: config2.Duration{
+				Duration: 30 * time.Second,
+			},
+		},
 	}
 
 	// K8sPluginConfigSection provides a singular top level config section for all plugins.
@@ -214,6 +228,59 @@ type K8sPluginConfig struct {
 
 	// Number of retries for exponential backoff when updating a resource.
 	UpdateBackoffRetries int `json:"update-backoff-retries" pflag:",Number of retries for exponential backoff when updating a resource."`
+
+	// GangScheduling submits all resources launched by Flyte to a gang scheduler, so that the pods of distributed tasks
+	// are only started once the whole group fits in the cluster.
+	GangScheduling GangSchedulingConfig `json:"gang-scheduling" pflag:",Gang scheduling configuration."`
+
+	// ResourceUsage samples the resource usage of the pods launched by Flyte and reports their peak usage in the task
+	// execution events, so that the resources of tasks can be sized after what they actually use.
+	ResourceUsage ResourceUsageConfig `json:"resource-usage" pflag:",Resource usage reporting configuration."`
+
+	// Preemption detects interruptible pods preempted from their nodes, signals them to checkpoint within a grace period
+	// and hands their checkpoint off to the next attempt of the task.
+	Preemption PreemptionConfig `json:"preemption" pflag:",Preemption handling configuration for interruptible pods."`
+}
+
+// PreemptionConfig specifies how the preemption of interruptible pods is detected and how they're terminated.
+type PreemptionConfig struct {
+	// Enabled detects pods disrupted by Kubernetes or running on nodes tainted with one of the node taints.
+	Enabled bool `json:"enabled" pflag:",Detects the preemption of interruptible pods and gives them a grace period to checkpoint before they're retried."`
+	// Keys of the taints set on nodes about to be reclaimed, e.g. by the spot termination handler of the cloud provider.
+	NodeTaints []string `json:"node-taints" pflag:",Keys of the taints set on nodes about to be reclaimed."`
+	// Signal sent to the primary container of preempted pods, e.g. SIGTERM or SIGUSR1. Signals other than SIGTERM are
+	// sent to PID 1 by a pre-stop hook, which requires /bin/sh in the image of the task.
+	Signal string `json:"signal" pflag:",Signal sent to the primary container of preempted pods."`
+	// Time preempted pods are given to checkpoint before they're killed.
+	GracePeriod config2.Duration `json:"grace-period" pflag:",Time preempted pods are given to checkpoint before they're killed."`
+}
+
+// ResourceUsageConfig specifies how the resource usage of the pods launched by Flyte is sampled.
+type ResourceUsageConfig struct {
+	// Enabled samples the usage of the pods from the metrics API, which requires the metrics server to be installed.
+	Enabled bool `json:"enabled" pflag:",Samples the resource usage of pods from the metrics API and reports their peak usage in task execution events."`
+	// SamplingInterval bounds the number of metrics API calls made for each task.
+	SamplingInterval config2.Duration `json:"sampling-interval" pflag:",Minimum interval between two samples of the resource usage of the pods of a task."`
+}
+
+// GangSchedulingConfig specifies the gang scheduler resources are submitted to and the queues they're submitted to.
+type GangSchedulingConfig struct {
+	// Gang scheduler to use, either kueue or volcano. Gang scheduling is disabled if empty.
+	Scheduler string `json:"scheduler" pflag:",Gang scheduler to use, either kueue or volcano. Gang scheduling is disabled if empty."`
+	// Scheduler name set on the pods when the volcano gang scheduler is used.
+	VolcanoSchedulerName string `json:"volcano-scheduler-name" pflag:",Scheduler name set on the pods when the volcano gang scheduler is used."`
+	// Queue the resources are submitted to if none of the queue rules match their project and domain.
+	DefaultQueue string `json:"default-queue" pflag:",Queue the resources are submitted to if none of the queue rules match their project and domain."`
+	// Rules mapping the project and domain of the resources to a queue. The first matching rule is used.
+	Queues []GangSchedulingQueue `json:"queues" pflag:"-,Rules mapping the project and domain of the resources to a queue. The first matching rule is used."`
+}
+
+// GangSchedulingQueue maps the projects and domains matching the glob patterns to a queue. Empty patterns match any
+// project or domain.
+type GangSchedulingQueue struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Queue   string `json:"queue"`
 }
 
 // FlyteCoPilotConfig specifies configuration for the Flyte CoPilot system. FlyteCoPilot, allows running flytekit-less containers
@@ -69,5 +69,14 @@ func (cfg K8sPluginConfig) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "send-object-events"), defaultK8sConfig.SendObjectEvents, "If true,  will send k8s object events in TaskExecutionEvent updates.")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "update-base-backoff-duration"), defaultK8sConfig.UpdateBaseBackoffDuration, "Initial delay in exponential backoff when updating a resource in milliseconds.")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "update-backoff-retries"), defaultK8sConfig.UpdateBackoffRetries, "Number of retries for exponential backoff when updating a resource.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "gang-scheduling.scheduler"), defaultK8sConfig.GangScheduling.Scheduler, "Gang scheduler to use,  either kueue or volcano. Gang scheduling is disabled if empty.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "gang-scheduling.volcano-scheduler-name"), defaultK8sConfig.GangScheduling.VolcanoSchedulerName, "Scheduler name set on the pods when the volcano gang scheduler is used.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "gang-scheduling.default-queue"), defaultK8sConfig.GangScheduling.DefaultQueue, "Queue the resources are submitted to if none of the queue rules match their project and domain.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "resource-usage.enabled"), defaultK8sConfig.ResourceUsage.Enabled, "Samples the resource usage of pods from the metrics API and reports their peak usage in task execution events.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "resource-usage.sampling-interval"), defaultK8sConfig.ResourceUsage.SamplingInterval.String(), "Minimum interval between two samples of the resource usage of the pods of a task.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "preemption.enabled"), defaultK8sConfig.Preemption.Enabled, "Detects the preemption of interruptible pods and gives them a grace period to checkpoint before they're retried.")
+	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "preemption.node-taints"), defaultK8sConfig.Preemption.NodeTaints, "Keys of the taints set on nodes about to be reclaimed.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "preemption.signal"), defaultK8sConfig.Preemption.Signal, "Signal sent to the primary container of preempted pods.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "preemption.grace-period"), defaultK8sConfig.Preemption.GracePeriod.String(), "Time preempted pods are given to checkpoint before they're killed.")
 	return cmdFlags
 }
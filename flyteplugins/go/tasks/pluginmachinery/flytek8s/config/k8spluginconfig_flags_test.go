@@ -365,4 +365,130 @@ func TestK8sPluginConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_gang-scheduling.scheduler", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("gang-scheduling.scheduler", testValue)
+			if vString, err := cmdFlags.GetString("gang-scheduling.scheduler"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.GangScheduling.Scheduler)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_gang-scheduling.volcano-scheduler-name", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("gang-scheduling.volcano-scheduler-name", testValue)
+			if vString, err := cmdFlags.GetString("gang-scheduling.volcano-scheduler-name"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.GangScheduling.VolcanoSchedulerName)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_gang-scheduling.default-queue", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("gang-scheduling.default-queue", testValue)
+			if vString, err := cmdFlags.GetString("gang-scheduling.default-queue"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.GangScheduling.DefaultQueue)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_resource-usage.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("resource-usage.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("resource-usage.enabled"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vBool), &actual.ResourceUsage.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_resource-usage.sampling-interval", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultK8sConfig.ResourceUsage.SamplingInterval.String()
+
+			cmdFlags.Set("resource-usage.sampling-interval", testValue)
+			if vString, err := cmdFlags.GetString("resource-usage.sampling-interval"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.ResourceUsage.SamplingInterval)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_preemption.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("preemption.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("preemption.enabled"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vBool), &actual.Preemption.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_preemption.node-taints", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := join_K8sPluginConfig(defaultK8sConfig.Preemption.NodeTaints, ",")
+
+			cmdFlags.Set("preemption.node-taints", testValue)
+			if vStringSlice, err := cmdFlags.GetStringSlice("preemption.node-taints"); err == nil {
+				testDecodeRaw_K8sPluginConfig(t, join_K8sPluginConfig(vStringSlice, ","), &actual.Preemption.NodeTaints)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_preemption.signal", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("preemption.signal", testValue)
+			if vString, err := cmdFlags.GetString("preemption.signal"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.Preemption.Signal)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_preemption.grace-period", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultK8sConfig.Preemption.GracePeriod.String()
+
+			cmdFlags.Set("preemption.grace-period", testValue)
+			if vString, err := cmdFlags.GetString("preemption.grace-period"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.Preemption.GracePeriod)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
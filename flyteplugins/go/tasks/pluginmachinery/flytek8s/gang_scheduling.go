@@ -0,0 +1,286 @@
+package flytek8s
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils"
+)
+
+const (
+	GangSchedulerKueue   = "kueue"
+	GangSchedulerVolcano = "volcano"
+
+	// KueueQueueNameLabel submits the labeled resource to the Kueue LocalQueue named by its value.
+	KueueQueueNameLabel = "kueue.x-k8s.io/queue-name"
+	// KueueJobUIDLabel is set by Kueue on the workloads it creates to the UID of the resource they were created for.
+	KueueJobUIDLabel = "kueue.x-k8s.io/job-uid"
+	// VolcanoQueueNameLabel submits the labeled resource to the Volcano queue named by its value.
+	VolcanoQueueNameLabel = "volcano.sh/queue-name"
+	// VolcanoGroupNameAnnotation assigns the annotated pod to the Volcano PodGroup named by its value.
+	VolcanoGroupNameAnnotation = "scheduling.k8s.io/group-name"
+
+	// GangSchedulingQueuedReason prefixes the reason of tasks waiting to be admitted by the gang scheduler.
+	GangSchedulingQueuedReason = "Queued"
+	// GangSchedulingAdmittedReason prefixes the reason of tasks admitted by the gang scheduler but not running yet.
+	GangSchedulingAdmittedReason = "Admitted"
+
+	kueueWorkloadAdmitted      = "Admitted"
+	kueueWorkloadQuotaReserved = "QuotaReserved"
+	volcanoPodGroupPending     = "Pending"
+	volcanoPodGroupUnknown     = "Unknown"
+	volcanoUnschedulable       = "Unschedulable"
+)
+
+var (
+	KueueWorkloadListGVK = schema.GroupVersionKind{Group: "kueue.x-k8s.io", Version: "v1beta1", Kind: "WorkloadList"}
+	VolcanoPodGroupGVK   = schema.GroupVersionKind{Group: "scheduling.volcano.sh", Version: "v1beta1", Kind: "PodGroup"}
+
+	// kueueIntegrations are the kinds of resources Kueue creates workloads for. Resources of other kinds would never be
+	// admitted, they're not submitted to Kueue.
+	kueueIntegrations = sets.New(
+		schema.GroupKind{Group: "", Kind: "Pod"},
+		schema.GroupKind{Group: "batch", Kind: "Job"},
+		schema.GroupKind{Group: "jobset.x-k8s.io", Kind: "JobSet"},
+		schema.GroupKind{Group: "kubeflow.org", Kind: "MPIJob"},
+		schema.GroupKind{Group: "kubeflow.org", Kind: "PyTorchJob"},
+		schema.GroupKind{Group: "kubeflow.org", Kind: "TFJob"},
+		schema.GroupKind{Group: "ray.io", Kind: "RayJob"},
+		schema.GroupKind{Group: "ray.io", Kind: "RayCluster"},
+	)
+)
+
+// GangSchedulingStatus describes whether a resource was admitted by the gang scheduler.
+type GangSchedulingStatus struct {
+	Admitted bool
+	// Message explains why the resource is not admitted yet.
+	Message string
+}
+
+// GetGangScheduler returns the configured gang scheduler, empty if gang scheduling is disabled.
+func GetGangScheduler() string {
+	return config.GetK8sPluginConfig().GangScheduling.Scheduler
+}
+
+// IsGangSchedulingSupported returns whether resources of the kind are submitted to the configured gang scheduler. Kueue
+// only supports the kinds it integrates with, Volcano supports all kinds since Flyte creates their PodGroups.
+func IsGangSchedulingSupported(gvk schema.GroupVersionKind) bool {
+	switch GetGangScheduler() {
+	case GangSchedulerKueue:
+		return kueueIntegrations.Has(gvk.GroupKind())
+	case GangSchedulerVolcano:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetGangSchedulingQueue returns the queue the resources of the task are submitted to, based on the project and domain
+// of its execution.
+func GetGangSchedulingQueue(taskExecutionMetadata pluginsCore.TaskExecutionMetadata) string {
+	cfg := config.GetK8sPluginConfig().GangScheduling
+	id := taskExecutionMetadata.GetTaskExecutionID().GetID()
+	executionID := id.NodeExecutionId.GetExecutionId() //nolint:protogetter
+	for _, queue := range cfg.Queues {
+		if matchesGlob(queue.Project, executionID.GetProject()) && matchesGlob(queue.Domain, executionID.GetDomain()) {
+			return queue.Queue
+		}
+	}
+
+	return cfg.DefaultQueue
+}
+
+// matchesGlob matches the value against a glob pattern, an empty pattern matches everything.
+func matchesGlob(pattern, value string) bool {
+	if len(pattern) == 0 {
+		return true
+	}
+
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
+
+// GetGangSchedulingGroupName returns the name of the Volcano PodGroup the pods of the task belong to. It matches the
+// name of the resource created for the task.
+func GetGangSchedulingGroupName(taskExecutionMetadata pluginsCore.TaskExecutionMetadata) string {
+	name := taskExecutionMetadata.GetTaskExecutionID().GetGeneratedName()
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		name = utils.ConvertToDNS1123SubdomainCompatibleString(name)
+	}
+
+	return name
+}
+
+// ApplyGangSchedulingMetadata submits the resource to the queue of the task. It's applied to the top level resource
+// created for the task, the gang scheduler or the operator of the resource then take care of its pods.
+func ApplyGangSchedulingMetadata(taskExecutionMetadata pluginsCore.TaskExecutionMetadata, o metav1.Object) {
+	queue := GetGangSchedulingQueue(taskExecutionMetadata)
+	if len(queue) == 0 {
+		return
+	}
+
+	var label string
+	switch GetGangScheduler() {
+	case GangSchedulerKueue:
+		label = KueueQueueNameLabel
+	case GangSchedulerVolcano:
+		label = VolcanoQueueNameLabel
+	default:
+		return
+	}
+
+	o.SetLabels(utils.UnionMaps(o.GetLabels(), map[string]string{label: queue}))
+}
+
+// ApplyGangSchedulingPodConfiguration hands the pods of the task over to the Volcano scheduler and assigns them to the
+// PodGroup of the task. Kueue doesn't require any changes to the pods.
+func ApplyGangSchedulingPodConfiguration(taskExecutionMetadata pluginsCore.TaskExecutionMetadata, podSpec *v1.PodSpec,
+	objectMeta *metav1.ObjectMeta) {
+	if GetGangScheduler() != GangSchedulerVolcano {
+		return
+	}
+
+	podSpec.SchedulerName = config.GetK8sPluginConfig().GangScheduling.VolcanoSchedulerName
+	objectMeta.Annotations = utils.UnionMaps(objectMeta.Annotations, GetGangSchedulingPodAnnotations(taskExecutionMetadata))
+}
+
+// GetGangSchedulingPodAnnotations returns the annotations assigning the pods of the task to its Volcano PodGroup. It's
+// meant for plugins whose pods aren't built from the ObjectMeta returned by ToK8sPodSpec.
+func GetGangSchedulingPodAnnotations(taskExecutionMetadata pluginsCore.TaskExecutionMetadata) map[string]string {
+	if GetGangScheduler() != GangSchedulerVolcano {
+		return nil
+	}
+
+	return map[string]string{
+		VolcanoGroupNameAnnotation: GetGangSchedulingGroupName(taskExecutionMetadata),
+	}
+}
+
+// BuildVolcanoPodGroup builds the Volcano PodGroup gang scheduling the pods of the resource. The PodGroup has the name
+// of the resource and is owned by it, so it's garbage collected together with the resource.
+func BuildVolcanoPodGroup(o metav1.Object, gvk schema.GroupVersionKind, queue string, minMember int32) *unstructured.Unstructured {
+	podGroup := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"minMember": int64(minMember),
+			},
+		},
+	}
+
+	if len(queue) > 0 {
+		podGroup.Object["spec"].(map[string]interface{})["queue"] = queue
+	}
+
+	podGroup.SetGroupVersionKind(VolcanoPodGroupGVK)
+	podGroup.SetNamespace(o.GetNamespace())
+	podGroup.SetName(o.GetName())
+	podGroup.SetLabels(o.GetLabels())
+	podGroup.SetOwnerReferences([]metav1.OwnerReference{
+		{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Name:       o.GetName(),
+			UID:        o.GetUID(),
+		},
+	})
+	return podGroup
+}
+
+// GetKueueWorkloadStatus returns the admission status of a resource from the Kueue workloads created for it.
+func GetKueueWorkloadStatus(workloads []unstructured.Unstructured) GangSchedulingStatus {
+	if len(workloads) == 0 {
+		return GangSchedulingStatus{Message: "waiting for the workload to be created"}
+	}
+
+	for _, workload := range workloads {
+		conditions, _, _ := unstructured.NestedSlice(workload.Object, "status", "conditions")
+		if status, _ := findCondition(conditions, kueueWorkloadAdmitted); status == string(metav1.ConditionTrue) {
+			return GangSchedulingStatus{Admitted: true}
+		}
+
+		if status, message := findCondition(conditions, kueueWorkloadQuotaReserved); status == string(metav1.ConditionFalse) &&
+			len(message) > 0 {
+			return GangSchedulingStatus{Message: message}
+		}
+	}
+
+	return GangSchedulingStatus{Message: "waiting for admission"}
+}
+
+// GetVolcanoPodGroupStatus returns the admission status of a resource from its Volcano PodGroup.
+func GetVolcanoPodGroupStatus(podGroup *unstructured.Unstructured) GangSchedulingStatus {
+	phase, _, _ := unstructured.NestedString(podGroup.Object, "status", "phase")
+	if len(phase) > 0 && phase != volcanoPodGroupPending && phase != volcanoPodGroupUnknown {
+		return GangSchedulingStatus{Admitted: true}
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(podGroup.Object, "status", "conditions")
+	if status, message := findCondition(conditions, volcanoUnschedulable); status == string(metav1.ConditionTrue) &&
+		len(message) > 0 {
+		return GangSchedulingStatus{Message: message}
+	}
+
+	return GangSchedulingStatus{Message: "waiting for the pod group to be enqueued"}
+}
+
+// findCondition returns the status and message of the condition of the given type.
+func findCondition(conditions []interface{}, conditionType string) (status, message string) {
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != conditionType {
+			continue
+		}
+
+		status, _ = condition["status"].(string)
+		message, _ = condition["message"].(string)
+		return status, message
+	}
+
+	return "", ""
+}
+
+// IsGangSchedulingPending returns whether tasks in the phase may still be waiting to be admitted by the gang scheduler.
+func IsGangSchedulingPending(phase pluginsCore.Phase) bool {
+	switch phase {
+	case pluginsCore.PhaseNotReady, pluginsCore.PhaseWaitingForResources, pluginsCore.PhaseQueued, pluginsCore.PhaseInitializing:
+		return true
+	default:
+		return false
+	}
+}
+
+// ApplyGangSchedulingStatus surfaces the admission of the resource by the gang scheduler in the phase of tasks that
+// aren't running yet. Tasks waiting for admission are reported as waiting for resources, tasks admitted but still
+// queued have their reason prefixed accordingly.
+func ApplyGangSchedulingStatus(phaseInfo pluginsCore.PhaseInfo, queue string, status GangSchedulingStatus) pluginsCore.PhaseInfo {
+	if !IsGangSchedulingPending(phaseInfo.Phase()) {
+		return phaseInfo
+	}
+
+	if !status.Admitted {
+		return pluginsCore.PhaseInfoWaitingForResourcesInfo(time.Now(), phaseInfo.Version(),
+			fmt.Sprintf("%s in queue [%s]: %s", GangSchedulingQueuedReason, queue, status.Message),
+			phaseInfo.Info())
+	}
+
+	if phaseInfo.Phase() != pluginsCore.PhaseQueued {
+		return phaseInfo
+	}
+
+	reason := fmt.Sprintf("%s to queue [%s]", GangSchedulingAdmittedReason, queue)
+	if len(phaseInfo.Reason()) > 0 {
+		reason = fmt.Sprintf("%s, %s", reason, phaseInfo.Reason())
+	}
+
+	return pluginsCore.PhaseInfoQueuedWithTaskInfo(time.Now(), phaseInfo.Version(), reason, phaseInfo.Info())
+}
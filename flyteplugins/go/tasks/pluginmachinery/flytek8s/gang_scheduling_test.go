@@ -0,0 +1,273 @@
+package flytek8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+)
+
+func setGangSchedulingConfig(t *testing.T, cfg config.GangSchedulingConfig) {
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+		GangScheduling: cfg,
+	}))
+}
+
+func TestGetGangSchedulingQueue(t *testing.T) {
+	taskExecutionMetadata := dummyTaskExecutionMetadata(&v1.ResourceRequirements{}, nil, "")
+
+	t.Run("default queue", func(t *testing.T) {
+		setGangSchedulingConfig(t, config.GangSchedulingConfig{
+			DefaultQueue: "default",
+			Queues: []config.GangSchedulingQueue{
+				{Project: "other_project", Queue: "other"},
+			},
+		})
+
+		assert.Equal(t, "default", GetGangSchedulingQueue(taskExecutionMetadata))
+	})
+
+	t.Run("first matching queue", func(t *testing.T) {
+		setGangSchedulingConfig(t, config.GangSchedulingConfig{
+			DefaultQueue: "default",
+			Queues: []config.GangSchedulingQueue{
+				{Project: "my_project", Domain: "production", Queue: "production"},
+				{Project: "my_*", Queue: "mine"},
+				{Domain: "my_domain", Queue: "domain"},
+			},
+		})
+
+		assert.Equal(t, "mine", GetGangSchedulingQueue(taskExecutionMetadata))
+	})
+}
+
+func TestIsGangSchedulingSupported(t *testing.T) {
+	job := batchv1.SchemeGroupVersion.WithKind("Job")
+	sparkApplication := schema.GroupVersionKind{Group: "sparkoperator.k8s.io", Version: "v1beta2", Kind: "SparkApplication"}
+
+	setGangSchedulingConfig(t, config.GangSchedulingConfig{})
+	assert.False(t, IsGangSchedulingSupported(job))
+
+	setGangSchedulingConfig(t, config.GangSchedulingConfig{Scheduler: GangSchedulerKueue})
+	assert.True(t, IsGangSchedulingSupported(job))
+	assert.False(t, IsGangSchedulingSupported(sparkApplication))
+
+	setGangSchedulingConfig(t, config.GangSchedulingConfig{Scheduler: GangSchedulerVolcano})
+	assert.True(t, IsGangSchedulingSupported(job))
+	assert.True(t, IsGangSchedulingSupported(sparkApplication))
+}
+
+func TestApplyGangSchedulingMetadata(t *testing.T) {
+	taskExecutionMetadata := dummyTaskExecutionMetadata(&v1.ResourceRequirements{}, nil, "")
+
+	t.Run("disabled", func(t *testing.T) {
+		setGangSchedulingConfig(t, config.GangSchedulingConfig{DefaultQueue: "default"})
+
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"a": "b"}}}
+		ApplyGangSchedulingMetadata(taskExecutionMetadata, job)
+		assert.Equal(t, map[string]string{"a": "b"}, job.GetLabels())
+	})
+
+	t.Run("kueue", func(t *testing.T) {
+		setGangSchedulingConfig(t, config.GangSchedulingConfig{Scheduler: GangSchedulerKueue, DefaultQueue: "default"})
+
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"a": "b"}}}
+		ApplyGangSchedulingMetadata(taskExecutionMetadata, job)
+		assert.Equal(t, map[string]string{"a": "b", KueueQueueNameLabel: "default"}, job.GetLabels())
+	})
+
+	t.Run("volcano", func(t *testing.T) {
+		setGangSchedulingConfig(t, config.GangSchedulingConfig{Scheduler: GangSchedulerVolcano, DefaultQueue: "default"})
+
+		job := &batchv1.Job{}
+		ApplyGangSchedulingMetadata(taskExecutionMetadata, job)
+		assert.Equal(t, map[string]string{VolcanoQueueNameLabel: "default"}, job.GetLabels())
+	})
+
+	t.Run("no queue", func(t *testing.T) {
+		setGangSchedulingConfig(t, config.GangSchedulingConfig{Scheduler: GangSchedulerKueue})
+
+		job := &batchv1.Job{}
+		ApplyGangSchedulingMetadata(taskExecutionMetadata, job)
+		assert.Empty(t, job.GetLabels())
+	})
+}
+
+func TestApplyGangSchedulingPodConfiguration(t *testing.T) {
+	taskExecutionMetadata := dummyTaskExecutionMetadata(&v1.ResourceRequirements{}, nil, "")
+
+	t.Run("kueue", func(t *testing.T) {
+		setGangSchedulingConfig(t, config.GangSchedulingConfig{Scheduler: GangSchedulerKueue})
+
+		podSpec := &v1.PodSpec{SchedulerName: "scheduler"}
+		objectMeta := &metav1.ObjectMeta{}
+		ApplyGangSchedulingPodConfiguration(taskExecutionMetadata, podSpec, objectMeta)
+		assert.Equal(t, "scheduler", podSpec.SchedulerName)
+		assert.Empty(t, objectMeta.Annotations)
+	})
+
+	t.Run("volcano", func(t *testing.T) {
+		setGangSchedulingConfig(t, config.GangSchedulingConfig{Scheduler: GangSchedulerVolcano, VolcanoSchedulerName: "volcano"})
+
+		podSpec := &v1.PodSpec{SchedulerName: "scheduler"}
+		objectMeta := &metav1.ObjectMeta{Annotations: map[string]string{"a": "b"}}
+		ApplyGangSchedulingPodConfiguration(taskExecutionMetadata, podSpec, objectMeta)
+		assert.Equal(t, "volcano", podSpec.SchedulerName)
+		assert.Equal(t, map[string]string{"a": "b", VolcanoGroupNameAnnotation: "some-acceptable-name"}, objectMeta.Annotations)
+	})
+
+	t.Run("pod", func(t *testing.T) {
+		setGangSchedulingConfig(t, config.GangSchedulingConfig{Scheduler: GangSchedulerVolcano, VolcanoSchedulerName: "volcano"})
+
+		podSpec, objectMeta, _, err := ToK8sPodSpec(context.TODO(), dummyExecContext(dummyTaskTemplate(), &v1.ResourceRequirements{}, nil, ""))
+		assert.NoError(t, err)
+		assert.Equal(t, "volcano", podSpec.SchedulerName)
+		assert.Equal(t, "some-acceptable-name", objectMeta.Annotations[VolcanoGroupNameAnnotation])
+	})
+}
+
+func TestBuildVolcanoPodGroup(t *testing.T) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+			UID:       types.UID("uid"),
+			Labels:    map[string]string{VolcanoQueueNameLabel: "queue"},
+		},
+	}
+
+	podGroup := BuildVolcanoPodGroup(job, batchv1.SchemeGroupVersion.WithKind("Job"), "queue", 3)
+	assert.Equal(t, VolcanoPodGroupGVK, podGroup.GroupVersionKind())
+	assert.Equal(t, "name", podGroup.GetName())
+	assert.Equal(t, "namespace", podGroup.GetNamespace())
+	assert.Equal(t, map[string]string{VolcanoQueueNameLabel: "queue"}, podGroup.GetLabels())
+	assert.Equal(t, []metav1.OwnerReference{
+		{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+			Name:       "name",
+			UID:        types.UID("uid"),
+		},
+	}, podGroup.GetOwnerReferences())
+
+	minMember, _, _ := unstructured.NestedInt64(podGroup.Object, "spec", "minMember")
+	assert.Equal(t, int64(3), minMember)
+	queue, _, _ := unstructured.NestedString(podGroup.Object, "spec", "queue")
+	assert.Equal(t, "queue", queue)
+}
+
+func newUnstructuredWithStatus(status map[string]interface{}) unstructured.Unstructured {
+	return unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"status": status,
+		},
+	}
+}
+
+func TestGetKueueWorkloadStatus(t *testing.T) {
+	t.Run("no workload", func(t *testing.T) {
+		assert.Equal(t, GangSchedulingStatus{Message: "waiting for the workload to be created"}, GetKueueWorkloadStatus(nil))
+	})
+
+	t.Run("pending", func(t *testing.T) {
+		workload := newUnstructuredWithStatus(map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":    "QuotaReserved",
+					"status":  "False",
+					"message": "couldn't assign flavors to pod set main: insufficient quota for cpu",
+				},
+			},
+		})
+
+		assert.Equal(t, GangSchedulingStatus{Message: "couldn't assign flavors to pod set main: insufficient quota for cpu"},
+			GetKueueWorkloadStatus([]unstructured.Unstructured{workload}))
+	})
+
+	t.Run("quota reserved", func(t *testing.T) {
+		workload := newUnstructuredWithStatus(map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "QuotaReserved", "status": "True"},
+				map[string]interface{}{"type": "Admitted", "status": "False"},
+			},
+		})
+
+		assert.Equal(t, GangSchedulingStatus{Message: "waiting for admission"},
+			GetKueueWorkloadStatus([]unstructured.Unstructured{workload}))
+	})
+
+	t.Run("admitted", func(t *testing.T) {
+		workload := newUnstructuredWithStatus(map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "QuotaReserved", "status": "True"},
+				map[string]interface{}{"type": "Admitted", "status": "True"},
+			},
+		})
+
+		assert.Equal(t, GangSchedulingStatus{Admitted: true}, GetKueueWorkloadStatus([]unstructured.Unstructured{workload}))
+	})
+}
+
+func TestGetVolcanoPodGroupStatus(t *testing.T) {
+	t.Run("no status", func(t *testing.T) {
+		assert.Equal(t, GangSchedulingStatus{Message: "waiting for the pod group to be enqueued"},
+			GetVolcanoPodGroupStatus(&unstructured.Unstructured{Object: map[string]interface{}{}}))
+	})
+
+	t.Run("unschedulable", func(t *testing.T) {
+		podGroup := newUnstructuredWithStatus(map[string]interface{}{
+			"phase": "Pending",
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":    "Unschedulable",
+					"status":  "True",
+					"message": "3/3 tasks in gang unschedulable",
+				},
+			},
+		})
+
+		assert.Equal(t, GangSchedulingStatus{Message: "3/3 tasks in gang unschedulable"}, GetVolcanoPodGroupStatus(&podGroup))
+	})
+
+	t.Run("inqueue", func(t *testing.T) {
+		podGroup := newUnstructuredWithStatus(map[string]interface{}{"phase": "Inqueue"})
+		assert.Equal(t, GangSchedulingStatus{Admitted: true}, GetVolcanoPodGroupStatus(&podGroup))
+	})
+}
+
+func TestApplyGangSchedulingStatus(t *testing.T) {
+	queued := pluginsCore.PhaseInfoQueued(metav1.Now().Time, 2, "Scheduling")
+
+	t.Run("not admitted", func(t *testing.T) {
+		phaseInfo := ApplyGangSchedulingStatus(queued, "queue", GangSchedulingStatus{Message: "insufficient quota"})
+		assert.Equal(t, pluginsCore.PhaseWaitingForResources, phaseInfo.Phase())
+		assert.Equal(t, uint32(2), phaseInfo.Version())
+		assert.Equal(t, "Queued in queue [queue]: insufficient quota", phaseInfo.Reason())
+	})
+
+	t.Run("admitted", func(t *testing.T) {
+		phaseInfo := ApplyGangSchedulingStatus(queued, "queue", GangSchedulingStatus{Admitted: true})
+		assert.Equal(t, pluginsCore.PhaseQueued, phaseInfo.Phase())
+		assert.Equal(t, uint32(2), phaseInfo.Version())
+		assert.Equal(t, "Admitted to queue [queue], Scheduling", phaseInfo.Reason())
+	})
+
+	t.Run("admitted and initializing", func(t *testing.T) {
+		initializing := pluginsCore.PhaseInfoInitializing(metav1.Now().Time, 1, "ContainerCreating", nil)
+		assert.Equal(t, initializing, ApplyGangSchedulingStatus(initializing, "queue", GangSchedulingStatus{Admitted: true}))
+	})
+
+	t.Run("running", func(t *testing.T) {
+		running := pluginsCore.PhaseInfoRunning(1, nil)
+		assert.Equal(t, running, ApplyGangSchedulingStatus(running, "queue", GangSchedulingStatus{Message: "insufficient quota"}))
+	})
+}
@@ -433,6 +433,9 @@ func ApplyFlytePodConfiguration(ctx context.Context, tCtx pluginsCore.TaskExecut
 		ApplyContainerImageOverride(podSpec, tCtx.TaskExecutionMetadata().GetOverrides().GetContainerImage(), primaryContainerName)
 	}
 
+	ApplyGangSchedulingPodConfiguration(tCtx.TaskExecutionMetadata(), podSpec, objectMeta)
+	ApplyPreemptionPodConfiguration(tCtx.TaskExecutionMetadata(), podSpec, primaryContainerName)
+
 	return podSpec, objectMeta, nil
 }
 
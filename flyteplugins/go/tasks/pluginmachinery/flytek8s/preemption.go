@@ -0,0 +1,98 @@
+package flytek8s
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+)
+
+// Preempted is the error code of interruptible tasks whose pod was preempted. Preempted attempts are retried from the
+// checkpoint of the preempted attempt, and aren't accounted for as failures.
+const Preempted = "Preempted"
+
+const defaultPreemptionSignal = "TERM"
+
+// IsPreemptionEnabled returns whether the preemption of the pods of the task is handled, which is only the case for
+// interruptible tasks.
+func IsPreemptionEnabled(taskExecutionMetadata pluginsCore.TaskExecutionMetadata) bool {
+	return config.GetK8sPluginConfig().Preemption.Enabled && taskExecutionMetadata.IsInterruptible()
+}
+
+// ApplyPreemptionPodConfiguration gives the pods of interruptible tasks the configured grace period to checkpoint when
+// they're preempted. If a signal other than SIGTERM is configured, a pre-stop hook sends it to the primary container
+// and waits for it to exit, SIGTERM is then only sent at the end of the grace period.
+//
+// The pre-stop hook runs /bin/sh in the primary container and signals its PID 1, so it assumes the image ships a shell
+// and that the task process is the entrypoint of the container rather than a child of a shell or init process that
+// doesn't forward the signal. If the image has no shell, the hook fails and the container only receives SIGTERM. Pods
+// sharing their process namespace don't get the hook since their PID 1 is the pause container.
+func ApplyPreemptionPodConfiguration(taskExecutionMetadata pluginsCore.TaskExecutionMetadata, podSpec *v1.PodSpec,
+	primaryContainerName string) {
+	if !IsPreemptionEnabled(taskExecutionMetadata) {
+		return
+	}
+
+	cfg := config.GetK8sPluginConfig().Preemption
+	if podSpec.TerminationGracePeriodSeconds == nil {
+		gracePeriod := int64(cfg.GracePeriod.Seconds())
+		podSpec.TerminationGracePeriodSeconds = &gracePeriod
+	}
+
+	signal := strings.TrimPrefix(strings.ToUpper(cfg.Signal), "SIG")
+	if len(signal) == 0 || signal == defaultPreemptionSignal {
+		return
+	}
+
+	if podSpec.ShareProcessNamespace != nil && *podSpec.ShareProcessNamespace {
+		return
+	}
+
+	for index := range podSpec.Containers {
+		container := &podSpec.Containers[index]
+		if container.Name != primaryContainerName {
+			continue
+		}
+
+		if container.Lifecycle == nil {
+			container.Lifecycle = &v1.Lifecycle{}
+		}
+
+		if container.Lifecycle.PreStop == nil {
+			container.Lifecycle.PreStop = &v1.LifecycleHandler{
+				Exec: &v1.ExecAction{
+					Command: []string{"/bin/sh", "-c",
+						fmt.Sprintf("kill -s %s 1; while kill -0 1 2>/dev/null; do sleep 1; done", signal)},
+				},
+			}
+		}
+	}
+}
+
+// GetPreemptionMessage returns why the pod is being preempted, or an empty message if it isn't. Pods are preempted
+// when Kubernetes marks them as disruption targets, e.g. when they're preempted by the scheduler or their node is
+// drained, or when their node is tainted with one of the configured node taints. The node is optional.
+func GetPreemptionMessage(pod *v1.Pod, node *v1.Node) string {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.DisruptionTarget && condition.Status == v1.ConditionTrue {
+			return fmt.Sprintf("pod [%s] was preempted [%s]: %s", pod.Name, condition.Reason, condition.Message)
+		}
+	}
+
+	if node == nil {
+		return ""
+	}
+
+	nodeTaints := config.GetK8sPluginConfig().Preemption.NodeTaints
+	for _, taint := range node.Spec.Taints {
+		if slices.Contains(nodeTaints, taint.Key) {
+			return fmt.Sprintf("node [%s] of pod [%s] is being reclaimed [%s]", node.Name, pod.Name, taint.Key)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,125 @@
+package flytek8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pluginsCoreMock "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+	config2 "github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+func setPreemptionConfig(t *testing.T, cfg config.PreemptionConfig) {
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+		Preemption: cfg,
+	}))
+}
+
+func TestApplyPreemptionPodConfiguration(t *testing.T) {
+	taskExecutionMetadata := dummyTaskExecutionMetadata(&v1.ResourceRequirements{}, nil, "")
+	preemptionConfig := config.PreemptionConfig{
+		Enabled:     true,
+		Signal:      "SIGUSR1",
+		GracePeriod: config2.Duration{Duration: time.Minute},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		setPreemptionConfig(t, config.PreemptionConfig{Signal: "SIGUSR1", GracePeriod: config2.Duration{Duration: time.Minute}})
+
+		podSpec := &v1.PodSpec{Containers: []v1.Container{{Name: "primary"}}}
+		ApplyPreemptionPodConfiguration(taskExecutionMetadata, podSpec, "primary")
+		assert.Nil(t, podSpec.TerminationGracePeriodSeconds)
+		assert.Nil(t, podSpec.Containers[0].Lifecycle)
+	})
+
+	t.Run("not interruptible", func(t *testing.T) {
+		setPreemptionConfig(t, preemptionConfig)
+
+		nonInterruptible := &pluginsCoreMock.TaskExecutionMetadata{}
+		nonInterruptible.OnIsInterruptible().Return(false)
+		podSpec := &v1.PodSpec{Containers: []v1.Container{{Name: "primary"}}}
+		ApplyPreemptionPodConfiguration(nonInterruptible, podSpec, "primary")
+		assert.Nil(t, podSpec.TerminationGracePeriodSeconds)
+		assert.Nil(t, podSpec.Containers[0].Lifecycle)
+	})
+
+	t.Run("signal", func(t *testing.T) {
+		setPreemptionConfig(t, preemptionConfig)
+
+		podSpec := &v1.PodSpec{Containers: []v1.Container{{Name: "sidecar"}, {Name: "primary"}}}
+		ApplyPreemptionPodConfiguration(taskExecutionMetadata, podSpec, "primary")
+		assert.Equal(t, int64(60), *podSpec.TerminationGracePeriodSeconds)
+		assert.Nil(t, podSpec.Containers[0].Lifecycle)
+		assert.Equal(t, []string{"/bin/sh", "-c", "kill -s USR1 1; while kill -0 1 2>/dev/null; do sleep 1; done"},
+			podSpec.Containers[1].Lifecycle.PreStop.Exec.Command)
+	})
+
+	t.Run("shared process namespace", func(t *testing.T) {
+		setPreemptionConfig(t, preemptionConfig)
+
+		shareProcessNamespace := true
+		podSpec := &v1.PodSpec{ShareProcessNamespace: &shareProcessNamespace, Containers: []v1.Container{{Name: "primary"}}}
+		ApplyPreemptionPodConfiguration(taskExecutionMetadata, podSpec, "primary")
+		assert.Equal(t, int64(60), *podSpec.TerminationGracePeriodSeconds)
+		assert.Nil(t, podSpec.Containers[0].Lifecycle)
+	})
+
+	t.Run("SIGTERM", func(t *testing.T) {
+		setPreemptionConfig(t, config.PreemptionConfig{Enabled: true, Signal: "SIGTERM"})
+
+		gracePeriod := int64(10)
+		podSpec := &v1.PodSpec{TerminationGracePeriodSeconds: &gracePeriod, Containers: []v1.Container{{Name: "primary"}}}
+		ApplyPreemptionPodConfiguration(taskExecutionMetadata, podSpec, "primary")
+		assert.Equal(t, int64(10), *podSpec.TerminationGracePeriodSeconds)
+		assert.Nil(t, podSpec.Containers[0].Lifecycle)
+	})
+
+	t.Run("pod", func(t *testing.T) {
+		setPreemptionConfig(t, preemptionConfig)
+
+		podSpec, _, primaryContainerName, err := ToK8sPodSpec(context.TODO(), dummyExecContext(dummyTaskTemplate(), &v1.ResourceRequirements{}, nil, ""))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(60), *podSpec.TerminationGracePeriodSeconds)
+		assert.Equal(t, primaryContainerName, podSpec.Containers[0].Name)
+		assert.NotNil(t, podSpec.Containers[0].Lifecycle.PreStop)
+	})
+}
+
+func TestGetPreemptionMessage(t *testing.T) {
+	setPreemptionConfig(t, config.PreemptionConfig{Enabled: true, NodeTaints: []string{"spot-termination"}})
+
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}}
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node"},
+		Spec: v1.NodeSpec{
+			Taints: []v1.Taint{{Key: "other", Effect: v1.TaintEffectNoSchedule}},
+		},
+	}
+
+	t.Run("not preempted", func(t *testing.T) {
+		assert.Empty(t, GetPreemptionMessage(pod, nil))
+		assert.Empty(t, GetPreemptionMessage(pod, node))
+	})
+
+	t.Run("disruption target", func(t *testing.T) {
+		disrupted := pod.DeepCopy()
+		disrupted.Status.Conditions = []v1.PodCondition{
+			{Type: v1.PodReady, Status: v1.ConditionTrue},
+			{Type: v1.DisruptionTarget, Status: v1.ConditionTrue, Reason: v1.PodReasonTerminationByKubelet, Message: "node shutdown"},
+		}
+
+		assert.Equal(t, "pod [pod] was preempted [TerminationByKubelet]: node shutdown", GetPreemptionMessage(disrupted, nil))
+	})
+
+	t.Run("node taint", func(t *testing.T) {
+		tainted := node.DeepCopy()
+		tainted.Spec.Taints = append(tainted.Spec.Taints, v1.Taint{Key: "spot-termination", Effect: v1.TaintEffectNoSchedule})
+
+		assert.Equal(t, "node [node] of pod [pod] is being reclaimed [spot-termination]", GetPreemptionMessage(pod, tainted))
+	})
+}
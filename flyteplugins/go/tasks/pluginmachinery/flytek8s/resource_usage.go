@@ -0,0 +1,123 @@
+package flytek8s
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+var PodMetricsListGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetricsList"}
+
+// ResourceUsage is the peak resource usage of a single container of a task. Task resources are applied to each
+// container, so the usage is tracked per container rather than per pod.
+type ResourceUsage struct {
+	CPUMillis   int64
+	MemoryBytes int64
+}
+
+// IsZero returns whether no usage was observed.
+func (u ResourceUsage) IsZero() bool {
+	return u.CPUMillis == 0 && u.MemoryBytes == 0
+}
+
+// Max returns the highest usage of each resource across both usages.
+func (u ResourceUsage) Max(other ResourceUsage) ResourceUsage {
+	if other.CPUMillis > u.CPUMillis {
+		u.CPUMillis = other.CPUMillis
+	}
+
+	if other.MemoryBytes > u.MemoryBytes {
+		u.MemoryBytes = other.MemoryBytes
+	}
+
+	return u
+}
+
+// ToResourceEntries converts the usage to the resource entries reported in task execution events.
+func (u ResourceUsage) ToResourceEntries() []*core.Resources_ResourceEntry {
+	entries := make([]*core.Resources_ResourceEntry, 0, 2)
+	if u.CPUMillis > 0 {
+		entries = append(entries, &core.Resources_ResourceEntry{
+			Name:  core.Resources_CPU,
+			Value: resource.NewMilliQuantity(u.CPUMillis, resource.DecimalSI).String(),
+		})
+	}
+
+	if u.MemoryBytes > 0 {
+		entries = append(entries, &core.Resources_ResourceEntry{
+			Name:  core.Resources_MEMORY,
+			Value: resource.NewQuantity(u.MemoryBytes, resource.BinarySI).String(),
+		})
+	}
+
+	return entries
+}
+
+// GetPodMetricsUsage returns the highest usage of any container across the PodMetrics reported by the metrics API.
+func GetPodMetricsUsage(podMetrics []unstructured.Unstructured) ResourceUsage {
+	usage := ResourceUsage{}
+	for _, metrics := range podMetrics {
+		containers, _, _ := unstructured.NestedSlice(metrics.Object, "containers")
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			containerUsage, _, _ := unstructured.NestedStringMap(container, "usage")
+			cpu := parseQuantity(containerUsage[string(v1.ResourceCPU)])
+			memory := parseQuantity(containerUsage[string(v1.ResourceMemory)])
+			usage = usage.Max(ResourceUsage{
+				CPUMillis:   cpu.MilliValue(),
+				MemoryBytes: memory.Value(),
+			})
+		}
+	}
+
+	return usage
+}
+
+// GetOOMKilledUsage returns the highest memory limit of the containers of the pod that were OOMKilled. These
+// containers reached their limit, which the metrics API may have missed since it's sampled.
+func GetOOMKilledUsage(pod *v1.Pod) ResourceUsage {
+	usage := ResourceUsage{}
+	for _, status := range pod.Status.ContainerStatuses {
+		if !isOOMKilled(status.State) && !isOOMKilled(status.LastTerminationState) {
+			continue
+		}
+
+		for _, container := range pod.Spec.Containers {
+			if container.Name != status.Name {
+				continue
+			}
+
+			if limit, found := container.Resources.Limits[v1.ResourceMemory]; found {
+				usage = usage.Max(ResourceUsage{MemoryBytes: limit.Value()})
+			}
+		}
+	}
+
+	return usage
+}
+
+func isOOMKilled(state v1.ContainerState) bool {
+	return state.Terminated != nil && strings.Contains(state.Terminated.Reason, OOMKilled)
+}
+
+func parseQuantity(value string) resource.Quantity {
+	if len(value) == 0 {
+		return resource.Quantity{}
+	}
+
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}
+	}
+
+	return quantity
+}
@@ -0,0 +1,103 @@
+package flytek8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+func TestResourceUsage(t *testing.T) {
+	t.Run("max", func(t *testing.T) {
+		usage := ResourceUsage{CPUMillis: 500, MemoryBytes: 1024}.Max(ResourceUsage{CPUMillis: 250, MemoryBytes: 2048})
+		assert.Equal(t, ResourceUsage{CPUMillis: 500, MemoryBytes: 2048}, usage)
+	})
+
+	t.Run("resource entries", func(t *testing.T) {
+		assert.Equal(t, []*core.Resources_ResourceEntry{
+			{Name: core.Resources_CPU, Value: "1500m"},
+			{Name: core.Resources_MEMORY, Value: "1Gi"},
+		}, ResourceUsage{CPUMillis: 1500, MemoryBytes: 1024 * 1024 * 1024}.ToResourceEntries())
+	})
+
+	t.Run("no usage", func(t *testing.T) {
+		assert.True(t, ResourceUsage{}.IsZero())
+		assert.Empty(t, ResourceUsage{}.ToResourceEntries())
+	})
+}
+
+func TestGetPodMetricsUsage(t *testing.T) {
+	podMetrics := []unstructured.Unstructured{
+		{
+			Object: map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "primary",
+						"usage": map[string]interface{}{"cpu": "1200m", "memory": "512Mi"},
+					},
+					map[string]interface{}{
+						"name":  "sidecar",
+						"usage": map[string]interface{}{"cpu": "10m", "memory": "1Gi"},
+					},
+				},
+			},
+		},
+		{
+			Object: map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "primary",
+						"usage": map[string]interface{}{"cpu": "invalid"},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, ResourceUsage{CPUMillis: 1200, MemoryBytes: 1024 * 1024 * 1024}, GetPodMetricsUsage(podMetrics))
+	assert.True(t, GetPodMetricsUsage(nil).IsZero())
+}
+
+func TestGetOOMKilledUsage(t *testing.T) {
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "primary",
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("2Gi")},
+					},
+				},
+				{
+					Name: "sidecar",
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("4Gi")},
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name: "primary",
+					LastTerminationState: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{Reason: OOMKilled},
+					},
+				},
+				{
+					Name: "sidecar",
+					State: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{Reason: "Completed"},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, ResourceUsage{MemoryBytes: 2 * 1024 * 1024 * 1024}, GetOOMKilledUsage(pod))
+	assert.True(t, GetOOMKilledUsage(&v1.Pod{}).IsZero())
+}
@@ -3,6 +3,7 @@ package k8s
 import (
 	"context"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
@@ -66,6 +67,14 @@ type PluginProperties struct {
 	// override that behavior unless the resource that gets created for this plugin does not consume resources (cluster's
 	// cpu/memory... etc. or external resources) once the plugin's Plugin.GetTaskPhase() returns a terminal phase.
 	DisableDeleteResourceOnFinalize bool
+	// ForceDeleteResourceOnFinalize deletes the created resource on finalize regardless of the base K8sPluginConfig.
+	// Plugins should only set it if the resource may keep consuming resources once the plugin's
+	// Plugin.GetTaskPhase() returns a terminal phase, e.g. because the task succeeds before all pods completed.
+	ForceDeleteResourceOnFinalize bool
+	// DeletePropagationPolicy is the propagation policy used when deleting the created resource. Empty keeps the default
+	// policy of the resource's kind. Plugins whose resource orphans its dependents by default, like batch/v1 Jobs, should
+	// set it to Background to delete them along with the resource.
+	DeletePropagationPolicy metav1.DeletionPropagation
 	// Specifies how errors are aggregated
 	ErrorAggregationStrategy ErrorAggregationStrategy
 }
@@ -91,6 +100,12 @@ type PluginContext interface {
 	PluginStateReader() pluginsCore.PluginStateReader
 }
 
+// K8sReaderProvider is optionally implemented by a PluginContext to let plugins read the kubernetes objects created on
+// behalf of their resource, e.g. its pods.
+type K8sReaderProvider interface {
+	K8sReader() client.Reader
+}
+
 // PluginState defines the state of a k8s plugin. This information must be maintained between propeller evaluations to
 // determine if there have been any updates since the previously evaluation.
 type PluginState struct {
@@ -125,6 +140,21 @@ type PluginAbortOverride interface {
 	OnAbort(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, resource client.Object) (behavior AbortBehavior, err error)
 }
 
+// An optional interface a Plugin can implement if its resource runs a group of pods that must be scheduled together.
+// It's used to size the gang the resource is submitted as when gang scheduling is enabled, resources of plugins not
+// implementing it are submitted as gangs of a single pod.
+type GangSchedulingPlugin interface {
+	// Returns the minimum number of pods of the resource that need to be scheduled for it to make progress.
+	GetGangSize(ctx context.Context, resource client.Object) (int32, error)
+}
+
+// An optional interface a Plugin can implement if its resource runs pods through an operator rather than being a pod
+// itself. It's used to detect the preemption of these pods when the preemption of interruptible tasks is handled.
+type PreemptionPlugin interface {
+	// Returns the labels selecting the pods of the resource, or no labels if it doesn't know its pods yet.
+	GetPodLabels(ctx context.Context, resource client.Object) (map[string]string, error)
+}
+
 // Defines the overridden OnAbort behavior. The resource (by default, the underlying resource, although this
 // can be overridden) can be either patched, updated, or deleted.
 type AbortBehavior struct {
@@ -0,0 +1,369 @@
+package batchjob
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/plugins"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/errors"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/logs"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/tasklog"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils"
+)
+
+const (
+	batchJobTaskType = "batch-job"
+	KindJob          = "Job"
+)
+
+var logTemplateRegexes = struct {
+	JobCompletionIndex *regexp.Regexp
+}{
+	tasklog.MustCreateRegex("jobCompletionIndex"),
+}
+
+type batchJobResourceHandler struct{}
+
+func (batchJobResourceHandler) GetProperties() k8s.PluginProperties {
+	// A success policy may complete the task while pods of other indexes are still running, they're only stopped by
+	// deleting the job.
+	return k8s.PluginProperties{
+		ForceDeleteResourceOnFinalize: true,
+		// The pods of a job are orphaned by default.
+		DeletePropagationPolicy: metav1.DeletePropagationBackground,
+	}
+}
+
+func (batchJobResourceHandler) BuildIdentityResource(_ context.Context, _ pluginsCore.TaskExecutionMetadata) (
+	client.Object, error) {
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindJob,
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+		},
+	}, nil
+}
+
+func readBatchJob(ctx context.Context, taskReader pluginsCore.TaskReader) (*plugins.BatchJob, error) {
+	taskTemplate, err := taskReader.Read(ctx)
+	if err != nil {
+		return nil, errors.Errorf(errors.BadTaskSpecification, "unable to fetch task specification [%v]", err.Error())
+	} else if taskTemplate == nil {
+		return nil, errors.Errorf(errors.BadTaskSpecification, "nil task specification")
+	}
+
+	batchJob := &plugins.BatchJob{}
+	if taskTemplate.GetCustom() != nil {
+		if err := utils.UnmarshalStruct(taskTemplate.GetCustom(), batchJob); err != nil {
+			return nil, errors.Wrapf(errors.BadTaskSpecification, err, "invalid TaskSpecification [%v], failed to unmarshal", taskTemplate.GetCustom())
+		}
+	}
+
+	return batchJob, nil
+}
+
+func validateBatchJob(batchJob *plugins.BatchJob) error {
+	completions := getCompletions(batchJob)
+	if completions < 1 {
+		return errors.Errorf(errors.BadTaskSpecification, "invalid number of completions [%v], must be at least 1", completions)
+	}
+
+	for _, index := range batchJob.GetSuccessPolicy().GetSucceededIndexes() {
+		if index < 0 || index >= completions {
+			return errors.Errorf(errors.BadTaskSpecification, "succeeded index [%v] of the success policy is out of range [0, %v)", index, completions)
+		}
+	}
+
+	if count := batchJob.GetSuccessPolicy().GetSucceededCount(); count < 0 || count > completions {
+		return errors.Errorf(errors.BadTaskSpecification, "succeeded count [%v] of the success policy is out of range [0, %v]", count, completions)
+	}
+
+	return nil
+}
+
+func getCompletions(batchJob *plugins.BatchJob) int32 {
+	if batchJob.GetCompletions() == 0 {
+		return 1
+	}
+
+	return batchJob.GetCompletions()
+}
+
+// BuildResource creates an indexed batch/v1 Job running the task's pod for every index. JobSets are not supported.
+func (batchJobResourceHandler) BuildResource(ctx context.Context, taskCtx pluginsCore.TaskExecutionContext) (client.Object, error) {
+	batchJob, err := readBatchJob(ctx, taskCtx.TaskReader())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateBatchJob(batchJob); err != nil {
+		return nil, err
+	}
+
+	podSpec, objectMeta, _, err := flytek8s.ToK8sPodSpec(ctx, taskCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Failed pods are replaced by the job controller according to the backoff limits.
+	podSpec.RestartPolicy = v1.RestartPolicyNever
+
+	cfg := config.GetK8sPluginConfig()
+	podTemplate := v1.PodTemplateSpec{
+		ObjectMeta: *objectMeta.DeepCopy(),
+		Spec:       *podSpec,
+	}
+	podTemplate.SetLabels(utils.UnionMaps(cfg.DefaultLabels, podTemplate.GetLabels(), utils.CopyMap(taskCtx.TaskExecutionMetadata().GetLabels())))
+	podTemplate.SetAnnotations(utils.UnionMaps(cfg.DefaultAnnotations, podTemplate.GetAnnotations(), utils.CopyMap(taskCtx.TaskExecutionMetadata().GetAnnotations())))
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindJob,
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: *objectMeta,
+		Spec:       buildJobSpec(batchJob, podTemplate),
+	}, nil
+}
+
+func buildJobSpec(batchJob *plugins.BatchJob, podTemplate v1.PodTemplateSpec) batchv1.JobSpec {
+	completions := getCompletions(batchJob)
+	parallelism := batchJob.GetParallelism()
+	if parallelism <= 0 || parallelism > completions {
+		parallelism = completions
+	}
+
+	completionMode := batchv1.IndexedCompletion
+	spec := batchv1.JobSpec{
+		Completions:    &completions,
+		Parallelism:    &parallelism,
+		CompletionMode: &completionMode,
+		Template:       podTemplate,
+	}
+
+	if batchJob.GetBackoffLimitPerIndex() != nil {
+		backoffLimitPerIndex := batchJob.GetBackoffLimitPerIndex().GetValue()
+		spec.BackoffLimitPerIndex = &backoffLimitPerIndex
+		if batchJob.GetMaxFailedIndexes() != nil {
+			maxFailedIndexes := batchJob.GetMaxFailedIndexes().GetValue()
+			spec.MaxFailedIndexes = &maxFailedIndexes
+		}
+	} else {
+		backoffLimit := batchJob.GetBackoffLimit()
+		spec.BackoffLimit = &backoffLimit
+	}
+
+	return spec
+}
+
+// parseIndexes parses the compressed list of indexes of a job status, e.g. "1,3-5,7"
+func parseIndexes(indexes string) (sets.Set[int32], error) {
+	result := sets.New[int32]()
+	if len(indexes) == 0 {
+		return result, nil
+	}
+
+	for _, interval := range strings.Split(indexes, ",") {
+		first, last, isRange := strings.Cut(interval, "-")
+		start, err := strconv.ParseInt(first, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index interval [%v]: %w", interval, err)
+		}
+
+		end := start
+		if isRange {
+			if end, err = strconv.ParseInt(last, 10, 32); err != nil {
+				return nil, fmt.Errorf("invalid index interval [%v]: %w", interval, err)
+			}
+		}
+
+		for i := start; i <= end; i++ {
+			result.Insert(int32(i))
+		}
+	}
+
+	return result, nil
+}
+
+// isSuccessPolicyMet checks whether the job can be considered successful before all indexes succeeded. The policy is
+// evaluated by the plugin from the status of the job rather than by the job controller, the pods still running are
+// stopped by deleting the job once the task succeeded.
+func isSuccessPolicyMet(policy *plugins.BatchJobSuccessPolicy, status batchv1.JobStatus) (bool, error) {
+	if count := policy.GetSucceededCount(); count > 0 && status.Succeeded >= count {
+		return true, nil
+	}
+
+	if len(policy.GetSucceededIndexes()) == 0 {
+		return false, nil
+	}
+
+	completedIndexes, err := parseIndexes(status.CompletedIndexes)
+	if err != nil {
+		return false, err
+	}
+
+	return completedIndexes.HasAll(policy.GetSucceededIndexes()...), nil
+}
+
+func getJobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i := range job.Status.Conditions {
+		condition := &job.Status.Conditions[i]
+		if condition.Type == conditionType && condition.Status == v1.ConditionTrue {
+			return condition
+		}
+	}
+
+	return nil
+}
+
+// getIndexLogs generates log links for the latest pod of each index of the job. Pods of indexed jobs are named
+// <job-name>-<index>-<random-suffix>, they're listed by the uid of the job and mapped to their index by the completion
+// index label the job controller sets. The index itself is available to log templates as {{ .jobCompletionIndex }}.
+func getIndexLogs(ctx context.Context, reader client.Reader, logPlugin tasklog.Plugin, job *batchv1.Job,
+	taskExecID pluginsCore.TaskExecutionID, maxIndexLogs int) ([]*core.TaskLog, error) {
+	pods := &v1.PodList{}
+	if err := reader.List(ctx, pods, client.InNamespace(job.Namespace),
+		client.MatchingLabels{batchv1.ControllerUidLabel: string(job.UID)}); err != nil {
+		return nil, err
+	}
+
+	latestPods := make(map[int]*v1.Pod, len(pods.Items))
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		index, err := strconv.Atoi(pod.GetLabels()[batchv1.JobCompletionIndexAnnotation])
+		if err != nil {
+			continue
+		}
+
+		if latest, found := latestPods[index]; !found || latest.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			latestPods[index] = pod
+		}
+	}
+
+	indexes := make([]int, 0, len(latestPods))
+	for index := range latestPods {
+		indexes = append(indexes, index)
+	}
+
+	sort.Ints(indexes)
+	if len(indexes) > maxIndexLogs {
+		indexes = indexes[:maxIndexLogs]
+	}
+
+	var taskLogs []*core.TaskLog
+	for _, index := range indexes {
+		pod := latestPods[index]
+		o, err := logPlugin.GetTaskLogs(tasklog.Input{
+			HostName:        pod.Spec.Hostname,
+			PodName:         pod.Name,
+			PodUID:          string(pod.UID),
+			Namespace:       pod.Namespace,
+			LogName:         fmt.Sprintf("(Index %v)", index),
+			TaskExecutionID: taskExecID,
+			ExtraTemplateVars: []tasklog.TemplateVar{
+				{Regex: logTemplateRegexes.JobCompletionIndex, Value: strconv.Itoa(index)},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		taskLogs = append(taskLogs, o.TaskLogs...)
+	}
+
+	return taskLogs, nil
+}
+
+func (batchJobResourceHandler) GetTaskPhase(ctx context.Context, pluginContext k8s.PluginContext, r client.Object) (pluginsCore.PhaseInfo, error) {
+	job := r.(*batchv1.Job)
+	batchJob, err := readBatchJob(ctx, pluginContext.TaskReader())
+	if err != nil {
+		return pluginsCore.PhaseInfoUndefined, err
+	}
+
+	cfg := GetConfig()
+	logPlugin, err := logs.InitializeLogPlugins(&cfg.Logs)
+	if err != nil {
+		return pluginsCore.PhaseInfoUndefined, err
+	}
+
+	occurredAt := time.Now()
+	info := pluginsCore.TaskInfo{
+		OccurredAt: &occurredAt,
+	}
+
+	// Log links need the names of the pods, they're only generated if the plugin context can read them.
+	if readerProvider, ok := pluginContext.(k8s.K8sReaderProvider); ok && job.Status.StartTime != nil {
+		info.Logs, err = getIndexLogs(ctx, readerProvider.K8sReader(), logPlugin, job,
+			pluginContext.TaskExecutionMetadata().GetTaskExecutionID(), cfg.MaxIndexLogs)
+		if err != nil {
+			return pluginsCore.PhaseInfoUndefined, err
+		}
+	}
+
+	successPolicyMet, err := isSuccessPolicyMet(batchJob.GetSuccessPolicy(), job.Status)
+	if err != nil {
+		return pluginsCore.PhaseInfoUndefined, err
+	}
+
+	var phaseInfo pluginsCore.PhaseInfo
+	if condition := getJobCondition(job, batchv1.JobFailed); condition != nil {
+		message := condition.Message
+		if job.Status.FailedIndexes != nil && len(*job.Status.FailedIndexes) > 0 {
+			message = fmt.Sprintf("%v, failed indexes [%v]", message, *job.Status.FailedIndexes)
+		}
+
+		phaseInfo = pluginsCore.PhaseInfoRetryableFailure(condition.Reason, message, &info)
+	} else if getJobCondition(job, batchv1.JobComplete) != nil || successPolicyMet {
+		phaseInfo = pluginsCore.PhaseInfoSuccess(&info)
+	} else if job.Status.Active == 0 && job.Status.Succeeded == 0 && job.Status.Failed == 0 {
+		phaseInfo = pluginsCore.PhaseInfoInitializing(occurredAt, pluginsCore.DefaultPhaseVersion, "job created", &info)
+	} else {
+		phaseInfo = pluginsCore.PhaseInfoRunning(pluginsCore.DefaultPhaseVersion, &info)
+	}
+
+	phaseVersionUpdateErr := k8s.MaybeUpdatePhaseVersionFromPluginContext(&phaseInfo, &pluginContext)
+	if phaseVersionUpdateErr != nil {
+		return phaseInfo, phaseVersionUpdateErr
+	}
+
+	return phaseInfo, nil
+}
+
+// GetGangSize returns the parallelism of the job, it's the number of pods running at once.
+func (batchJobResourceHandler) GetGangSize(_ context.Context, r client.Object) (int32, error) {
+	job, ok := r.(*batchv1.Job)
+	if !ok || job.Spec.Parallelism == nil {
+		return 1, nil
+	}
+
+	return *job.Spec.Parallelism, nil
+}
+
+func init() {
+	pluginmachinery.PluginRegistry().RegisterK8sPlugin(
+		k8s.PluginEntry{
+			ID:                  batchJobTaskType,
+			RegisteredTaskTypes: []pluginsCore.TaskType{batchJobTaskType},
+			ResourceToWatch:     &batchv1.Job{},
+			Plugin:              batchJobResourceHandler{},
+			IsDefault:           false,
+		})
+}
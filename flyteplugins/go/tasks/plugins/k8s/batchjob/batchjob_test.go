@@ -0,0 +1,422 @@
+package batchjob
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/plugins"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/logs"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	pluginIOMocks "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io/mocks"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/tasklog"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils"
+	stdlibUtils "github.com/flyteorg/flyte/flytestdlib/utils"
+)
+
+const (
+	testImage                 = "image://"
+	testTaskID                = "some-acceptable-name"
+	defaultServiceAccountName = "default-service-account"
+	defaultNamespace          = "default-namespace"
+)
+
+var (
+	testArgs              = []string{"execute-batch-job-task"}
+	testAnnotations       = map[string]string{"annotation-1": "val1"}
+	testLabels            = map[string]string{"label-1": "val1"}
+	testPlatformResources = v1.ResourceRequirements{
+		Requests: v1.ResourceList{
+			v1.ResourceCPU: resource.MustParse("1"),
+		},
+		Limits: v1.ResourceList{
+			v1.ResourceCPU: resource.MustParse("1"),
+		},
+	}
+)
+
+func dummyBatchJobTaskTemplate(batchJob *plugins.BatchJob) *core.TaskTemplate {
+	taskTemplate := &core.TaskTemplate{
+		Id:   &core.Identifier{Name: "test-build-resource"},
+		Type: batchJobTaskType,
+		Target: &core.TaskTemplate_Container{
+			Container: &core.Container{
+				Image: testImage,
+				Args:  testArgs,
+			},
+		},
+	}
+
+	if batchJob != nil {
+		batchJobJSON, err := utils.MarshalToString(batchJob)
+		if err != nil {
+			panic(err)
+		}
+
+		structObj := structpb.Struct{}
+		if err := stdlibUtils.UnmarshalStringToPb(batchJobJSON, &structObj); err != nil {
+			panic(err)
+		}
+
+		taskTemplate.Custom = &structObj
+	}
+
+	return taskTemplate
+}
+
+func dummyBatchJobTaskContext(taskTemplate *core.TaskTemplate, pluginState k8s.PluginState) pluginsCore.TaskExecutionContext {
+	taskCtx := &mocks.TaskExecutionContext{}
+
+	inputReader := &pluginIOMocks.InputReader{}
+	inputReader.OnGetInputPrefixPath().Return("/input/prefix")
+	inputReader.OnGetInputPath().Return("/input")
+	inputReader.OnGetMatch(mock.Anything).Return(&core.LiteralMap{}, nil)
+	taskCtx.OnInputReader().Return(inputReader)
+
+	outputReader := &pluginIOMocks.OutputWriter{}
+	outputReader.OnGetOutputPath().Return("/data/outputs.pb")
+	outputReader.OnGetOutputPrefixPath().Return("/data/")
+	outputReader.OnGetRawOutputPrefix().Return("")
+	outputReader.OnGetCheckpointPrefix().Return("/checkpoint")
+	outputReader.OnGetPreviousCheckpointsPrefix().Return("/prev")
+	taskCtx.On("OutputWriter").Return(outputReader)
+
+	taskReader := &mocks.TaskReader{}
+	taskReader.OnReadMatch(mock.Anything).Return(taskTemplate, nil)
+	taskCtx.OnTaskReader().Return(taskReader)
+
+	tID := &mocks.TaskExecutionID{}
+	tID.OnGetID().Return(core.TaskExecutionIdentifier{
+		NodeExecutionId: &core.NodeExecutionIdentifier{
+			ExecutionId: &core.WorkflowExecutionIdentifier{
+				Name:    "my_name",
+				Project: "my_project",
+				Domain:  "my_domain",
+			},
+		},
+	})
+	tID.On("GetGeneratedName").Return(testTaskID)
+	tID.On("GetUniqueNodeID").Return("an-unique-id")
+
+	taskExecutionMetadata := &mocks.TaskExecutionMetadata{}
+	taskExecutionMetadata.OnGetTaskExecutionID().Return(tID)
+	taskExecutionMetadata.OnGetAnnotations().Return(testAnnotations)
+	taskExecutionMetadata.OnGetLabels().Return(testLabels)
+	taskExecutionMetadata.OnGetPlatformResources().Return(&testPlatformResources)
+	taskExecutionMetadata.OnGetMaxAttempts().Return(uint32(1))
+	taskExecutionMetadata.OnIsInterruptible().Return(false)
+	taskExecutionMetadata.OnGetEnvironmentVariables().Return(nil)
+	taskExecutionMetadata.OnGetK8sServiceAccount().Return(defaultServiceAccountName)
+	taskExecutionMetadata.OnGetNamespace().Return(defaultNamespace)
+	taskExecutionMetadata.OnGetConsoleURL().Return("")
+	overrides := &mocks.TaskOverrides{}
+	overrides.OnGetResources().Return(&v1.ResourceRequirements{})
+	overrides.OnGetExtendedResources().Return(nil)
+	overrides.OnGetContainerImage().Return("")
+	taskExecutionMetadata.OnGetOverrides().Return(overrides)
+	taskCtx.On("TaskExecutionMetadata").Return(taskExecutionMetadata)
+
+	pluginStateReaderMock := mocks.PluginStateReader{}
+	pluginStateReaderMock.On("Get", mock.AnythingOfType(reflect.TypeOf(&pluginState).String())).Return(
+		func(v interface{}) uint8 {
+			*(v.(*k8s.PluginState)) = pluginState
+			return 0
+		},
+		func(v interface{}) error {
+			return nil
+		})
+
+	taskCtx.OnPluginStateReader().Return(&pluginStateReaderMock)
+	return taskCtx
+}
+
+// readerPluginContext provides a reader of the kubernetes objects along with the task execution context.
+type readerPluginContext struct {
+	pluginsCore.TaskExecutionContext
+	reader client.Reader
+}
+
+func (c readerPluginContext) K8sReader() client.Reader {
+	return c.reader
+}
+
+func dummyJob(status batchv1.JobStatus) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testTaskID,
+			Namespace: defaultNamespace,
+			UID:       "job-uid",
+		},
+		Status: status,
+	}
+}
+
+func dummyIndexPod(index int, name string, created time.Time) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultNamespace,
+			UID:       types.UID(name + "-uid"),
+			Labels: map[string]string{
+				batchv1.ControllerUidLabel:           "job-uid",
+				batchv1.JobCompletionIndexAnnotation: strconv.Itoa(index),
+			},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: v1.PodSpec{
+			Hostname: fmt.Sprintf("%v-%v", testTaskID, index),
+		},
+	}
+}
+
+func TestBuildResourceBatchJob(t *testing.T) {
+	handler := batchJobResourceHandler{}
+	ctx := context.TODO()
+
+	t.Run("Defaults", func(t *testing.T) {
+		taskCtx := dummyBatchJobTaskContext(dummyBatchJobTaskTemplate(nil), k8s.PluginState{})
+		r, err := handler.BuildResource(ctx, taskCtx)
+		assert.NoError(t, err)
+
+		job, ok := r.(*batchv1.Job)
+		assert.True(t, ok)
+		assert.Equal(t, KindJob, job.Kind)
+		assert.Equal(t, int32(1), *job.Spec.Completions)
+		assert.Equal(t, int32(1), *job.Spec.Parallelism)
+		assert.Equal(t, batchv1.IndexedCompletion, *job.Spec.CompletionMode)
+		assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+		assert.Nil(t, job.Spec.BackoffLimitPerIndex)
+		assert.Equal(t, v1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+		assert.Equal(t, testLabels["label-1"], job.Spec.Template.Labels["label-1"])
+		assert.Equal(t, testAnnotations["annotation-1"], job.Spec.Template.Annotations["annotation-1"])
+		assert.Equal(t, testImage, job.Spec.Template.Spec.Containers[0].Image)
+		assert.Equal(t, testArgs, job.Spec.Template.Spec.Containers[0].Args)
+	})
+
+	t.Run("Indexed", func(t *testing.T) {
+		taskCtx := dummyBatchJobTaskContext(dummyBatchJobTaskTemplate(&plugins.BatchJob{
+			Completions:          8,
+			Parallelism:          4,
+			BackoffLimitPerIndex: wrapperspb.Int32(2),
+			MaxFailedIndexes:     wrapperspb.Int32(1),
+			BackoffLimit:         3,
+		}), k8s.PluginState{})
+		r, err := handler.BuildResource(ctx, taskCtx)
+		assert.NoError(t, err)
+
+		job := r.(*batchv1.Job)
+		assert.Equal(t, int32(8), *job.Spec.Completions)
+		assert.Equal(t, int32(4), *job.Spec.Parallelism)
+		assert.Equal(t, int32(2), *job.Spec.BackoffLimitPerIndex)
+		assert.Equal(t, int32(1), *job.Spec.MaxFailedIndexes)
+		assert.Nil(t, job.Spec.BackoffLimit)
+	})
+
+	t.Run("ParallelismBoundedByCompletions", func(t *testing.T) {
+		taskCtx := dummyBatchJobTaskContext(dummyBatchJobTaskTemplate(&plugins.BatchJob{
+			Completions: 2,
+			Parallelism: 4,
+		}), k8s.PluginState{})
+		r, err := handler.BuildResource(ctx, taskCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), *r.(*batchv1.Job).Spec.Parallelism)
+	})
+
+	t.Run("InvalidSpec", func(t *testing.T) {
+		for _, batchJob := range []*plugins.BatchJob{
+			{Completions: -1},
+			{Completions: 2, SuccessPolicy: &plugins.BatchJobSuccessPolicy{SucceededIndexes: []int32{2}}},
+			{Completions: 2, SuccessPolicy: &plugins.BatchJobSuccessPolicy{SucceededCount: 3}},
+		} {
+			taskCtx := dummyBatchJobTaskContext(dummyBatchJobTaskTemplate(batchJob), k8s.PluginState{})
+			_, err := handler.BuildResource(ctx, taskCtx)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestBuildIdentityResourceBatchJob(t *testing.T) {
+	handler := batchJobResourceHandler{}
+	r, err := handler.BuildIdentityResource(context.TODO(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindJob,
+			APIVersion: "batch/v1",
+		},
+	}, r)
+}
+
+func TestGetPropertiesBatchJob(t *testing.T) {
+	handler := batchJobResourceHandler{}
+	assert.True(t, handler.GetProperties().ForceDeleteResourceOnFinalize)
+	assert.Equal(t, metav1.DeletePropagationBackground, handler.GetProperties().DeletePropagationPolicy)
+}
+
+func TestGetGangSizeBatchJob(t *testing.T) {
+	handler := batchJobResourceHandler{}
+	ctx := context.TODO()
+
+	batchJob := &plugins.BatchJob{Completions: 10, Parallelism: 4}
+	r, err := handler.BuildResource(ctx, dummyBatchJobTaskContext(dummyBatchJobTaskTemplate(batchJob), k8s.PluginState{}))
+	assert.NoError(t, err)
+
+	size, err := handler.GetGangSize(ctx, r)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), size)
+}
+
+func TestParseIndexes(t *testing.T) {
+	indexes, err := parseIndexes("")
+	assert.NoError(t, err)
+	assert.Empty(t, indexes)
+
+	indexes, err = parseIndexes("1,3-5,7")
+	assert.NoError(t, err)
+	assert.Equal(t, sets.New[int32](1, 3, 4, 5, 7), indexes)
+
+	_, err = parseIndexes("1,a-3")
+	assert.Error(t, err)
+}
+
+func TestIsSuccessPolicyMet(t *testing.T) {
+	status := batchv1.JobStatus{Succeeded: 3, CompletedIndexes: "0,2-3"}
+
+	tests := []struct {
+		name   string
+		policy *plugins.BatchJobSuccessPolicy
+		met    bool
+	}{
+		{"NoPolicy", nil, false},
+		{"Count", &plugins.BatchJobSuccessPolicy{SucceededCount: 3}, true},
+		{"CountNotReached", &plugins.BatchJobSuccessPolicy{SucceededCount: 4}, false},
+		{"Indexes", &plugins.BatchJobSuccessPolicy{SucceededIndexes: []int32{0, 3}}, true},
+		{"IndexesNotCompleted", &plugins.BatchJobSuccessPolicy{SucceededIndexes: []int32{0, 1}}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			met, err := isSuccessPolicyMet(test.policy, status)
+			assert.NoError(t, err)
+			assert.Equal(t, test.met, met)
+		})
+	}
+}
+
+func TestGetTaskPhaseBatchJob(t *testing.T) {
+	handler := batchJobResourceHandler{}
+	ctx := context.TODO()
+	startTime := metav1.NewTime(time.Now())
+	failedIndexes := "1"
+
+	assert.NoError(t, SetConfig(&Config{
+		Logs: logs.LogConfig{
+			Templates: []tasklog.TemplateLogPlugin{
+				{
+					DisplayName:  "Index Logs",
+					TemplateURIs: []tasklog.TemplateURI{"https://logs/{{ .namespace }}/{{ .podName }}?index={{ .jobCompletionIndex }}"},
+				},
+			},
+		},
+		MaxIndexLogs: 2,
+	}))
+	defer func() {
+		assert.NoError(t, SetConfig(&defaultConfig))
+	}()
+
+	created := time.Now()
+	reader := fake.NewClientBuilder().WithObjects(
+		dummyIndexPod(0, testTaskID+"-0-abcde", created),
+		dummyIndexPod(1, testTaskID+"-1-fghij", created),
+		dummyIndexPod(1, testTaskID+"-1-klmno", created.Add(time.Minute)),
+		dummyIndexPod(2, testTaskID+"-2-pqrst", created),
+	).Build()
+
+	taskCtx := readerPluginContext{
+		TaskExecutionContext: dummyBatchJobTaskContext(dummyBatchJobTaskTemplate(&plugins.BatchJob{
+			Completions:   3,
+			SuccessPolicy: &plugins.BatchJobSuccessPolicy{SucceededIndexes: []int32{0}},
+		}), k8s.PluginState{}),
+		reader: reader,
+	}
+
+	tests := []struct {
+		name    string
+		status  batchv1.JobStatus
+		phase   pluginsCore.Phase
+		message string
+		logs    int
+	}{
+		{"Created", batchv1.JobStatus{}, pluginsCore.PhaseInitializing, "", 0},
+		{"Running", batchv1.JobStatus{StartTime: &startTime, Active: 3}, pluginsCore.PhaseRunning, "", 2},
+		{"SuccessPolicyMet", batchv1.JobStatus{StartTime: &startTime, Active: 2, Succeeded: 1, CompletedIndexes: "0"},
+			pluginsCore.PhaseSuccess, "", 2},
+		{"Complete", batchv1.JobStatus{StartTime: &startTime, Succeeded: 3, CompletedIndexes: "1-2", Conditions: []batchv1.JobCondition{
+			{Type: batchv1.JobComplete, Status: v1.ConditionTrue},
+		}}, pluginsCore.PhaseSuccess, "", 2},
+		{"Failed", batchv1.JobStatus{StartTime: &startTime, Failed: 2, FailedIndexes: &failedIndexes, Conditions: []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "FailedIndexes", Message: "Job has failed indexes"},
+		}}, pluginsCore.PhaseRetryableFailure, "Job has failed indexes, failed indexes [1]", 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			phaseInfo, err := handler.GetTaskPhase(ctx, taskCtx, dummyJob(test.status))
+			assert.NoError(t, err)
+			assert.Equal(t, test.phase, phaseInfo.Phase())
+			assert.Len(t, phaseInfo.Info().Logs, test.logs)
+			if test.message != "" {
+				assert.Equal(t, test.message, phaseInfo.Err().GetMessage())
+			}
+		})
+	}
+
+	t.Run("IndexLogs", func(t *testing.T) {
+		phaseInfo, err := handler.GetTaskPhase(ctx, taskCtx, dummyJob(batchv1.JobStatus{StartTime: &startTime, Active: 3}))
+		assert.NoError(t, err)
+		assert.Equal(t, "https://logs/default-namespace/some-acceptable-name-1-klmno?index=1", phaseInfo.Info().Logs[1].GetUri())
+		assert.Equal(t, "Index Logs(Index 1)", phaseInfo.Info().Logs[1].GetName())
+	})
+
+	t.Run("NoReader", func(t *testing.T) {
+		phaseInfo, err := handler.GetTaskPhase(ctx, taskCtx.TaskExecutionContext, dummyJob(batchv1.JobStatus{StartTime: &startTime, Active: 3}))
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRunning, phaseInfo.Phase())
+		assert.Empty(t, phaseInfo.Info().Logs)
+	})
+}
+
+func TestGetTaskPhaseIncreasePhaseVersion(t *testing.T) {
+	handler := batchJobResourceHandler{}
+	ctx := context.TODO()
+
+	pluginState := k8s.PluginState{
+		Phase:        pluginsCore.PhaseInitializing,
+		PhaseVersion: pluginsCore.DefaultPhaseVersion,
+		Reason:       "task submitted to K8s",
+	}
+	taskCtx := dummyBatchJobTaskContext(dummyBatchJobTaskTemplate(nil), pluginState)
+
+	taskPhase, err := handler.GetTaskPhase(ctx, taskCtx, dummyJob(batchv1.JobStatus{}))
+	assert.NoError(t, err)
+	assert.Equal(t, taskPhase.Version(), pluginsCore.DefaultPhaseVersion+1)
+}
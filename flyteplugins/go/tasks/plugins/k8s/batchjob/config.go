@@ -0,0 +1,32 @@
+package batchjob
+
+import (
+	pluginsConfig "github.com/flyteorg/flyte/flyteplugins/go/tasks/config"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/logs"
+)
+
+//go:generate pflags Config --default-var=defaultConfig
+
+var (
+	defaultConfig = Config{
+		Logs:         logs.DefaultConfig,
+		MaxIndexLogs: 100,
+	}
+
+	configSection = pluginsConfig.MustRegisterSubSection("batch-job", &defaultConfig)
+)
+
+// Config is config for 'batch-job' plugin
+type Config struct {
+	Logs logs.LogConfig `json:"logs,omitempty"`
+	// MaxIndexLogs bounds the number of log links of a job, one log link is generated per index.
+	MaxIndexLogs int `json:"max-index-logs" pflag:",Maximum number of indexes log links are generated for."`
+}
+
+func GetConfig() *Config {
+	return configSection.GetConfig().(*Config)
+}
+
+func SetConfig(cfg *Config) error {
+	return configSection.SetConfig(cfg)
+}
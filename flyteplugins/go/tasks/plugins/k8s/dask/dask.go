@@ -331,6 +331,17 @@ func (p daskResourceHandler) GetTaskPhase(ctx context.Context, pluginContext k8s
 	return phaseInfo, nil
 }
 
+// GetGangSize returns the number of job runner, scheduler and worker pods, the job runner only makes progress once the
+// cluster is up.
+func (daskResourceHandler) GetGangSize(_ context.Context, r client.Object) (int32, error) {
+	job, ok := r.(*daskAPI.DaskJob)
+	if !ok {
+		return 0, fmt.Errorf("failed to convert resource data type")
+	}
+
+	return int32(2 + job.Spec.Cluster.Spec.Worker.Replicas), nil // #nosec G115
+}
+
 func (daskResourceHandler) GetProperties() k8s.PluginProperties {
 	return k8s.PluginProperties{}
 }
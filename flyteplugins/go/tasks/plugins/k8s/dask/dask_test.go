@@ -684,6 +684,16 @@ func TestGetPropertiesDask(t *testing.T) {
 	assert.Equal(t, expected, daskResourceHandler.GetProperties())
 }
 
+func TestGetGangSizeDask(t *testing.T) {
+	daskResourceHandler := daskResourceHandler{}
+	daskJob := &daskAPI.DaskJob{}
+	daskJob.Spec.Cluster.Spec.Worker.Replicas = 3
+
+	size, err := daskResourceHandler.GetGangSize(context.TODO(), daskJob)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), size)
+}
+
 func TestBuildIdentityResourceDask(t *testing.T) {
 	daskResourceHandler := daskResourceHandler{}
 	expected := &daskAPI.DaskJob{
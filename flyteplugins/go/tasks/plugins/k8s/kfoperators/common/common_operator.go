@@ -371,3 +371,23 @@ func GetReplicaCount(specs map[commonOp.ReplicaType]*commonOp.ReplicaSpec, repli
 
 	return new(int32) // return 0 as default value
 }
+
+// GetGangSize returns the number of pods of all replicas, replicas without a count default to a single pod like in the
+// training operator.
+func GetGangSize(specs map[commonOp.ReplicaType]*commonOp.ReplicaSpec) int32 {
+	size := int32(0)
+	for _, spec := range specs {
+		if spec.Replicas == nil {
+			size++
+		} else {
+			size += *spec.Replicas
+		}
+	}
+
+	return size
+}
+
+// GetPodLabels returns the labels the training operator sets on the pods of all replicas of the job.
+func GetPodLabels(job meta_v1.Object) map[string]string {
+	return map[string]string{commonOp.JobNameLabel: job.GetName()}
+}
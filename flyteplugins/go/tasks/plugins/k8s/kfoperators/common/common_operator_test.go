@@ -297,6 +297,22 @@ func TestOverrideContainerSpecEmptyFields(t *testing.T) {
 	assert.Equal(t, []string{"pyflyte-execute", "--task-module", "tests.flytekit.unit.sdk.tasks.test_sidecar_tasks", "--task-name", "simple_sidecar_task", "--inputs", "{{.input}}", "--output-prefix", "{{.outputPrefix}}"}, podSpec.Containers[0].Args)
 }
 
+func TestGetGangSize(t *testing.T) {
+	replicas := int32(3)
+	specs := map[commonOp.ReplicaType]*commonOp.ReplicaSpec{
+		"Master": {},
+		"Worker": {Replicas: &replicas},
+	}
+
+	assert.Equal(t, int32(4), GetGangSize(specs))
+	assert.Equal(t, int32(0), GetGangSize(nil))
+}
+
+func TestGetPodLabels(t *testing.T) {
+	job := &meta_v1.ObjectMeta{Name: "job"}
+	assert.Equal(t, map[string]string{"training.kubeflow.org/job-name": "job"}, GetPodLabels(job))
+}
+
 func dummyTaskContext() pluginsCore.TaskExecutionContext {
 	taskCtx := &mocks.TaskExecutionContext{}
 
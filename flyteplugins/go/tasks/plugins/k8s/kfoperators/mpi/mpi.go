@@ -29,6 +29,7 @@ type mpiOperatorResourceHandler struct {
 
 // Sanity test that the plugin implements method of k8s.Plugin
 var _ k8s.Plugin = mpiOperatorResourceHandler{}
+var _ k8s.GangSchedulingPlugin = mpiOperatorResourceHandler{}
 
 func (mpiOperatorResourceHandler) GetProperties() k8s.PluginProperties {
 	return k8s.PluginProperties{}
@@ -196,6 +197,21 @@ func (mpiOperatorResourceHandler) GetTaskPhase(_ context.Context, pluginContext
 	return phaseInfo, err
 }
 
+// GetGangSize returns the number of launcher and worker pods, mpirun only starts once it reached all workers.
+func (mpiOperatorResourceHandler) GetGangSize(_ context.Context, resource client.Object) (int32, error) {
+	app, ok := resource.(*kubeflowv1.MPIJob)
+	if !ok {
+		return 0, fmt.Errorf("failed to convert resource data type")
+	}
+
+	return common.GetGangSize(app.Spec.MPIReplicaSpecs), nil
+}
+
+// GetPodLabels returns the labels of the launcher and worker pods of the job.
+func (mpiOperatorResourceHandler) GetPodLabels(_ context.Context, resource client.Object) (map[string]string, error) {
+	return common.GetPodLabels(resource), nil
+}
+
 func init() {
 	if err := kubeflowv1.AddToScheme(scheme.Scheme); err != nil {
 		panic(err)
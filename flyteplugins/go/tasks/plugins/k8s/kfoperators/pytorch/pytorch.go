@@ -28,6 +28,7 @@ type pytorchOperatorResourceHandler struct {
 
 // Sanity test that the plugin implements method of k8s.Plugin
 var _ k8s.Plugin = pytorchOperatorResourceHandler{}
+var _ k8s.GangSchedulingPlugin = pytorchOperatorResourceHandler{}
 
 func (pytorchOperatorResourceHandler) GetProperties() k8s.PluginProperties {
 	return k8s.PluginProperties{
@@ -238,6 +239,21 @@ func (pytorchOperatorResourceHandler) GetTaskPhase(_ context.Context, pluginCont
 	return phaseInfo, err
 }
 
+// GetGangSize returns the number of master and worker pods, the rendezvous of torch.distributed waits for all of them.
+func (pytorchOperatorResourceHandler) GetGangSize(_ context.Context, resource client.Object) (int32, error) {
+	app, ok := resource.(*kubeflowv1.PyTorchJob)
+	if !ok {
+		return 0, fmt.Errorf("failed to convert resource data type")
+	}
+
+	return common.GetGangSize(app.Spec.PyTorchReplicaSpecs), nil
+}
+
+// GetPodLabels returns the labels of the master and worker pods of the job.
+func (pytorchOperatorResourceHandler) GetPodLabels(_ context.Context, resource client.Object) (map[string]string, error) {
+	return common.GetPodLabels(resource), nil
+}
+
 func init() {
 	if err := kubeflowv1.AddToScheme(scheme.Scheme); err != nil {
 		panic(err)
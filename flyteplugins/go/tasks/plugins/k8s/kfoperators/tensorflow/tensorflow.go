@@ -26,6 +26,7 @@ type tensorflowOperatorResourceHandler struct {
 
 // Sanity test that the plugin implements method of k8s.Plugin
 var _ k8s.Plugin = tensorflowOperatorResourceHandler{}
+var _ k8s.GangSchedulingPlugin = tensorflowOperatorResourceHandler{}
 
 func (tensorflowOperatorResourceHandler) GetProperties() k8s.PluginProperties {
 	return k8s.PluginProperties{}
@@ -195,6 +196,21 @@ func (tensorflowOperatorResourceHandler) GetTaskPhase(_ context.Context, pluginC
 	return phaseInfo, err
 }
 
+// GetGangSize returns the number of chief, worker and parameter server pods, the TF cluster needs all of them.
+func (tensorflowOperatorResourceHandler) GetGangSize(_ context.Context, resource client.Object) (int32, error) {
+	app, ok := resource.(*kubeflowv1.TFJob)
+	if !ok {
+		return 0, fmt.Errorf("failed to convert resource data type")
+	}
+
+	return common.GetGangSize(app.Spec.TFReplicaSpecs), nil
+}
+
+// GetPodLabels returns the labels of the chief, parameter server, worker and evaluator pods of the job.
+func (tensorflowOperatorResourceHandler) GetPodLabels(_ context.Context, resource client.Object) (map[string]string, error) {
+	return common.GetPodLabels(resource), nil
+}
+
 func init() {
 	if err := kubeflowv1.AddToScheme(scheme.Scheme); err != nil {
 		panic(err)
@@ -40,6 +40,8 @@ const (
 	DashboardHost                      = "dashboard-host"
 	DisableUsageStatsStartParameter    = "disable-usage-stats"
 	DisableUsageStatsStartParameterVal = "true"
+	// rayClusterLabel is the label KubeRay sets on the pods of a ray cluster to the name of the cluster.
+	rayClusterLabel = "ray.io/cluster"
 )
 
 var logTemplateRegexes = struct {
@@ -650,6 +652,43 @@ func (plugin rayJobResourceHandler) GetTaskPhase(ctx context.Context, pluginCont
 	return phaseInfo, err
 }
 
+// GetGangSize returns the number of pods of the ray cluster at its minimum size, the head and the minimum replicas of
+// all worker groups.
+func (rayJobResourceHandler) GetGangSize(_ context.Context, resource client.Object) (int32, error) {
+	rayJob, ok := resource.(*rayv1.RayJob)
+	if !ok {
+		return 0, fmt.Errorf("failed to convert resource data type")
+	}
+
+	size := int32(1)
+	if rayJob.Spec.RayClusterSpec == nil {
+		return size, nil
+	}
+
+	for _, workerGroup := range rayJob.Spec.RayClusterSpec.WorkerGroupSpecs {
+		if workerGroup.MinReplicas != nil {
+			size += *workerGroup.MinReplicas
+		}
+	}
+
+	return size, nil
+}
+
+// GetPodLabels returns the labels of the head and worker pods of the ray cluster of the job, none until the cluster is
+// created.
+func (rayJobResourceHandler) GetPodLabels(_ context.Context, resource client.Object) (map[string]string, error) {
+	rayJob, ok := resource.(*rayv1.RayJob)
+	if !ok {
+		return nil, fmt.Errorf("failed to convert resource data type")
+	}
+
+	if len(rayJob.Status.RayClusterName) == 0 {
+		return nil, nil
+	}
+
+	return map[string]string{rayClusterLabel: rayJob.Status.RayClusterName}, nil
+}
+
 func init() {
 	if err := rayv1.AddToScheme(scheme.Scheme); err != nil {
 		panic(err)
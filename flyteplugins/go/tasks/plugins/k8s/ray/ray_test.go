@@ -537,6 +537,45 @@ func TestBuildResourceRayCustomK8SPod(t *testing.T) {
 	}
 }
 
+func TestGetGangSizeRay(t *testing.T) {
+	handler := rayJobResourceHandler{}
+	minReplicas := int32(2)
+	rayJob := &rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayClusterSpec: &rayv1.RayClusterSpec{
+				WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+					{MinReplicas: &minReplicas},
+					{MinReplicas: &minReplicas},
+					{},
+				},
+			},
+		},
+	}
+
+	size, err := handler.GetGangSize(context.TODO(), rayJob)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), size)
+
+	size, err = handler.GetGangSize(context.TODO(), &rayv1.RayJob{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), size)
+}
+
+func TestGetPodLabelsRay(t *testing.T) {
+	handler := rayJobResourceHandler{}
+	rayJob := &rayv1.RayJob{
+		Status: rayv1.RayJobStatus{RayClusterName: "cluster"},
+	}
+
+	podLabels, err := handler.GetPodLabels(context.TODO(), rayJob)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"ray.io/cluster": "cluster"}, podLabels)
+
+	podLabels, err = handler.GetPodLabels(context.TODO(), &rayv1.RayJob{})
+	assert.NoError(t, err)
+	assert.Empty(t, podLabels)
+}
+
 func TestDefaultStartParameters(t *testing.T) {
 	rayJobResourceHandler := rayJobResourceHandler{}
 	rayJob := &plugins.RayJob{
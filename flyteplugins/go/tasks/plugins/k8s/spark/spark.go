@@ -142,7 +142,8 @@ func serviceAccountName(metadata pluginsCore.TaskExecutionMetadata) string {
 }
 
 func createSparkPodSpec(taskCtx pluginsCore.TaskExecutionContext, podSpec *v1.PodSpec, container *v1.Container) *sparkOp.SparkPodSpec {
-	annotations := utils.UnionMaps(config.GetK8sPluginConfig().DefaultAnnotations, utils.CopyMap(taskCtx.TaskExecutionMetadata().GetAnnotations()))
+	annotations := utils.UnionMaps(config.GetK8sPluginConfig().DefaultAnnotations, utils.CopyMap(taskCtx.TaskExecutionMetadata().GetAnnotations()),
+		flytek8s.GetGangSchedulingPodAnnotations(taskCtx.TaskExecutionMetadata()))
 	labels := utils.UnionMaps(config.GetK8sPluginConfig().DefaultLabels, utils.CopyMap(taskCtx.TaskExecutionMetadata().GetLabels()))
 
 	sparkEnv := make([]v1.EnvVar, 0)
@@ -490,6 +491,21 @@ func (sparkResourceHandler) GetTaskPhase(ctx context.Context, pluginContext k8s.
 	return phaseInfo, nil
 }
 
+// GetGangSize returns the number of driver and executor pods, the application is stuck until all executors registered.
+// Applications without an executor count run a single executor like in the spark operator.
+func (sparkResourceHandler) GetGangSize(_ context.Context, resource client.Object) (int32, error) {
+	app, ok := resource.(*sparkOp.SparkApplication)
+	if !ok {
+		return 0, fmt.Errorf("failed to convert resource data type")
+	}
+
+	if app.Spec.Executor.Instances == nil {
+		return 2, nil
+	}
+
+	return 1 + *app.Spec.Executor.Instances, nil
+}
+
 func init() {
 	if err := sparkOp.AddToScheme(scheme.Scheme); err != nil {
 		panic(err)
@@ -925,6 +925,21 @@ func TestBuildResourcePodTemplate(t *testing.T) {
 	assert.Equal(t, dummySparkConf["spark.executor.memory"], *sparkApp.Spec.Executor.Memory)
 }
 
+func TestGetGangSizeSpark(t *testing.T) {
+	sparkResourceHandler := sparkResourceHandler{}
+	instances := int32(4)
+	app := &sparkOp.SparkApplication{}
+	app.Spec.Executor.Instances = &instances
+
+	size, err := sparkResourceHandler.GetGangSize(context.TODO(), app)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), size)
+
+	size, err = sparkResourceHandler.GetGangSize(context.TODO(), &sparkOp.SparkApplication{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), size)
+}
+
 func TestGetPropertiesSpark(t *testing.T) {
 	sparkResourceHandler := sparkResourceHandler{}
 	expected := k8s.PluginProperties{}
@@ -3,15 +3,38 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/workflowstore"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/visualize"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/config/viper"
+)
+
+type graphFormat = string
+
+const (
+	graphFormatDot     graphFormat = "dot"
+	graphFormatMermaid graphFormat = "mermaid"
+	graphFormatSVG     graphFormat = "svg"
+	graphFormatHTML    graphFormat = "html"
 )
 
 type VisualizeOpts struct {
 	*RootOptions
+	inputFile    string
+	inputFormat  format
+	outputFile   string
+	outputFormat graphFormat
+	// statusStoreConfig is the path of the propeller config used to read statuses kept by the ExternalStatus policy.
+	statusStoreConfig string
 }
 
 func NewVisualizeCommand(opts *RootOptions) *cobra.Command {
@@ -21,21 +44,139 @@ func NewVisualizeCommand(opts *RootOptions) *cobra.Command {
 	}
 
 	visualizeCmd := &cobra.Command{
-		Use:   "visualize <workflow_name>",
-		Short: "Get GraphViz dot-formatted output.",
-		Long:  `Generates GraphViz dot-formatted output for the workflow.`,
-		Args:  cobra.ExactArgs(1),
+		Use:   "visualize [<workflow_name>]",
+		Short: "Renders the DAG of a workflow.",
+		Long: `Renders the DAG of a live FlyteWorkflow or of a compiled workflow closure (--input-file) as GraphViz dot, Mermaid,
+SVG or a self-contained HTML page. Nodes of live workflows are colored by phase and the chain of nodes with the longest
+total duration (the critical path) is highlighted. Subworkflow, branch and array nodes are rendered along with the nodes
+they run. Workflows whose status is kept in an external status store (ExternalStatus workflow store policy) only carry
+the phase of the workflow in the CR, pass the propeller config with --status-store-config to read their full status.`,
+		Args: cobra.MaximumNArgs(1),
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Compiled closures are rendered without connecting to the cluster.
+			if vizOpts.inputFile != "" {
+				return nil
+			}
+
+			return vizOpts.ConfigureClient()
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			name := args[0]
-			w, err := vizOpts.flyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(vizOpts.ConfigOverrides.Context.Namespace).Get(context.TODO(), name, v1.GetOptions{})
-			if err != nil {
-				return err
+			if (len(args) == 0) == (vizOpts.inputFile == "") {
+				return errors.Errorf("Either a workflow name or an input file is required")
 			}
 
-			fmt.Printf("Dot-formatted: %v\n", visualize.WorkflowToGraphViz(w))
-			return nil
+			var g *visualize.Graph
+			if len(args) > 0 {
+				w, err := vizOpts.flyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(vizOpts.ConfigOverrides.Context.Namespace).Get(context.TODO(), args[0], v1.GetOptions{})
+				if err != nil {
+					return err
+				}
+
+				if err := vizOpts.loadExternalStatus(context.TODO(), w); err != nil {
+					return err
+				}
+
+				g = visualize.NewGraphFromWorkflow(w, time.Now())
+			} else {
+				closure, err := vizOpts.readClosure()
+				if err != nil {
+					return err
+				}
+
+				g = visualize.NewGraphFromCompiledWorkflow(closure.GetPrimary(), closure.GetSubWorkflows())
+			}
+
+			return vizOpts.render(g)
 		},
 	}
 
+	visualizeCmd.Flags().StringVarP(&vizOpts.inputFile, "input-file", "i", "", "Path of a compiled workflow closure to render instead of a live workflow.")
+	visualizeCmd.Flags().StringVarP(&vizOpts.inputFormat, "input-format", "f", formatProto, "Format of the input file. Supported formats: proto (default), json, yaml")
+	visualizeCmd.Flags().StringVarP(&vizOpts.outputFile, "output-file", "o", "", "Path of the generated output file, defaults to STDOUT.")
+	visualizeCmd.Flags().StringVarP(&vizOpts.outputFormat, "output-format", "m", graphFormatDot, "Format of the output. Supported formats: dot (default), mermaid, svg, html")
+	visualizeCmd.Flags().StringVar(&vizOpts.statusStoreConfig, "status-store-config", "", "Path of the propeller config to read the status of workflows kept in an external status store.")
+
 	return visualizeCmd
 }
+
+// loadExternalStatus replaces the pointer status of workflows whose status is kept in an external status store with the
+// stored status.
+func (v *VisualizeOpts) loadExternalStatus(ctx context.Context, w *v1alpha1.FlyteWorkflow) error {
+	if _, ok := w.GetAnnotations()[workflowstore.StatusVersionAnnotation]; !ok {
+		return nil
+	}
+
+	if v.statusStoreConfig == "" {
+		fmt.Fprintf(os.Stderr, "Warning: the status of workflow [%v] is kept in an external status store, node statuses "+
+			"are only shown with --status-store-config\n", w.Name)
+		return nil
+	}
+
+	accessor := viper.NewAccessor(config.Options{SearchPaths: []string{v.statusStoreConfig}})
+	if err := accessor.UpdateConfig(ctx); err != nil {
+		return errors.Wrapf(err, "Failed to load config [%v]", v.statusStoreConfig)
+	}
+
+	statuses, err := workflowstore.NewStatusStore(ctx, workflowstore.GetConfig().ExternalStatus)
+	if err != nil {
+		return err
+	}
+
+	status, _, err := statuses.Get(ctx, w.Namespace, w.Name)
+	if err != nil {
+		if workflowstore.IsStatusNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	w.Status = *status
+	return nil
+}
+
+func (v *VisualizeOpts) readClosure() (*core.CompiledWorkflowClosure, error) {
+	raw, err := os.ReadFile(v.inputFile)
+	if err != nil {
+		return nil, err
+	}
+
+	closure := &core.CompiledWorkflowClosure{}
+	if err := unmarshal(raw, v.inputFormat, closure); err != nil {
+		return nil, errors.Wrapf(err, "Failed to unmarshal compiled workflow closure")
+	}
+
+	return closure, nil
+}
+
+func (v *VisualizeOpts) render(g *visualize.Graph) error {
+	criticalPathDuration, err := visualize.MarkCriticalPath(g)
+	if err != nil {
+		return err
+	}
+
+	var out string
+	switch v.outputFormat {
+	case graphFormatDot:
+		out = visualize.ToDot(g)
+	case graphFormatMermaid:
+		out = visualize.ToMermaid(g)
+	case graphFormatSVG:
+		out, err = visualize.ToSVG(g)
+	case graphFormatHTML:
+		out, err = visualize.ToHTML(g, criticalPathDuration)
+	default:
+		return errors.Errorf("Unknown output format [%v]", v.outputFormat)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if v.outputFile != "" {
+		return os.WriteFile(v.outputFile, []byte(out), 0644) // #nosec G306
+	}
+
+	fmt.Print(out)
+	return nil
+}
@@ -1,10 +1,21 @@
 package v1alpha1
 
+// ArrayNodePartialSuccess configures an ArrayNode to produce the outputs of the sub-nodes that succeeded even if others
+// failed.
+type ArrayNodePartialSuccess struct {
+	// ErrorsOutput is the name of the output holding the errors of the sub-nodes that failed
+	ErrorsOutput string
+}
+
 type ArrayNodeSpec struct {
-	SubNodeSpec     *NodeSpec
-	Parallelism     *uint32
-	MinSuccesses    *uint32
-	MinSuccessRatio *float32
+	SubNodeSpec         *NodeSpec
+	Parallelism         *uint32
+	MinSuccesses        *uint32
+	MinSuccessRatio     *float32
+	PartialSuccess      *ArrayNodePartialSuccess
+	RetryBudget         *uint32
+	MaxFailures         uint32
+	AdaptiveParallelism bool
 }
 
 func (a *ArrayNodeSpec) GetSubNodeSpec() *NodeSpec {
@@ -22,3 +33,19 @@ func (a *ArrayNodeSpec) GetMinSuccesses() *uint32 {
 func (a *ArrayNodeSpec) GetMinSuccessRatio() *float32 {
 	return a.MinSuccessRatio
 }
+
+func (a *ArrayNodeSpec) GetPartialSuccess() *ArrayNodePartialSuccess {
+	return a.PartialSuccess
+}
+
+func (a *ArrayNodeSpec) GetRetryBudget() *uint32 {
+	return a.RetryBudget
+}
+
+func (a *ArrayNodeSpec) GetMaxFailures() uint32 {
+	return a.MaxFailures
+}
+
+func (a *ArrayNodeSpec) GetAdaptiveParallelism() bool {
+	return a.AdaptiveParallelism
+}
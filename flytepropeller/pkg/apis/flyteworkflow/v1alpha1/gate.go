@@ -12,9 +12,11 @@ func (n ConditionKind) String() string {
 }
 
 const (
-	ConditionKindApprove ConditionKind = "approve"
-	ConditionKindSignal  ConditionKind = "signal"
-	ConditionKindSleep   ConditionKind = "sleep"
+	ConditionKindApprove   ConditionKind = "approve"
+	ConditionKindSignal    ConditionKind = "signal"
+	ConditionKindSleep     ConditionKind = "sleep"
+	ConditionKindWaitUntil ConditionKind = "waitUntil"
+	ConditionKindExternal  ConditionKind = "external"
 )
 
 type ApproveCondition struct {
@@ -56,11 +58,39 @@ func (in *SleepCondition) UnmarshalJSON(b []byte) error {
 	return utils.UnmarshalBytesToPb(b, in.SleepCondition)
 }
 
+type WaitUntilCondition struct {
+	*core.WaitUntilCondition
+}
+
+func (in WaitUntilCondition) MarshalJSON() ([]byte, error) {
+	return utils.MarshalPbToBytes(in.WaitUntilCondition)
+}
+
+func (in *WaitUntilCondition) UnmarshalJSON(b []byte) error {
+	in.WaitUntilCondition = &core.WaitUntilCondition{}
+	return utils.UnmarshalBytesToPb(b, in.WaitUntilCondition)
+}
+
+type ExternalCondition struct {
+	*core.ExternalCondition
+}
+
+func (in ExternalCondition) MarshalJSON() ([]byte, error) {
+	return utils.MarshalPbToBytes(in.ExternalCondition)
+}
+
+func (in *ExternalCondition) UnmarshalJSON(b []byte) error {
+	in.ExternalCondition = &core.ExternalCondition{}
+	return utils.UnmarshalBytesToPb(b, in.ExternalCondition)
+}
+
 type GateNodeSpec struct {
-	Kind    ConditionKind     `json:"kind"`
-	Approve *ApproveCondition `json:"approve,omitempty"`
-	Signal  *SignalCondition  `json:"signal,omitempty"`
-	Sleep   *SleepCondition   `json:"sleep,omitempty"`
+	Kind      ConditionKind       `json:"kind"`
+	Approve   *ApproveCondition   `json:"approve,omitempty"`
+	Signal    *SignalCondition    `json:"signal,omitempty"`
+	Sleep     *SleepCondition     `json:"sleep,omitempty"`
+	WaitUntil *WaitUntilCondition `json:"waitUntil,omitempty"`
+	External  *ExternalCondition  `json:"external,omitempty"`
 }
 
 func (g *GateNodeSpec) GetKind() ConditionKind {
@@ -78,3 +108,11 @@ func (g *GateNodeSpec) GetSignal() *core.SignalCondition {
 func (g *GateNodeSpec) GetSleep() *core.SleepCondition {
 	return g.Sleep.SleepCondition
 }
+
+func (g *GateNodeSpec) GetWaitUntil() *core.WaitUntilCondition {
+	return g.WaitUntil.WaitUntilCondition
+}
+
+func (g *GateNodeSpec) GetExternal() *core.ExternalCondition {
+	return g.External.ExternalCondition
+}
@@ -90,6 +90,60 @@ func TestSleepConditionJSONMarshalling(t *testing.T) {
 	assert.Equal(t, sleepCondition.Duration, sleepConditionObj.Duration)
 }
 
+func TestWaitUntilConditionJSONMarshalling(t *testing.T) {
+	waitUntilCondition := WaitUntilCondition{
+		&core.WaitUntilCondition{
+			InputVariableName:  "until",
+			OutputVariableName: "reached",
+		},
+	}
+
+	expected, mockErr := mockMarshalPbToBytes(waitUntilCondition.WaitUntilCondition)
+	assert.Nil(t, mockErr)
+
+	// MarshalJSON
+	waitUntilConditionBytes, mErr := waitUntilCondition.MarshalJSON()
+	assert.Nil(t, mErr)
+	assert.Equal(t, expected, waitUntilConditionBytes)
+
+	// UnmarshalJSON
+	waitUntilConditionObj := &WaitUntilCondition{}
+	uErr := waitUntilConditionObj.UnmarshalJSON(waitUntilConditionBytes)
+	assert.Nil(t, uErr)
+	assert.Equal(t, waitUntilCondition.InputVariableName, waitUntilConditionObj.InputVariableName)
+	assert.Equal(t, waitUntilCondition.OutputVariableName, waitUntilConditionObj.OutputVariableName)
+}
+
+func TestExternalConditionJSONMarshalling(t *testing.T) {
+	externalCondition := ExternalCondition{
+		&core.ExternalCondition{
+			Condition: &core.ExternalCondition_StorageObject{
+				StorageObject: &core.StorageObjectCondition{
+					UriSource: &core.StorageObjectCondition_Uri{Uri: "s3://bucket/key"},
+				},
+			},
+			PollInterval: &durationpb.Duration{
+				Seconds: 10,
+			},
+		},
+	}
+
+	expected, mockErr := mockMarshalPbToBytes(externalCondition.ExternalCondition)
+	assert.Nil(t, mockErr)
+
+	// MarshalJSON
+	externalConditionBytes, mErr := externalCondition.MarshalJSON()
+	assert.Nil(t, mErr)
+	assert.Equal(t, expected, externalConditionBytes)
+
+	// UnmarshalJSON
+	externalConditionObj := &ExternalCondition{}
+	uErr := externalConditionObj.UnmarshalJSON(externalConditionBytes)
+	assert.Nil(t, uErr)
+	assert.Equal(t, externalCondition.GetStorageObject().GetUri(), externalConditionObj.GetStorageObject().GetUri())
+	assert.Equal(t, externalCondition.PollInterval.Seconds, externalConditionObj.PollInterval.Seconds)
+}
+
 func TestGateNodeSpec_GetKind(t *testing.T) {
 	kind := ConditionKindApprove
 	gateNodeSpec := GateNodeSpec{
@@ -148,3 +202,33 @@ func TestGateNodeSpec_GetSleep(t *testing.T) {
 		t.Errorf("Expected sleepCondition, but got a different value")
 	}
 }
+
+func TestGateNodeSpec_GetWaitUntil(t *testing.T) {
+	waitUntilCondition := &WaitUntilCondition{
+		&core.WaitUntilCondition{
+			InputVariableName: "until",
+		},
+	}
+	gateNodeSpec := GateNodeSpec{
+		WaitUntil: waitUntilCondition,
+	}
+
+	if gateNodeSpec.GetWaitUntil() != waitUntilCondition.WaitUntilCondition {
+		t.Errorf("Expected waitUntilCondition, but got a different value")
+	}
+}
+
+func TestGateNodeSpec_GetExternal(t *testing.T) {
+	externalCondition := &ExternalCondition{
+		&core.ExternalCondition{
+			OutputVariableName: "exists",
+		},
+	}
+	gateNodeSpec := GateNodeSpec{
+		External: externalCondition,
+	}
+
+	if gateNodeSpec.GetExternal() != externalCondition.ExternalCondition {
+		t.Errorf("Expected externalCondition, but got a different value")
+	}
+}
@@ -252,6 +252,8 @@ type ExecutableGateNode interface {
 	GetApprove() *core.ApproveCondition
 	GetSignal() *core.SignalCondition
 	GetSleep() *core.SleepCondition
+	GetWaitUntil() *core.WaitUntilCondition
+	GetExternal() *core.ExternalCondition
 }
 
 type ExecutableArrayNode interface {
@@ -259,6 +261,10 @@ type ExecutableArrayNode interface {
 	GetParallelism() *uint32
 	GetMinSuccesses() *uint32
 	GetMinSuccessRatio() *float32
+	GetPartialSuccess() *ArrayNodePartialSuccess
+	GetRetryBudget() *uint32
+	GetMaxFailures() uint32
+	GetAdaptiveParallelism() bool
 }
 
 type ExecutableWorkflowNodeStatus interface {
@@ -275,12 +281,16 @@ type MutableWorkflowNodeStatus interface {
 
 type ExecutableGateNodeStatus interface {
 	GetGateNodePhase() GateNodePhase
+	GetPollAttempts() uint32
+	GetLastPolledAt() time.Time
 }
 
 type MutableGateNodeStatus interface {
 	Mutable
 	ExecutableGateNodeStatus
 	SetGateNodePhase(phase GateNodePhase)
+	SetPollAttempts(pollAttempts uint32)
+	SetLastPolledAt(lastPolledAt time.Time)
 }
 
 type ExecutableArrayNodeStatus interface {
@@ -290,7 +300,9 @@ type ExecutableArrayNodeStatus interface {
 	GetSubNodeTaskPhases() bitarray.CompactArray
 	GetSubNodeRetryAttempts() bitarray.CompactArray
 	GetSubNodeSystemFailures() bitarray.CompactArray
+	GetSubNodePreemptions() bitarray.CompactArray
 	GetTaskPhaseVersion() uint32
+	GetParallelism() uint32
 }
 
 type MutableArrayNodeStatus interface {
@@ -302,7 +314,9 @@ type MutableArrayNodeStatus interface {
 	SetSubNodeTaskPhases(subNodeTaskPhases bitarray.CompactArray)
 	SetSubNodeRetryAttempts(subNodeRetryAttempts bitarray.CompactArray)
 	SetSubNodeSystemFailures(subNodeSystemFailures bitarray.CompactArray)
+	SetSubNodePreemptions(subNodePreemptions bitarray.CompactArray)
 	SetTaskPhaseVersion(taskPhaseVersion uint32)
+	SetParallelism(parallelism uint32)
 }
 
 type Mutable interface {
@@ -319,6 +333,8 @@ type MutableNodeStatus interface {
 	UpdatePhase(phase NodePhase, occurredAt metav1.Time, reason string, enableCRDebugMetadata bool, err *core.ExecutionError)
 	IncrementAttempts() uint32
 	IncrementSystemFailures() uint32
+	IncrementOOMFailures() uint32
+	IncrementPreemptions() uint32
 	SetCached()
 	ResetDirty()
 
@@ -369,6 +385,8 @@ type ExecutableNodeStatus interface {
 	GetExecutionError() *core.ExecutionError
 	GetAttempts() uint32
 	GetSystemFailures() uint32
+	GetOOMFailures() uint32
+	GetPreemptions() uint32
 	GetWorkflowNodeStatus() ExecutableWorkflowNodeStatus
 	GetTaskNodeStatus() ExecutableTaskNodeStatus
 
@@ -393,6 +411,7 @@ type ExecutableTaskNodeStatus interface {
 	GetBarrierClockTick() uint32
 	GetLastPhaseUpdatedAt() time.Time
 	GetPreviousNodeExecutionCheckpointPath() DataReference
+	GetPreviousAttemptCheckpointPath() DataReference
 	GetCleanupOnFailure() bool
 }
 
@@ -406,6 +425,7 @@ type MutableTaskNodeStatus interface {
 	SetPluginStateVersion(uint32)
 	SetBarrierClockTick(tick uint32)
 	SetPreviousNodeExecutionCheckpointPath(DataReference)
+	SetPreviousAttemptCheckpointPath(DataReference)
 	SetCleanupOnFailure(bool)
 }
 
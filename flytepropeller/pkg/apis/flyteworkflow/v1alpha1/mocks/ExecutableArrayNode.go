@@ -12,6 +12,70 @@ type ExecutableArrayNode struct {
 	mock.Mock
 }
 
+type ExecutableArrayNode_GetAdaptiveParallelism struct {
+	*mock.Call
+}
+
+func (_m ExecutableArrayNode_GetAdaptiveParallelism) Return(_a0 bool) *ExecutableArrayNode_GetAdaptiveParallelism {
+	return &ExecutableArrayNode_GetAdaptiveParallelism{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableArrayNode) OnGetAdaptiveParallelism() *ExecutableArrayNode_GetAdaptiveParallelism {
+	c_call := _m.On("GetAdaptiveParallelism")
+	return &ExecutableArrayNode_GetAdaptiveParallelism{Call: c_call}
+}
+
+func (_m *ExecutableArrayNode) OnGetAdaptiveParallelismMatch(matchers ...interface{}) *ExecutableArrayNode_GetAdaptiveParallelism {
+	c_call := _m.On("GetAdaptiveParallelism", matchers...)
+	return &ExecutableArrayNode_GetAdaptiveParallelism{Call: c_call}
+}
+
+// GetAdaptiveParallelism provides a mock function with given fields:
+func (_m *ExecutableArrayNode) GetAdaptiveParallelism() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+type ExecutableArrayNode_GetMaxFailures struct {
+	*mock.Call
+}
+
+func (_m ExecutableArrayNode_GetMaxFailures) Return(_a0 uint32) *ExecutableArrayNode_GetMaxFailures {
+	return &ExecutableArrayNode_GetMaxFailures{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableArrayNode) OnGetMaxFailures() *ExecutableArrayNode_GetMaxFailures {
+	c_call := _m.On("GetMaxFailures")
+	return &ExecutableArrayNode_GetMaxFailures{Call: c_call}
+}
+
+func (_m *ExecutableArrayNode) OnGetMaxFailuresMatch(matchers ...interface{}) *ExecutableArrayNode_GetMaxFailures {
+	c_call := _m.On("GetMaxFailures", matchers...)
+	return &ExecutableArrayNode_GetMaxFailures{Call: c_call}
+}
+
+// GetMaxFailures provides a mock function with given fields:
+func (_m *ExecutableArrayNode) GetMaxFailures() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 type ExecutableArrayNode_GetMinSuccessRatio struct {
 	*mock.Call
 }
@@ -114,6 +178,74 @@ func (_m *ExecutableArrayNode) GetParallelism() *uint32 {
 	return r0
 }
 
+type ExecutableArrayNode_GetPartialSuccess struct {
+	*mock.Call
+}
+
+func (_m ExecutableArrayNode_GetPartialSuccess) Return(_a0 *v1alpha1.ArrayNodePartialSuccess) *ExecutableArrayNode_GetPartialSuccess {
+	return &ExecutableArrayNode_GetPartialSuccess{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableArrayNode) OnGetPartialSuccess() *ExecutableArrayNode_GetPartialSuccess {
+	c_call := _m.On("GetPartialSuccess")
+	return &ExecutableArrayNode_GetPartialSuccess{Call: c_call}
+}
+
+func (_m *ExecutableArrayNode) OnGetPartialSuccessMatch(matchers ...interface{}) *ExecutableArrayNode_GetPartialSuccess {
+	c_call := _m.On("GetPartialSuccess", matchers...)
+	return &ExecutableArrayNode_GetPartialSuccess{Call: c_call}
+}
+
+// GetPartialSuccess provides a mock function with given fields:
+func (_m *ExecutableArrayNode) GetPartialSuccess() *v1alpha1.ArrayNodePartialSuccess {
+	ret := _m.Called()
+
+	var r0 *v1alpha1.ArrayNodePartialSuccess
+	if rf, ok := ret.Get(0).(func() *v1alpha1.ArrayNodePartialSuccess); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ArrayNodePartialSuccess)
+		}
+	}
+
+	return r0
+}
+
+type ExecutableArrayNode_GetRetryBudget struct {
+	*mock.Call
+}
+
+func (_m ExecutableArrayNode_GetRetryBudget) Return(_a0 *uint32) *ExecutableArrayNode_GetRetryBudget {
+	return &ExecutableArrayNode_GetRetryBudget{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableArrayNode) OnGetRetryBudget() *ExecutableArrayNode_GetRetryBudget {
+	c_call := _m.On("GetRetryBudget")
+	return &ExecutableArrayNode_GetRetryBudget{Call: c_call}
+}
+
+func (_m *ExecutableArrayNode) OnGetRetryBudgetMatch(matchers ...interface{}) *ExecutableArrayNode_GetRetryBudget {
+	c_call := _m.On("GetRetryBudget", matchers...)
+	return &ExecutableArrayNode_GetRetryBudget{Call: c_call}
+}
+
+// GetRetryBudget provides a mock function with given fields:
+func (_m *ExecutableArrayNode) GetRetryBudget() *uint32 {
+	ret := _m.Called()
+
+	var r0 *uint32
+	if rf, ok := ret.Get(0).(func() *uint32); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint32)
+		}
+	}
+
+	return r0
+}
+
 type ExecutableArrayNode_GetSubNodeSpec struct {
 	*mock.Call
 }
@@ -82,6 +82,38 @@ func (_m *ExecutableArrayNodeStatus) GetExecutionError() *core.ExecutionError {
 	return r0
 }
 
+type ExecutableArrayNodeStatus_GetParallelism struct {
+	*mock.Call
+}
+
+func (_m ExecutableArrayNodeStatus_GetParallelism) Return(_a0 uint32) *ExecutableArrayNodeStatus_GetParallelism {
+	return &ExecutableArrayNodeStatus_GetParallelism{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableArrayNodeStatus) OnGetParallelism() *ExecutableArrayNodeStatus_GetParallelism {
+	c_call := _m.On("GetParallelism")
+	return &ExecutableArrayNodeStatus_GetParallelism{Call: c_call}
+}
+
+func (_m *ExecutableArrayNodeStatus) OnGetParallelismMatch(matchers ...interface{}) *ExecutableArrayNodeStatus_GetParallelism {
+	c_call := _m.On("GetParallelism", matchers...)
+	return &ExecutableArrayNodeStatus_GetParallelism{Call: c_call}
+}
+
+// GetParallelism provides a mock function with given fields:
+func (_m *ExecutableArrayNodeStatus) GetParallelism() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 type ExecutableArrayNodeStatus_GetSubNodePhases struct {
 	*mock.Call
 }
@@ -114,6 +146,38 @@ func (_m *ExecutableArrayNodeStatus) GetSubNodePhases() bitarray.CompactArray {
 	return r0
 }
 
+type ExecutableArrayNodeStatus_GetSubNodePreemptions struct {
+	*mock.Call
+}
+
+func (_m ExecutableArrayNodeStatus_GetSubNodePreemptions) Return(_a0 bitarray.CompactArray) *ExecutableArrayNodeStatus_GetSubNodePreemptions {
+	return &ExecutableArrayNodeStatus_GetSubNodePreemptions{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableArrayNodeStatus) OnGetSubNodePreemptions() *ExecutableArrayNodeStatus_GetSubNodePreemptions {
+	c_call := _m.On("GetSubNodePreemptions")
+	return &ExecutableArrayNodeStatus_GetSubNodePreemptions{Call: c_call}
+}
+
+func (_m *ExecutableArrayNodeStatus) OnGetSubNodePreemptionsMatch(matchers ...interface{}) *ExecutableArrayNodeStatus_GetSubNodePreemptions {
+	c_call := _m.On("GetSubNodePreemptions", matchers...)
+	return &ExecutableArrayNodeStatus_GetSubNodePreemptions{Call: c_call}
+}
+
+// GetSubNodePreemptions provides a mock function with given fields:
+func (_m *ExecutableArrayNodeStatus) GetSubNodePreemptions() bitarray.CompactArray {
+	ret := _m.Called()
+
+	var r0 bitarray.CompactArray
+	if rf, ok := ret.Get(0).(func() bitarray.CompactArray); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bitarray.CompactArray)
+	}
+
+	return r0
+}
+
 type ExecutableArrayNodeStatus_GetSubNodeRetryAttempts struct {
 	*mock.Call
 }
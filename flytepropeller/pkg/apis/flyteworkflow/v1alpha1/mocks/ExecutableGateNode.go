@@ -48,6 +48,40 @@ func (_m *ExecutableGateNode) GetApprove() *core.ApproveCondition {
 	return r0
 }
 
+type ExecutableGateNode_GetExternal struct {
+	*mock.Call
+}
+
+func (_m ExecutableGateNode_GetExternal) Return(_a0 *core.ExternalCondition) *ExecutableGateNode_GetExternal {
+	return &ExecutableGateNode_GetExternal{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableGateNode) OnGetExternal() *ExecutableGateNode_GetExternal {
+	c_call := _m.On("GetExternal")
+	return &ExecutableGateNode_GetExternal{Call: c_call}
+}
+
+func (_m *ExecutableGateNode) OnGetExternalMatch(matchers ...interface{}) *ExecutableGateNode_GetExternal {
+	c_call := _m.On("GetExternal", matchers...)
+	return &ExecutableGateNode_GetExternal{Call: c_call}
+}
+
+// GetExternal provides a mock function with given fields:
+func (_m *ExecutableGateNode) GetExternal() *core.ExternalCondition {
+	ret := _m.Called()
+
+	var r0 *core.ExternalCondition
+	if rf, ok := ret.Get(0).(func() *core.ExternalCondition); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ExternalCondition)
+		}
+	}
+
+	return r0
+}
+
 type ExecutableGateNode_GetKind struct {
 	*mock.Call
 }
@@ -147,3 +181,37 @@ func (_m *ExecutableGateNode) GetSleep() *core.SleepCondition {
 
 	return r0
 }
+
+type ExecutableGateNode_GetWaitUntil struct {
+	*mock.Call
+}
+
+func (_m ExecutableGateNode_GetWaitUntil) Return(_a0 *core.WaitUntilCondition) *ExecutableGateNode_GetWaitUntil {
+	return &ExecutableGateNode_GetWaitUntil{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableGateNode) OnGetWaitUntil() *ExecutableGateNode_GetWaitUntil {
+	c_call := _m.On("GetWaitUntil")
+	return &ExecutableGateNode_GetWaitUntil{Call: c_call}
+}
+
+func (_m *ExecutableGateNode) OnGetWaitUntilMatch(matchers ...interface{}) *ExecutableGateNode_GetWaitUntil {
+	c_call := _m.On("GetWaitUntil", matchers...)
+	return &ExecutableGateNode_GetWaitUntil{Call: c_call}
+}
+
+// GetWaitUntil provides a mock function with given fields:
+func (_m *ExecutableGateNode) GetWaitUntil() *core.WaitUntilCondition {
+	ret := _m.Called()
+
+	var r0 *core.WaitUntilCondition
+	if rf, ok := ret.Get(0).(func() *core.WaitUntilCondition); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.WaitUntilCondition)
+		}
+	}
+
+	return r0
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	time "time"
+
 	v1alpha1 "github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -43,3 +45,67 @@ func (_m *ExecutableGateNodeStatus) GetGateNodePhase() v1alpha1.GateNodePhase {
 
 	return r0
 }
+
+type ExecutableGateNodeStatus_GetLastPolledAt struct {
+	*mock.Call
+}
+
+func (_m ExecutableGateNodeStatus_GetLastPolledAt) Return(_a0 time.Time) *ExecutableGateNodeStatus_GetLastPolledAt {
+	return &ExecutableGateNodeStatus_GetLastPolledAt{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableGateNodeStatus) OnGetLastPolledAt() *ExecutableGateNodeStatus_GetLastPolledAt {
+	c_call := _m.On("GetLastPolledAt")
+	return &ExecutableGateNodeStatus_GetLastPolledAt{Call: c_call}
+}
+
+func (_m *ExecutableGateNodeStatus) OnGetLastPolledAtMatch(matchers ...interface{}) *ExecutableGateNodeStatus_GetLastPolledAt {
+	c_call := _m.On("GetLastPolledAt", matchers...)
+	return &ExecutableGateNodeStatus_GetLastPolledAt{Call: c_call}
+}
+
+// GetLastPolledAt provides a mock function with given fields:
+func (_m *ExecutableGateNodeStatus) GetLastPolledAt() time.Time {
+	ret := _m.Called()
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
+
+type ExecutableGateNodeStatus_GetPollAttempts struct {
+	*mock.Call
+}
+
+func (_m ExecutableGateNodeStatus_GetPollAttempts) Return(_a0 uint32) *ExecutableGateNodeStatus_GetPollAttempts {
+	return &ExecutableGateNodeStatus_GetPollAttempts{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableGateNodeStatus) OnGetPollAttempts() *ExecutableGateNodeStatus_GetPollAttempts {
+	c_call := _m.On("GetPollAttempts")
+	return &ExecutableGateNodeStatus_GetPollAttempts{Call: c_call}
+}
+
+func (_m *ExecutableGateNodeStatus) OnGetPollAttemptsMatch(matchers ...interface{}) *ExecutableGateNodeStatus_GetPollAttempts {
+	c_call := _m.On("GetPollAttempts", matchers...)
+	return &ExecutableGateNodeStatus_GetPollAttempts{Call: c_call}
+}
+
+// GetPollAttempts provides a mock function with given fields:
+func (_m *ExecutableGateNodeStatus) GetPollAttempts() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
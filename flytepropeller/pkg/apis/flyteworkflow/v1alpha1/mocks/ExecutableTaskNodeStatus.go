@@ -240,6 +240,38 @@ func (_m *ExecutableTaskNodeStatus) GetPluginStateVersion() uint32 {
 	return r0
 }
 
+type ExecutableTaskNodeStatus_GetPreviousAttemptCheckpointPath struct {
+	*mock.Call
+}
+
+func (_m ExecutableTaskNodeStatus_GetPreviousAttemptCheckpointPath) Return(_a0 storage.DataReference) *ExecutableTaskNodeStatus_GetPreviousAttemptCheckpointPath {
+	return &ExecutableTaskNodeStatus_GetPreviousAttemptCheckpointPath{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *ExecutableTaskNodeStatus) OnGetPreviousAttemptCheckpointPath() *ExecutableTaskNodeStatus_GetPreviousAttemptCheckpointPath {
+	c_call := _m.On("GetPreviousAttemptCheckpointPath")
+	return &ExecutableTaskNodeStatus_GetPreviousAttemptCheckpointPath{Call: c_call}
+}
+
+func (_m *ExecutableTaskNodeStatus) OnGetPreviousAttemptCheckpointPathMatch(matchers ...interface{}) *ExecutableTaskNodeStatus_GetPreviousAttemptCheckpointPath {
+	c_call := _m.On("GetPreviousAttemptCheckpointPath", matchers...)
+	return &ExecutableTaskNodeStatus_GetPreviousAttemptCheckpointPath{Call: c_call}
+}
+
+// GetPreviousAttemptCheckpointPath provides a mock function with given fields:
+func (_m *ExecutableTaskNodeStatus) GetPreviousAttemptCheckpointPath() storage.DataReference {
+	ret := _m.Called()
+
+	var r0 storage.DataReference
+	if rf, ok := ret.Get(0).(func() storage.DataReference); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(storage.DataReference)
+	}
+
+	return r0
+}
+
 type ExecutableTaskNodeStatus_GetPreviousNodeExecutionCheckpointPath struct {
 	*mock.Call
 }
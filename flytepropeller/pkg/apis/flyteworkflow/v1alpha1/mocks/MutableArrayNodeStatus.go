@@ -82,6 +82,38 @@ func (_m *MutableArrayNodeStatus) GetExecutionError() *core.ExecutionError {
 	return r0
 }
 
+type MutableArrayNodeStatus_GetParallelism struct {
+	*mock.Call
+}
+
+func (_m MutableArrayNodeStatus_GetParallelism) Return(_a0 uint32) *MutableArrayNodeStatus_GetParallelism {
+	return &MutableArrayNodeStatus_GetParallelism{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *MutableArrayNodeStatus) OnGetParallelism() *MutableArrayNodeStatus_GetParallelism {
+	c_call := _m.On("GetParallelism")
+	return &MutableArrayNodeStatus_GetParallelism{Call: c_call}
+}
+
+func (_m *MutableArrayNodeStatus) OnGetParallelismMatch(matchers ...interface{}) *MutableArrayNodeStatus_GetParallelism {
+	c_call := _m.On("GetParallelism", matchers...)
+	return &MutableArrayNodeStatus_GetParallelism{Call: c_call}
+}
+
+// GetParallelism provides a mock function with given fields:
+func (_m *MutableArrayNodeStatus) GetParallelism() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 type MutableArrayNodeStatus_GetSubNodePhases struct {
 	*mock.Call
 }
@@ -114,6 +146,38 @@ func (_m *MutableArrayNodeStatus) GetSubNodePhases() bitarray.CompactArray {
 	return r0
 }
 
+type MutableArrayNodeStatus_GetSubNodePreemptions struct {
+	*mock.Call
+}
+
+func (_m MutableArrayNodeStatus_GetSubNodePreemptions) Return(_a0 bitarray.CompactArray) *MutableArrayNodeStatus_GetSubNodePreemptions {
+	return &MutableArrayNodeStatus_GetSubNodePreemptions{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *MutableArrayNodeStatus) OnGetSubNodePreemptions() *MutableArrayNodeStatus_GetSubNodePreemptions {
+	c_call := _m.On("GetSubNodePreemptions")
+	return &MutableArrayNodeStatus_GetSubNodePreemptions{Call: c_call}
+}
+
+func (_m *MutableArrayNodeStatus) OnGetSubNodePreemptionsMatch(matchers ...interface{}) *MutableArrayNodeStatus_GetSubNodePreemptions {
+	c_call := _m.On("GetSubNodePreemptions", matchers...)
+	return &MutableArrayNodeStatus_GetSubNodePreemptions{Call: c_call}
+}
+
+// GetSubNodePreemptions provides a mock function with given fields:
+func (_m *MutableArrayNodeStatus) GetSubNodePreemptions() bitarray.CompactArray {
+	ret := _m.Called()
+
+	var r0 bitarray.CompactArray
+	if rf, ok := ret.Get(0).(func() bitarray.CompactArray); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bitarray.CompactArray)
+	}
+
+	return r0
+}
+
 type MutableArrayNodeStatus_GetSubNodeRetryAttempts struct {
 	*mock.Call
 }
@@ -284,11 +348,21 @@ func (_m *MutableArrayNodeStatus) SetExecutionError(executionError *core.Executi
 	_m.Called(executionError)
 }
 
+// SetParallelism provides a mock function with given fields: parallelism
+func (_m *MutableArrayNodeStatus) SetParallelism(parallelism uint32) {
+	_m.Called(parallelism)
+}
+
 // SetSubNodePhases provides a mock function with given fields: subNodePhases
 func (_m *MutableArrayNodeStatus) SetSubNodePhases(subNodePhases bitarray.CompactArray) {
 	_m.Called(subNodePhases)
 }
 
+// SetSubNodePreemptions provides a mock function with given fields: subNodePreemptions
+func (_m *MutableArrayNodeStatus) SetSubNodePreemptions(subNodePreemptions bitarray.CompactArray) {
+	_m.Called(subNodePreemptions)
+}
+
 // SetSubNodeRetryAttempts provides a mock function with given fields: subNodeRetryAttempts
 func (_m *MutableArrayNodeStatus) SetSubNodeRetryAttempts(subNodeRetryAttempts bitarray.CompactArray) {
 	_m.Called(subNodeRetryAttempts)
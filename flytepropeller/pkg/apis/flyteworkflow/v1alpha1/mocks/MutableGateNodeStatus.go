@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	time "time"
+
 	v1alpha1 "github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -44,6 +46,70 @@ func (_m *MutableGateNodeStatus) GetGateNodePhase() v1alpha1.GateNodePhase {
 	return r0
 }
 
+type MutableGateNodeStatus_GetLastPolledAt struct {
+	*mock.Call
+}
+
+func (_m MutableGateNodeStatus_GetLastPolledAt) Return(_a0 time.Time) *MutableGateNodeStatus_GetLastPolledAt {
+	return &MutableGateNodeStatus_GetLastPolledAt{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *MutableGateNodeStatus) OnGetLastPolledAt() *MutableGateNodeStatus_GetLastPolledAt {
+	c_call := _m.On("GetLastPolledAt")
+	return &MutableGateNodeStatus_GetLastPolledAt{Call: c_call}
+}
+
+func (_m *MutableGateNodeStatus) OnGetLastPolledAtMatch(matchers ...interface{}) *MutableGateNodeStatus_GetLastPolledAt {
+	c_call := _m.On("GetLastPolledAt", matchers...)
+	return &MutableGateNodeStatus_GetLastPolledAt{Call: c_call}
+}
+
+// GetLastPolledAt provides a mock function with given fields:
+func (_m *MutableGateNodeStatus) GetLastPolledAt() time.Time {
+	ret := _m.Called()
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
+
+type MutableGateNodeStatus_GetPollAttempts struct {
+	*mock.Call
+}
+
+func (_m MutableGateNodeStatus_GetPollAttempts) Return(_a0 uint32) *MutableGateNodeStatus_GetPollAttempts {
+	return &MutableGateNodeStatus_GetPollAttempts{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *MutableGateNodeStatus) OnGetPollAttempts() *MutableGateNodeStatus_GetPollAttempts {
+	c_call := _m.On("GetPollAttempts")
+	return &MutableGateNodeStatus_GetPollAttempts{Call: c_call}
+}
+
+func (_m *MutableGateNodeStatus) OnGetPollAttemptsMatch(matchers ...interface{}) *MutableGateNodeStatus_GetPollAttempts {
+	c_call := _m.On("GetPollAttempts", matchers...)
+	return &MutableGateNodeStatus_GetPollAttempts{Call: c_call}
+}
+
+// GetPollAttempts provides a mock function with given fields:
+func (_m *MutableGateNodeStatus) GetPollAttempts() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 type MutableGateNodeStatus_IsDirty struct {
 	*mock.Call
 }
@@ -80,3 +146,13 @@ func (_m *MutableGateNodeStatus) IsDirty() bool {
 func (_m *MutableGateNodeStatus) SetGateNodePhase(phase v1alpha1.GateNodePhase) {
 	_m.Called(phase)
 }
+
+// SetLastPolledAt provides a mock function with given fields: lastPolledAt
+func (_m *MutableGateNodeStatus) SetLastPolledAt(lastPolledAt time.Time) {
+	_m.Called(lastPolledAt)
+}
+
+// SetPollAttempts provides a mock function with given fields: pollAttempts
+func (_m *MutableGateNodeStatus) SetPollAttempts(pollAttempts uint32) {
+	_m.Called(pollAttempts)
+}
@@ -240,6 +240,38 @@ func (_m *MutableTaskNodeStatus) GetPluginStateVersion() uint32 {
 	return r0
 }
 
+type MutableTaskNodeStatus_GetPreviousAttemptCheckpointPath struct {
+	*mock.Call
+}
+
+func (_m MutableTaskNodeStatus_GetPreviousAttemptCheckpointPath) Return(_a0 storage.DataReference) *MutableTaskNodeStatus_GetPreviousAttemptCheckpointPath {
+	return &MutableTaskNodeStatus_GetPreviousAttemptCheckpointPath{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *MutableTaskNodeStatus) OnGetPreviousAttemptCheckpointPath() *MutableTaskNodeStatus_GetPreviousAttemptCheckpointPath {
+	c_call := _m.On("GetPreviousAttemptCheckpointPath")
+	return &MutableTaskNodeStatus_GetPreviousAttemptCheckpointPath{Call: c_call}
+}
+
+func (_m *MutableTaskNodeStatus) OnGetPreviousAttemptCheckpointPathMatch(matchers ...interface{}) *MutableTaskNodeStatus_GetPreviousAttemptCheckpointPath {
+	c_call := _m.On("GetPreviousAttemptCheckpointPath", matchers...)
+	return &MutableTaskNodeStatus_GetPreviousAttemptCheckpointPath{Call: c_call}
+}
+
+// GetPreviousAttemptCheckpointPath provides a mock function with given fields:
+func (_m *MutableTaskNodeStatus) GetPreviousAttemptCheckpointPath() storage.DataReference {
+	ret := _m.Called()
+
+	var r0 storage.DataReference
+	if rf, ok := ret.Get(0).(func() storage.DataReference); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(storage.DataReference)
+	}
+
+	return r0
+}
+
 type MutableTaskNodeStatus_GetPreviousNodeExecutionCheckpointPath struct {
 	*mock.Call
 }
@@ -339,6 +371,11 @@ func (_m *MutableTaskNodeStatus) SetPluginStateVersion(_a0 uint32) {
 	_m.Called(_a0)
 }
 
+// SetPreviousAttemptCheckpointPath provides a mock function with given fields: _a0
+func (_m *MutableTaskNodeStatus) SetPreviousAttemptCheckpointPath(_a0 storage.DataReference) {
+	_m.Called(_a0)
+}
+
 // SetPreviousNodeExecutionCheckpointPath provides a mock function with given fields: _a0
 func (_m *MutableTaskNodeStatus) SetPreviousNodeExecutionCheckpointPath(_a0 storage.DataReference) {
 	_m.Called(_a0)
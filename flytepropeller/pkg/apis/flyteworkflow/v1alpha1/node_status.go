@@ -206,6 +206,9 @@ const (
 type GateNodeStatus struct {
 	MutableStruct
 	Phase GateNodePhase `json:"phase,omitempty"`
+	// PollAttempts and LastPolledAt track the polls of external conditions
+	PollAttempts uint32    `json:"pollAttempts,omitempty"`
+	LastPolledAt time.Time `json:"lastPolledAt,omitempty"`
 }
 
 func (in *GateNodeStatus) GetGateNodePhase() GateNodePhase {
@@ -219,6 +222,28 @@ func (in *GateNodeStatus) SetGateNodePhase(phase GateNodePhase) {
 	}
 }
 
+func (in *GateNodeStatus) GetPollAttempts() uint32 {
+	return in.PollAttempts
+}
+
+func (in *GateNodeStatus) SetPollAttempts(pollAttempts uint32) {
+	if in.PollAttempts != pollAttempts {
+		in.SetDirty()
+		in.PollAttempts = pollAttempts
+	}
+}
+
+func (in *GateNodeStatus) GetLastPolledAt() time.Time {
+	return in.LastPolledAt
+}
+
+func (in *GateNodeStatus) SetLastPolledAt(lastPolledAt time.Time) {
+	if !in.LastPolledAt.Equal(lastPolledAt) {
+		in.SetDirty()
+		in.LastPolledAt = lastPolledAt
+	}
+}
+
 type ArrayNodePhase int
 
 const (
@@ -236,7 +261,10 @@ type ArrayNodeStatus struct {
 	SubNodeTaskPhases     bitarray.CompactArray `json:"subtphase,omitempty"`
 	SubNodeRetryAttempts  bitarray.CompactArray `json:"subattempts,omitempty"`
 	SubNodeSystemFailures bitarray.CompactArray `json:"subsysfailures,omitempty"`
+	SubNodePreemptions    bitarray.CompactArray `json:"subpreemptions,omitempty"`
 	TaskPhaseVersion      uint32                `json:"taskPhaseVersion,omitempty"`
+	// Parallelism is the number of subNodes evaluated concurrently when the parallelism is adapted to the cluster
+	Parallelism uint32 `json:"parallelism,omitempty"`
 }
 
 func (in *ArrayNodeStatus) GetArrayNodePhase() ArrayNodePhase {
@@ -305,6 +333,17 @@ func (in *ArrayNodeStatus) SetSubNodeSystemFailures(subNodeSystemFailures bitarr
 	}
 }
 
+func (in *ArrayNodeStatus) GetSubNodePreemptions() bitarray.CompactArray {
+	return in.SubNodePreemptions
+}
+
+func (in *ArrayNodeStatus) SetSubNodePreemptions(subNodePreemptions bitarray.CompactArray) {
+	if in.SubNodePreemptions != subNodePreemptions {
+		in.SetDirty()
+		in.SubNodePreemptions = subNodePreemptions
+	}
+}
+
 func (in *ArrayNodeStatus) GetTaskPhaseVersion() uint32 {
 	return in.TaskPhaseVersion
 }
@@ -316,6 +355,17 @@ func (in *ArrayNodeStatus) SetTaskPhaseVersion(taskPhaseVersion uint32) {
 	}
 }
 
+func (in *ArrayNodeStatus) GetParallelism() uint32 {
+	return in.Parallelism
+}
+
+func (in *ArrayNodeStatus) SetParallelism(parallelism uint32) {
+	if in.Parallelism != parallelism {
+		in.SetDirty()
+		in.Parallelism = parallelism
+	}
+}
+
 func (in *ArrayNodeStatus) DeepCopyInto(out *ArrayNodeStatus) {
 	*out = *in
 	out.MutableStruct = in.MutableStruct
@@ -350,6 +400,8 @@ type NodeStatus struct {
 	OutputDir            DataReference `json:"-"`
 	Attempts             uint32        `json:"attempts,omitempty"`
 	SystemFailures       uint32        `json:"systemFailures,omitempty"`
+	OOMFailures          uint32        `json:"oomFailures,omitempty"`
+	Preemptions          uint32        `json:"preemptions,omitempty"`
 	Cached               bool          `json:"cached,omitempty"`
 
 	// This is useful only for branch nodes. If this is set, then it can be used to determine if execution can proceed
@@ -514,6 +566,14 @@ func (in *NodeStatus) GetSystemFailures() uint32 {
 	return in.SystemFailures
 }
 
+func (in *NodeStatus) GetOOMFailures() uint32 {
+	return in.OOMFailures
+}
+
+func (in *NodeStatus) GetPreemptions() uint32 {
+	return in.Preemptions
+}
+
 func (in *NodeStatus) SetCached() {
 	in.Cached = true
 	in.SetDirty()
@@ -535,6 +595,18 @@ func (in *NodeStatus) IncrementSystemFailures() uint32 {
 	return in.SystemFailures
 }
 
+func (in *NodeStatus) IncrementOOMFailures() uint32 {
+	in.OOMFailures++
+	in.SetDirty()
+	return in.OOMFailures
+}
+
+func (in *NodeStatus) IncrementPreemptions() uint32 {
+	in.Preemptions++
+	in.SetDirty()
+	return in.Preemptions
+}
+
 func (in *NodeStatus) GetOrCreateDynamicNodeStatus() MutableDynamicNodeStatus {
 	if in.DynamicNodeStatus == nil {
 		in.SetDirty()
@@ -829,6 +901,14 @@ func (in *NodeStatus) Equals(other *NodeStatus) bool {
 		return false
 	}
 
+	if in.OOMFailures != other.OOMFailures {
+		return false
+	}
+
+	if in.Preemptions != other.Preemptions {
+		return false
+	}
+
 	if in.Phase != other.Phase {
 		return false
 	}
@@ -918,6 +998,7 @@ type TaskNodeStatus struct {
 	BarrierClockTick                    uint32        `json:"tick,omitempty"`
 	LastPhaseUpdatedAt                  time.Time     `json:"updAt,omitempty"`
 	PreviousNodeExecutionCheckpointPath DataReference `json:"checkpointPath,omitempty"`
+	PreviousAttemptCheckpointPath       DataReference `json:"prevAttemptCheckpointPath,omitempty"`
 	CleanupOnFailure                    bool          `json:"clean,omitempty"`
 }
 
@@ -935,6 +1016,11 @@ func (in *TaskNodeStatus) SetPreviousNodeExecutionCheckpointPath(path DataRefere
 	in.SetDirty()
 }
 
+func (in *TaskNodeStatus) SetPreviousAttemptCheckpointPath(path DataReference) {
+	in.PreviousAttemptCheckpointPath = path
+	in.SetDirty()
+}
+
 func (in *TaskNodeStatus) SetPluginState(s []byte) {
 	in.PluginState = s
 	in.SetDirty()
@@ -984,6 +1070,10 @@ func (in TaskNodeStatus) GetPreviousNodeExecutionCheckpointPath() DataReference
 	return in.PreviousNodeExecutionCheckpointPath
 }
 
+func (in TaskNodeStatus) GetPreviousAttemptCheckpointPath() DataReference {
+	return in.PreviousAttemptCheckpointPath
+}
+
 func (in TaskNodeStatus) GetPhaseVersion() uint32 {
 	return in.PhaseVersion
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/errors"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/validators"
 )
 
 // Gets the compiled subgraph if this node contains an inline-declared coreWorkflow. Otherwise nil.
@@ -168,6 +169,20 @@ func buildNodeSpec(n *core.Node, tasks []*core.CompiledTask, errs errors.Compile
 					SleepCondition: gateNode.GetSleep(),
 				},
 			}
+		case *core.GateNode_WaitUntil:
+			nodeSpec.GateNode = &v1alpha1.GateNodeSpec{
+				Kind: v1alpha1.ConditionKindWaitUntil,
+				WaitUntil: &v1alpha1.WaitUntilCondition{
+					WaitUntilCondition: gateNode.GetWaitUntil(),
+				},
+			}
+		case *core.GateNode_External:
+			nodeSpec.GateNode = &v1alpha1.GateNodeSpec{
+				Kind: v1alpha1.ConditionKindExternal,
+				External: &v1alpha1.ExternalCondition{
+					ExternalCondition: gateNode.GetExternal(),
+				},
+			}
 		}
 	case *core.Node_ArrayNode:
 		arrayNode := n.GetArrayNode()
@@ -197,6 +212,20 @@ func buildNodeSpec(n *core.Node, tasks []*core.CompiledTask, errs errors.Compile
 		case *core.ArrayNode_MinSuccessRatio:
 			nodeSpec.ArrayNode.MinSuccessRatio = &successCriteria.MinSuccessRatio
 		}
+
+		if partialSuccess := arrayNode.GetPartialSuccess(); partialSuccess != nil {
+			nodeSpec.ArrayNode.PartialSuccess = &v1alpha1.ArrayNodePartialSuccess{
+				ErrorsOutput: validators.ArrayNodeErrorsOutput(partialSuccess),
+			}
+		}
+
+		switch retryBudget := arrayNode.GetRetryBudgetOption().(type) {
+		case *core.ArrayNode_RetryBudget:
+			nodeSpec.ArrayNode.RetryBudget = &retryBudget.RetryBudget
+		}
+
+		nodeSpec.ArrayNode.MaxFailures = arrayNode.GetMaxFailures()
+		nodeSpec.ArrayNode.AdaptiveParallelism = arrayNode.GetAdaptiveParallelism()
 	default:
 		if n.GetId() == v1alpha1.StartNodeID {
 			nodeSpec.Kind = v1alpha1.NodeKindStart
@@ -283,6 +283,42 @@ func TestBuildNodeSpec(t *testing.T) {
 		mustBuild(t, n, 1, errs.NewScope())
 	})
 
+	t.Run("GateNodeWaitUntil", func(t *testing.T) {
+		n.Node.Target = &core.Node_GateNode{
+			GateNode: &core.GateNode{
+				Condition: &core.GateNode_WaitUntil{
+					WaitUntil: &core.WaitUntilCondition{
+						InputVariableName: "until",
+					},
+				},
+			},
+		}
+
+		spec := mustBuild(t, n, 1, errs.NewScope())
+		assert.Equal(t, v1alpha1.ConditionKindWaitUntil, spec.GetGateNode().GetKind())
+		assert.Equal(t, "until", spec.GetGateNode().GetWaitUntil().GetInputVariableName())
+	})
+
+	t.Run("GateNodeExternal", func(t *testing.T) {
+		n.Node.Target = &core.Node_GateNode{
+			GateNode: &core.GateNode{
+				Condition: &core.GateNode_External{
+					External: &core.ExternalCondition{
+						Condition: &core.ExternalCondition_StorageObject{
+							StorageObject: &core.StorageObjectCondition{
+								UriSource: &core.StorageObjectCondition_Uri{Uri: "s3://bucket/key"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		spec := mustBuild(t, n, 1, errs.NewScope())
+		assert.Equal(t, v1alpha1.ConditionKindExternal, spec.GetGateNode().GetKind())
+		assert.Equal(t, "s3://bucket/key", spec.GetGateNode().GetExternal().GetStorageObject().GetUri())
+	})
+
 	t.Run("ArrayNode", func(t *testing.T) {
 		n.Node.Target = &core.Node_ArrayNode{
 			ArrayNode: &core.ArrayNode{
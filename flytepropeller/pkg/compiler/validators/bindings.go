@@ -271,10 +271,11 @@ func ValidateBindings(w c.WorkflowBuilder, node c.Node, bindings []*flyte.Bindin
 
 // IsOptionalType Return true if there is a None type in Union Type
 func IsOptionalType(variable flyte.Variable) bool {
-	if variable.GetType().GetUnionType() == nil {
-		return false
-	}
-	for _, variant := range variable.GetType().GetUnionType().GetVariants() {
+	return isOptionalType(variable.GetType())
+}
+
+func isOptionalType(literalType *flyte.LiteralType) bool {
+	for _, variant := range literalType.GetUnionType().GetVariants() {
 		if flyte.SimpleType_NONE == variant.GetSimple() {
 			return true
 		}
@@ -2,6 +2,7 @@ package validators
 
 import (
 	"fmt"
+	"regexp"
 
 	flyte "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	c "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
@@ -15,11 +16,15 @@ func validateOperand(node c.NodeBuilder, paramName string, operand *flyte.Operan
 	} else if operand.GetPrimitive() != nil {
 		// no validation
 		literalType = literalTypeForPrimitive(operand.GetPrimitive())
+	} else if operand.GetScalar() != nil {
+		literalType = literalTypeForScalar(operand.GetScalar())
+	} else if operand.GetCollection() != nil {
+		literalType = LiteralTypeForLiteral(&flyte.Literal{Value: &flyte.Literal_Collection{Collection: operand.GetCollection()}})
 	} else if len(operand.GetVar()) > 0 {
 		if node.GetInterface() != nil {
 			if param, paramOk := validateInputVar(node, operand.GetVar(), requireParamType, errs.NewScope()); paramOk {
 				if param != nil {
-					literalType = param.GetType()
+					literalType = literalTypeForAttrPath(param.GetType(), operand.GetAttrPath())
 				}
 			}
 		} else {
@@ -32,21 +37,138 @@ func validateOperand(node c.NodeBuilder, paramName string, operand *flyte.Operan
 	return literalType, !errs.HasErrors()
 }
 
+// literalTypeForAttrPath returns the type of the attribute at the path, nil if it can't be known at compile time (e.g.
+// attributes of untyped structs).
+func literalTypeForAttrPath(literalType *flyte.LiteralType, attrPath []*flyte.PromiseAttribute) *flyte.LiteralType {
+	for _, attr := range attrPath {
+		switch {
+		case literalType.GetCollectionType() != nil:
+			literalType = literalType.GetCollectionType()
+		case literalType.GetMapValueType() != nil:
+			literalType = literalType.GetMapValueType()
+		case literalType.GetStructure().GetDataclassType() != nil:
+			literalType = literalType.GetStructure().GetDataclassType()[attr.GetStringValue()]
+		default:
+			return nil
+		}
+	}
+
+	return literalType
+}
+
+// typeVariants returns the types a value of the literal type can hold. Union (and optional) types are unwrapped to
+// their variants other than none.
+func typeVariants(literalType *flyte.LiteralType) []*flyte.LiteralType {
+	if literalType.GetUnionType() == nil {
+		return []*flyte.LiteralType{literalType}
+	}
+
+	variants := make([]*flyte.LiteralType, 0, len(literalType.GetUnionType().GetVariants()))
+	for _, variant := range literalType.GetUnionType().GetVariants() {
+		if !isNoneType(variant) {
+			variants = append(variants, typeVariants(variant)...)
+		}
+	}
+
+	return variants
+}
+
+// areTypesComparable returns whether operands of the two types can be compared, i.e. either is none (the type of none
+// scalars, comparable to operands of any type) or they have a variant in common.
+func areTypesComparable(t1, t2 *flyte.LiteralType) bool {
+	if isNoneType(t1) || isNoneType(t2) {
+		return true
+	}
+
+	for _, v1 := range typeVariants(t1) {
+		for _, v2 := range typeVariants(t2) {
+			if isNoneType(v1) || isNoneType(v2) || v1.String() == v2.String() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isStringType(literalType *flyte.LiteralType) bool {
+	return areTypesComparable(literalType, &flyte.LiteralType{Type: &flyte.LiteralType_Simple{Simple: flyte.SimpleType_STRING}})
+}
+
+// isCollectionOf returns whether a variant of the collection type holds elements comparable to the element type.
+func isCollectionOf(collectionType, elementType *flyte.LiteralType) bool {
+	for _, variant := range typeVariants(collectionType) {
+		if variant.GetCollectionType() != nil && areTypesComparable(variant.GetCollectionType(), elementType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateComparison(node c.NodeBuilder, expr *flyte.ComparisonExpression, requireParamType bool, errs errors.CompileErrors) (ok bool) {
+	switch expr.GetOperator() {
+	case flyte.ComparisonExpression_IS_NULL, flyte.ComparisonExpression_IS_NOT_NULL:
+		validateOperand(node, "LeftValue", expr.GetLeftValue(), requireParamType, errs.NewScope())
+		if expr.GetRightValue() != nil {
+			errs.Collect(errors.NewInvalidValueErr(node.GetId(), "RightValue"))
+		}
+
+		return !errs.HasErrors()
+	}
+
+	op1Type, op1Valid := validateOperand(node, "RightValue",
+		expr.GetRightValue(), requireParamType, errs.NewScope())
+	op2Type, op2Valid := validateOperand(node, "LeftValue",
+		expr.GetLeftValue(), requireParamType, errs.NewScope())
+	if !op1Valid || !op2Valid || op1Type == nil || op2Type == nil {
+		return !errs.HasErrors()
+	}
+
+	switch expr.GetOperator() {
+	case flyte.ComparisonExpression_IN, flyte.ComparisonExpression_NOT_IN:
+		if !isNoneType(op1Type) && !isCollectionOf(op1Type, op2Type) {
+			errs.Collect(errors.NewMismatchingTypesErr(node.GetId(), "RightValue",
+				c.LiteralTypeToStr(op1Type), "collection of "+c.LiteralTypeToStr(op2Type)))
+		}
+	case flyte.ComparisonExpression_STARTS_WITH, flyte.ComparisonExpression_ENDS_WITH, flyte.ComparisonExpression_MATCHES:
+		if !isStringType(op2Type) {
+			errs.Collect(errors.NewMismatchingTypesErr(node.GetId(), "LeftValue",
+				c.LiteralTypeToStr(op2Type), flyte.SimpleType_STRING.String()))
+		}
+		if !isStringType(op1Type) {
+			errs.Collect(errors.NewMismatchingTypesErr(node.GetId(), "RightValue",
+				c.LiteralTypeToStr(op1Type), flyte.SimpleType_STRING.String()))
+		}
+
+		// Constant patterns are checked at compile time, patterns bound to inputs when the branch is evaluated.
+		pattern := expr.GetRightValue().GetPrimitive()
+		if pattern == nil {
+			pattern = expr.GetRightValue().GetScalar().GetPrimitive()
+		}
+		if expr.GetOperator() == flyte.ComparisonExpression_MATCHES && pattern != nil {
+			if _, err := regexp.Compile(pattern.GetStringValue()); err != nil {
+				errs.Collect(errors.NewSyntaxError(node.GetId(), "RightValue", err))
+			}
+		}
+	default:
+		if !areTypesComparable(op1Type, op2Type) {
+			errs.Collect(errors.NewMismatchingTypesErr(node.GetId(), "RightValue",
+				c.LiteralTypeToStr(op1Type), c.LiteralTypeToStr(op2Type)))
+		}
+	}
+
+	return !errs.HasErrors()
+}
+
 func ValidateBooleanExpression(w c.WorkflowBuilder, node c.NodeBuilder, expr *flyte.BooleanExpression, requireParamType bool, errs errors.CompileErrors) (ok bool) {
 	if expr == nil {
 		errs.Collect(errors.NewBranchNodeHasNoCondition(node.GetId()))
 	} else {
 		if expr.GetComparison() != nil {
-			op1Type, op1Valid := validateOperand(node, "RightValue",
-				expr.GetComparison().GetRightValue(), requireParamType, errs.NewScope())
-			op2Type, op2Valid := validateOperand(node, "LeftValue",
-				expr.GetComparison().GetLeftValue(), requireParamType, errs.NewScope())
-			if op1Valid && op2Valid && op1Type != nil && op2Type != nil {
-				if op1Type.String() != op2Type.String() {
-					errs.Collect(errors.NewMismatchingTypesErr(node.GetId(), "RightValue",
-						c.LiteralTypeToStr(op1Type), c.LiteralTypeToStr(op2Type)))
-				}
-			}
+			validateComparison(node, expr.GetComparison(), requireParamType, errs.NewScope())
+		} else if expr.GetNegation() != nil {
+			ValidateBooleanExpression(w, node, expr.GetNegation().GetExpression(), requireParamType, errs.NewScope())
 		} else if expr.GetConjunction() != nil {
 			ValidateBooleanExpression(w, node, expr.GetConjunction().GetLeftExpression(), requireParamType, errs.NewScope())
 			ValidateBooleanExpression(w, node, expr.GetConjunction().GetRightExpression(), requireParamType, errs.NewScope())
@@ -0,0 +1,100 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common/mocks"
+	compilerErrors "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/errors"
+)
+
+func TestValidateBooleanExpression(t *testing.T) {
+	varOperand := func(name string, attrPath ...*core.PromiseAttribute) *core.Operand {
+		return &core.Operand{Val: &core.Operand_Var{Var: name}, AttrPath: attrPath}
+	}
+	primitiveOperand := func(v interface{}) *core.Operand {
+		return &core.Operand{Val: &core.Operand_Primitive{Primitive: coreutils.MustMakePrimitive(v)}}
+	}
+	collectionOperand := func(v []interface{}) *core.Operand {
+		return &core.Operand{Val: &core.Operand_Collection{Collection: coreutils.MustMakeLiteral(v).GetCollection()}}
+	}
+	noneOperand := &core.Operand{Val: &core.Operand_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_NoneType{NoneType: &core.Void{}}}}}
+	comparison := func(left *core.Operand, op core.ComparisonExpression_Operator, right *core.Operand) *core.BooleanExpression {
+		return &core.BooleanExpression{
+			Expr: &core.BooleanExpression_Comparison{
+				Comparison: &core.ComparisonExpression{LeftValue: left, Operator: op, RightValue: right},
+			},
+		}
+	}
+
+	n := &mocks.NodeBuilder{}
+	n.OnGetId().Return("n1")
+	n.OnGetInterface().Return(&core.TypedInterface{
+		Inputs: &core.VariableMap{
+			Variables: map[string]*core.Variable{
+				"s":    {Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}}},
+				"i":    {Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}},
+				"ints": {Type: &core.LiteralType{Type: &core.LiteralType_CollectionType{CollectionType: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}}}},
+				"os": {Type: &core.LiteralType{Type: &core.LiteralType_UnionType{UnionType: &core.UnionType{Variants: []*core.LiteralType{
+					{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}},
+					{Type: &core.LiteralType_Simple{Simple: core.SimpleType_NONE}},
+				}}}}},
+				"oints": {Type: &core.LiteralType{Type: &core.LiteralType_UnionType{UnionType: &core.UnionType{Variants: []*core.LiteralType{
+					{Type: &core.LiteralType_CollectionType{CollectionType: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}}},
+					{Type: &core.LiteralType_Simple{Simple: core.SimpleType_NONE}},
+				}}}}},
+				"m": {Type: &core.LiteralType{Type: &core.LiteralType_MapValueType{MapValueType: &core.LiteralType{
+					Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}}}}},
+			},
+		},
+	})
+
+	for _, tc := range []struct {
+		name         string
+		expr         *core.BooleanExpression
+		expectedCode compilerErrors.ErrorCode
+	}{
+		{"In collection", comparison(varOperand("i"), core.ComparisonExpression_IN, collectionOperand([]interface{}{1, 2})), ""},
+		{"In collection variable", comparison(primitiveOperand(1), core.ComparisonExpression_NOT_IN, varOperand("ints")), ""},
+		{"In mismatching collection", comparison(varOperand("s"), core.ComparisonExpression_IN, collectionOperand([]interface{}{1, 2})), compilerErrors.MismatchingTypes},
+		{"In non collection", comparison(varOperand("i"), core.ComparisonExpression_IN, primitiveOperand(1)), compilerErrors.MismatchingTypes},
+		{"Starts with", comparison(varOperand("s"), core.ComparisonExpression_STARTS_WITH, primitiveOperand("a")), ""},
+		{"Ends with map value", comparison(varOperand("m", &core.PromiseAttribute{Value: &core.PromiseAttribute_StringValue{StringValue: "k"}}),
+			core.ComparisonExpression_ENDS_WITH, primitiveOperand("a")), ""},
+		{"Starts with non string", comparison(varOperand("i"), core.ComparisonExpression_STARTS_WITH, primitiveOperand("a")), compilerErrors.MismatchingTypes},
+		{"Matches", comparison(varOperand("s"), core.ComparisonExpression_MATCHES, primitiveOperand("^a.*$")), ""},
+		{"Matches invalid regex", comparison(varOperand("s"), core.ComparisonExpression_MATCHES, primitiveOperand("a(")), compilerErrors.SyntaxError},
+		{"Is null", comparison(varOperand("s"), core.ComparisonExpression_IS_NULL, nil), ""},
+		{"Is null with right value", comparison(varOperand("s"), core.ComparisonExpression_IS_NOT_NULL, primitiveOperand("a")), compilerErrors.InvalidValue},
+		{"Optional equals", comparison(varOperand("os"), core.ComparisonExpression_EQ, primitiveOperand("a")), ""},
+		{"Optional mismatching types", comparison(varOperand("os"), core.ComparisonExpression_EQ, primitiveOperand(1)), compilerErrors.MismatchingTypes},
+		{"Equals none", comparison(varOperand("i"), core.ComparisonExpression_NEQ, noneOperand), ""},
+		{"Optional starts with", comparison(varOperand("os"), core.ComparisonExpression_STARTS_WITH, primitiveOperand("a")), ""},
+		{"In optional collection", comparison(varOperand("i"), core.ComparisonExpression_IN, varOperand("oints")), ""},
+		{"Optional in collection", comparison(varOperand("os"), core.ComparisonExpression_NOT_IN, collectionOperand([]interface{}{"a", "b"})), ""},
+		{"Optional in mismatching collection", comparison(varOperand("os"), core.ComparisonExpression_IN, varOperand("oints")), compilerErrors.MismatchingTypes},
+		{"Mismatching types", comparison(varOperand("s"), core.ComparisonExpression_EQ, varOperand("i")), compilerErrors.MismatchingTypes},
+		{"Negation", &core.BooleanExpression{Expr: &core.BooleanExpression_Negation{Negation: &core.NegationExpression{
+			Expression: comparison(varOperand("i"), core.ComparisonExpression_GT, primitiveOperand(1)),
+		}}}, ""},
+		{"Negation without expression", &core.BooleanExpression{Expr: &core.BooleanExpression_Negation{Negation: &core.NegationExpression{}}},
+			compilerErrors.BranchNodeHasNoCondition},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := compilerErrors.NewCompileErrors()
+			ok := ValidateBooleanExpression(nil, n, tc.expr, true, errs)
+			if tc.expectedCode == "" {
+				assert.True(t, ok)
+				assert.False(t, errs.HasErrors())
+			} else {
+				assert.False(t, ok)
+				if assert.True(t, errs.HasErrors()) {
+					assert.Equal(t, tc.expectedCode, errs.Errors().List()[0].Code())
+				}
+			}
+		})
+	}
+}
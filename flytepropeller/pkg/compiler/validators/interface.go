@@ -8,6 +8,111 @@ import (
 	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/errors"
 )
 
+const defaultArrayNodeErrorsOutput = "errors"
+
+// ArrayNodeErrorsOutput returns the name of the output holding the errors of the sub-nodes of an ArrayNode producing
+// partial outputs.
+func ArrayNodeErrorsOutput(partialSuccess *core.ArrayNode_PartialSuccess) string {
+	if len(partialSuccess.GetErrorsOutput()) > 0 {
+		return partialSuccess.GetErrorsOutput()
+	}
+
+	return defaultArrayNodeErrorsOutput
+}
+
+func optionalType(literalType *core.LiteralType) *core.LiteralType {
+	if isOptionalType(literalType) {
+		return literalType
+	}
+
+	return &core.LiteralType{
+		Type: &core.LiteralType_UnionType{
+			UnionType: &core.UnionType{
+				Variants: []*core.LiteralType{
+					literalType,
+					{Type: &core.LiteralType_Simple{Simple: core.SimpleType_NONE}},
+				},
+			},
+		},
+	}
+}
+
+// partialSuccessInterface returns the interface of an ArrayNode producing partial outputs. Sub-nodes that failed
+// produce none, so all outputs are optional, and the errors of the sub-nodes are added as an output.
+func partialSuccessInterface(nodeID c.NodeID, iface *core.TypedInterface, partialSuccess *core.ArrayNode_PartialSuccess,
+	errs errors.CompileErrors) *core.TypedInterface {
+
+	errorsOutput := ArrayNodeErrorsOutput(partialSuccess)
+	outputs := make(map[string]*core.Variable, len(iface.GetOutputs().GetVariables())+1)
+	for name, variable := range iface.GetOutputs().GetVariables() {
+		if name == errorsOutput {
+			errs.Collect(errors.NewValueCollisionError(nodeID, "errors_output", errorsOutput))
+		}
+
+		outputs[name] = &core.Variable{
+			Type:        optionalType(variable.GetType()),
+			Description: variable.GetDescription(),
+		}
+	}
+
+	outputs[errorsOutput] = &core.Variable{
+		Type:        optionalType(&core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_ERROR}}),
+		Description: "Errors of the sub-nodes that failed, none for the sub-nodes that succeeded",
+	}
+
+	return &core.TypedInterface{
+		Inputs:  iface.GetInputs(),
+		Outputs: &core.VariableMap{Variables: outputs},
+	}
+}
+
+// validateGateInput validates the input of a gate node condition is bound and has the expected simple type.
+func validateGateInput(nodeID c.NodeID, inputs *core.VariableMap, paramName, variableName string, simpleType core.SimpleType,
+	errs errors.CompileErrors) {
+
+	if len(variableName) == 0 {
+		errs.Collect(errors.NewValueRequiredErr(nodeID, paramName))
+		return
+	}
+
+	variable, found := inputs.GetVariables()[variableName]
+	if !found {
+		errs.Collect(errors.NewVariableNameNotFoundErr(nodeID, nodeID, variableName))
+		return
+	}
+
+	expectedType := &core.LiteralType{Type: &core.LiteralType_Simple{Simple: simpleType}}
+	if !AreTypesCastable(variable.GetType(), expectedType) {
+		errs.Collect(errors.NewMismatchingTypesErr(nodeID, variableName, variable.GetType().String(), expectedType.String()))
+	}
+}
+
+// gateConditionOutputs returns the outputs of a gate node condition, which are either empty or the boolean output set
+// once the condition is met. The default output of the timeout needs the output to be set.
+func gateConditionOutputs(nodeID c.NodeID, paramName, outputVariableName string, timeout *core.GateTimeout,
+	errs errors.CompileErrors) *core.VariableMap {
+
+	outputs := &core.VariableMap{Variables: map[string]*core.Variable{}}
+	if len(outputVariableName) == 0 {
+		if timeout.GetDefaultOutput() != nil {
+			errs.Collect(errors.NewValueRequiredErr(nodeID, paramName))
+		}
+
+		return outputs
+	}
+
+	outputType := &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_BOOLEAN}}
+	if defaultOutput := timeout.GetDefaultOutput(); defaultOutput != nil {
+		defaultOutputType := LiteralTypeForLiteral(defaultOutput)
+		if !AreTypesCastable(defaultOutputType, outputType) {
+			errs.Collect(errors.NewMismatchingTypesErr(nodeID, outputVariableName, defaultOutputType.String(), outputType.String()))
+		}
+	}
+
+	outputs.Variables[outputVariableName] = &core.Variable{Type: outputType}
+	return outputs
+}
+
 // ValidateInterface validates interface has its required attributes set
 func ValidateInterface(nodeID c.NodeID, iface *core.TypedInterface, errs errors.CompileErrors) (
 	typedInterface *core.TypedInterface, ok bool) {
@@ -150,6 +255,44 @@ func ValidateUnderlyingInterface(w c.WorkflowBuilder, node c.NodeBuilder, errs e
 				Inputs:  &core.VariableMap{Variables: map[string]*core.Variable{}},
 				Outputs: &core.VariableMap{Variables: map[string]*core.Variable{}},
 			}
+		} else if waitUntil := gateNode.GetWaitUntil(); waitUntil != nil {
+			inputVarsFromBindings, _ := ValidateBindings(w, node, node.GetInputs(), &core.VariableMap{Variables: map[string]*core.Variable{}},
+				false, c.EdgeDirectionUpstream, errs.NewScope())
+
+			validateGateInput(node.GetId(), inputVarsFromBindings, "GateNode.WaitUntil.InputVariableName",
+				waitUntil.GetInputVariableName(), core.SimpleType_DATETIME, errs.NewScope())
+
+			iface = &core.TypedInterface{
+				Inputs: inputVarsFromBindings,
+				Outputs: gateConditionOutputs(node.GetId(), "GateNode.WaitUntil.OutputVariableName",
+					waitUntil.GetOutputVariableName(), waitUntil.GetTimeout(), errs.NewScope()),
+			}
+		} else if external := gateNode.GetExternal(); external != nil {
+			inputVarsFromBindings, _ := ValidateBindings(w, node, node.GetInputs(), &core.VariableMap{Variables: map[string]*core.Variable{}},
+				false, c.EdgeDirectionUpstream, errs.NewScope())
+
+			if storageObject := external.GetStorageObject(); storageObject != nil {
+				if len(storageObject.GetUri()) == 0 {
+					validateGateInput(node.GetId(), inputVarsFromBindings, "GateNode.External.StorageObject.Uri",
+						storageObject.GetUriInputVariableName(), core.SimpleType_STRING, errs.NewScope())
+				}
+			} else if catalogArtifact := external.GetCatalogArtifact(); catalogArtifact != nil {
+				if len(catalogArtifact.GetName()) == 0 {
+					errs.Collect(errors.NewValueRequiredErr(node.GetId(), "GateNode.External.CatalogArtifact.Name"))
+				}
+
+				if len(catalogArtifact.GetTag()) == 0 {
+					errs.Collect(errors.NewValueRequiredErr(node.GetId(), "GateNode.External.CatalogArtifact.Tag"))
+				}
+			} else {
+				errs.Collect(errors.NewNoConditionFound(node.GetId()))
+			}
+
+			iface = &core.TypedInterface{
+				Inputs: inputVarsFromBindings,
+				Outputs: gateConditionOutputs(node.GetId(), "GateNode.External.OutputVariableName",
+					external.GetOutputVariableName(), external.GetTimeout(), errs.NewScope()),
+			}
 		} else {
 			errs.Collect(errors.NewNoConditionFound(node.GetId()))
 		}
@@ -160,6 +303,9 @@ func ValidateUnderlyingInterface(w c.WorkflowBuilder, node c.NodeBuilder, errs e
 			// ArrayNode interface should be inferred from the underlying node interface. flytekit
 			// will correct wrap variables in collections as needed, leaving partials as is.
 			iface = underlyingIface
+			if partialSuccess := arrayNode.GetPartialSuccess(); partialSuccess != nil {
+				iface = partialSuccessInterface(node.GetId(), underlyingIface, partialSuccess, errs.NewScope())
+			}
 		}
 	default:
 		errs.Collect(errors.NewValueRequiredErr(node.GetId(), "Target"))
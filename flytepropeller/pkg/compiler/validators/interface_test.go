@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
@@ -372,6 +373,188 @@ func TestValidateUnderlyingInterface(t *testing.T) {
 			iface, ifaceOk := ValidateUnderlyingInterface(&wfBuilder, &nodeBuilder, errs.NewScope())
 			assertNonEmptyInterface(t, iface, ifaceOk, errs)
 		})
+
+		newGateNodeBuilder := func(gateNode *core.GateNode, inputs []*core.Binding) *mocks.NodeBuilder {
+			nodeBuilder := &mocks.NodeBuilder{}
+			nodeBuilder.On("GetCoreNode").Return(&core.Node{
+				Target: &core.Node_GateNode{
+					GateNode: gateNode,
+				},
+			})
+			nodeBuilder.OnGetInterface().Return(nil)
+			nodeBuilder.OnGetInputs().Return(inputs)
+
+			nodeBuilder.On("GetGateNode").Return(gateNode)
+			nodeBuilder.On("GetId").Return("node_1")
+			nodeBuilder.On("SetInterface", mock.Anything).Return()
+			return nodeBuilder
+		}
+
+		staticBinding := func(name string, value interface{}) *core.Binding {
+			return &core.Binding{
+				Var: name,
+				Binding: &core.BindingData{
+					Value: &core.BindingData_Scalar{Scalar: coreutils.MustMakeLiteral(value).GetScalar()},
+				},
+			}
+		}
+
+		t.Run("WaitUntil", func(t *testing.T) {
+			gateNode := &core.GateNode{
+				Condition: &core.GateNode_WaitUntil{
+					WaitUntil: &core.WaitUntilCondition{
+						InputVariableName:  "until",
+						OutputVariableName: "reached",
+						Timeout: &core.GateTimeout{
+							Duration:      durationpb.New(time.Hour),
+							DefaultOutput: coreutils.MustMakeLiteral(false),
+						},
+					},
+				},
+			}
+
+			wfBuilder := mocks.WorkflowBuilder{}
+			nodeBuilder := newGateNodeBuilder(gateNode, []*core.Binding{staticBinding("until", time.Now())})
+
+			errs := errors.NewCompileErrors()
+			iface, ifaceOk := ValidateUnderlyingInterface(&wfBuilder, nodeBuilder, errs.NewScope())
+			assertNonEmptyInterface(t, iface, ifaceOk, errs)
+			assert.Contains(t, iface.GetInputs().GetVariables(), "until")
+			assert.Equal(t, core.SimpleType_BOOLEAN, iface.GetOutputs().GetVariables()["reached"].GetType().GetSimple())
+		})
+
+		t.Run("WaitUntilMismatchingInputType", func(t *testing.T) {
+			gateNode := &core.GateNode{
+				Condition: &core.GateNode_WaitUntil{
+					WaitUntil: &core.WaitUntilCondition{
+						InputVariableName: "until",
+					},
+				},
+			}
+
+			wfBuilder := mocks.WorkflowBuilder{}
+			nodeBuilder := newGateNodeBuilder(gateNode, []*core.Binding{staticBinding("until", "tomorrow")})
+
+			errs := errors.NewCompileErrors()
+			_, ifaceOk := ValidateUnderlyingInterface(&wfBuilder, nodeBuilder, errs.NewScope())
+			assert.False(t, ifaceOk)
+			assert.Equal(t, errors.MismatchingTypes, errs.Errors().List()[0].Code())
+		})
+
+		t.Run("WaitUntilMissingInput", func(t *testing.T) {
+			gateNode := &core.GateNode{
+				Condition: &core.GateNode_WaitUntil{
+					WaitUntil: &core.WaitUntilCondition{
+						InputVariableName: "until",
+					},
+				},
+			}
+
+			wfBuilder := mocks.WorkflowBuilder{}
+			nodeBuilder := newGateNodeBuilder(gateNode, nil)
+
+			errs := errors.NewCompileErrors()
+			_, ifaceOk := ValidateUnderlyingInterface(&wfBuilder, nodeBuilder, errs.NewScope())
+			assert.False(t, ifaceOk)
+			assert.Equal(t, errors.VariableNameNotFound, errs.Errors().List()[0].Code())
+		})
+
+		t.Run("ExternalStorageObject", func(t *testing.T) {
+			gateNode := &core.GateNode{
+				Condition: &core.GateNode_External{
+					External: &core.ExternalCondition{
+						Condition: &core.ExternalCondition_StorageObject{
+							StorageObject: &core.StorageObjectCondition{
+								UriSource: &core.StorageObjectCondition_UriInputVariableName{UriInputVariableName: "uri"},
+							},
+						},
+					},
+				},
+			}
+
+			wfBuilder := mocks.WorkflowBuilder{}
+			nodeBuilder := newGateNodeBuilder(gateNode, []*core.Binding{staticBinding("uri", "s3://bucket/key")})
+
+			errs := errors.NewCompileErrors()
+			iface, ifaceOk := ValidateUnderlyingInterface(&wfBuilder, nodeBuilder, errs.NewScope())
+			assertNonEmptyInterface(t, iface, ifaceOk, errs)
+			assert.Empty(t, iface.GetOutputs().GetVariables())
+		})
+
+		t.Run("ExternalCatalogArtifactMissingTag", func(t *testing.T) {
+			gateNode := &core.GateNode{
+				Condition: &core.GateNode_External{
+					External: &core.ExternalCondition{
+						Condition: &core.ExternalCondition_CatalogArtifact{
+							CatalogArtifact: &core.CatalogArtifactCondition{
+								Name: "dataset",
+							},
+						},
+					},
+				},
+			}
+
+			wfBuilder := mocks.WorkflowBuilder{}
+			nodeBuilder := newGateNodeBuilder(gateNode, nil)
+
+			errs := errors.NewCompileErrors()
+			_, ifaceOk := ValidateUnderlyingInterface(&wfBuilder, nodeBuilder, errs.NewScope())
+			assert.False(t, ifaceOk)
+			assert.Equal(t, errors.ValueRequired, errs.Errors().List()[0].Code())
+		})
+
+		t.Run("ExternalDefaultOutputWithoutOutput", func(t *testing.T) {
+			gateNode := &core.GateNode{
+				Condition: &core.GateNode_External{
+					External: &core.ExternalCondition{
+						Condition: &core.ExternalCondition_StorageObject{
+							StorageObject: &core.StorageObjectCondition{
+								UriSource: &core.StorageObjectCondition_Uri{Uri: "s3://bucket/key"},
+							},
+						},
+						Timeout: &core.GateTimeout{
+							Duration:      durationpb.New(time.Hour),
+							DefaultOutput: coreutils.MustMakeLiteral(false),
+						},
+					},
+				},
+			}
+
+			wfBuilder := mocks.WorkflowBuilder{}
+			nodeBuilder := newGateNodeBuilder(gateNode, nil)
+
+			errs := errors.NewCompileErrors()
+			_, ifaceOk := ValidateUnderlyingInterface(&wfBuilder, nodeBuilder, errs.NewScope())
+			assert.False(t, ifaceOk)
+			assert.Equal(t, errors.ValueRequired, errs.Errors().List()[0].Code())
+		})
+
+		t.Run("ExternalMismatchingDefaultOutput", func(t *testing.T) {
+			gateNode := &core.GateNode{
+				Condition: &core.GateNode_External{
+					External: &core.ExternalCondition{
+						Condition: &core.ExternalCondition_StorageObject{
+							StorageObject: &core.StorageObjectCondition{
+								UriSource: &core.StorageObjectCondition_Uri{Uri: "s3://bucket/key"},
+							},
+						},
+						OutputVariableName: "exists",
+						Timeout: &core.GateTimeout{
+							Duration:      durationpb.New(time.Hour),
+							DefaultOutput: coreutils.MustMakeLiteral("no"),
+						},
+					},
+				},
+			}
+
+			wfBuilder := mocks.WorkflowBuilder{}
+			nodeBuilder := newGateNodeBuilder(gateNode, nil)
+
+			errs := errors.NewCompileErrors()
+			_, ifaceOk := ValidateUnderlyingInterface(&wfBuilder, nodeBuilder, errs.NewScope())
+			assert.False(t, ifaceOk)
+			assert.Equal(t, errors.MismatchingTypes, errs.Errors().List()[0].Code())
+		})
 	})
 
 	t.Run("ArrayNode", func(t *testing.T) {
@@ -459,6 +642,38 @@ func TestValidateUnderlyingInterface(t *testing.T) {
 	})
 }
 
+func TestPartialSuccessInterface(t *testing.T) {
+	floatType := &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_FLOAT}}
+	iface := &core.TypedInterface{
+		Outputs: &core.VariableMap{
+			Variables: map[string]*core.Variable{
+				"bar": {Type: floatType},
+				"baz": {Type: optionalType(floatType)},
+			},
+		},
+	}
+
+	t.Run("Default errors output", func(t *testing.T) {
+		errs := errors.NewCompileErrors()
+		partialIface := partialSuccessInterface("node_1", iface, &core.ArrayNode_PartialSuccess{}, errs.NewScope())
+		assert.False(t, errs.HasErrors())
+
+		outputs := partialIface.GetOutputs().GetVariables()
+		assert.Len(t, outputs, 3)
+		assert.True(t, proto.Equal(optionalType(floatType), outputs["bar"].GetType()))
+		assert.True(t, proto.Equal(optionalType(floatType), outputs["baz"].GetType()))
+		assert.True(t, isOptionalType(outputs["errors"].GetType()))
+		assert.Equal(t, core.SimpleType_ERROR, outputs["errors"].GetType().GetUnionType().GetVariants()[0].GetSimple())
+	})
+
+	t.Run("Colliding errors output", func(t *testing.T) {
+		errs := errors.NewCompileErrors()
+		partialSuccessInterface("node_1", iface, &core.ArrayNode_PartialSuccess{ErrorsOutput: "bar"}, errs.NewScope())
+		assert.True(t, errs.HasErrors())
+		assert.Equal(t, 1, errs.ErrorCount())
+	})
+}
+
 func matchIdentifier(id *core.Identifier) interface{} {
 	return mock.MatchedBy(func(arg *core.Identifier) bool {
 		return arg.String() == id.String()
@@ -15,6 +15,8 @@ const (
 	workflowTerminatedValue      = "terminated"
 	hourOfDayCompletedKey        = "hour-of-day"
 	completedTimeKey             = "completed-time"
+	// The phase workflows completed in, which retention policies select workflows by.
+	completedPhaseKey = "completed-phase"
 	// Layout string for time.Format() function expects the time ("Mon, 02 Jan 2006 15:04:05 MST") formatted
 	// in the desired layout.
 	labelTimeFormat = "2006-01-02.15"
@@ -49,6 +51,7 @@ func SetCompletedLabel(w *v1alpha1.FlyteWorkflow, currentTime time.Time) {
 	}
 	w.Labels[workflowTerminationStatusKey] = workflowTerminatedValue
 	w.Labels[completedTimeKey] = FormatTimeForLabel(currentTime)
+	w.Labels[completedPhaseKey] = w.Status.Phase.String()
 }
 
 func HasCompletedLabel(w *v1alpha1.FlyteWorkflow) bool {
@@ -71,13 +71,14 @@ func TestSetCompletedLabel(t *testing.T) {
 	n := time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)
 	t.Run("no-labels", func(t *testing.T) {
 
-		w := &v1alpha1.FlyteWorkflow{}
+		w := &v1alpha1.FlyteWorkflow{Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowPhaseFailed}}
 		assert.Empty(t, w.Labels)
 		SetCompletedLabel(w, n)
 		assert.NotEmpty(t, w.Labels)
 		v, ok := w.Labels[workflowTerminationStatusKey]
 		assert.True(t, ok)
 		assert.Equal(t, workflowTerminatedValue, v)
+		assert.Equal(t, "Failed", w.Labels[completedPhaseKey])
 	})
 
 	t.Run("existing-lables", func(t *testing.T) {
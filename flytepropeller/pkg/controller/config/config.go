@@ -16,6 +16,15 @@
 //	   enable-admin-launcher: true
 //	   max-ttl-hours: 1
 //	   gc-interval: 500m
+//	   gc-config:
+//	     batch-size: 500
+//	     delete-rate: 20
+//	     retention-policies:
+//	       # Keep failed runs of the production domain for a week
+//	       - name: production-failures
+//	         domains: [production]
+//	         phases: [Failed, Aborted]
+//	         ttl-hours: 168
 //	   queue:
 //	     type: batch
 //	     queue:
@@ -67,6 +76,10 @@ var (
 		GCInterval: config.Duration{
 			Duration: 30 * time.Minute,
 		},
+		GC: GCConfig{
+			BatchSize:  500,
+			DeleteRate: 20,
+		},
 		MaxDatasetSizeBytes: -1,
 		Queue: CompositeQueueConfig{
 			Type: CompositeQueueBatch,
@@ -100,10 +113,15 @@ var (
 		},
 		NodeConfig: NodeConfig{
 			MaxNodeRetriesOnSystemFailures: 3,
+			MaxNodeRetriesOnPreemptions:    5,
 			InterruptibleFailureThreshold:  -1,
 			DefaultMaxAttempts:             1,
 			IgnoreRetryCause:               false,
 			EnableCRDebugMetadata:          false,
+			OOMRetryPolicy: OOMRetryPolicy{
+				Enabled:          false,
+				MemoryMultiplier: 2,
+			},
 		},
 		MaxStreakLength: 8, // Turbo mode is enabled by default
 		ProfilerPort: config.Port{
@@ -124,6 +142,15 @@ var (
 		ArrayNode: ArrayNodeConfig{
 			EventVersion:               0,
 			DefaultParallelismBehavior: ParallelismBehaviorUnlimited,
+			AdaptiveParallelism: AdaptiveParallelismConfig{
+				Enabled:         false,
+				MinParallelism:  1,
+				MaxParallelism:  1000,
+				IncreaseStep:    10,
+				DecreaseFactor:  0.5,
+				MaxPendingRatio: 0.5,
+				MaxFailureRatio: 0.5,
+			},
 		},
 		LiteralOffloadingConfig: LiteralOffloadingConfig{
 			Enabled: false, // Default keep this disabled and we will followup when flytekit is released with the offloaded changes.
@@ -160,6 +187,7 @@ type Config struct {
 	MaxWorkflowRetries       int                     `json:"max-workflow-retries" pflag:"Maximum number of retries per workflow"`
 	MaxTTLInHours            int                     `json:"max-ttl-hours" pflag:"Maximum number of hours a completed workflow should be retained. Number between 1-23 hours"`
 	GCInterval               config.Duration         `json:"gc-interval" pflag:"Run periodic GC every 30 minutes"`
+	GC                       GCConfig                `json:"gc-config,omitempty" pflag:",Configuration for the garbage collection of completed workflows"`
 	LeaderElection           LeaderElectionConfig    `json:"leader-election,omitempty" pflag:",Config for leader election."`
 	PublishK8sEvents         bool                    `json:"publish-k8s-events" pflag:",Enable events publishing to K8s events API."`
 	MaxDatasetSizeBytes      int64                   `json:"max-output-size-bytes" pflag:",Deprecated! Use storage.limits.maxDownloadMBs instead"`
@@ -178,6 +206,7 @@ type Config struct {
 	CreateFlyteWorkflowCRD   bool                    `json:"create-flyteworkflow-crd" pflag:",Enable creation of the FlyteWorkflow CRD on startup"`
 	NodeExecutionWorkerCount int                     `json:"node-execution-worker-count" pflag:",Number of workers to evaluate node executions, currently only used for array nodes"`
 	ArrayNode                ArrayNodeConfig         `json:"array-node-config,omitempty" pflag:",Configuration for array nodes"`
+	GateNode                 GateNodeConfig          `json:"gate-node-config,omitempty" pflag:",Configuration for gate nodes"`
 	LiteralOffloadingConfig  LiteralOffloadingConfig `json:"literal-offloading-config" pflag:",config used for literal offloading."`
 }
 
@@ -276,10 +305,19 @@ type WorkqueueConfig struct {
 type NodeConfig struct {
 	DefaultDeadlines               DefaultDeadlines `json:"default-deadlines,omitempty" pflag:",Default value for timeouts"`
 	MaxNodeRetriesOnSystemFailures int64            `json:"max-node-retries-system-failures" pflag:"2,Maximum number of retries per node for node failure due to infra issues"`
+	MaxNodeRetriesOnPreemptions    int64            `json:"max-node-retries-preemptions" pflag:"5,Maximum number of retries per node for preempted interruptible tasks. Further preemptions count as failures due to infra issues"`
 	InterruptibleFailureThreshold  int32            `json:"interruptible-failure-threshold" pflag:"1,number of failures for a node to be still considered interruptible. Negative numbers are treated as complementary (ex. -1 means last attempt is non-interruptible).'"`
 	DefaultMaxAttempts             int32            `json:"default-max-attempts" pflag:"3,Default maximum number of attempts for a node"`
 	IgnoreRetryCause               bool             `json:"ignore-retry-cause" pflag:",Ignore retry cause and count all attempts toward a node's max attempts"`
 	EnableCRDebugMetadata          bool             `json:"enable-cr-debug-metadata" pflag:",Collapse node on any terminal state, not just successful terminations. This is useful to reduce the size of workflow state in etcd."`
+	OOMRetryPolicy                 OOMRetryPolicy   `json:"oom-retry-policy,omitempty" pflag:",Policy escalating the memory of tasks retried after being OOMKilled"`
+}
+
+// OOMRetryPolicy escalates the memory of tasks retried after being OOMKilled, instead of retrying them with the same
+// memory they ran out of.
+type OOMRetryPolicy struct {
+	Enabled          bool    `json:"enabled" pflag:",Enables escalating the memory of tasks retried after being OOMKilled."`
+	MemoryMultiplier float64 `json:"memory-multiplier" pflag:",Factor the memory requests and limits of a task are multiplied by for each of its attempts that was OOMKilled. The escalated memory is capped by the platform limits."`
 }
 
 // DefaultDeadlines contains default values for timeouts
@@ -346,9 +384,56 @@ const (
 )
 
 type ArrayNodeConfig struct {
-	EventVersion               int                 `json:"event-version" pflag:",ArrayNode eventing version. 0 => legacy (drop-in replacement for maptask), 1 => new"`
-	DefaultParallelismBehavior ParallelismBehavior `json:"default-parallelism-behavior" pflag:",Default parallelism behavior for array nodes"`
-	UseMapPluginLogs           bool                `json:"use-map-plugin-logs" pflag:",Override subNode log links with those configured for the map plugin logs"`
+	EventVersion               int                       `json:"event-version" pflag:",ArrayNode eventing version. 0 => legacy (drop-in replacement for maptask), 1 => new"`
+	DefaultParallelismBehavior ParallelismBehavior       `json:"default-parallelism-behavior" pflag:",Default parallelism behavior for array nodes"`
+	UseMapPluginLogs           bool                      `json:"use-map-plugin-logs" pflag:",Override subNode log links with those configured for the map plugin logs"`
+	AdaptiveParallelism        AdaptiveParallelismConfig `json:"adaptive-parallelism" pflag:",Adjusts the parallelism of array nodes to the feedback of the cluster"`
+}
+
+// GateNodeConfig configures gate nodes. The storage object conditions of gate nodes may only poll objects under the raw
+// output prefix of the execution or under one of the AllowedStoragePrefixes.
+type GateNodeConfig struct {
+	AllowedStoragePrefixes []string `json:"allowed-storage-prefixes" pflag:",Storage prefixes besides the raw output prefix of the execution under which gate nodes may poll for objects"`
+}
+
+// AdaptiveParallelismConfig configures how the number of concurrently evaluated subNodes of an ArrayNode is adjusted
+// after every round. It applies to ArrayNodes opting into adaptive parallelism. The parallelism is raised by
+// IncreaseStep while all allowed subNodes are in use and scheduled quickly, held while subNodes wait for resources or
+// too many subNodes are pending and multiplied by DecreaseFactor when launching subNodes exceeds a resource quota or
+// subNodes fail too often. It never exceeds the parallelism of the ArrayNode or the remaining workflow parallelism.
+type AdaptiveParallelismConfig struct {
+	Enabled         bool    `json:"enabled" pflag:",Enables adaptive parallelism for array nodes opting into it"`
+	MinParallelism  int     `json:"min-parallelism" pflag:",Parallelism array nodes start with and never go below"`
+	MaxParallelism  int     `json:"max-parallelism" pflag:",Parallelism array nodes never go above"`
+	IncreaseStep    int     `json:"increase-step" pflag:",Number of subNodes the parallelism is raised by per round"`
+	DecreaseFactor  float64 `json:"decrease-factor" pflag:",Factor the parallelism is multiplied by when backing off"`
+	MaxPendingRatio float64 `json:"max-pending-ratio" pflag:",Ratio of evaluated subNodes waiting to be scheduled above which the parallelism is not raised"`
+	MaxFailureRatio float64 `json:"max-failure-ratio" pflag:",Ratio of subNodes completing in a round that failed above which the parallelism is lowered"`
+}
+
+// GCConfig configures how completed workflows are garbage collected.
+type GCConfig struct {
+	// RetentionPolicies are evaluated in order and the first policy matching a workflow determines how long it's
+	// retained. Workflows that don't match any policy are retained for MaxTTLInHours. Without retention policies, the
+	// completed workflows outside of MaxTTLInHours are deleted by label selector, unaffected by BatchSize and DeleteRate,
+	// unless running dry.
+	RetentionPolicies []GCRetentionPolicy `json:"retention-policies" pflag:"-,Retention policies of completed workflows"`
+	BatchSize         int                 `json:"batch-size" pflag:",Number of completed workflows listed and deleted per batch"`
+	DeleteRate        int                 `json:"delete-rate" pflag:",Maximum number of workflows deleted per second. 0 disables rate limiting"`
+	DryRun            bool                `json:"dry-run" pflag:",Logs and counts the workflows outside of their retention period without deleting them"`
+}
+
+// GCRetentionPolicy retains the completed workflows matching all of its selectors for TTLInHours. Empty selectors match
+// all workflows.
+type GCRetentionPolicy struct {
+	Name     string   `json:"name"`
+	Projects []string `json:"projects"`
+	Domains  []string `json:"domains"`
+	// Phases the workflow completed in, e.g. Succeeded, Failed or Aborted. Workflows completed by older versions of
+	// propeller, which didn't label the phase, don't match policies with phases.
+	Phases      []string          `json:"phases"`
+	MatchLabels map[string]string `json:"match-labels"`
+	TTLInHours  int               `json:"ttl-hours"`
 }
 
 // GetConfig extracts the Configuration from the global config module in flytestdlib and returns the corresponding type-casted object.
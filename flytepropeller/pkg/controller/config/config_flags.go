@@ -78,6 +78,9 @@ func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "max-workflow-retries"), defaultConfig.MaxWorkflowRetries, "")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "max-ttl-hours"), defaultConfig.MaxTTLInHours, "")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "gc-interval"), defaultConfig.GCInterval.String(), "")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "gc-config.batch-size"), defaultConfig.GC.BatchSize, "Number of completed workflows listed and deleted per batch")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "gc-config.delete-rate"), defaultConfig.GC.DeleteRate, "Maximum number of workflows deleted per second. 0 disables rate limiting")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "gc-config.dry-run"), defaultConfig.GC.DryRun, "Logs and counts the workflows outside of their retention period without deleting them")
 	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "leader-election.enabled"), defaultConfig.LeaderElection.Enabled, "Enables/Disables leader election.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "leader-election.lock-config-map.Namespace"), defaultConfig.LeaderElection.LockConfigMap.Namespace, "")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "leader-election.lock-config-map.Name"), defaultConfig.LeaderElection.LockConfigMap.Name, "")
@@ -93,10 +96,13 @@ func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "node-config.default-deadlines.node-active-deadline"), defaultConfig.NodeConfig.DefaultDeadlines.DefaultNodeActiveDeadline.String(), "Default value of node timeout that includes the time spent queued.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "node-config.default-deadlines.workflow-active-deadline"), defaultConfig.NodeConfig.DefaultDeadlines.DefaultWorkflowActiveDeadline.String(), "Default value of workflow timeout that includes the time spent queued.")
 	cmdFlags.Int64(fmt.Sprintf("%v%v", prefix, "node-config.max-node-retries-system-failures"), defaultConfig.NodeConfig.MaxNodeRetriesOnSystemFailures, "Maximum number of retries per node for node failure due to infra issues")
+	cmdFlags.Int64(fmt.Sprintf("%v%v", prefix, "node-config.max-node-retries-preemptions"), defaultConfig.NodeConfig.MaxNodeRetriesOnPreemptions, "Maximum number of retries per node for preempted interruptible tasks. Further preemptions count as failures due to infra issues")
 	cmdFlags.Int32(fmt.Sprintf("%v%v", prefix, "node-config.interruptible-failure-threshold"), defaultConfig.NodeConfig.InterruptibleFailureThreshold, "number of failures for a node to be still considered interruptible. Negative numbers are treated as complementary (ex. -1 means last attempt is non-interruptible).'")
 	cmdFlags.Int32(fmt.Sprintf("%v%v", prefix, "node-config.default-max-attempts"), defaultConfig.NodeConfig.DefaultMaxAttempts, "Default maximum number of attempts for a node")
 	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "node-config.ignore-retry-cause"), defaultConfig.NodeConfig.IgnoreRetryCause, "Ignore retry cause and count all attempts toward a node's max attempts")
 	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "node-config.enable-cr-debug-metadata"), defaultConfig.NodeConfig.EnableCRDebugMetadata, "Collapse node on any terminal state,  not just successful terminations. This is useful to reduce the size of workflow state in etcd.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "node-config.oom-retry-policy.enabled"), defaultConfig.NodeConfig.OOMRetryPolicy.Enabled, "Enables escalating the memory of tasks retried after being OOMKilled.")
+	cmdFlags.Float64(fmt.Sprintf("%v%v", prefix, "node-config.oom-retry-policy.memory-multiplier"), defaultConfig.NodeConfig.OOMRetryPolicy.MemoryMultiplier, "Factor the memory requests and limits of a task are multiplied by for each of its attempts that was OOMKilled. The escalated memory is capped by the platform limits.")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "max-streak-length"), defaultConfig.MaxStreakLength, "Maximum number of consecutive rounds that one propeller worker can use for one workflow - >1 => turbo-mode is enabled.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "event-config.raw-output-policy"), defaultConfig.EventConfig.RawOutputPolicy, "How output data should be passed along in execution events.")
 	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "event-config.fallback-to-output-reference"), defaultConfig.EventConfig.FallbackToOutputReference, "Whether output data should be sent by reference when it is too large to be sent inline in execution events.")
@@ -113,6 +119,14 @@ func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "array-node-config.event-version"), defaultConfig.ArrayNode.EventVersion, "ArrayNode eventing version. 0 => legacy (drop-in replacement for maptask),  1 => new")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "array-node-config.default-parallelism-behavior"), defaultConfig.ArrayNode.DefaultParallelismBehavior, "Default parallelism behavior for array nodes")
 	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "array-node-config.use-map-plugin-logs"), defaultConfig.ArrayNode.UseMapPluginLogs, "Override subNode log links with those configured for the map plugin logs")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "array-node-config.adaptive-parallelism.enabled"), defaultConfig.ArrayNode.AdaptiveParallelism.Enabled, "Enables adaptive parallelism for array nodes opting into it")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "array-node-config.adaptive-parallelism.min-parallelism"), defaultConfig.ArrayNode.AdaptiveParallelism.MinParallelism, "Parallelism array nodes start with and never go below")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "array-node-config.adaptive-parallelism.max-parallelism"), defaultConfig.ArrayNode.AdaptiveParallelism.MaxParallelism, "Parallelism array nodes never go above")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "array-node-config.adaptive-parallelism.increase-step"), defaultConfig.ArrayNode.AdaptiveParallelism.IncreaseStep, "Number of subNodes the parallelism is raised by per round")
+	cmdFlags.Float64(fmt.Sprintf("%v%v", prefix, "array-node-config.adaptive-parallelism.decrease-factor"), defaultConfig.ArrayNode.AdaptiveParallelism.DecreaseFactor, "Factor the parallelism is multiplied by when backing off")
+	cmdFlags.Float64(fmt.Sprintf("%v%v", prefix, "array-node-config.adaptive-parallelism.max-pending-ratio"), defaultConfig.ArrayNode.AdaptiveParallelism.MaxPendingRatio, "Ratio of evaluated subNodes waiting to be scheduled above which the parallelism is not raised")
+	cmdFlags.Float64(fmt.Sprintf("%v%v", prefix, "array-node-config.adaptive-parallelism.max-failure-ratio"), defaultConfig.ArrayNode.AdaptiveParallelism.MaxFailureRatio, "Ratio of subNodes completing in a round that failed above which the parallelism is lowered")
+	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "gate-node-config.allowed-storage-prefixes"), defaultConfig.GateNode.AllowedStoragePrefixes, "Storage prefixes besides the raw output prefix of the execution under which gate nodes may poll for objects")
 	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "literal-offloading-config.Enabled"), defaultConfig.LiteralOffloadingConfig.Enabled, "")
 	cmdFlags.StringToString(fmt.Sprintf("%v%v", prefix, "literal-offloading-config.supported-sdk-versions"), defaultConfig.LiteralOffloadingConfig.SupportedSDKVersions, "Maps flytekit and union SDK names to minimum supported version that can handle reading offloaded literals.")
 	cmdFlags.Int64(fmt.Sprintf("%v%v", prefix, "literal-offloading-config.min-size-in-mb-for-offloading"), defaultConfig.LiteralOffloadingConfig.MinSizeInMBForOffloading, "Size of a literal at which to trigger offloading")
@@ -491,6 +491,48 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_gc-config.batch-size", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("gc-config.batch-size", testValue)
+			if vInt, err := cmdFlags.GetInt("gc-config.batch-size"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt), &actual.GC.BatchSize)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_gc-config.delete-rate", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("gc-config.delete-rate", testValue)
+			if vInt, err := cmdFlags.GetInt("gc-config.delete-rate"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt), &actual.GC.DeleteRate)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_gc-config.dry-run", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("gc-config.dry-run", testValue)
+			if vBool, err := cmdFlags.GetBool("gc-config.dry-run"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.GC.DryRun)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 	t.Run("Test_leader-election.enabled", func(t *testing.T) {
 
 		t.Run("Override", func(t *testing.T) {
@@ -701,6 +743,20 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_node-config.max-node-retries-preemptions", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("node-config.max-node-retries-preemptions", testValue)
+			if vInt64, err := cmdFlags.GetInt64("node-config.max-node-retries-preemptions"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt64), &actual.NodeConfig.MaxNodeRetriesOnPreemptions)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 	t.Run("Test_node-config.interruptible-failure-threshold", func(t *testing.T) {
 
 		t.Run("Override", func(t *testing.T) {
@@ -757,6 +813,34 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_node-config.oom-retry-policy.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("node-config.oom-retry-policy.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("node-config.oom-retry-policy.enabled"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.NodeConfig.OOMRetryPolicy.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_node-config.oom-retry-policy.memory-multiplier", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("node-config.oom-retry-policy.memory-multiplier", testValue)
+			if vFloat64, err := cmdFlags.GetFloat64("node-config.oom-retry-policy.memory-multiplier"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vFloat64), &actual.NodeConfig.OOMRetryPolicy.MemoryMultiplier)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 	t.Run("Test_max-streak-length", func(t *testing.T) {
 
 		t.Run("Override", func(t *testing.T) {
@@ -981,6 +1065,118 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_array-node-config.adaptive-parallelism.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("array-node-config.adaptive-parallelism.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("array-node-config.adaptive-parallelism.enabled"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.ArrayNode.AdaptiveParallelism.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_array-node-config.adaptive-parallelism.min-parallelism", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("array-node-config.adaptive-parallelism.min-parallelism", testValue)
+			if vInt, err := cmdFlags.GetInt("array-node-config.adaptive-parallelism.min-parallelism"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt), &actual.ArrayNode.AdaptiveParallelism.MinParallelism)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_array-node-config.adaptive-parallelism.max-parallelism", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("array-node-config.adaptive-parallelism.max-parallelism", testValue)
+			if vInt, err := cmdFlags.GetInt("array-node-config.adaptive-parallelism.max-parallelism"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt), &actual.ArrayNode.AdaptiveParallelism.MaxParallelism)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_array-node-config.adaptive-parallelism.increase-step", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("array-node-config.adaptive-parallelism.increase-step", testValue)
+			if vInt, err := cmdFlags.GetInt("array-node-config.adaptive-parallelism.increase-step"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt), &actual.ArrayNode.AdaptiveParallelism.IncreaseStep)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_array-node-config.adaptive-parallelism.decrease-factor", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("array-node-config.adaptive-parallelism.decrease-factor", testValue)
+			if vFloat64, err := cmdFlags.GetFloat64("array-node-config.adaptive-parallelism.decrease-factor"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vFloat64), &actual.ArrayNode.AdaptiveParallelism.DecreaseFactor)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_array-node-config.adaptive-parallelism.max-pending-ratio", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("array-node-config.adaptive-parallelism.max-pending-ratio", testValue)
+			if vFloat64, err := cmdFlags.GetFloat64("array-node-config.adaptive-parallelism.max-pending-ratio"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vFloat64), &actual.ArrayNode.AdaptiveParallelism.MaxPendingRatio)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_array-node-config.adaptive-parallelism.max-failure-ratio", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("array-node-config.adaptive-parallelism.max-failure-ratio", testValue)
+			if vFloat64, err := cmdFlags.GetFloat64("array-node-config.adaptive-parallelism.max-failure-ratio"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vFloat64), &actual.ArrayNode.AdaptiveParallelism.MaxFailureRatio)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_gate-node-config.allowed-storage-prefixes", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := join_Config(defaultConfig.GateNode.AllowedStoragePrefixes, ",")
+
+			cmdFlags.Set("gate-node-config.allowed-storage-prefixes", testValue)
+			if vStringSlice, err := cmdFlags.GetStringSlice("gate-node-config.allowed-storage-prefixes"); err == nil {
+				testDecodeRaw_Config(t, join_Config(vStringSlice, ","), &actual.GateNode.AllowedStoragePrefixes)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 	t.Run("Test_literal-offloading-config.Enabled", func(t *testing.T) {
 
 		t.Run("Override", func(t *testing.T) {
@@ -2,15 +2,24 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"runtime/pprof"
 	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/metadata"
 	"k8s.io/utils/clock"
 
+	flyteworkflowv1alpha1 "github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/client/clientset/versioned/typed/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
 	"github.com/flyteorg/flyte/flytestdlib/contextutils"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
@@ -18,18 +27,40 @@ import (
 	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
 )
 
+var flyteWorkflowsResource = flyteworkflowv1alpha1.SchemeGroupVersion.WithResource("flyteworkflows")
+
 type gcMetrics struct {
 	gcRoundSuccess labeled.Counter
 	gcRoundFailure labeled.Counter
 	gcTime         labeled.StopWatch
+	// Per namespace counts of the workflows outside of their retention period and of the ones actually deleted, which
+	// differ in dry-run mode.
+	gcExpired       *prometheus.CounterVec
+	gcDeleted       *prometheus.CounterVec
+	gcDeleteFailure *prometheus.CounterVec
+}
+
+// retentionPolicy retains the completed workflows whose labels match the selector for ttlHours.
+type retentionPolicy struct {
+	name     string
+	selector labels.Selector
+	ttlHours int
 }
 
 // GarbageCollector is an active background cleanup service, that deletes all workflows that are completed and older
-// than the configured TTL
+// than the TTL of the first retention policy they match
 type GarbageCollector struct {
-	wfClient                  v1alpha1.FlyteworkflowV1alpha1Interface
-	namespaceClient           corev1.NamespaceInterface
-	ttlHours                  int
+	wfClient        v1alpha1.FlyteworkflowV1alpha1Interface
+	metadataClient  metadata.Interface
+	namespaceClient corev1.NamespaceInterface
+	ttlHours        int
+	policies        []retentionPolicy
+	batchSize       int
+	deleteLimiter   *rate.Limiter
+	dryRun          bool
+	// deleteCollection is set if the completed workflows are deleted with a single deletion command per namespace,
+	// which is the case if no retention policies are configured and the garbage collector doesn't run dry.
+	deleteCollection          bool
 	interval                  time.Duration
 	clk                       clock.WithTicker
 	metrics                   *gcMetrics
@@ -37,43 +68,135 @@ type GarbageCollector struct {
 	labelSelectorRequirements []v1.LabelSelectorRequirement
 }
 
-// Issues a background deletion command with label selector for all completed workflows outside of the retention period
+// Deletes all completed workflows outside of their retention period. Without configured retention policies, a
+// background deletion command with a label selector is issued per namespace. Otherwise, the metadata of all completed
+// workflows is listed in batches and the ones outside of the retention period of their policy are deleted.
 func (g *GarbageCollector) deleteWorkflows(ctx context.Context) error {
-	s := CompletedWorkflowsSelectorOutsideRetentionPeriod(g.ttlHours, g.clk.Now())
+	now := g.clk.Now()
+	var s *v1.LabelSelector
+	if g.deleteCollection {
+		s = CompletedWorkflowsSelectorOutsideRetentionPeriod(g.ttlHours, now)
+	} else {
+		s = CompletedWorkflowsLabelSelector()
+		s.MatchExpressions = append(s.MatchExpressions, v1.LabelSelectorRequirement{
+			Key:      hourOfDayCompletedKey,
+			Operator: v1.LabelSelectorOpDoesNotExist,
+		})
+	}
+
 	if len(g.labelSelectorRequirements) != 0 {
 		s.MatchExpressions = append(s.MatchExpressions, g.labelSelectorRequirements...)
 	}
 
-	// Delete doesn't support 'all' namespaces. Let's fetch namespaces and loop over each.
+	namespaces := []string{g.namespace}
+	// List doesn't support 'all' namespaces with a limit consistently. Let's fetch namespaces and loop over each.
 	if g.namespace == "" || strings.ToLower(g.namespace) == "all" || strings.ToLower(g.namespace) == "all-namespaces" {
 		namespaceList, err := g.namespaceClient.List(ctx, v1.ListOptions{})
 		if err != nil {
 			return err
 		}
+
+		namespaces = make([]string, 0, len(namespaceList.Items))
 		for _, n := range namespaceList.Items {
-			namespaceCtx := contextutils.WithNamespace(ctx, n.GetName())
-			logger.Infof(namespaceCtx, "Triggering Workflow delete for namespace: [%s]", n.GetName())
+			namespaces = append(namespaces, n.GetName())
+		}
+	}
 
-			if err := g.deleteWorkflowsForNamespace(ctx, n.GetName(), s); err != nil {
-				g.metrics.gcRoundFailure.Inc(namespaceCtx)
-				logger.Errorf(namespaceCtx, "Garbage collection failed for for namespace: [%s]. Error : [%v]", n.GetName(), err)
-			} else {
-				g.metrics.gcRoundSuccess.Inc(namespaceCtx)
-			}
+	for _, namespace := range namespaces {
+		namespaceCtx := contextutils.WithNamespace(ctx, namespace)
+		logger.Infof(namespaceCtx, "Triggering Workflow garbage collection for namespace: [%s]", namespace)
+		var err error
+		if g.deleteCollection {
+			err = g.deleteWorkflowsForNamespace(namespaceCtx, namespace, s)
+		} else {
+			err = g.collectNamespace(namespaceCtx, namespace, s, now)
 		}
-	} else {
-		namespaceCtx := contextutils.WithNamespace(ctx, g.namespace)
-		logger.Infof(namespaceCtx, "Triggering Workflow delete for namespace: [%s]", g.namespace)
-		if err := g.deleteWorkflowsForNamespace(ctx, g.namespace, s); err != nil {
+
+		if err != nil {
 			g.metrics.gcRoundFailure.Inc(namespaceCtx)
-			logger.Errorf(namespaceCtx, "Garbage collection failed for for namespace: [%s]. Error : [%v]", g.namespace, err)
+			logger.Errorf(namespaceCtx, "Garbage collection failed for for namespace: [%s]. Error : [%v]", namespace, err)
 		} else {
 			g.metrics.gcRoundSuccess.Inc(namespaceCtx)
 		}
 	}
+
 	return nil
 }
 
+// expiredPolicy returns the policy the workflow is retained by, and whether the workflow is outside of its retention
+// period. Workflows without a parsable completed time are considered outside of the retention period.
+func (g *GarbageCollector) expiredPolicy(w *v1.PartialObjectMetadata, now time.Time) (string, bool) {
+	for _, p := range g.policies {
+		if !p.selector.Matches(labels.Set(w.GetLabels())) {
+			continue
+		}
+
+		completedAt, err := time.Parse(labelTimeFormat, w.GetLabels()[completedTimeKey])
+		if err != nil {
+			return p.name, true
+		}
+
+		// The current hour and ttlHours before it are retained.
+		return p.name, completedAt.Before(now.UTC().Truncate(time.Hour).Add(-time.Duration(p.ttlHours) * time.Hour))
+	}
+
+	return "", false
+}
+
+func (g *GarbageCollector) collectNamespace(ctx context.Context, namespace string, labelSelector *v1.LabelSelector, now time.Time) error {
+	gracePeriodZero := int64(0)
+	propagation := v1.DeletePropagationBackground
+	listOptions := v1.ListOptions{
+		LabelSelector: v1.FormatLabelSelector(labelSelector),
+		Limit:         int64(g.batchSize),
+	}
+
+	for {
+		// Only the metadata is listed, the specs and statuses of workflows can be large.
+		workflows, err := g.metadataClient.Resource(flyteWorkflowsResource).Namespace(namespace).List(ctx, listOptions)
+		if err != nil {
+			return err
+		}
+
+		for i := range workflows.Items {
+			w := &workflows.Items[i]
+			policy, expired := g.expiredPolicy(w, now)
+			if !expired {
+				continue
+			}
+
+			g.metrics.gcExpired.WithLabelValues(namespace).Inc()
+			if g.dryRun {
+				logger.Infof(ctx, "Dry run, not deleting workflow [%s] outside of the retention period of policy [%s]", w.GetName(), policy)
+				continue
+			}
+
+			if err := g.deleteLimiter.Wait(ctx); err != nil {
+				return err
+			}
+
+			logger.Debugf(ctx, "Deleting workflow [%s] outside of the retention period of policy [%s]", w.GetName(), policy)
+			err := g.wfClient.FlyteWorkflows(namespace).Delete(ctx, w.GetName(), v1.DeleteOptions{
+				GracePeriodSeconds: &gracePeriodZero,
+				PropagationPolicy:  &propagation,
+			})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				g.metrics.gcDeleteFailure.WithLabelValues(namespace).Inc()
+				logger.Warnf(ctx, "Failed to delete workflow [%s]. Error: %v", w.GetName(), err)
+				continue
+			}
+
+			g.metrics.gcDeleted.WithLabelValues(namespace).Inc()
+		}
+
+		if len(workflows.Continue) == 0 {
+			return nil
+		}
+
+		listOptions.Continue = workflows.Continue
+	}
+}
+
 // Deprecated: Please use deleteWorkflows instead
 func (g *GarbageCollector) deprecatedDeleteWorkflows(ctx context.Context) error {
 	s := DeprecatedCompletedWorkflowsSelectorOutsideRetentionPeriod(g.ttlHours, g.clk.Now())
@@ -129,7 +252,8 @@ func (g *GarbageCollector) deleteWorkflowsForNamespace(ctx context.Context, name
 
 // runGC runs GC periodically
 func (g *GarbageCollector) runGC(ctx context.Context, ticker clock.Ticker) {
-	logger.Infof(ctx, "Background workflow garbage collection started, with duration [%s], TTL [%d] hours", g.interval.String(), g.ttlHours)
+	logger.Infof(ctx, "Background workflow garbage collection started, with duration [%s], TTL [%d] hours, [%d] retention policies, dry-run [%v]",
+		g.interval.String(), g.ttlHours, len(g.policies), g.dryRun)
 
 	ctx = contextutils.WithGoroutineLabel(ctx, "gc-worker")
 	pprof.SetGoroutineLabels(ctx)
@@ -139,8 +263,11 @@ func (g *GarbageCollector) runGC(ctx context.Context, ticker clock.Ticker) {
 		case <-ticker.C():
 			logger.Infof(ctx, "Garbage collector running...")
 			t := g.metrics.gcTime.Start(ctx)
-			if err := g.deprecatedDeleteWorkflows(ctx); err != nil {
-				logger.Errorf(ctx, "Garbage collection failed in this round.Error : [%v]", err)
+			// Workflows labeled with the deprecated hour of day are not subject to retention policies.
+			if !g.dryRun && g.ttlHours > 0 {
+				if err := g.deprecatedDeleteWorkflows(ctx); err != nil {
+					logger.Errorf(ctx, "Garbage collection failed in this round.Error : [%v]", err)
+				}
 			}
 
 			if err := g.deleteWorkflows(ctx); err != nil {
@@ -158,8 +285,8 @@ func (g *GarbageCollector) runGC(ctx context.Context, ticker clock.Ticker) {
 
 // StartGC starts a background garbage collection routine. Use the context to signal an exit signal
 func (g *GarbageCollector) StartGC(ctx context.Context) error {
-	if g.ttlHours <= 0 {
-		logger.Warningf(ctx, "Garbage collector is disabled, as ttl [%d] is <=0", g.ttlHours)
+	if g.ttlHours <= 0 && len(g.policies) == 0 {
+		logger.Warningf(ctx, "Garbage collector is disabled, as ttl [%d] is <=0 and there are no retention policies", g.ttlHours)
 		return nil
 	}
 	ticker := g.clk.NewTicker(g.interval)
@@ -167,23 +294,102 @@ func (g *GarbageCollector) StartGC(ctx context.Context) error {
 	return nil
 }
 
-func NewGarbageCollector(cfg *config.Config, scope promutils.Scope, clk clock.WithTicker, namespaceClient corev1.NamespaceInterface, wfClient v1alpha1.FlyteworkflowV1alpha1Interface) (*GarbageCollector, error) {
+// newRetentionPolicies creates the configured retention policies followed by the default policy retaining all other
+// workflows for ttlHours, if positive.
+func newRetentionPolicies(cfg config.GCConfig, ttlHours int) ([]retentionPolicy, error) {
+	terminalPhases := sets.New(
+		flyteworkflowv1alpha1.WorkflowPhaseSuccess.String(),
+		flyteworkflowv1alpha1.WorkflowPhaseFailed.String(),
+		flyteworkflowv1alpha1.WorkflowPhaseAborted.String(),
+	)
+
+	policies := make([]retentionPolicy, 0, len(cfg.RetentionPolicies)+1)
+	for i, p := range cfg.RetentionPolicies {
+		name := p.Name
+		if len(name) == 0 {
+			name = fmt.Sprintf("policy-%d", i)
+		}
+
+		if p.TTLInHours <= 0 {
+			return nil, fmt.Errorf("ttl of retention policy [%s] must be positive, found [%d]", name, p.TTLInHours)
+		}
+
+		if unknown := sets.New(p.Phases...).Difference(terminalPhases); unknown.Len() > 0 {
+			return nil, fmt.Errorf("retention policy [%s] has unknown phases %v, supported phases are %v", name, sets.List(unknown), sets.List(terminalPhases))
+		}
+
+		labelSelector := &v1.LabelSelector{MatchLabels: p.MatchLabels}
+		if len(p.Projects) > 0 {
+			labelSelector.MatchExpressions = append(labelSelector.MatchExpressions, v1.LabelSelectorRequirement{
+				Key: k8s.ProjectLabel, Operator: v1.LabelSelectorOpIn, Values: p.Projects,
+			})
+		}
+
+		if len(p.Domains) > 0 {
+			labelSelector.MatchExpressions = append(labelSelector.MatchExpressions, v1.LabelSelectorRequirement{
+				Key: k8s.DomainLabel, Operator: v1.LabelSelectorOpIn, Values: p.Domains,
+			})
+		}
+
+		// Workflows completed before the completed phase label was introduced don't match policies selecting phases.
+		if len(p.Phases) > 0 {
+			labelSelector.MatchExpressions = append(labelSelector.MatchExpressions, v1.LabelSelectorRequirement{
+				Key: completedPhaseKey, Operator: v1.LabelSelectorOpIn, Values: p.Phases,
+			})
+		}
+
+		selector, err := v1.LabelSelectorAsSelector(labelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of retention policy [%s]: %w", name, err)
+		}
+
+		policies = append(policies, retentionPolicy{name: name, selector: selector, ttlHours: p.TTLInHours})
+	}
+
+	if ttlHours > 0 {
+		policies = append(policies, retentionPolicy{name: "default", selector: labels.Everything(), ttlHours: ttlHours})
+	}
+
+	return policies, nil
+}
+
+func NewGarbageCollector(cfg *config.Config, scope promutils.Scope, clk clock.WithTicker, namespaceClient corev1.NamespaceInterface, wfClient v1alpha1.FlyteworkflowV1alpha1Interface,
+	metadataClient metadata.Interface) (*GarbageCollector, error) {
 	ttl := 23
 	if cfg.MaxTTLInHours <= 23 {
 		ttl = cfg.MaxTTLInHours
 	} else {
 		logger.Warningf(context.TODO(), "defaulting max ttl for workflows to 23 hours, since configured duration is larger than 23 [%d]", cfg.MaxTTLInHours)
 	}
+	policies, err := newRetentionPolicies(cfg.GC, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteLimiter := rate.NewLimiter(rate.Inf, 0)
+	if cfg.GC.DeleteRate > 0 {
+		deleteLimiter = rate.NewLimiter(rate.Limit(cfg.GC.DeleteRate), cfg.GC.DeleteRate)
+	}
+
 	labelSelectorRequirements := getShardedLabelSelectorRequirements(cfg)
 	return &GarbageCollector{
-		wfClient:        wfClient,
-		ttlHours:        ttl,
-		interval:        cfg.GCInterval.Duration,
-		namespaceClient: namespaceClient,
+		wfClient:         wfClient,
+		metadataClient:   metadataClient,
+		ttlHours:         ttl,
+		policies:         policies,
+		batchSize:        cfg.GC.BatchSize,
+		deleteLimiter:    deleteLimiter,
+		dryRun:           cfg.GC.DryRun,
+		deleteCollection: len(cfg.GC.RetentionPolicies) == 0 && !cfg.GC.DryRun,
+		interval:         cfg.GCInterval.Duration,
+		namespaceClient:  namespaceClient,
 		metrics: &gcMetrics{
-			gcTime:         labeled.NewStopWatch("gc_latency", "time taken to issue a delete for TTL'ed workflows", time.Millisecond, scope),
-			gcRoundSuccess: labeled.NewCounter("gc_success", "successful executions of delete request", scope),
-			gcRoundFailure: labeled.NewCounter("gc_failure", "failure to delete workflows", scope),
+			gcTime:          labeled.NewStopWatch("gc_latency", "time taken to issue a delete for TTL'ed workflows", time.Millisecond, scope),
+			gcRoundSuccess:  labeled.NewCounter("gc_success", "successful executions of delete request", scope),
+			gcRoundFailure:  labeled.NewCounter("gc_failure", "failure to delete workflows", scope),
+			gcExpired:       scope.MustNewCounterVec("gc_expired", "completed workflows outside of their retention period", "namespace"),
+			gcDeleted:       scope.MustNewCounterVec("gc_deleted", "workflows deleted by the garbage collector", "namespace"),
+			gcDeleteFailure: scope.MustNewCounterVec("gc_delete_failure", "failures to delete workflows outside of their retention period", "namespace"),
 		},
 		clk:                       clk,
 		namespace:                 cfg.LimitNamespace,
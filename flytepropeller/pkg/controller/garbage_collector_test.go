@@ -7,13 +7,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	corev1Types "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/metadata"
 	testing2 "k8s.io/utils/clock/testing"
 
+	flyteworkflowv1alpha1 "github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/client/clientset/versioned/typed/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
 	config2 "github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
@@ -26,7 +32,7 @@ func TestNewGarbageCollector(t *testing.T) {
 			MaxTTLInHours:  2,
 			LimitNamespace: "flyte",
 		}
-		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), testing2.NewFakeClock(time.Now()), nil, nil)
+		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), testing2.NewFakeClock(time.Now()), nil, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, gc.ttlHours)
 	})
@@ -37,7 +43,7 @@ func TestNewGarbageCollector(t *testing.T) {
 			MaxTTLInHours:  24,
 			LimitNamespace: "flyte",
 		}
-		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), testing2.NewFakeClock(time.Now()), nil, nil)
+		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), testing2.NewFakeClock(time.Now()), nil, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 23, gc.ttlHours)
 	})
@@ -48,7 +54,7 @@ func TestNewGarbageCollector(t *testing.T) {
 			MaxTTLInHours:  0,
 			LimitNamespace: "flyte",
 		}
-		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), nil, nil, nil)
+		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), nil, nil, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, gc.ttlHours)
 		assert.NoError(t, gc.StartGC(context.TODO()))
@@ -61,7 +67,7 @@ func TestNewGarbageCollector(t *testing.T) {
 			MaxTTLInHours:  -1,
 			LimitNamespace: "flyte",
 		}
-		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), nil, nil, nil)
+		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), nil, nil, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, -1, gc.ttlHours)
 		assert.NoError(t, gc.StartGC(context.TODO()))
@@ -71,12 +77,35 @@ func TestNewGarbageCollector(t *testing.T) {
 type mockWfClient struct {
 	v1alpha1.FlyteWorkflowInterface
 	DeleteCollectionCb func(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	DeleteCb           func(name string, options v1.DeleteOptions) error
 }
 
 func (m *mockWfClient) DeleteCollection(ctx context.Context, options v1.DeleteOptions, listOptions v1.ListOptions) error {
 	return m.DeleteCollectionCb(&options, listOptions)
 }
 
+func (m *mockWfClient) Delete(ctx context.Context, name string, options v1.DeleteOptions) error {
+	return m.DeleteCb(name, options)
+}
+
+type mockMetadataClient struct {
+	metadata.Getter
+	ListCb    func(namespace string, opts v1.ListOptions) (*v1.PartialObjectMetadataList, error)
+	namespace string
+}
+
+func (m *mockMetadataClient) Resource(resource schema.GroupVersionResource) metadata.Getter {
+	return m
+}
+
+func (m *mockMetadataClient) Namespace(namespace string) metadata.ResourceInterface {
+	return &mockMetadataClient{ListCb: m.ListCb, namespace: namespace}
+}
+
+func (m *mockMetadataClient) List(ctx context.Context, opts v1.ListOptions) (*v1.PartialObjectMetadataList, error) {
+	return m.ListCb(m.namespace, opts)
+}
+
 type mockClient struct {
 	v1alpha1.FlyteworkflowV1alpha1Client
 	FlyteWorkflowsCb func(namespace string) v1alpha1.FlyteWorkflowInterface
@@ -112,6 +141,13 @@ func TestGarbageCollector_StartGC(t *testing.T) {
 		},
 	}
 
+	mockMetadataClient := &mockMetadataClient{
+		ListCb: func(namespace string, opts v1.ListOptions) (*v1.PartialObjectMetadataList, error) {
+			assert.Fail(t, "workflows are deleted by label selector without retention policies")
+			return &v1.PartialObjectMetadataList{}, nil
+		},
+	}
+
 	mockClient := &mockClient{
 		FlyteWorkflowsCb: func(namespace string) v1alpha1.FlyteWorkflowInterface {
 			return mockWfClient
@@ -148,7 +184,7 @@ func TestGarbageCollector_StartGC(t *testing.T) {
 
 		fakeClock := testing2.NewFakeClock(b)
 		mockNamespaceInvoked = false
-		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), fakeClock, mockNamespaceClient, mockClient)
+		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), fakeClock, mockNamespaceClient, mockClient, mockMetadataClient)
 		assert.NoError(t, err)
 		wg.Add(2)
 		ctx := context.TODO()
@@ -169,7 +205,7 @@ func TestGarbageCollector_StartGC(t *testing.T) {
 
 		fakeClock := testing2.NewFakeClock(b)
 		mockNamespaceInvoked = false
-		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), fakeClock, mockNamespaceClient, mockClient)
+		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), fakeClock, mockNamespaceClient, mockClient, mockMetadataClient)
 		assert.NoError(t, err)
 		wg.Add(4)
 		ctx := context.TODO()
@@ -181,3 +217,148 @@ func TestGarbageCollector_StartGC(t *testing.T) {
 		assert.True(t, mockNamespaceInvoked)
 	})
 }
+
+func newCompletedWorkflow(name, project, domain string, phase flyteworkflowv1alpha1.WorkflowPhase, completedAt time.Time) *flyteworkflowv1alpha1.FlyteWorkflow {
+	w := &flyteworkflowv1alpha1.FlyteWorkflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{k8s.ProjectLabel: project, k8s.DomainLabel: domain},
+		},
+		Status: flyteworkflowv1alpha1.WorkflowStatus{Phase: phase},
+	}
+
+	SetCompletedLabel(w, completedAt)
+	return w
+}
+
+func metadataList(workflows []*flyteworkflowv1alpha1.FlyteWorkflow) []v1.PartialObjectMetadata {
+	items := make([]v1.PartialObjectMetadata, 0, len(workflows))
+	for _, w := range workflows {
+		items = append(items, v1.PartialObjectMetadata{ObjectMeta: w.ObjectMeta})
+	}
+
+	return items
+}
+
+func TestGarbageCollector_deleteWorkflows(t *testing.T) {
+	now := time.Date(2009, time.November, 10, 23, 30, 0, 0, time.UTC)
+	// Two pages of workflows, the first one ending in a workflow deleted concurrently.
+	pages := [][]*flyteworkflowv1alpha1.FlyteWorkflow{
+		{
+			newCompletedWorkflow("recent", "p", "production", flyteworkflowv1alpha1.WorkflowPhaseSuccess, now.Add(-2*time.Hour)),
+			newCompletedWorkflow("old", "p", "production", flyteworkflowv1alpha1.WorkflowPhaseSuccess, now.Add(-3*time.Hour)),
+			newCompletedWorkflow("old-failed", "p", "production", flyteworkflowv1alpha1.WorkflowPhaseFailed, now.Add(-100*time.Hour)),
+		},
+		{
+			newCompletedWorkflow("expired-failed", "p", "production", flyteworkflowv1alpha1.WorkflowPhaseFailed, now.Add(-200*time.Hour)),
+			newCompletedWorkflow("old-failed-development", "p", "development", flyteworkflowv1alpha1.WorkflowPhaseFailed, now.Add(-3*time.Hour)),
+			newCompletedWorkflow("old-pinned", "p", "production", flyteworkflowv1alpha1.WorkflowPhaseSuccess, now.Add(-3*time.Hour)),
+			newCompletedWorkflow("gone", "p", "production", flyteworkflowv1alpha1.WorkflowPhaseSuccess, now.Add(-3*time.Hour)),
+		},
+	}
+	pages[1][2].Labels["pinned"] = "true"
+
+	cfg := &config2.Config{
+		MaxTTLInHours:  2,
+		LimitNamespace: "flyte",
+		GC: config2.GCConfig{
+			BatchSize: 3,
+			RetentionPolicies: []config2.GCRetentionPolicy{
+				{Name: "pinned", MatchLabels: map[string]string{"pinned": "true"}, TTLInHours: 1000},
+				{Name: "failures", Domains: []string{"production"}, Phases: []string{"Failed", "Aborted"}, TTLInHours: 168},
+			},
+		},
+	}
+
+	metadataClient := &mockMetadataClient{
+		ListCb: func(namespace string, opts v1.ListOptions) (*v1.PartialObjectMetadataList, error) {
+			assert.Equal(t, "flyte", namespace)
+			assert.Equal(t, int64(3), opts.Limit)
+			if opts.Continue == "" {
+				return &v1.PartialObjectMetadataList{Items: metadataList(pages[0]), ListMeta: v1.ListMeta{Continue: "page-2"}}, nil
+			}
+
+			assert.Equal(t, "page-2", opts.Continue)
+			return &v1.PartialObjectMetadataList{Items: metadataList(pages[1])}, nil
+		},
+	}
+
+	newMockClient := func(deleted *[]string) *mockClient {
+		wfClient := &mockWfClient{
+			DeleteCb: func(name string, options v1.DeleteOptions) error {
+				if name == "gone" {
+					return k8serrors.NewNotFound(flyteworkflowv1alpha1.Resource("flyteworkflows"), name)
+				}
+
+				*deleted = append(*deleted, name)
+				return nil
+			},
+		}
+
+		return &mockClient{
+			FlyteWorkflowsCb: func(namespace string) v1alpha1.FlyteWorkflowInterface {
+				assert.Equal(t, "flyte", namespace)
+				return wfClient
+			},
+		}
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		var deleted []string
+		gc, err := NewGarbageCollector(cfg, promutils.NewTestScope(), testing2.NewFakeClock(now), nil, newMockClient(&deleted), metadataClient)
+		assert.NoError(t, err)
+		assert.NoError(t, gc.deleteWorkflows(context.TODO()))
+		assert.Equal(t, []string{"old", "expired-failed", "old-failed-development"}, deleted)
+		assert.Equal(t, float64(4), testutil.ToFloat64(gc.metrics.gcExpired.WithLabelValues("flyte")))
+		assert.Equal(t, float64(4), testutil.ToFloat64(gc.metrics.gcDeleted.WithLabelValues("flyte")))
+	})
+
+	t.Run("dry-run", func(t *testing.T) {
+		dryRunCfg := *cfg
+		dryRunCfg.GC.DryRun = true
+		var deleted []string
+		gc, err := NewGarbageCollector(&dryRunCfg, promutils.NewTestScope(), testing2.NewFakeClock(now), nil, newMockClient(&deleted), metadataClient)
+		assert.NoError(t, err)
+		assert.NoError(t, gc.deleteWorkflows(context.TODO()))
+		assert.Empty(t, deleted)
+		assert.Equal(t, float64(4), testutil.ToFloat64(gc.metrics.gcExpired.WithLabelValues("flyte")))
+		assert.Equal(t, float64(0), testutil.ToFloat64(gc.metrics.gcDeleted.WithLabelValues("flyte")))
+	})
+}
+
+func TestNewRetentionPolicies(t *testing.T) {
+	t.Run("default policy", func(t *testing.T) {
+		policies, err := newRetentionPolicies(config2.GCConfig{
+			RetentionPolicies: []config2.GCRetentionPolicy{{Projects: []string{"p"}, Phases: []string{"Failed"}, TTLInHours: 48}},
+		}, 2)
+		assert.NoError(t, err)
+		if assert.Len(t, policies, 2) {
+			assert.Equal(t, "policy-0", policies[0].name)
+			assert.Equal(t, "completed-phase in (Failed),project in (p)", policies[0].selector.String())
+			assert.Equal(t, "default", policies[1].name)
+			assert.Equal(t, 2, policies[1].ttlHours)
+		}
+	})
+
+	t.Run("no default policy", func(t *testing.T) {
+		policies, err := newRetentionPolicies(config2.GCConfig{
+			RetentionPolicies: []config2.GCRetentionPolicy{{Name: "p", TTLInHours: 48}},
+		}, 0)
+		assert.NoError(t, err)
+		assert.Len(t, policies, 1)
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		_, err := newRetentionPolicies(config2.GCConfig{
+			RetentionPolicies: []config2.GCRetentionPolicy{{Name: "p"}},
+		}, 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown phase", func(t *testing.T) {
+		_, err := newRetentionPolicies(config2.GCConfig{
+			RetentionPolicies: []config2.GCRetentionPolicy{{Name: "p", Phases: []string{"Running"}, TTLInHours: 1}},
+		}, 2)
+		assert.Error(t, err)
+	})
+}
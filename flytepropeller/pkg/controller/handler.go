@@ -389,13 +389,14 @@ func (p *Propeller) streak(ctx context.Context, w *v1alpha1.FlyteWorkflow, wfClo
 			if mutatedWf.GetExecutionStatus().IsTerminated() {
 				ResetFinalizers(mutableW)
 				SetDefinitionVersionIfEmpty(mutableW, v1alpha1.LatestWorkflowDefinitionVersion)
-				SetCompletedLabel(mutableW, time.Now())
 				msg := fmt.Sprintf("Workflow size has breached threshold. Finalized with status: %v", mutatedWf.GetExecutionStatus().GetPhase())
 				mutableW.Status.UpdatePhase(v1alpha1.WorkflowPhaseFailed, msg, &core.ExecutionError{
 					Kind:    core.ExecutionError_SYSTEM,
 					Code:    "WorkflowTooLarge",
 					Message: "Workflow execution state is too large for Flyte to handle.",
 				})
+				// Labeled after the update of the phase, for the completed phase label to hold the final phase.
+				SetCompletedLabel(mutableW, time.Now())
 			} else {
 				mutableW.Status.UpdatePhase(v1alpha1.WorkflowPhaseFailing, "Workflow size has breached threshold, aborting", &core.ExecutionError{
 					Kind:    core.ExecutionError_SYSTEM,
@@ -21,6 +21,7 @@ import (
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/common"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/interfaces"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/backoff"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 )
 
@@ -53,6 +54,8 @@ type arrayEventRecorder interface {
 	process(ctx context.Context, nCtx interfaces.NodeExecutionContext, index int, retryAttempt uint32) error
 	finalize(ctx context.Context, nCtx interfaces.NodeExecutionContext, taskPhase idlcore.TaskExecution_Phase, taskPhaseVersion uint32, eventConfig *config.EventConfig) error
 	finalizeRequired(ctx context.Context) bool
+	// resourceQuotaExceeded returns whether a recorded task event reports that a resource quota was exceeded
+	resourceQuotaExceeded() bool
 }
 
 type externalResourcesEventRecorder struct {
@@ -232,8 +235,24 @@ func (e *externalResourcesEventRecorder) finalizeRequired(ctx context.Context) b
 	return len(e.externalResources) > 0
 }
 
+func (e *externalResourcesEventRecorder) resourceQuotaExceeded() bool {
+	for _, taskEvent := range e.taskEvents {
+		if isResourceQuotaExceededEvent(taskEvent) {
+			return true
+		}
+	}
+
+	return false
+}
+
 type passThroughEventRecorder struct {
 	interfaces.EventRecorder
+	quotaExceeded bool
+}
+
+func (p *passThroughEventRecorder) RecordTaskEvent(ctx context.Context, event *event.TaskExecutionEvent, eventConfig *config.EventConfig) error {
+	p.quotaExceeded = p.quotaExceeded || isResourceQuotaExceededEvent(event)
+	return p.EventRecorder.RecordTaskEvent(ctx, event, eventConfig)
 }
 
 func (*passThroughEventRecorder) process(ctx context.Context, nCtx interfaces.NodeExecutionContext, index int, retryAttempt uint32) error {
@@ -249,6 +268,30 @@ func (*passThroughEventRecorder) finalizeRequired(ctx context.Context) bool {
 	return false
 }
 
+func (p *passThroughEventRecorder) resourceQuotaExceeded() bool {
+	return p.quotaExceeded
+}
+
+// isResourceQuotaExceededEvent returns whether the event reports that the resources of a task could not be created
+// because a resource quota was exceeded.
+func isResourceQuotaExceededEvent(taskEvent *event.TaskExecutionEvent) bool {
+	if taskEvent.GetPhase() != idlcore.TaskExecution_WAITING_FOR_RESOURCES {
+		return false
+	}
+
+	if backoff.IsResourceQuotaExceededReason(taskEvent.GetReason()) {
+		return true
+	}
+
+	for _, reason := range taskEvent.GetReasons() {
+		if backoff.IsResourceQuotaExceededReason(reason.GetReason()) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func newArrayEventRecorder(eventRecorder interfaces.EventRecorder) arrayEventRecorder {
 	if config.GetConfig().ArrayNode.EventVersion == 0 {
 		return &externalResourcesEventRecorder{
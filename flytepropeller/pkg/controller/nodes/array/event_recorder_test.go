@@ -2,6 +2,7 @@ package array
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,7 @@ import (
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
 	execmocks "github.com/flyteorg/flyte/flytepropeller/pkg/controller/executors/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/interfaces/mocks"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/backoff"
 )
 
 type bufferedEventRecorder struct {
@@ -104,3 +106,36 @@ func TestGetPluginLogs(t *testing.T) {
 	assert.Equal(t, "bar", logs[0].GetName())
 	assert.Equal(t, "/console/projects/node_project/domains/node_domain/executions/node_name/nodeId/foo/taskId/task_name/attempt/0/mappedIndex/1/mappedAttempt/0/view/logs?duration=all", logs[0].GetUri())
 }
+
+func TestResourceQuotaExceeded(t *testing.T) {
+	ctx := context.TODO()
+	quotaErr := fmt.Errorf("exceeded quota: compute-resources")
+	quotaEvent := &event.TaskExecutionEvent{
+		Phase:   idlcore.TaskExecution_WAITING_FOR_RESOURCES,
+		Reasons: []*event.EventReason{{Reason: backoff.ResourceQuotaExceededReason(quotaErr)}},
+	}
+	waitingEvent := &event.TaskExecutionEvent{
+		Phase:   idlcore.TaskExecution_WAITING_FOR_RESOURCES,
+		Reasons: []*event.EventReason{{Reason: "waiting for resource manager tokens"}},
+	}
+
+	t.Run("ExternalResources", func(t *testing.T) {
+		recorder := &externalResourcesEventRecorder{EventRecorder: newBufferedEventRecorder()}
+		assert.NoError(t, recorder.RecordTaskEvent(ctx, waitingEvent, &config.EventConfig{}))
+		assert.False(t, recorder.resourceQuotaExceeded())
+
+		assert.NoError(t, recorder.RecordTaskEvent(ctx, quotaEvent, &config.EventConfig{}))
+		assert.True(t, recorder.resourceQuotaExceeded())
+	})
+
+	t.Run("PassThrough", func(t *testing.T) {
+		buffered := newBufferedEventRecorder()
+		recorder := &passThroughEventRecorder{EventRecorder: buffered}
+		assert.NoError(t, recorder.RecordTaskEvent(ctx, waitingEvent, &config.EventConfig{}))
+		assert.False(t, recorder.resourceQuotaExceeded())
+
+		assert.NoError(t, recorder.RecordTaskEvent(ctx, quotaEvent, &config.EventConfig{}))
+		assert.True(t, recorder.resourceQuotaExceeded())
+		assert.Len(t, buffered.taskExecutionEvents, 2)
+	})
+}
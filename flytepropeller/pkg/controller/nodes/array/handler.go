@@ -232,18 +232,7 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 		}
 
 		// initialize ArrayNode state
-		maxSystemFailuresValue := int(config.GetConfig().NodeConfig.MaxNodeRetriesOnSystemFailures)
-		maxAttemptsValue := int(config.GetConfig().NodeConfig.DefaultMaxAttempts)
-		if nCtx.Node().GetRetryStrategy() != nil && nCtx.Node().GetRetryStrategy().MinAttempts != nil && *nCtx.Node().GetRetryStrategy().MinAttempts != 1 {
-			maxAttemptsValue = *nCtx.Node().GetRetryStrategy().MinAttempts
-		}
-
-		if config.GetConfig().NodeConfig.IgnoreRetryCause {
-			maxSystemFailuresValue = maxAttemptsValue
-		} else {
-			maxAttemptsValue += maxSystemFailuresValue
-		}
-
+		maxAttemptsValue, maxSystemFailuresValue, maxPreemptionsValue := getSubNodeMaxValues(nCtx)
 		for _, item := range []struct {
 			arrayReference *bitarray.CompactArray
 			maxValue       int
@@ -254,6 +243,7 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 			{arrayReference: &arrayNodeState.SubNodeTaskPhases, maxValue: len(core.Phases) - 1},
 			{arrayReference: &arrayNodeState.SubNodeRetryAttempts, maxValue: maxAttemptsValue},
 			{arrayReference: &arrayNodeState.SubNodeSystemFailures, maxValue: maxSystemFailuresValue},
+			{arrayReference: &arrayNodeState.SubNodePreemptions, maxValue: maxPreemptionsValue},
 		} {
 
 			*item.arrayReference, err = bitarray.NewCompactArray(uint(size), bitarray.Item(item.maxValue)) // #nosec G115
@@ -276,18 +266,42 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 		// transition ArrayNode to `ArrayNodePhaseExecuting`
 		arrayNodeState.Phase = v1alpha1.ArrayNodePhaseExecuting
 	case v1alpha1.ArrayNodePhaseExecuting:
+		if arrayNodeState.SubNodePreemptions.ItemsCount == 0 {
+			if err := migrateSubNodePreemptions(nCtx, &arrayNodeState); err != nil {
+				return handler.UnknownTransition, err
+			}
+		}
+
 		// process array node subNodes
 		remainingWorkflowParallelism := int(nCtx.ExecutionContext().GetExecutionConfig().MaxParallelism - nCtx.ExecutionContext().CurrentParallelism())
 		incrementWorkflowParallelism, maxParallelism := inferParallelism(ctx, arrayNode.GetParallelism(),
 			config.GetConfig().ArrayNode.DefaultParallelismBehavior, remainingWorkflowParallelism, len(arrayNodeState.SubNodePhases.GetItems()))
 
+		// the adaptive parallelism is bounded by the parallelism of the ArrayNode
+		adaptiveParallelism := config.GetConfig().ArrayNode.AdaptiveParallelism
+		adaptive := adaptiveParallelism.Enabled && arrayNode.GetAdaptiveParallelism()
+		adaptiveMaxParallelism := maxParallelism
+		if adaptive {
+			if arrayNodeState.Parallelism == 0 {
+				arrayNodeState.Parallelism = uint32(initialParallelism(adaptiveParallelism)) // #nosec G115
+			}
+
+			adaptiveMaxParallelism = min(maxParallelism, int(arrayNodeState.Parallelism))
+		}
+
+		// the retries of all subNodes count against the retry budget
+		retryBudget := arrayNode.GetRetryBudget()
+		retriesUsed := 0
+		if retryBudget != nil {
+			for _, retryAttempts := range arrayNodeState.SubNodeRetryAttempts.GetItems() {
+				retriesUsed += int(retryAttempts) // #nosec G115
+			}
+		}
+
 		nodeExecutionRequests := make([]*nodeExecutionRequest, 0, maxParallelism)
+		subNodeFailureCollector := errorcollector.NewErrorMessageCollector()
 		currentParallelism := 0
 		for i, nodePhaseUint64 := range arrayNodeState.SubNodePhases.GetItems() {
-			if currentParallelism >= maxParallelism {
-				break
-			}
-
 			nodePhase := v1alpha1.NodePhase(nodePhaseUint64)              // #nosec G115
 			taskPhase := int(arrayNodeState.SubNodeTaskPhases.GetItem(i)) // #nosec G115
 
@@ -296,8 +310,35 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 				continue
 			}
 
-			// create array contexts
+			if currentParallelism >= maxParallelism {
+				break
+			}
+
+			// lowering the adaptive parallelism holds back launching subNodes, the subNodes already started are still
+			// evaluated within the parallelism of the ArrayNode and the workflow
+			if currentParallelism >= adaptiveMaxParallelism && nodePhase == v1alpha1.NodePhaseNotYetStarted {
+				continue
+			}
+
 			subNodeEventRecorder := newArrayEventRecorder(nCtx.EventsRecorder())
+
+			// once the retry budget is used up subNodes waiting to be retried fail instead
+			if nodePhase == v1alpha1.NodePhaseRetryableFailure && retryBudget != nil {
+				if retriesUsed >= int(*retryBudget) {
+					retryAttempt := uint32(arrayNodeState.SubNodeRetryAttempts.GetItem(i)) // #nosec G115
+					if err := sendEvents(ctx, nCtx, i, retryAttempt, idlcore.NodeExecution_FAILED, idlcore.TaskExecution_FAILED, subNodeEventRecorder, a.eventConfig); err != nil {
+						logger.Warnf(ctx, "failed to record ArrayNode events: %v", err)
+					}
+
+					subNodeFailureCollector.Collect(i, fmt.Sprintf("retry budget of %d retries used up", *retryBudget))
+					nodePhase = v1alpha1.NodePhaseFailing
+					arrayNodeState.SubNodePhases.SetItem(i, uint64(nodePhase))
+				} else {
+					retriesUsed++
+				}
+			}
+
+			// create array contexts
 			arrayNodeExecutor, arrayExecutionContext, arrayDAGStructure, arrayNodeLookup, subNodeSpec, subNodeStatus, err :=
 				a.buildArrayNodeContext(ctx, nCtx, &arrayNodeState, arrayNode, i, subNodeEventRecorder)
 			if err != nil {
@@ -335,7 +376,7 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 		}
 
 		workerErrorCollector := errorcollector.NewErrorMessageCollector()
-		subNodeFailureCollector := errorcollector.NewErrorMessageCollector()
+		parallelismSignals := parallelismSignals{}
 		for i, nodeExecutionRequest := range nodeExecutionRequests {
 			nodeExecutionResponse := <-nodeExecutionRequest.responseChannel
 			if nodeExecutionResponse.error != nil {
@@ -349,6 +390,15 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 			// capture subNode error if exists
 			if nodeExecutionRequest.subNodeStatus.Error != nil {
 				subNodeFailureCollector.Collect(index, subNodeStatus.Error.Message)
+
+				// the error is reported in the errors output once the ArrayNode succeeds. It is only written when the
+				// subNode transitions to failed, errors of retryable failures are overwritten by later attempts and
+				// terminal subNodes are not evaluated again.
+				if arrayNode.GetPartialSuccess() != nil && isFailedNodePhase(subNodeStatus.GetPhase()) {
+					if err := writeSubNodeError(ctx, nCtx.DataStore(), subNodeStatus); err != nil {
+						return handler.UnknownTransition, err
+					}
+				}
 			}
 
 			// process events by copying from internal event recorder
@@ -379,11 +429,15 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 			}
 			arrayNodeState.SubNodeRetryAttempts.SetItem(index, uint64(subNodeStatus.GetAttempts()))
 			arrayNodeState.SubNodeSystemFailures.SetItem(index, uint64(subNodeStatus.GetSystemFailures()))
+			arrayNodeState.SubNodePreemptions.SetItem(index, uint64(subNodeStatus.GetPreemptions()))
 
 			// increment task phase version if subNode phase or task phase changed
 			if subNodeStatus.GetPhase() != nodeExecutionRequest.nodePhase || subNodeStatus.GetTaskNodeStatus().GetPhase() != nodeExecutionRequest.taskPhase {
 				incrementTaskPhaseVersion = true
 			}
+
+			parallelismSignals.observe(nodeExecutionRequest.nodePhase, subNodeStatus.GetPhase(),
+				core.Phase(arrayNodeState.SubNodeTaskPhases.GetItem(index)), nodeExecutionRequest.arrayEventRecorder.resourceQuotaExceeded()) // #nosec G115
 		}
 
 		// if any workers failed then return the error
@@ -391,6 +445,15 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 			return handler.UnknownTransition, fmt.Errorf("worker error(s) encountered: %s", workerErrorCollector.Summary(events.MaxErrorMessageLength))
 		}
 
+		if adaptive {
+			parallelism := adaptParallelism(adaptiveParallelism, int(arrayNodeState.Parallelism), parallelismSignals)
+			if parallelism != int(arrayNodeState.Parallelism) {
+				logger.Debugf(ctx, "adapting ArrayNode parallelism from %d to %d, signals: %+v", arrayNodeState.Parallelism, parallelism, parallelismSignals)
+			}
+
+			arrayNodeState.Parallelism = uint32(parallelism) // #nosec G115
+		}
+
 		// process phases of subNodes to determine overall `ArrayNode` phase
 		successCount := 0
 		failedCount := 0
@@ -416,6 +479,9 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 			minSuccesses = int(*arrayNode.GetMinSuccesses())
 		} else if minSuccessRatio := arrayNode.GetMinSuccessRatio(); minSuccessRatio != nil {
 			minSuccesses = int(math.Ceil(float64(*minSuccessRatio) * float64(minSuccesses)))
+		} else if arrayNode.GetPartialSuccess() != nil {
+			// partial outputs are produced regardless of the number of failed subNodes
+			minSuccesses = 0
 		}
 
 		// if there is a failing node set the error message if it has not been previous set
@@ -425,9 +491,22 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 			}
 		}
 
+		maxFailures := int(arrayNode.GetMaxFailures())
 		if len(arrayNodeState.SubNodePhases.GetItems())-failedCount < minSuccesses {
 			// no chance to reach the minimum number of successes
 			arrayNodeState.Phase = v1alpha1.ArrayNodePhaseFailing
+		} else if maxFailures > 0 && failedCount+failingCount >= maxFailures {
+			// fail fast, the running subNodes are aborted
+			arrayNodeState.Phase = v1alpha1.ArrayNodePhaseFailing
+			message := fmt.Sprintf("%d subNodes failed, reaching the maximum of %d failures", failedCount+failingCount, maxFailures)
+			if arrayNodeState.Error != nil {
+				message = fmt.Sprintf("%s: %s", message, arrayNodeState.Error.GetMessage())
+			}
+
+			arrayNodeState.Error = &idlcore.ExecutionError{
+				Code:    errors.ArrayNodeMaxFailuresReached,
+				Message: message,
+			}
 		} else if successCount >= minSuccesses && runningCount == 0 {
 			// wait until all tasks have completed before declaring success
 			arrayNodeState.Phase = v1alpha1.ArrayNodePhaseSucceeding
@@ -456,8 +535,21 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 		)), nil
 	case v1alpha1.ArrayNodePhaseSucceeding:
 		gatherOutputsRequests := make([]*gatherOutputsRequest, 0, len(arrayNodeState.SubNodePhases.GetItems()))
+		var errorLiterals []*idlcore.Literal
+		if arrayNode.GetPartialSuccess() != nil {
+			errorLiterals = make([]*idlcore.Literal, 0, len(arrayNodeState.SubNodePhases.GetItems()))
+		}
+
 		for i, nodePhaseUint64 := range arrayNodeState.SubNodePhases.GetItems() {
 			nodePhase := v1alpha1.NodePhase(nodePhaseUint64) // #nosec G115
+			if errorLiterals != nil {
+				errorLiteral, err := a.buildSubNodeErrorLiteral(ctx, nCtx, &arrayNodeState, i)
+				if err != nil {
+					return handler.UnknownTransition, err
+				}
+
+				errorLiterals = append(errorLiterals, errorLiteral)
+			}
 			gatherOutputsRequest := &gatherOutputsRequest{
 				ctx: ctx,
 				responseChannel: make(chan struct {
@@ -565,6 +657,16 @@ func (a *arrayNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecu
 			return handler.UnknownTransition, fmt.Errorf("worker error(s) encountered: %s", workerErrorCollector.Summary(events.MaxErrorMessageLength))
 		}
 
+		if errorLiterals != nil {
+			outputLiterals[arrayNode.GetPartialSuccess().ErrorsOutput] = &idlcore.Literal{
+				Value: &idlcore.Literal_Collection{
+					Collection: &idlcore.LiteralCollection{
+						Literals: errorLiterals,
+					},
+				},
+			}
+		}
+
 		// only offload literal if config is enabled for this feature.
 		if a.literalOffloadingConfig.Enabled {
 			for outputLiteralKey, outputLiteral := range outputLiterals {
@@ -678,6 +780,50 @@ func (a *arrayNodeHandler) Setup(_ context.Context, _ interfaces.SetupContext) e
 	return nil
 }
 
+// getSubNodeMaxValues returns the maximum number of attempts, system failures and preemptions of the subNodes, which
+// size the compact arrays tracking them.
+func getSubNodeMaxValues(nCtx interfaces.NodeExecutionContext) (maxAttemptsValue, maxSystemFailuresValue, maxPreemptionsValue int) {
+	maxSystemFailuresValue = int(config.GetConfig().NodeConfig.MaxNodeRetriesOnSystemFailures)
+	maxPreemptionsValue = int(config.GetConfig().NodeConfig.MaxNodeRetriesOnPreemptions)
+	maxAttemptsValue = int(config.GetConfig().NodeConfig.DefaultMaxAttempts)
+	if nCtx.Node().GetRetryStrategy() != nil && nCtx.Node().GetRetryStrategy().MinAttempts != nil && *nCtx.Node().GetRetryStrategy().MinAttempts != 1 {
+		maxAttemptsValue = *nCtx.Node().GetRetryStrategy().MinAttempts
+	}
+
+	if config.GetConfig().NodeConfig.IgnoreRetryCause {
+		maxSystemFailuresValue = maxAttemptsValue
+	} else {
+		maxAttemptsValue += maxSystemFailuresValue + maxPreemptionsValue
+	}
+
+	return maxAttemptsValue, maxSystemFailuresValue, maxPreemptionsValue
+}
+
+// migrateSubNodePreemptions tracks the preemptions of the subNodes of ArrayNodes initialized before preemptions were
+// tracked, widening the retry attempts of their subNodes to fit the retries of preempted subNodes.
+func migrateSubNodePreemptions(nCtx interfaces.NodeExecutionContext, arrayNodeState *handler.ArrayNodeState) error {
+	maxAttemptsValue, _, maxPreemptionsValue := getSubNodeMaxValues(nCtx)
+	size := arrayNodeState.SubNodeRetryAttempts.ItemsCount
+	maxRetryAttemptsValue := max(bitarray.Item(maxAttemptsValue), (bitarray.Item(1)<<arrayNodeState.SubNodeRetryAttempts.ItemSize)-1) // #nosec G115
+	subNodeRetryAttempts, err := bitarray.NewCompactArray(size, maxRetryAttemptsValue)
+	if err != nil {
+		return err
+	}
+
+	for i, retryAttempts := range arrayNodeState.SubNodeRetryAttempts.GetItems() {
+		subNodeRetryAttempts.SetItem(i, retryAttempts)
+	}
+
+	subNodePreemptions, err := bitarray.NewCompactArray(size, bitarray.Item(maxPreemptionsValue)) // #nosec G115
+	if err != nil {
+		return err
+	}
+
+	arrayNodeState.SubNodeRetryAttempts = subNodeRetryAttempts
+	arrayNodeState.SubNodePreemptions = subNodePreemptions
+	return nil
+}
+
 // New initializes a new arrayNodeHandler
 func New(nodeExecutor interfaces.Node, eventConfig *config.EventConfig, literalOffloadingConfig config.LiteralOffloadingConfig, scope promutils.Scope) (interfaces.NodeHandler, error) {
 	// create k8s PluginState byte mocks to reuse instead of creating for each subNode evaluation
@@ -716,6 +862,49 @@ func New(nodeExecutor interfaces.Node, eventConfig *config.EventConfig, literalO
 	}, nil
 }
 
+// buildSubNodeErrorLiteral returns the entry of the errors output for the subNode, none if the subNode did not fail.
+func (a *arrayNodeHandler) buildSubNodeErrorLiteral(ctx context.Context, nCtx interfaces.NodeExecutionContext,
+	arrayNodeState *handler.ArrayNodeState, subNodeIndex int) (*idlcore.Literal, error) {
+
+	nodePhase := v1alpha1.NodePhase(arrayNodeState.SubNodePhases.GetItem(subNodeIndex)) // #nosec G115
+	if nodePhase != v1alpha1.NodePhaseFailed && nodePhase != v1alpha1.NodePhaseTimedOut {
+		return nilLiteral, nil
+	}
+
+	currentAttempt := int(arrayNodeState.SubNodeRetryAttempts.GetItem(subNodeIndex)) // #nosec G115
+	_, subOutputDir, err := constructOutputReferences(ctx, nCtx, strconv.Itoa(subNodeIndex), strconv.Itoa(currentAttempt))
+	if err != nil {
+		return nil, err
+	}
+
+	executionError, err := readSubNodeError(ctx, nCtx.DataStore(), subOutputDir)
+	if err != nil {
+		return nil, err
+	}
+
+	message := "subNode failed"
+	if nodePhase == v1alpha1.NodePhaseTimedOut {
+		message = "subNode timed out"
+	}
+
+	if executionError != nil {
+		message = fmt.Sprintf("[%s] %s", executionError.GetCode(), executionError.GetMessage())
+	}
+
+	return &idlcore.Literal{
+		Value: &idlcore.Literal_Scalar{
+			Scalar: &idlcore.Scalar{
+				Value: &idlcore.Scalar_Error{
+					Error: &idlcore.Error{
+						FailedNodeId: buildSubNodeID(nCtx, subNodeIndex),
+						Message:      message,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
 // buildArrayNodeContext creates a custom environment to execute the ArrayNode subnode. This is uniquely required for
 // the arrayNodeHandler because we require the same node execution entrypoint (ie. recursiveNodeExecutor.RecursiveNodeHandler)
 // but need many different execution details, for example setting input values as a singular item rather than a collection,
@@ -767,12 +956,19 @@ func (a *arrayNodeHandler) buildArrayNodeContext(ctx context.Context, nCtx inter
 		return nil, nil, nil, nil, nil, nil, err
 	}
 
+	// ArrayNodes initialized before preemptions were tracked are only migrated while executing
+	preemptions := uint32(0)
+	if arrayNodeState.SubNodePreemptions.ItemsCount > 0 {
+		preemptions = uint32(arrayNodeState.SubNodePreemptions.GetItem(subNodeIndex)) // #nosec G115
+	}
+
 	subNodeStatus := &v1alpha1.NodeStatus{
 		Phase:          nodePhase,
 		DataDir:        subDataDir,
 		OutputDir:      subOutputDir,
 		Attempts:       currentAttempt,
 		SystemFailures: uint32(arrayNodeState.SubNodeSystemFailures.GetItem(subNodeIndex)), // #nosec G115
+		Preemptions:    preemptions,
 		TaskNodeStatus: &v1alpha1.TaskNodeStatus{
 			Phase:       taskPhase,
 			PluginState: pluginStateBytes,
@@ -458,6 +458,32 @@ func TestHandleArrayNodePhaseNone(t *testing.T) {
 	}
 }
 
+func TestMigrateSubNodePreemptions(t *testing.T) {
+	scope := promutils.NewTestScope()
+	dataStore, err := storage.NewDataStore(&storage.Config{
+		Type: storage.TypeMemory,
+	}, scope)
+	assert.NoError(t, err)
+
+	// an ArrayNode initialized before preemptions were tracked
+	subNodeRetryAttempts, err := bitarray.NewCompactArray(2, 1)
+	assert.NoError(t, err)
+	subNodeRetryAttempts.SetItem(1, 1)
+	arrayNodeState := &handler.ArrayNodeState{
+		Phase:                v1alpha1.ArrayNodePhaseExecuting,
+		SubNodeRetryAttempts: subNodeRetryAttempts,
+	}
+	nCtx := createNodeExecutionContext(dataStore, newBufferedEventRecorder(), nil, nil, &arrayNodeSpec, arrayNodeState, 0, workflowMaxParallelism)
+
+	assert.NoError(t, migrateSubNodePreemptions(nCtx, arrayNodeState))
+	assert.Equal(t, []bitarray.Item{0, 1}, arrayNodeState.SubNodeRetryAttempts.GetItems())
+	assert.Equal(t, []bitarray.Item{0, 0}, arrayNodeState.SubNodePreemptions.GetItems())
+
+	maxAttemptsValue, _, maxPreemptionsValue := getSubNodeMaxValues(nCtx)
+	arrayNodeState.SubNodeRetryAttempts.SetItem(0, bitarray.Item(maxAttemptsValue))
+	arrayNodeState.SubNodePreemptions.SetItem(0, bitarray.Item(maxPreemptionsValue))
+}
+
 func uint32Ptr(v uint32) *uint32 {
 	return &v
 }
@@ -509,7 +535,12 @@ func TestHandleArrayNodePhaseExecuting(t *testing.T) {
 		name                           string
 		parallelism                    *uint32
 		minSuccessRatio                *float32
+		partialSuccess                 *v1alpha1.ArrayNodePartialSuccess
+		retryBudget                    *uint32
+		maxFailures                    uint32
+		adaptiveParallelism            uint32
 		subNodePhases                  []v1alpha1.NodePhase
+		subNodeRetryAttempts           []uint64
 		subNodeTaskPhases              []core.Phase
 		subNodeTransitions             []handler.Transition
 		expectedArrayNodePhase         v1alpha1.ArrayNodePhase
@@ -525,6 +556,8 @@ func TestHandleArrayNodePhaseExecuting(t *testing.T) {
 		expectedTaskPhaseVersion       uint32
 		expectHandleError              bool
 		expectedEventingCalls          int
+		expectedAdaptiveParallelism    uint32
+		expectedSubNodeErrorFiles      []bool
 	}{
 		{
 			name:        "StartAllSubNodes",
@@ -575,6 +608,86 @@ func TestHandleArrayNodePhaseExecuting(t *testing.T) {
 			expectedExternalResourcePhases: []idlcore.TaskExecution_Phase{idlcore.TaskExecution_RUNNING},
 			incrementParallelismCount:      1,
 		},
+		{
+			name:                "AdaptiveParallelismHoldsBackLaunch",
+			parallelism:         uint32Ptr(0),
+			adaptiveParallelism: 1,
+			subNodePhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseQueued,
+				v1alpha1.NodePhaseNotYetStarted,
+			},
+			subNodeTaskPhases: []core.Phase{
+				core.PhaseUndefined,
+				core.PhaseUndefined,
+			},
+			subNodeTransitions: []handler.Transition{
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoRunning(&handler.ExecutionInfo{})),
+			},
+			expectedArrayNodePhase: v1alpha1.ArrayNodePhaseExecuting,
+			expectedArrayNodeSubPhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseRunning,
+				v1alpha1.NodePhaseNotYetStarted,
+			},
+			expectedTaskPhaseVersion:       1,
+			expectedTransitionPhase:        handler.EPhaseRunning,
+			expectedExternalResourcePhases: []idlcore.TaskExecution_Phase{idlcore.TaskExecution_RUNNING},
+			incrementParallelismCount:      1,
+			expectedAdaptiveParallelism:    11,
+		},
+		{
+			name:                "AdaptiveParallelismEvaluatesStartedSubNodes",
+			parallelism:         uint32Ptr(0),
+			adaptiveParallelism: 1,
+			subNodePhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseQueued,
+				v1alpha1.NodePhaseQueued,
+			},
+			subNodeTaskPhases: []core.Phase{
+				core.PhaseUndefined,
+				core.PhaseUndefined,
+			},
+			subNodeTransitions: []handler.Transition{
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoRunning(&handler.ExecutionInfo{})),
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoRunning(&handler.ExecutionInfo{})),
+			},
+			expectedArrayNodePhase: v1alpha1.ArrayNodePhaseExecuting,
+			expectedArrayNodeSubPhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseRunning,
+				v1alpha1.NodePhaseRunning,
+			},
+			expectedTaskPhaseVersion:       1,
+			expectedTransitionPhase:        handler.EPhaseRunning,
+			expectedExternalResourcePhases: []idlcore.TaskExecution_Phase{idlcore.TaskExecution_RUNNING, idlcore.TaskExecution_RUNNING},
+			incrementParallelismCount:      1,
+			expectedAdaptiveParallelism:    11,
+		},
+		{
+			name:                "AdaptiveParallelismBoundedByWfParallelism",
+			parallelism:         nil,
+			adaptiveParallelism: 1,
+			subNodePhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseQueued,
+				v1alpha1.NodePhaseQueued,
+			},
+			subNodeTaskPhases: []core.Phase{
+				core.PhaseUndefined,
+				core.PhaseUndefined,
+			},
+			subNodeTransitions: []handler.Transition{
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoRunning(&handler.ExecutionInfo{})),
+			},
+			expectedArrayNodePhase: v1alpha1.ArrayNodePhaseExecuting,
+			expectedArrayNodeSubPhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseRunning,
+				v1alpha1.NodePhaseQueued,
+			},
+			expectedTaskPhaseVersion:       1,
+			expectedTransitionPhase:        handler.EPhaseRunning,
+			expectedExternalResourcePhases: []idlcore.TaskExecution_Phase{idlcore.TaskExecution_RUNNING},
+			currentWfParallelism:           workflowMaxParallelism - 1,
+			incrementParallelismCount:      1,
+			expectedAdaptiveParallelism:    11,
+		},
 		{
 			name:        "UtilizeWfParallelismAllSubNodes",
 			parallelism: nil,
@@ -747,6 +860,84 @@ func TestHandleArrayNodePhaseExecuting(t *testing.T) {
 			expectedTransitionPhase:        handler.EPhaseRunning,
 			expectedExternalResourcePhases: []idlcore.TaskExecution_Phase{idlcore.TaskExecution_FAILED, idlcore.TaskExecution_SUCCEEDED},
 		},
+		{
+			name:           "OneSubNodeFailedPartialSuccess",
+			parallelism:    uint32Ptr(0),
+			partialSuccess: &v1alpha1.ArrayNodePartialSuccess{ErrorsOutput: "errors"},
+			subNodePhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseRunning,
+				v1alpha1.NodePhaseRunning,
+			},
+			subNodeTaskPhases: []core.Phase{
+				core.PhaseRunning,
+				core.PhaseRunning,
+			},
+			subNodeTransitions: []handler.Transition{
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(0, "code", "message", &handler.ExecutionInfo{})),
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoSuccess(&handler.ExecutionInfo{})),
+			},
+			expectedArrayNodePhase: v1alpha1.ArrayNodePhaseSucceeding,
+			expectedArrayNodeSubPhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseFailed,
+				v1alpha1.NodePhaseSucceeded,
+			},
+			expectedTaskPhaseVersion:       0,
+			expectedTransitionPhase:        handler.EPhaseRunning,
+			expectedExternalResourcePhases: []idlcore.TaskExecution_Phase{idlcore.TaskExecution_FAILED, idlcore.TaskExecution_SUCCEEDED},
+			expectedSubNodeErrorFiles:      []bool{true, false},
+		},
+		{
+			name:           "MaxFailuresReached",
+			parallelism:    uint32Ptr(0),
+			partialSuccess: &v1alpha1.ArrayNodePartialSuccess{ErrorsOutput: "errors"},
+			maxFailures:    1,
+			subNodePhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseRunning,
+				v1alpha1.NodePhaseRunning,
+			},
+			subNodeTaskPhases: []core.Phase{
+				core.PhaseRunning,
+				core.PhaseRunning,
+			},
+			subNodeTransitions: []handler.Transition{
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(0, "code", "message", &handler.ExecutionInfo{})),
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoRunning(&handler.ExecutionInfo{})),
+			},
+			expectedArrayNodePhase: v1alpha1.ArrayNodePhaseFailing,
+			expectedArrayNodeSubPhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseFailed,
+				v1alpha1.NodePhaseRunning,
+			},
+			expectedTaskPhaseVersion:       0,
+			expectedTransitionPhase:        handler.EPhaseRunning,
+			expectedExternalResourcePhases: []idlcore.TaskExecution_Phase{idlcore.TaskExecution_FAILED, idlcore.TaskExecution_RUNNING},
+		},
+		{
+			name:        "RetryBudgetUsedUp",
+			parallelism: uint32Ptr(0),
+			retryBudget: uint32Ptr(1),
+			subNodePhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseRetryableFailure,
+				v1alpha1.NodePhaseRunning,
+			},
+			subNodeRetryAttempts: []uint64{1, 0},
+			subNodeTaskPhases: []core.Phase{
+				core.PhaseRetryableFailure,
+				core.PhaseRunning,
+			},
+			subNodeTransitions: []handler.Transition{
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoRunning(&handler.ExecutionInfo{})),
+				handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoSuccess(&handler.ExecutionInfo{})),
+			},
+			expectedArrayNodePhase: v1alpha1.ArrayNodePhaseFailing,
+			expectedArrayNodeSubPhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseFailed,
+				v1alpha1.NodePhaseSucceeded,
+			},
+			expectedTaskPhaseVersion:       0,
+			expectedTransitionPhase:        handler.EPhaseRunning,
+			expectedExternalResourcePhases: []idlcore.TaskExecution_Phase{idlcore.TaskExecution_FAILED, idlcore.TaskExecution_SUCCEEDED},
+		},
 		{
 			name:        "EventingAlreadyExists_EventuallySucceeds",
 			parallelism: uint32Ptr(0),
@@ -848,7 +1039,8 @@ func TestHandleArrayNodePhaseExecuting(t *testing.T) {
 			}
 			// initialize ArrayNodeState
 			arrayNodeState := &handler.ArrayNodeState{
-				Phase: v1alpha1.ArrayNodePhaseExecuting,
+				Phase:       v1alpha1.ArrayNodePhaseExecuting,
+				Parallelism: test.adaptiveParallelism,
 			}
 			for _, item := range []struct {
 				arrayReference *bitarray.CompactArray
@@ -867,15 +1059,26 @@ func TestHandleArrayNodePhaseExecuting(t *testing.T) {
 				arrayNodeState.SubNodePhases.SetItem(i, bitarray.Item(nodePhase)) // #nosec G115
 			}
 
+			for i, retryAttempts := range test.subNodeRetryAttempts {
+				arrayNodeState.SubNodeRetryAttempts.SetItem(i, retryAttempts)
+			}
+
 			nodeSpec := arrayNodeSpec
 			nodeSpec.ArrayNode.Parallelism = test.parallelism
 			nodeSpec.ArrayNode.MinSuccessRatio = test.minSuccessRatio
+			nodeSpec.ArrayNode.PartialSuccess = test.partialSuccess
+			nodeSpec.ArrayNode.RetryBudget = test.retryBudget
+			nodeSpec.ArrayNode.MaxFailures = test.maxFailures
+			flyteConfig.ArrayNode.AdaptiveParallelism.Enabled = test.adaptiveParallelism > 0
+			nodeSpec.ArrayNode.AdaptiveParallelism = test.adaptiveParallelism > 0
 
 			nCtx := createNodeExecutionContext(dataStore, eventRecorder, nil, literalMap, &nodeSpec, arrayNodeState, test.currentWfParallelism, workflowMaxParallelism)
 
 			// initialize ArrayNodeHandler
 			nodeHandler := &mocks.NodeHandler{}
 			nodeHandler.OnFinalizeRequired().Return(false)
+			nodeHandler.OnAbortMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			nodeHandler.OnFinalizeMatch(mock.Anything, mock.Anything).Return(nil)
 			for i, transition := range test.subNodeTransitions {
 				nodeID := fmt.Sprintf("n%d", i)
 				transitionPhase := test.expectedExternalResourcePhases[i]
@@ -917,11 +1120,18 @@ func TestHandleArrayNodePhaseExecuting(t *testing.T) {
 			assert.Equal(t, test.expectedArrayNodePhase, arrayNodeState.Phase)
 			assert.Equal(t, test.expectedTransitionPhase, transition.Info().GetPhase())
 			assert.Equal(t, test.expectedTaskPhaseVersion, arrayNodeState.TaskPhaseVersion)
+			assert.Equal(t, test.expectedAdaptiveParallelism, arrayNodeState.Parallelism)
 
 			for i, expectedPhase := range test.expectedArrayNodeSubPhases {
 				assert.Equal(t, expectedPhase, v1alpha1.NodePhase(arrayNodeState.SubNodePhases.GetItem(i))) // #nosec G115
 			}
 
+			for i, expectedErrorFile := range test.expectedSubNodeErrorFiles {
+				executionError, err := readSubNodeError(ctx, dataStore, storage.DataReference(fmt.Sprintf("s3://bucket/output/%d/0", i)))
+				assert.NoError(t, err)
+				assert.Equal(t, expectedErrorFile, executionError != nil)
+			}
+
 			bufferedEventRecorder, ok := eventRecorder.(*bufferedEventRecorder)
 			if ok {
 				if len(test.expectedExternalResourcePhases) > 0 {
@@ -1143,6 +1353,9 @@ func TestHandleArrayNodePhaseSucceeding(t *testing.T) {
 		outputVariable          string
 		outputValues            []*int
 		subNodePhases           []v1alpha1.NodePhase
+		partialSuccess          bool
+		subNodeErrors           []*idlcore.ExecutionError
+		expectedErrorMessages   []string
 		expectedArrayNodePhase  v1alpha1.ArrayNodePhase
 		expectedTransitionPhase handler.EPhase
 	}{
@@ -1157,6 +1370,25 @@ func TestHandleArrayNodePhaseSucceeding(t *testing.T) {
 			expectedArrayNodePhase:  v1alpha1.ArrayNodePhaseSucceeding,
 			expectedTransitionPhase: handler.EPhaseSuccess,
 		},
+		{
+			name:           "PartialSuccess",
+			outputValues:   []*int{&valueOne, nil, nil},
+			outputVariable: "foo",
+			subNodePhases: []v1alpha1.NodePhase{
+				v1alpha1.NodePhaseSucceeded,
+				v1alpha1.NodePhaseFailed,
+				v1alpha1.NodePhaseTimedOut,
+			},
+			partialSuccess: true,
+			subNodeErrors: []*idlcore.ExecutionError{
+				nil,
+				{Code: "OOMKilled", Message: "out of memory"},
+				nil,
+			},
+			expectedErrorMessages:   []string{"", "[OOMKilled] out of memory", "subNode timed out"},
+			expectedArrayNodePhase:  v1alpha1.ArrayNodePhaseSucceeding,
+			expectedTransitionPhase: handler.EPhaseSuccess,
+		},
 		{
 			name:                    "SuccessEmptyInput",
 			outputValues:            []*int{},
@@ -1188,7 +1420,25 @@ func TestHandleArrayNodePhaseSucceeding(t *testing.T) {
 			// create NodeExecutionContext
 			eventRecorder := newBufferedEventRecorder()
 			literalMap := &idlcore.LiteralMap{}
-			nCtx := createNodeExecutionContext(dataStore, eventRecorder, []string{test.outputVariable}, literalMap, &arrayNodeSpec, arrayNodeState, 0, workflowMaxParallelism)
+			nodeSpec := arrayNodeSpec
+			if test.partialSuccess {
+				arrayNode := *arrayNodeSpec.ArrayNode
+				arrayNode.PartialSuccess = &v1alpha1.ArrayNodePartialSuccess{ErrorsOutput: "errors"}
+				nodeSpec.ArrayNode = &arrayNode
+			}
+
+			nCtx := createNodeExecutionContext(dataStore, eventRecorder, []string{test.outputVariable}, literalMap, &nodeSpec, arrayNodeState, 0, workflowMaxParallelism)
+
+			// write mocked error files
+			for i, subNodeError := range test.subNodeErrors {
+				if subNodeError == nil {
+					continue
+				}
+
+				errorFile := storage.DataReference(fmt.Sprintf("s3://bucket/output/%d/0/%s", i, subNodeErrorFile))
+				err := nCtx.DataStore().WriteProtobuf(ctx, errorFile, storage.Options{}, subNodeError)
+				assert.NoError(t, err)
+			}
 
 			// write mocked output files
 			for i, outputValue := range test.outputValues {
@@ -1233,7 +1483,23 @@ func TestHandleArrayNodePhaseSucceeding(t *testing.T) {
 			err = nCtx.DataStore().ReadProtobuf(ctx, outputFile, &outputs)
 			assert.NoError(t, err)
 
-			assert.Len(t, outputs.GetLiterals(), 1)
+			if test.partialSuccess {
+				assert.Len(t, outputs.GetLiterals(), 2)
+
+				errors := outputs.GetLiterals()["errors"].GetCollection()
+				assert.NotNil(t, errors)
+				assert.Len(t, errors.GetLiterals(), len(test.expectedErrorMessages))
+				for i, expectedMessage := range test.expectedErrorMessages {
+					if expectedMessage == "" {
+						assert.NotNil(t, errors.GetLiterals()[i].GetScalar().GetNoneType())
+					} else {
+						assert.Equal(t, expectedMessage, errors.GetLiterals()[i].GetScalar().GetError().GetMessage())
+						assert.Equal(t, buildSubNodeID(nCtx, i), errors.GetLiterals()[i].GetScalar().GetError().GetFailedNodeId())
+					}
+				}
+			} else {
+				assert.Len(t, outputs.GetLiterals(), 1)
+			}
 
 			collection := outputs.GetLiterals()[test.outputVariable].GetCollection()
 			assert.NotNil(t, collection)
@@ -180,3 +180,35 @@ func (_m *arrayEventRecorder) process(ctx context.Context, nCtx interfaces.NodeE
 
 	return r0
 }
+
+type arrayEventRecorder_resourceQuotaExceeded struct {
+	*mock.Call
+}
+
+func (_m arrayEventRecorder_resourceQuotaExceeded) Return(_a0 bool) *arrayEventRecorder_resourceQuotaExceeded {
+	return &arrayEventRecorder_resourceQuotaExceeded{Call: _m.Call.Return(_a0)}
+}
+
+func (_m *arrayEventRecorder) OnresourceQuotaExceeded() *arrayEventRecorder_resourceQuotaExceeded {
+	c_call := _m.On("resourceQuotaExceeded")
+	return &arrayEventRecorder_resourceQuotaExceeded{Call: c_call}
+}
+
+func (_m *arrayEventRecorder) OnresourceQuotaExceededMatch(matchers ...interface{}) *arrayEventRecorder_resourceQuotaExceeded {
+	c_call := _m.On("resourceQuotaExceeded", matchers...)
+	return &arrayEventRecorder_resourceQuotaExceeded{Call: c_call}
+}
+
+// resourceQuotaExceeded provides a mock function with given fields:
+func (_m *arrayEventRecorder) resourceQuotaExceeded() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
@@ -0,0 +1,72 @@
+package array
+
+import (
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+)
+
+// parallelismSignals summarizes the feedback of the cluster on the subNodes evaluated in a round
+type parallelismSignals struct {
+	evaluated int
+	// quotaExceeded counts the subNodes whose resources could not be created because a resource quota is exceeded
+	quotaExceeded int
+	// waitingForResources counts the subNodes that could not be launched yet, e.g. waiting for resource manager tokens
+	// or for a quota to be freed up
+	waitingForResources int
+	// pending counts the subNodes that were launched but are not running yet, e.g. pods waiting to be scheduled
+	pending   int
+	completed int
+	failed    int
+}
+
+// observe records the phases a subNode was left in after being evaluated and whether launching it exceeded a resource
+// quota
+func (s *parallelismSignals) observe(previousNodePhase, nodePhase v1alpha1.NodePhase, taskPhase core.Phase, quotaExceeded bool) {
+	s.evaluated++
+	if quotaExceeded {
+		s.quotaExceeded++
+	}
+
+	switch taskPhase {
+	case core.PhaseWaitingForResources:
+		s.waitingForResources++
+	case core.PhaseQueued, core.PhaseInitializing:
+		s.pending++
+	}
+
+	if nodePhase == previousNodePhase {
+		return
+	}
+
+	switch nodePhase {
+	case v1alpha1.NodePhaseSucceeded, v1alpha1.NodePhaseRecovered, v1alpha1.NodePhaseSkipped:
+		s.completed++
+	case v1alpha1.NodePhaseRetryableFailure, v1alpha1.NodePhaseFailing, v1alpha1.NodePhaseFailed, v1alpha1.NodePhaseTimedOut:
+		s.completed++
+		s.failed++
+	}
+}
+
+// initialParallelism returns the parallelism the first round of an ArrayNode is evaluated with
+func initialParallelism(cfg config.AdaptiveParallelismConfig) int {
+	return max(cfg.MinParallelism, 1)
+}
+
+// adaptParallelism returns the parallelism of the next round. The parallelism backs off as soon as launching subNodes
+// exceeds a resource quota or subNodes fail too often, it's held while subNodes wait for resources or too many subNodes
+// are pending and raised while all allowed subNodes are in use.
+func adaptParallelism(cfg config.AdaptiveParallelismConfig, parallelism int, signals parallelismSignals) int {
+	switch {
+	case signals.quotaExceeded > 0:
+		parallelism = int(float64(parallelism) * cfg.DecreaseFactor)
+	case signals.completed > 0 && float64(signals.failed) > cfg.MaxFailureRatio*float64(signals.completed):
+		parallelism = int(float64(parallelism) * cfg.DecreaseFactor)
+	case signals.waitingForResources > 0, float64(signals.pending) > cfg.MaxPendingRatio*float64(signals.evaluated):
+		// the cluster has not caught up with the subNodes launched so far
+	case signals.evaluated >= parallelism:
+		parallelism += cfg.IncreaseStep
+	}
+
+	return max(min(parallelism, cfg.MaxParallelism), initialParallelism(cfg))
+}
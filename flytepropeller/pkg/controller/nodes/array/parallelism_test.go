@@ -0,0 +1,105 @@
+package array
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+)
+
+func TestParallelismSignalsObserve(t *testing.T) {
+	signals := parallelismSignals{}
+	signals.observe(v1alpha1.NodePhaseQueued, v1alpha1.NodePhaseQueued, core.PhaseWaitingForResources, true)
+	signals.observe(v1alpha1.NodePhaseQueued, v1alpha1.NodePhaseQueued, core.PhaseWaitingForResources, false)
+	signals.observe(v1alpha1.NodePhaseQueued, v1alpha1.NodePhaseRunning, core.PhaseQueued, false)
+	signals.observe(v1alpha1.NodePhaseRunning, v1alpha1.NodePhaseRunning, core.PhaseInitializing, false)
+	signals.observe(v1alpha1.NodePhaseRunning, v1alpha1.NodePhaseSucceeded, core.PhaseSuccess, false)
+	signals.observe(v1alpha1.NodePhaseRunning, v1alpha1.NodePhaseRetryableFailure, core.PhaseRetryableFailure, false)
+	signals.observe(v1alpha1.NodePhaseRetryableFailure, v1alpha1.NodePhaseRetryableFailure, core.PhaseUndefined, false)
+
+	assert.Equal(t, parallelismSignals{
+		evaluated:           7,
+		quotaExceeded:       1,
+		waitingForResources: 2,
+		pending:             2,
+		completed:           2,
+		failed:              1,
+	}, signals)
+}
+
+func TestAdaptParallelism(t *testing.T) {
+	cfg := config.AdaptiveParallelismConfig{
+		Enabled:         true,
+		MinParallelism:  2,
+		MaxParallelism:  20,
+		IncreaseStep:    5,
+		DecreaseFactor:  0.5,
+		MaxPendingRatio: 0.5,
+		MaxFailureRatio: 0.25,
+	}
+
+	tests := []struct {
+		name                string
+		parallelism         int
+		signals             parallelismSignals
+		expectedParallelism int
+	}{
+		{
+			name:                "IncreaseWhenSaturated",
+			parallelism:         10,
+			signals:             parallelismSignals{evaluated: 10, pending: 2, completed: 4, failed: 1},
+			expectedParallelism: 15,
+		},
+		{
+			name:                "IncreaseBoundedByMax",
+			parallelism:         18,
+			signals:             parallelismSignals{evaluated: 18},
+			expectedParallelism: 20,
+		},
+		{
+			name:                "HoldWhenNotSaturated",
+			parallelism:         10,
+			signals:             parallelismSignals{evaluated: 4},
+			expectedParallelism: 10,
+		},
+		{
+			name:                "HoldWhenPending",
+			parallelism:         10,
+			signals:             parallelismSignals{evaluated: 10, pending: 6},
+			expectedParallelism: 10,
+		},
+		{
+			name:                "HoldWhenWaitingForResources",
+			parallelism:         10,
+			signals:             parallelismSignals{evaluated: 10, waitingForResources: 1},
+			expectedParallelism: 10,
+		},
+		{
+			name:                "DecreaseWhenQuotaExceeded",
+			parallelism:         10,
+			signals:             parallelismSignals{evaluated: 10, quotaExceeded: 1, waitingForResources: 1},
+			expectedParallelism: 5,
+		},
+		{
+			name:                "DecreaseWhenFailing",
+			parallelism:         10,
+			signals:             parallelismSignals{evaluated: 10, completed: 4, failed: 2},
+			expectedParallelism: 5,
+		},
+		{
+			name:                "DecreaseBoundedByMin",
+			parallelism:         3,
+			signals:             parallelismSignals{evaluated: 3, quotaExceeded: 3},
+			expectedParallelism: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedParallelism, adaptParallelism(cfg, test.parallelism, test.signals))
+		})
+	}
+}
@@ -16,6 +16,9 @@ import (
 	"github.com/flyteorg/flyte/flytestdlib/storage"
 )
 
+// subNodeErrorFile is the file in the output directory of a subNode attempt holding the error it failed with
+const subNodeErrorFile = "array_node_error.pb"
+
 func appendLiteral(name string, literal *idlcore.Literal, outputLiterals map[string]*idlcore.Literal, length int) {
 	outputLiteral, exists := outputLiterals[name]
 	if !exists {
@@ -86,3 +89,37 @@ func isTerminalNodePhase(nodePhase v1alpha1.NodePhase) bool {
 	return nodePhase == v1alpha1.NodePhaseSucceeded || nodePhase == v1alpha1.NodePhaseFailed || nodePhase == v1alpha1.NodePhaseTimedOut ||
 		nodePhase == v1alpha1.NodePhaseSkipped || nodePhase == v1alpha1.NodePhaseRecovered
 }
+
+func isFailedNodePhase(nodePhase v1alpha1.NodePhase) bool {
+	return nodePhase == v1alpha1.NodePhaseFailed || nodePhase == v1alpha1.NodePhaseTimedOut
+}
+
+// writeSubNodeError stores the error of the subNode, which is lost once the subNode status is compacted into the
+// ArrayNode state.
+func writeSubNodeError(ctx context.Context, dataStore *storage.DataStore, subNodeStatus *v1alpha1.NodeStatus) error {
+	errorFile, err := dataStore.ConstructReference(ctx, subNodeStatus.GetOutputDir(), subNodeErrorFile)
+	if err != nil {
+		return err
+	}
+
+	return dataStore.WriteProtobuf(ctx, errorFile, storage.Options{}, subNodeStatus.GetExecutionError())
+}
+
+// readSubNodeError returns the error stored for a subNode attempt, nil if none has been stored.
+func readSubNodeError(ctx context.Context, dataStore *storage.DataStore, subOutputDir storage.DataReference) (*idlcore.ExecutionError, error) {
+	errorFile, err := dataStore.ConstructReference(ctx, subOutputDir, subNodeErrorFile)
+	if err != nil {
+		return nil, err
+	}
+
+	executionError := &idlcore.ExecutionError{}
+	if err := dataStore.ReadProtobuf(ctx, errorFile, executionError); err != nil {
+		if storage.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return executionError, nil
+}
@@ -0,0 +1,126 @@
+package branch
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/shamaton/msgpack/v2"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// resolveAttrPath resolves the attribute path (e.g. x.a["b"][0]) in the literal. Values inside structs and msgpack
+// encoded binaries are converted to literals so they can be compared.
+func resolveAttrPath(literal *core.Literal, attrPath []*core.PromiseAttribute) (*core.Literal, error) {
+	curr := literal
+	for i, attr := range attrPath {
+		curr = unwrapUnion(curr)
+		switch {
+		case curr.GetMap() != nil:
+			next, found := curr.GetMap().GetLiterals()[attr.GetStringValue()]
+			if !found {
+				return nil, fmt.Errorf("key [%v] does not exist", attr.GetStringValue())
+			}
+			curr = next
+		case curr.GetCollection() != nil:
+			index := int(attr.GetIntValue())
+			if index < 0 || index >= len(curr.GetCollection().GetLiterals()) {
+				return nil, fmt.Errorf("index [%v] is out of range", index)
+			}
+			curr = curr.GetCollection().GetLiterals()[index]
+		case curr.GetScalar().GetGeneric() != nil:
+			return resolveAttrPathInValue(curr.GetScalar().GetGeneric().AsMap(), attrPath[i:])
+		case curr.GetScalar().GetBinary() != nil:
+			binary := curr.GetScalar().GetBinary()
+			if binary.GetTag() != coreutils.MESSAGEPACK {
+				return nil, fmt.Errorf("unsupported binary format [%v]", binary.GetTag())
+			}
+
+			var value any
+			if err := msgpack.Unmarshal(binary.GetValue(), &value); err != nil {
+				return nil, err
+			}
+			return resolveAttrPathInValue(value, attrPath[i:])
+		default:
+			return nil, fmt.Errorf("attribute [%v] can't be resolved in a value of type [%T]", attr.GetValue(), curr.GetValue())
+		}
+	}
+
+	return curr, nil
+}
+
+// resolveAttrPathInValue resolves the attribute path in a decoded struct or msgpack value.
+func resolveAttrPathInValue(value any, attrPath []*core.PromiseAttribute) (*core.Literal, error) {
+	for _, attr := range attrPath {
+		var found bool
+		switch v := value.(type) {
+		case map[string]any:
+			value, found = v[attr.GetStringValue()]
+		case map[any]any:
+			value, found = v[attr.GetStringValue()]
+		case []any:
+			index := int(attr.GetIntValue())
+			if found = index >= 0 && index < len(v); found {
+				value = v[index]
+			}
+		default:
+			return nil, fmt.Errorf("attribute [%v] can't be resolved in a value of type [%T]", attr.GetValue(), value)
+		}
+
+		if !found {
+			return nil, fmt.Errorf("attribute [%v] does not exist", attr.GetValue())
+		}
+	}
+
+	return valueToLiteral(value)
+}
+
+// valueToLiteral converts a decoded struct or msgpack value to a literal, null values become none.
+func valueToLiteral(value any) (*core.Literal, error) {
+	switch v := value.(type) {
+	case nil:
+		return &core.Literal{Value: &core.Literal_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_NoneType{NoneType: &core.Void{}}}}}, nil
+	case []any:
+		literals := make([]*core.Literal, 0, len(v))
+		for _, element := range v {
+			literal, err := valueToLiteral(element)
+			if err != nil {
+				return nil, err
+			}
+			literals = append(literals, literal)
+		}
+		return &core.Literal{Value: &core.Literal_Collection{Collection: &core.LiteralCollection{Literals: literals}}}, nil
+	case map[string]any:
+		literals := make(map[string]*core.Literal, len(v))
+		for key, element := range v {
+			literal, err := valueToLiteral(element)
+			if err != nil {
+				return nil, err
+			}
+			literals[key] = literal
+		}
+		return &core.Literal{Value: &core.Literal_Map{Map: &core.LiteralMap{Literals: literals}}}, nil
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, element := range v {
+			m[fmt.Sprintf("%v", key)] = element
+		}
+		return valueToLiteral(m)
+	case string, bool:
+		return coreutils.MakePrimitiveLiteral(v)
+	}
+
+	// msgpack decodes numbers to the smallest type holding them
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return coreutils.MakePrimitiveLiteral(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return coreutils.MakePrimitiveLiteral(int64(rv.Uint())) // #nosec G115
+	case reflect.Float32, reflect.Float64:
+		return coreutils.MakePrimitiveLiteral(rv.Float())
+	}
+
+	return nil, fmt.Errorf("values of type [%T] can't be compared", value)
+}
@@ -2,6 +2,8 @@ package branch
 
 import (
 	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flytestdlib/errors"
@@ -14,6 +16,7 @@ type comparators struct {
 }
 
 var primitiveBooleanType = reflect.TypeOf(&core.Primitive_Boolean{}).String()
+var primitiveStringType = reflect.TypeOf(&core.Primitive_StringValue{}).String()
 
 var perTypeComparators = map[string]comparators{
 	reflect.TypeOf(&core.Primitive_FloatValue{}).String(): {
@@ -114,26 +117,55 @@ func Evaluate(lValue *core.Primitive, rValue *core.Primitive, op core.Comparison
 	return false, errors.Errorf(ErrorCodeMalformedBranch, "Unsupported operator type in Propeller. System error.")
 }
 
-func Evaluate1(lValue *core.Primitive, rValue *core.Literal, op core.ComparisonExpression_Operator) (bool, error) {
+func EvaluateLiterals(lValue *core.Literal, rValue *core.Literal, op core.ComparisonExpression_Operator) (bool, error) {
+	if lValue.GetScalar() == nil || lValue.GetScalar().GetPrimitive() == nil {
+		return false, errors.Errorf(ErrorCodeMalformedBranch, "Only primitives can be compared. LHS Variable is non primitive.")
+	}
 	if rValue.GetScalar() == nil || rValue.GetScalar().GetPrimitive() == nil {
-		return false, errors.Errorf(ErrorCodeMalformedBranch, "Only primitives can be compared. RHS Variable is non primitive.")
+		return false, errors.Errorf(ErrorCodeMalformedBranch, "Only primitives can be compared. RHS Variable is non primitive")
 	}
-	return Evaluate(lValue, rValue.GetScalar().GetPrimitive(), op)
+	return Evaluate(lValue.GetScalar().GetPrimitive(), rValue.GetScalar().GetPrimitive(), op)
 }
 
-func Evaluate2(lValue *core.Literal, rValue *core.Primitive, op core.ComparisonExpression_Operator) (bool, error) {
-	if lValue.GetScalar() == nil || lValue.GetScalar().GetPrimitive() == nil {
-		return false, errors.Errorf(ErrorCodeMalformedBranch, "Only primitives can be compared. LHS Variable is non primitive.")
+// EvaluateIn evaluates whether the primitive lValue is an element of the collection rValue. Elements of other types
+// than lValue never match.
+func EvaluateIn(lValue *core.Primitive, rValue *core.LiteralCollection) (bool, error) {
+	lValueType := reflect.TypeOf(lValue.GetValue())
+	comps, ok := perTypeComparators[lValueType.String()]
+	if !ok {
+		return false, errors.Errorf(ErrorCodeMalformedBranch, "Comparator not defined for type: [%v]", lValueType.String())
+	}
+
+	for _, element := range rValue.GetLiterals() {
+		rPrim := unwrapUnion(element).GetScalar().GetPrimitive()
+		if rPrim != nil && reflect.TypeOf(rPrim.GetValue()) == lValueType && comps.eq(lValue, rPrim) {
+			return true, nil
+		}
 	}
-	return Evaluate(lValue.GetScalar().GetPrimitive(), rValue, op)
+
+	return false, nil
 }
 
-func EvaluateLiterals(lValue *core.Literal, rValue *core.Literal, op core.ComparisonExpression_Operator) (bool, error) {
-	if lValue.GetScalar() == nil || lValue.GetScalar().GetPrimitive() == nil {
-		return false, errors.Errorf(ErrorCodeMalformedBranch, "Only primitives can be compared. LHS Variable is non primitive.")
+// EvaluateString evaluates the string operators STARTS_WITH, ENDS_WITH and MATCHES.
+func EvaluateString(lValue *core.Primitive, rValue *core.Primitive, op core.ComparisonExpression_Operator) (bool, error) {
+	if lValue.GetValue() == nil || reflect.TypeOf(lValue.GetValue()).String() != primitiveStringType ||
+		rValue.GetValue() == nil || reflect.TypeOf(rValue.GetValue()).String() != primitiveStringType {
+		return false, errors.Errorf(ErrorCodeMalformedBranch, "[%v] is only defined for string operands.", op)
 	}
-	if rValue.GetScalar() == nil || rValue.GetScalar().GetPrimitive() == nil {
-		return false, errors.Errorf(ErrorCodeMalformedBranch, "Only primitives can be compared. RHS Variable is non primitive")
+
+	switch op {
+	case core.ComparisonExpression_STARTS_WITH:
+		return strings.HasPrefix(lValue.GetStringValue(), rValue.GetStringValue()), nil
+	case core.ComparisonExpression_ENDS_WITH:
+		return strings.HasSuffix(lValue.GetStringValue(), rValue.GetStringValue()), nil
+	case core.ComparisonExpression_MATCHES:
+		re, err := regexp.Compile(rValue.GetStringValue())
+		if err != nil {
+			return false, errors.Errorf(ErrorCodeMalformedBranch, "Invalid regular expression [%v]: %v", rValue.GetStringValue(), err)
+		}
+
+		return re.MatchString(lValue.GetStringValue()), nil
 	}
-	return Evaluate(lValue.GetScalar().GetPrimitive(), rValue.GetScalar().GetPrimitive(), op)
+
+	return false, errors.Errorf(ErrorCodeMalformedBranch, "[%v] is not a string operator.", op)
 }
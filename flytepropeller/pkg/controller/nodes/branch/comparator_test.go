@@ -402,3 +402,49 @@ func TestEvaluate_boolean(t *testing.T) {
 		assert.False(t, b)
 	}
 }
+
+func TestEvaluateIn(t *testing.T) {
+	collection := coreutils.MustMakeLiteral([]interface{}{1, "a", 2.5}).GetCollection()
+
+	b, err := EvaluateIn(coreutils.MustMakePrimitive(1), collection)
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	b, err = EvaluateIn(coreutils.MustMakePrimitive("a"), collection)
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	// Elements of other types don't match
+	b, err = EvaluateIn(coreutils.MustMakePrimitive("1"), collection)
+	assert.NoError(t, err)
+	assert.False(t, b)
+
+	b, err = EvaluateIn(coreutils.MustMakePrimitive(2), &core.LiteralCollection{})
+	assert.NoError(t, err)
+	assert.False(t, b)
+}
+
+func TestEvaluateString(t *testing.T) {
+	s := coreutils.MustMakePrimitive("flyte-propeller")
+
+	b, err := EvaluateString(s, coreutils.MustMakePrimitive("flyte"), core.ComparisonExpression_STARTS_WITH)
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	b, err = EvaluateString(s, coreutils.MustMakePrimitive("flyte"), core.ComparisonExpression_ENDS_WITH)
+	assert.NoError(t, err)
+	assert.False(t, b)
+
+	b, err = EvaluateString(s, coreutils.MustMakePrimitive("-prop"), core.ComparisonExpression_MATCHES)
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	_, err = EvaluateString(s, coreutils.MustMakePrimitive("[a-"), core.ComparisonExpression_MATCHES)
+	assert.Error(t, err)
+
+	_, err = EvaluateString(coreutils.MustMakePrimitive(1), coreutils.MustMakePrimitive("1"), core.ComparisonExpression_STARTS_WITH)
+	assert.Error(t, err)
+
+	_, err = EvaluateString(s, coreutils.MustMakePrimitive("flyte"), core.ComparisonExpression_EQ)
+	assert.Error(t, err)
+}
@@ -17,52 +17,114 @@ const ErrorCodeMalformedBranch = "MalformedBranchUserError"
 const ErrorCodeCompilerError = "CompilerError"
 const ErrorCodeFailedFetchOutputs = "FailedFetchOutputs"
 
-func EvaluateComparison(expr *core.ComparisonExpression, nodeInputs *core.LiteralMap) (bool, error) {
-	var lValue *core.Literal
-	var rValue *core.Literal
-	var lPrim *core.Primitive
-	var rPrim *core.Primitive
-
-	if expr.GetLeftValue().GetPrimitive() == nil {
-		if nodeInputs == nil {
-			return false, errors.Errorf(ErrorCodeMalformedBranch, "Failed to find Value for Variable [%v]", expr.GetLeftValue().GetVar())
-		}
-		lValue = nodeInputs.GetLiterals()[expr.GetLeftValue().GetVar()]
-		if lValue == nil {
-			return false, errors.Errorf(ErrorCodeMalformedBranch, "Failed to find Value for Variable [%v]", expr.GetLeftValue().GetVar())
+// resolveOperand returns the value of the operand, looking up variables (and their attribute paths) in the node inputs.
+// Union values are unwrapped to the value they hold.
+func resolveOperand(operand *core.Operand, nodeInputs *core.LiteralMap) (*core.Literal, error) {
+	switch val := operand.GetVal().(type) {
+	case *core.Operand_Primitive:
+		return &core.Literal{Value: &core.Literal_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_Primitive{Primitive: val.Primitive}}}}, nil
+	case *core.Operand_Scalar:
+		return unwrapUnion(&core.Literal{Value: &core.Literal_Scalar{Scalar: val.Scalar}}), nil
+	case *core.Operand_Collection:
+		return &core.Literal{Value: &core.Literal_Collection{Collection: val.Collection}}, nil
+	}
+
+	value := nodeInputs.GetLiterals()[operand.GetVar()]
+	if value == nil {
+		return nil, errors.Errorf(ErrorCodeMalformedBranch, "Failed to find Value for Variable [%v]", operand.GetVar())
+	}
+
+	if len(operand.GetAttrPath()) > 0 {
+		var err error
+		if value, err = resolveAttrPath(value, operand.GetAttrPath()); err != nil {
+			return nil, errors.Wrapf(ErrorCodeMalformedBranch, err, "Failed to resolve attribute path of Variable [%v]", operand.GetVar())
 		}
-	} else {
-		lPrim = expr.GetLeftValue().GetPrimitive()
 	}
 
-	if expr.GetRightValue().GetPrimitive() == nil {
-		if nodeInputs == nil {
-			return false, errors.Errorf(ErrorCodeMalformedBranch, "Failed to find Value for Variable [%v]", expr.GetLeftValue().GetVar())
+	return unwrapUnion(value), nil
+}
+
+// unwrapUnion returns the value held by a union literal, the literal itself otherwise.
+func unwrapUnion(literal *core.Literal) *core.Literal {
+	for literal.GetScalar().GetUnion() != nil {
+		literal = literal.GetScalar().GetUnion().GetValue()
+	}
+
+	return literal
+}
+
+// isNull returns whether the literal is none, e.g. an unset optional input or a null attribute of a struct.
+func isNull(literal *core.Literal) bool {
+	return literal.GetValue() == nil || literal.GetScalar().GetNoneType() != nil
+}
+
+// promoteNumbers converts an integer compared to a float to a float. Numbers resolved from structs are always floats.
+func promoteNumbers(lValue *core.Literal, rValue *core.Literal) (*core.Literal, *core.Literal) {
+	lPrim, rPrim := lValue.GetScalar().GetPrimitive(), rValue.GetScalar().GetPrimitive()
+	toFloat := func(p *core.Primitive) *core.Literal {
+		return &core.Literal{Value: &core.Literal_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_Primitive{
+			Primitive: &core.Primitive{Value: &core.Primitive_FloatValue{FloatValue: float64(p.GetInteger())}},
+		}}}}
+	}
+
+	if _, ok := lPrim.GetValue().(*core.Primitive_Integer); ok {
+		if _, ok := rPrim.GetValue().(*core.Primitive_FloatValue); ok {
+			return toFloat(lPrim), rValue
 		}
-		rValue = nodeInputs.GetLiterals()[expr.GetRightValue().GetVar()]
-		if rValue == nil {
-			return false, errors.Errorf(ErrorCodeMalformedBranch, "Failed to find Value for Variable [%v]", expr.GetRightValue().GetVar())
+	} else if _, ok := lPrim.GetValue().(*core.Primitive_FloatValue); ok {
+		if _, ok := rPrim.GetValue().(*core.Primitive_Integer); ok {
+			return lValue, toFloat(rPrim)
 		}
-	} else {
-		rPrim = expr.GetRightValue().GetPrimitive()
 	}
 
-	if lValue != nil && rValue != nil {
-		return EvaluateLiterals(lValue, rValue, expr.GetOperator())
+	return lValue, rValue
+}
+
+func EvaluateComparison(expr *core.ComparisonExpression, nodeInputs *core.LiteralMap) (bool, error) {
+	lValue, err := resolveOperand(expr.GetLeftValue(), nodeInputs)
+	if err != nil {
+		return false, err
+	}
+
+	switch op := expr.GetOperator(); op {
+	case core.ComparisonExpression_IS_NULL, core.ComparisonExpression_IS_NOT_NULL:
+		return isNull(lValue) == (op == core.ComparisonExpression_IS_NULL), nil
 	}
-	if lValue != nil && rPrim != nil {
-		return Evaluate2(lValue, rPrim, expr.GetOperator())
+
+	rValue, err := resolveOperand(expr.GetRightValue(), nodeInputs)
+	if err != nil {
+		return false, err
 	}
-	if lPrim != nil && rValue != nil {
-		return Evaluate1(lPrim, rValue, expr.GetOperator())
+
+	switch op := expr.GetOperator(); op {
+	case core.ComparisonExpression_IN, core.ComparisonExpression_NOT_IN:
+		if lValue.GetScalar().GetPrimitive() == nil {
+			return false, errors.Errorf(ErrorCodeMalformedBranch, "Only primitives can be compared. LHS Variable is non primitive.")
+		}
+		if rValue.GetCollection() == nil {
+			return false, errors.Errorf(ErrorCodeMalformedBranch, "[%v] requires a collection as the right value.", op)
+		}
+		in, err := EvaluateIn(lValue.GetScalar().GetPrimitive(), rValue.GetCollection())
+		return in == (op == core.ComparisonExpression_IN), err
+	case core.ComparisonExpression_STARTS_WITH, core.ComparisonExpression_ENDS_WITH, core.ComparisonExpression_MATCHES:
+		return EvaluateString(lValue.GetScalar().GetPrimitive(), rValue.GetScalar().GetPrimitive(), op)
 	}
-	return Evaluate(lPrim, rPrim, expr.GetOperator())
+
+	lValue, rValue = promoteNumbers(lValue, rValue)
+	return EvaluateLiterals(lValue, rValue, expr.GetOperator())
 }
 
 func EvaluateBooleanExpression(expr *core.BooleanExpression, nodeInputs *core.LiteralMap) (bool, error) {
 	if expr.GetComparison() != nil {
 		return EvaluateComparison(expr.GetComparison(), nodeInputs)
 	}
+	if expr.GetNegation() != nil {
+		value, err := EvaluateBooleanExpression(expr.GetNegation().GetExpression(), nodeInputs)
+		if err != nil {
+			return false, err
+		}
+		return !value, nil
+	}
 	if expr.GetConjunction() == nil {
 		return false, errors.Errorf(ErrorCodeMalformedBranch, "No Comparison or Conjunction found in Branch node expression.")
 	}
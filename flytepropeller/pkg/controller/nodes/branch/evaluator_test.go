@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/shamaton/msgpack/v2"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
@@ -688,3 +690,100 @@ func TestDecideBranch(t *testing.T) {
 		assert.Equal(t, v1alpha1.NodePhaseSkipped, w.Status.NodeStatus[n2].GetPhase())
 	})
 }
+
+func TestEvaluateComparison_Operators(t *testing.T) {
+	varOperand := func(name string, attrPath ...*core.PromiseAttribute) *core.Operand {
+		return &core.Operand{Val: &core.Operand_Var{Var: name}, AttrPath: attrPath}
+	}
+	stringAttr := func(s string) *core.PromiseAttribute {
+		return &core.PromiseAttribute{Value: &core.PromiseAttribute_StringValue{StringValue: s}}
+	}
+	intAttr := func(i int32) *core.PromiseAttribute {
+		return &core.PromiseAttribute{Value: &core.PromiseAttribute_IntValue{IntValue: i}}
+	}
+
+	st, err := structpb.NewStruct(map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{3, "x"}}, "c": nil})
+	assert.NoError(t, err)
+	packed, err := msgpack.Marshal(map[string]interface{}{"model": map[string]interface{}{"name": "resnet50", "layers": 50}})
+	assert.NoError(t, err)
+	inputs := &core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			"x":        coreutils.MustMakePrimitiveLiteral("flyte-task"),
+			"n":        coreutils.MustMakePrimitiveLiteral(2),
+			"list":     coreutils.MustMakeLiteral([]interface{}{1, 2, 3}),
+			"none":     coreutils.MustMakeLiteral(nil),
+			"struct":   coreutils.MakeGenericLiteral(st),
+			"binary":   coreutils.MakeBinaryLiteral(packed),
+			"optional": {Value: &core.Literal_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_Union{Union: &core.Union{Value: coreutils.MustMakeLiteral(nil)}}}}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		expr     *core.ComparisonExpression
+		expected bool
+	}{
+		{"In collection variable", &core.ComparisonExpression{Operator: core.ComparisonExpression_IN, LeftValue: varOperand("n"), RightValue: varOperand("list")}, true},
+		{"Not in collection variable", &core.ComparisonExpression{Operator: core.ComparisonExpression_NOT_IN, LeftValue: varOperand("n"), RightValue: varOperand("list")}, false},
+		{"In constant collection", &core.ComparisonExpression{Operator: core.ComparisonExpression_IN, LeftValue: varOperand("x"),
+			RightValue: &core.Operand{Val: &core.Operand_Collection{Collection: coreutils.MustMakeLiteral([]interface{}{"a", "b"}).GetCollection()}}}, false},
+		{"Starts with", &core.ComparisonExpression{Operator: core.ComparisonExpression_STARTS_WITH, LeftValue: varOperand("x"),
+			RightValue: &core.Operand{Val: &core.Operand_Primitive{Primitive: coreutils.MustMakePrimitive("flyte")}}}, true},
+		{"Ends with", &core.ComparisonExpression{Operator: core.ComparisonExpression_ENDS_WITH, LeftValue: varOperand("x"),
+			RightValue: &core.Operand{Val: &core.Operand_Primitive{Primitive: coreutils.MustMakePrimitive("flyte")}}}, false},
+		{"Matches", &core.ComparisonExpression{Operator: core.ComparisonExpression_MATCHES, LeftValue: varOperand("x"),
+			RightValue: &core.Operand{Val: &core.Operand_Scalar{Scalar: coreutils.MustMakeLiteral("^fl[a-z]+-task$").GetScalar()}}}, true},
+		{"Is null", &core.ComparisonExpression{Operator: core.ComparisonExpression_IS_NULL, LeftValue: varOperand("none")}, true},
+		{"Is null optional", &core.ComparisonExpression{Operator: core.ComparisonExpression_IS_NULL, LeftValue: varOperand("optional")}, true},
+		{"Is not null", &core.ComparisonExpression{Operator: core.ComparisonExpression_IS_NOT_NULL, LeftValue: varOperand("x")}, true},
+		{"Is null struct attribute", &core.ComparisonExpression{Operator: core.ComparisonExpression_IS_NULL, LeftValue: varOperand("struct", stringAttr("c"))}, true},
+		{"Struct attribute", &core.ComparisonExpression{Operator: core.ComparisonExpression_EQ, LeftValue: varOperand("struct", stringAttr("a"), stringAttr("b"), intAttr(0)),
+			RightValue: &core.Operand{Val: &core.Operand_Primitive{Primitive: coreutils.MustMakePrimitive(3)}}}, true},
+		{"Binary attribute", &core.ComparisonExpression{Operator: core.ComparisonExpression_GTE, LeftValue: varOperand("binary", stringAttr("model"), stringAttr("layers")),
+			RightValue: &core.Operand{Val: &core.Operand_Primitive{Primitive: coreutils.MustMakePrimitive(34)}}}, true},
+		{"Binary attribute in collection", &core.ComparisonExpression{Operator: core.ComparisonExpression_IN, LeftValue: varOperand("binary", stringAttr("model"), stringAttr("name")),
+			RightValue: &core.Operand{Val: &core.Operand_Collection{Collection: coreutils.MustMakeLiteral([]interface{}{"resnet50", "vit"}).GetCollection()}}}, true},
+		{"Collection attribute", &core.ComparisonExpression{Operator: core.ComparisonExpression_EQ, LeftValue: varOperand("list", intAttr(2)), RightValue: varOperand("n")}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := EvaluateComparison(tc.expr, inputs)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, v)
+		})
+	}
+
+	for _, tc := range []struct {
+		name string
+		expr *core.ComparisonExpression
+	}{
+		{"In non collection", &core.ComparisonExpression{Operator: core.ComparisonExpression_IN, LeftValue: varOperand("n"), RightValue: varOperand("n")}},
+		{"Starts with non string", &core.ComparisonExpression{Operator: core.ComparisonExpression_STARTS_WITH, LeftValue: varOperand("n"), RightValue: varOperand("x")}},
+		{"Invalid regex", &core.ComparisonExpression{Operator: core.ComparisonExpression_MATCHES, LeftValue: varOperand("x"),
+			RightValue: &core.Operand{Val: &core.Operand_Primitive{Primitive: coreutils.MustMakePrimitive("fl(")}}}},
+		{"Missing attribute", &core.ComparisonExpression{Operator: core.ComparisonExpression_IS_NULL, LeftValue: varOperand("struct", stringAttr("d"))}},
+		{"Attribute of primitive", &core.ComparisonExpression{Operator: core.ComparisonExpression_IS_NULL, LeftValue: varOperand("x", stringAttr("d"))}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := EvaluateComparison(tc.expr, inputs)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEvaluateBooleanExpression_Negation(t *testing.T) {
+	ce, inputs := getComparisonExpression(1, core.ComparisonExpression_EQ, 1)
+	exp := &core.BooleanExpression{
+		Expr: &core.BooleanExpression_Negation{
+			Negation: &core.NegationExpression{
+				Expression: &core.BooleanExpression{Expr: &core.BooleanExpression_Comparison{Comparison: ce}},
+			},
+		},
+	}
+	v, err := EvaluateBooleanExpression(exp, inputs)
+	assert.NoError(t, err)
+	assert.False(t, v)
+
+	exp.GetNegation().Expression = nil
+	_, err = EvaluateBooleanExpression(exp, inputs)
+	assert.Error(t, err)
+}
@@ -57,13 +57,13 @@ func (m *CatalogClient) GetDataset(ctx context.Context, key catalog.Key) (*datac
 	return datasetResponse.GetDataset(), nil
 }
 
-// GetArtifactByTag retrieves an artifact using the provided tag and dataset.
-func (m *CatalogClient) GetArtifactByTag(ctx context.Context, tagName string, dataset *datacatalog.Dataset) (*datacatalog.Artifact, error) {
-	logger.Debugf(ctx, "Get Artifact by tag %v", tagName)
+// LookupArtifact retrieves an artifact using the provided tag and dataset ID regardless of the max cache age.
+func (m *CatalogClient) LookupArtifact(ctx context.Context, datasetID *datacatalog.DatasetID, tag string) (*datacatalog.Artifact, error) {
+	logger.Debugf(ctx, "Get Artifact by tag %v", tag)
 	artifactQuery := &datacatalog.GetArtifactRequest{
-		Dataset: dataset.GetId(),
+		Dataset: datasetID,
 		QueryHandle: &datacatalog.GetArtifactRequest_TagName{
-			TagName: tagName,
+			TagName: tag,
 		},
 	}
 	response, err := m.client.GetArtifact(ctx, artifactQuery)
@@ -71,9 +71,18 @@ func (m *CatalogClient) GetArtifactByTag(ctx context.Context, tagName string, da
 		return nil, err
 	}
 
+	return response.GetArtifact(), nil
+}
+
+// GetArtifactByTag retrieves an artifact using the provided tag and dataset.
+func (m *CatalogClient) GetArtifactByTag(ctx context.Context, tagName string, dataset *datacatalog.Dataset) (*datacatalog.Artifact, error) {
+	artifact, err := m.LookupArtifact(ctx, dataset.GetId(), tagName)
+	if err != nil {
+		return nil, err
+	}
+
 	// check artifact's age if the configuration specifies a max age
 	if m.maxCacheAge > time.Duration(0) {
-		artifact := response.GetArtifact()
 		createdAt, err := ptypes.Timestamp(artifact.GetCreatedAt())
 		if err != nil {
 			logger.Errorf(ctx, "DataCatalog Artifact has invalid createdAt %+v, err: %+v", artifact.GetCreatedAt(), err)
@@ -87,7 +96,7 @@ func (m *CatalogClient) GetArtifactByTag(ctx context.Context, tagName string, da
 		}
 	}
 
-	return response.GetArtifact(), nil
+	return artifact, nil
 }
 
 // Get the cached task execution from Catalog.
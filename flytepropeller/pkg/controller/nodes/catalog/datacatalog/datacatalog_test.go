@@ -389,6 +389,51 @@ func TestCatalog_Get(t *testing.T) {
 	})
 }
 
+func TestCatalog_LookupArtifact(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Found expired artifact", func(t *testing.T) {
+		mockClient := &mocks.DataCatalogClient{}
+		catalogClient := &CatalogClient{
+			client:      mockClient,
+			maxCacheAge: time.Hour,
+		}
+
+		createdAt, err := ptypes.TimestampProto(time.Now().Add(time.Minute * -61))
+		assert.NoError(t, err)
+
+		sampleArtifact := &datacatalog.Artifact{
+			Id:        "test-artifact",
+			Dataset:   datasetID,
+			CreatedAt: createdAt,
+		}
+		mockClient.On("GetArtifact",
+			ctx,
+			mock.MatchedBy(func(o *datacatalog.GetArtifactRequest) bool {
+				assert.EqualValues(t, datasetID, o.GetDataset())
+				assert.Equal(t, "latest", o.GetTagName())
+				return true
+			}),
+		).Return(&datacatalog.GetArtifactResponse{Artifact: sampleArtifact}, nil)
+
+		artifact, err := catalogClient.LookupArtifact(ctx, datasetID, "latest")
+		assert.NoError(t, err)
+		assert.Equal(t, "test-artifact", artifact.GetId())
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockClient := &mocks.DataCatalogClient{}
+		catalogClient := &CatalogClient{
+			client: mockClient,
+		}
+
+		mockClient.On("GetArtifact", ctx, mock.Anything).Return(nil, status.Error(codes.NotFound, "test not found"))
+
+		_, err := catalogClient.LookupArtifact(ctx, datasetID, "latest")
+		assert.True(t, catalog.IsNotFound(err))
+	})
+}
+
 func TestCatalog_Put(t *testing.T) {
 	ctx := context.Background()
 
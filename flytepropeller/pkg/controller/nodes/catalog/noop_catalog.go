@@ -4,6 +4,9 @@ import (
 	"context"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
@@ -38,3 +41,7 @@ func (n NOOPCatalog) GetOrExtendReservation(_ context.Context, _ catalog.Key, _
 func (n NOOPCatalog) ReleaseReservation(_ context.Context, _ catalog.Key, _ string) error {
 	return nil
 }
+
+func (n NOOPCatalog) LookupArtifact(_ context.Context, _ *datacatalog.DatasetID, _ string) (*datacatalog.Artifact, error) {
+	return nil, status.Error(codes.Unimplemented, "catalog is disabled")
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
 	"github.com/flyteorg/flyte/flytepropeller/events"
 	eventsErr "github.com/flyteorg/flyte/flytepropeller/events/errors"
@@ -494,6 +495,7 @@ type nodeExecutor struct {
 	literalOffloadingConfig         config.LiteralOffloadingConfig
 	interruptibleFailureThreshold   int32
 	maxNodeRetriesForSystemFailures uint32
+	maxNodeRetriesForPreemptions    uint32
 	metrics                         *nodeMetrics
 	nodeRecorder                    events.NodeEventRecorder
 	outputResolver                  OutputResolver
@@ -805,10 +807,23 @@ func isTimeoutExpired(queuedAt *metav1.Time, timeout time.Duration) bool {
 	return false
 }
 
+// isRetriedPreemption returns whether the error is a preemption retried within the preemption budget. Preemptions past
+// the budget are accounted for as system failures.
+func (c *nodeExecutor) isRetriedPreemption(nodeStatus v1alpha1.ExecutableNodeStatus, err *core.ExecutionError) bool {
+	return err.GetCode() == flytek8s.Preempted && nodeStatus.GetPreemptions() < c.maxNodeRetriesForPreemptions
+}
+
 func (c *nodeExecutor) isEligibleForRetry(nCtx interfaces.NodeExecutionContext, nodeStatus v1alpha1.ExecutableNodeStatus, err *core.ExecutionError) (currentAttempt uint32, maxAttempts uint32, isEligible bool) {
 	if config.GetConfig().NodeConfig.IgnoreRetryCause {
 		currentAttempt = nodeStatus.GetAttempts() + 1
 	} else {
+		if c.isRetriedPreemption(nodeStatus, err) {
+			currentAttempt = nodeStatus.GetPreemptions()
+			maxAttempts = c.maxNodeRetriesForPreemptions
+			isEligible = true
+			return
+		}
+
 		if err.GetKind() == core.ExecutionError_SYSTEM {
 			currentAttempt = nodeStatus.GetSystemFailures()
 			maxAttempts = c.maxNodeRetriesForSystemFailures
@@ -816,7 +831,7 @@ func (c *nodeExecutor) isEligibleForRetry(nCtx interfaces.NodeExecutionContext,
 			return
 		}
 
-		currentAttempt = (nodeStatus.GetAttempts() + 1) - nodeStatus.GetSystemFailures()
+		currentAttempt = (nodeStatus.GetAttempts() + 1) - nodeStatus.GetSystemFailures() - nodeStatus.GetPreemptions()
 	}
 	maxAttempts = uint32(config.GetConfig().NodeConfig.DefaultMaxAttempts) // #nosec G115
 	if nCtx.Node().GetRetryStrategy() != nil && nCtx.Node().GetRetryStrategy().MinAttempts != nil && *nCtx.Node().GetRetryStrategy().MinAttempts != 1 {
@@ -872,6 +887,11 @@ func (c *nodeExecutor) execute(ctx context.Context, h interfaces.NodeHandler, nC
 
 		// Retrying to clearing all status
 		nCtx.NodeStateWriter().ClearNodeStatus()
+
+		// OOMKilled attempts are tracked across retries to escalate the memory of the following attempts
+		if phase.GetErr().GetCode() == flytek8s.OOMKilled {
+			nodeStatus.IncrementOOMFailures()
+		}
 	}
 
 	return phase, nil
@@ -1195,7 +1215,10 @@ func (c *nodeExecutor) handleQueuedOrRunningNode(ctx context.Context, nCtx inter
 			startTime = lastAttemptStartTime.Time
 		}
 
-		if execErr.GetKind() == core.ExecutionError_SYSTEM {
+		if c.isRetriedPreemption(nodeStatus, execErr) {
+			// preempted attempts are retried from their checkpoint, they aren't accounted for as failures
+			nodeStatus.IncrementPreemptions()
+		} else if execErr.GetKind() == core.ExecutionError_SYSTEM {
 			nodeStatus.IncrementSystemFailures()
 			c.metrics.SystemErrorDuration.Observe(ctx, startTime, endTime)
 		} else if execErr.GetKind() == core.ExecutionError_USER {
@@ -1484,6 +1507,7 @@ func NewExecutor(ctx context.Context, nodeConfig config.NodeConfig, store *stora
 		literalOffloadingConfig:         literalOffloadingConfig,
 		interruptibleFailureThreshold:   nodeConfig.InterruptibleFailureThreshold,
 		maxNodeRetriesForSystemFailures: uint32(nodeConfig.MaxNodeRetriesOnSystemFailures), // #nosec G115
+		maxNodeRetriesForPreemptions:    uint32(nodeConfig.MaxNodeRetriesOnPreemptions),    // #nosec G115
 		metrics:                         metrics,
 		nodeRecorder:                    events.NewNodeEventRecorder(eventSink, nodeScope, store),
 		outputResolver:                  NewRemoteFileOutputResolver(store),
@@ -21,6 +21,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
 	pluginscatalog "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
 	catalogmocks "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog/mocks"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
 	mocks3 "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/events"
 	eventsErr "github.com/flyteorg/flyte/flytepropeller/events/errors"
@@ -1501,6 +1502,7 @@ func Test_nodeExecutor_timeout(t *testing.T) {
 	ns.On("GetLastAttemptStartedAt").Return(queuedAtTime)
 	ns.OnGetAttempts().Return(0)
 	ns.OnGetSystemFailures().Return(0)
+	ns.OnGetPreemptions().Return(0)
 	ns.On("ClearLastAttemptStartedAt").Return()
 
 	for _, tt := range tests {
@@ -1551,6 +1553,7 @@ func Test_nodeExecutor_system_error(t *testing.T) {
 	ns := &mocks.ExecutableNodeStatus{}
 	ns.OnGetAttempts().Return(0)
 	ns.OnGetSystemFailures().Return(0)
+	ns.OnGetPreemptions().Return(0)
 	ns.On("GetQueuedAt").Return(&v1.Time{Time: time.Now()})
 	ns.On("GetLastAttemptStartedAt").Return(&v1.Time{Time: time.Now()})
 
@@ -1582,6 +1585,86 @@ func Test_nodeExecutor_system_error(t *testing.T) {
 	assert.Equal(t, core.ExecutionError_SYSTEM, phaseInfo.GetErr().GetKind())
 }
 
+func Test_nodeExecutor_oom_error(t *testing.T) {
+	phaseInfo := handler.PhaseInfoRetryableFailureErr(&core.ExecutionError{Code: "OOMKilled", Message: "test", Kind: core.ExecutionError_USER}, nil)
+
+	// mocking status
+	ns := &mocks.ExecutableNodeStatus{}
+	ns.OnGetAttempts().Return(0)
+	ns.OnGetSystemFailures().Return(0)
+	ns.OnGetPreemptions().Return(0)
+	ns.On("GetQueuedAt").Return(&v1.Time{Time: time.Now()})
+	ns.On("GetLastAttemptStartedAt").Return(&v1.Time{Time: time.Now()})
+	ns.On("ClearLastAttemptStartedAt").Return()
+	ns.OnIncrementOOMFailures().Return(1)
+
+	c := &nodeExecutor{}
+	h := &nodemocks.NodeHandler{}
+	h.On("Handle",
+		mock.MatchedBy(func(ctx context.Context) bool { return true }),
+		mock.MatchedBy(func(o interfaces.NodeExecutionContext) bool { return true }),
+	).Return(handler.DoTransition(handler.TransitionTypeEphemeral, phaseInfo), nil)
+
+	mockNode := &mocks.ExecutableNode{}
+	mockNode.On("GetID").Return("node")
+	mockNode.On("GetActiveDeadline").Return(nil)
+	mockNode.On("GetExecutionDeadline").Return(nil)
+	retries := 2
+	mockNode.OnGetRetryStrategy().Return(&v1alpha1.RetryStrategy{MinAttempts: &retries})
+
+	nCtx := &nodeExecContext{node: mockNode, nsm: &nodeStateManager{nodeStatus: ns}}
+	phaseInfo, err := c.execute(context.TODO(), h, nCtx, ns)
+	assert.NoError(t, err)
+	assert.Equal(t, handler.EPhaseRetryableFailure, phaseInfo.GetPhase())
+	ns.AssertCalled(t, "IncrementOOMFailures")
+}
+
+func Test_nodeExecutor_preempted(t *testing.T) {
+	defer func(ignoreRetryCause bool, defaultMaxAttempts int32) {
+		config.GetConfig().NodeConfig.IgnoreRetryCause = ignoreRetryCause
+		config.GetConfig().NodeConfig.DefaultMaxAttempts = defaultMaxAttempts
+	}(config.GetConfig().NodeConfig.IgnoreRetryCause, config.GetConfig().NodeConfig.DefaultMaxAttempts)
+	config.GetConfig().NodeConfig.IgnoreRetryCause = false
+	config.GetConfig().NodeConfig.DefaultMaxAttempts = 2
+
+	preempted := &core.ExecutionError{Code: flytek8s.Preempted, Message: "test", Kind: core.ExecutionError_SYSTEM}
+	c := &nodeExecutor{maxNodeRetriesForSystemFailures: 1, maxNodeRetriesForPreemptions: 2}
+	node := &mocks.ExecutableNode{}
+	node.OnGetRetryStrategy().Return(nil)
+	nCtx := &nodeExecContext{node: node}
+
+	t.Run("within preemption budget", func(t *testing.T) {
+		nodeStatus := &mocks.ExecutableNodeStatus{}
+		nodeStatus.OnGetPreemptions().Return(1)
+		nodeStatus.OnGetSystemFailures().Return(1)
+
+		currentAttempt, maxAttempts, isEligible := c.isEligibleForRetry(nCtx, nodeStatus, preempted)
+		assert.True(t, isEligible)
+		assert.Equal(t, uint32(1), currentAttempt)
+		assert.Equal(t, uint32(2), maxAttempts)
+	})
+
+	t.Run("preemption budget exhausted", func(t *testing.T) {
+		nodeStatus := &mocks.ExecutableNodeStatus{}
+		nodeStatus.OnGetPreemptions().Return(2)
+		nodeStatus.OnGetSystemFailures().Return(1)
+
+		_, _, isEligible := c.isEligibleForRetry(nCtx, nodeStatus, preempted)
+		assert.False(t, isEligible)
+	})
+
+	t.Run("user retries exclude preemptions", func(t *testing.T) {
+		nodeStatus := &mocks.ExecutableNodeStatus{}
+		nodeStatus.OnGetAttempts().Return(2)
+		nodeStatus.OnGetPreemptions().Return(2)
+		nodeStatus.OnGetSystemFailures().Return(0)
+
+		currentAttempt, _, isEligible := c.isEligibleForRetry(nCtx, nodeStatus, &core.ExecutionError{Kind: core.ExecutionError_USER})
+		assert.True(t, isEligible)
+		assert.Equal(t, uint32(1), currentAttempt)
+	})
+}
+
 func Test_nodeExecutor_abort(t *testing.T) {
 	ctx := context.Background()
 	exec := nodeExecutor{}
@@ -2849,6 +2932,7 @@ func TestNodeExecutor_IsEligibleForRetry(t *testing.T) {
 			nodeStatus := &mocks.ExecutableNodeStatus{}
 			nodeStatus.OnGetAttempts().Return(test.attempts)
 			nodeStatus.OnGetSystemFailures().Return(test.systemFailures)
+			nodeStatus.OnGetPreemptions().Return(0)
 
 			err := &core.ExecutionError{
 				Kind: test.errorKind,
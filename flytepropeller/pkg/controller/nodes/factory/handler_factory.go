@@ -64,7 +64,7 @@ func (f *handlerFactory) Setup(ctx context.Context, executor interfaces.Node, se
 		v1alpha1.NodeKindBranch:   branch.New(executor, f.eventConfig, f.scope),
 		v1alpha1.NodeKindTask:     dynamic.New(t, executor, f.launchPlanReader, f.eventConfig, f.scope),
 		v1alpha1.NodeKindWorkflow: subworkflow.New(executor, f.workflowLauncher, f.recoveryClient, f.eventConfig, f.scope),
-		v1alpha1.NodeKindGate:     gate.New(f.eventConfig, f.signalClient, f.scope),
+		v1alpha1.NodeKindGate:     gate.New(f.eventConfig, f.signalClient, f.catalogClient, f.scope),
 		v1alpha1.NodeKindArray:    arrayHandler,
 		v1alpha1.NodeKindStart:    start.New(),
 		v1alpha1.NodeKindEnd:      end.New(),
@@ -3,11 +3,18 @@ package gate
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/errors"
@@ -18,6 +25,11 @@ import (
 	"github.com/flyteorg/flyte/flytestdlib/storage"
 )
 
+const (
+	defaultPollInterval    = 10 * time.Second
+	defaultMaxPollInterval = 5 * time.Minute
+)
+
 //go:generate mockery -all -case=underscore
 
 // SignalServiceClient is a SignalServiceClient wrapper interface used specifically for generating
@@ -28,8 +40,10 @@ type SignalServiceClient interface {
 
 // gateNodeHandler is a handle implementation for processing gate nodes
 type gateNodeHandler struct {
-	signalClient SignalServiceClient
-	metrics      metrics
+	signalClient  SignalServiceClient
+	catalogClient catalog.Client
+	cfg           config.GateNodeConfig
+	metrics       metrics
 }
 
 // metrics encapsulates the prometheus metrics for this handler
@@ -91,6 +105,7 @@ func (g *gateNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecut
 					Simple: core.SimpleType_BOOLEAN,
 				},
 			},
+			ApprovalPolicy: approveCondition.GetPolicy(),
 		}
 
 		signal, err := g.signalClient.GetOrCreateSignal(ctx, request)
@@ -188,6 +203,67 @@ func (g *gateNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecut
 		if lastAttemptStartedAt != nil && sleepDuration <= time.Since(lastAttemptStartedAt.Time) {
 			return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoSuccess(&handler.ExecutionInfo{})), nil
 		}
+	case v1alpha1.ConditionKindWaitUntil:
+		// retrieve wait until condition
+		waitUntilCondition := gateNode.GetWaitUntil()
+		if waitUntilCondition == nil {
+			errMsg := "gateNode wait until condition is nil"
+			return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(core.ExecutionError_SYSTEM,
+				errors.BadSpecificationError, errMsg, nil)), nil
+		}
+
+		inputs, err := nCtx.InputReader().Get(ctx)
+		if err != nil {
+			errMsg := fmt.Sprintf("failed to read input with error [%s]", err)
+			return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(core.ExecutionError_SYSTEM, errors.RuntimeExecutionError, errMsg, nil)), nil
+		}
+
+		until, ok := getDatetime(inputs.GetLiterals()[waitUntilCondition.GetInputVariableName()])
+		if !ok {
+			errMsg := fmt.Sprintf("gateNode wait until input [%v] is not a datetime", waitUntilCondition.GetInputVariableName())
+			return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(core.ExecutionError_USER,
+				errors.BadSpecificationError, errMsg, nil)), nil
+		}
+
+		if !time.Now().Before(until) {
+			return writeConditionOutput(ctx, nCtx, waitUntilCondition.GetOutputVariableName(), coreutils.MustMakeLiteral(true)), nil
+		}
+
+		if transition, timedOut := checkTimeout(ctx, nCtx, waitUntilCondition.GetOutputVariableName(), waitUntilCondition.GetTimeout()); timedOut {
+			return transition, nil
+		}
+	case v1alpha1.ConditionKindExternal:
+		// retrieve external condition
+		externalCondition := gateNode.GetExternal()
+		if externalCondition == nil {
+			errMsg := "gateNode external condition is nil"
+			return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(core.ExecutionError_SYSTEM,
+				errors.BadSpecificationError, errMsg, nil)), nil
+		}
+
+		// poll the condition with exponential backoff, the first poll happens right away
+		if time.Since(gateNodeState.LastPolledAt) >= pollInterval(externalCondition, gateNodeState.PollAttempts) {
+			met, err := g.isExternalConditionMet(ctx, nCtx, externalCondition)
+			if err != nil {
+				if errors.Matches(err, errors.BadSpecificationError) {
+					return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(core.ExecutionError_USER,
+						errors.BadSpecificationError, err.Error(), nil)), nil
+				}
+
+				return handler.UnknownTransition, err
+			}
+
+			if met {
+				return writeConditionOutput(ctx, nCtx, externalCondition.GetOutputVariableName(), coreutils.MustMakeLiteral(true)), nil
+			}
+
+			gateNodeState.PollAttempts++
+			gateNodeState.LastPolledAt = time.Now()
+		}
+
+		if transition, timedOut := checkTimeout(ctx, nCtx, externalCondition.GetOutputVariableName(), externalCondition.GetTimeout()); timedOut {
+			return transition, nil
+		}
 	default:
 		errMsg := "gateNode does not have a supported condition reference"
 		return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(core.ExecutionError_SYSTEM,
@@ -203,18 +279,171 @@ func (g *gateNodeHandler) Handle(ctx context.Context, nCtx interfaces.NodeExecut
 	return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoRunning(&handler.ExecutionInfo{})), nil
 }
 
+// isExternalConditionMet polls the external condition once. Errors in the specification of the condition are returned
+// as BadSpecificationError.
+func (g *gateNodeHandler) isExternalConditionMet(ctx context.Context, nCtx interfaces.NodeExecutionContext,
+	externalCondition *core.ExternalCondition) (bool, error) {
+
+	nodeID := nCtx.NodeID()
+	if storageObject := externalCondition.GetStorageObject(); storageObject != nil {
+		uri := storageObject.GetUri()
+		if len(uri) == 0 {
+			inputs, err := nCtx.InputReader().Get(ctx)
+			if err != nil {
+				return false, err
+			}
+
+			var ok bool
+			uri, ok = getString(inputs.GetLiterals()[storageObject.GetUriInputVariableName()])
+			if !ok {
+				return false, errors.Errorf(errors.BadSpecificationError, nodeID,
+					"gateNode storage object input [%v] is not a string", storageObject.GetUriInputVariableName())
+			}
+		}
+
+		// only objects the execution is expected to reach are polled, which keeps gates from probing arbitrary buckets
+		// with the credentials of propeller
+		allowedPrefixes := append([]string{nCtx.RawOutputPrefix().String()}, g.cfg.AllowedStoragePrefixes...)
+		if !isUnderPrefix(uri, allowedPrefixes) {
+			return false, errors.Errorf(errors.BadSpecificationError, nodeID,
+				"gateNode storage object [%v] is neither under the raw output prefix of the execution nor under an allowed storage prefix", uri)
+		}
+
+		metadata, err := nCtx.DataStore().Head(ctx, storage.DataReference(uri))
+		if err != nil {
+			return false, err
+		}
+
+		return metadata.Exists(), nil
+	}
+
+	if catalogArtifact := externalCondition.GetCatalogArtifact(); catalogArtifact != nil {
+		datasetID := &datacatalog.DatasetID{
+			Project: catalogArtifact.GetProject(),
+			Domain:  catalogArtifact.GetDomain(),
+			Name:    catalogArtifact.GetName(),
+			Version: catalogArtifact.GetVersion(),
+		}
+
+		// the max cache age of the catalog only applies to cached task outputs, gates wait for artifacts of any age
+		if _, err := g.catalogClient.LookupArtifact(ctx, datasetID, catalogArtifact.GetTag()); err != nil {
+			if catalog.IsNotFound(err) {
+				return false, nil
+			}
+
+			if status.Code(err) == codes.Unimplemented {
+				return false, errors.Errorf(errors.BadSpecificationError, nodeID,
+					"gateNode catalog artifact conditions require a catalog, found error [%v]", err)
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	return false, errors.Errorf(errors.BadSpecificationError, nodeID, "gateNode external condition is not supported")
+}
+
 // Setup handles any initialization requirements for this handler
 func (g *gateNodeHandler) Setup(_ context.Context, _ interfaces.SetupContext) error {
 	return nil
 }
 
 // New initializes a new gateNodeHandler
-func New(eventConfig *config.EventConfig, signalClient service.SignalServiceClient, scope promutils.Scope) interfaces.NodeHandler {
+func New(eventConfig *config.EventConfig, signalClient service.SignalServiceClient, catalogClient catalog.Client,
+	scope promutils.Scope) interfaces.NodeHandler {
+
 	gateScope := scope.NewSubScope("gate")
 	return &gateNodeHandler{
-		signalClient: signalClient,
-		metrics:      newMetrics(gateScope),
+		signalClient:  signalClient,
+		catalogClient: catalogClient,
+		cfg:           config.GetConfig().GateNode,
+		metrics:       newMetrics(gateScope),
+	}
+}
+
+// pollInterval returns the interval to wait for after the given number of polls of the external condition
+func pollInterval(externalCondition *core.ExternalCondition, pollAttempts uint32) time.Duration {
+	interval := defaultPollInterval
+	if externalCondition.GetPollInterval() != nil {
+		interval = externalCondition.GetPollInterval().AsDuration()
 	}
+
+	maxInterval := defaultMaxPollInterval
+	if externalCondition.GetMaxPollInterval() != nil {
+		maxInterval = externalCondition.GetMaxPollInterval().AsDuration()
+	}
+
+	for i := uint32(1); i < pollAttempts && interval < maxInterval; i++ {
+		interval *= 2
+	}
+
+	return min(interval, maxInterval)
+}
+
+// checkTimeout returns the transition of a gate node whose timeout is reached, which either writes the default output
+// or fails the node
+func checkTimeout(ctx context.Context, nCtx interfaces.NodeExecutionContext, outputVariableName string,
+	timeout *core.GateTimeout) (handler.Transition, bool) {
+
+	lastAttemptStartedAt := nCtx.NodeStatus().GetLastAttemptStartedAt()
+	if timeout.GetDuration() == nil || lastAttemptStartedAt == nil || time.Since(lastAttemptStartedAt.Time) < timeout.GetDuration().AsDuration() {
+		return handler.UnknownTransition, false
+	}
+
+	if timeout.GetDefaultOutput() != nil {
+		return writeConditionOutput(ctx, nCtx, outputVariableName, timeout.GetDefaultOutput()), true
+	}
+
+	errMsg := fmt.Sprintf("gateNode condition was not met within [%v]", timeout.GetDuration().AsDuration())
+	return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(core.ExecutionError_USER,
+		"GateTimedOut", errMsg, nil)), true
+}
+
+// writeConditionOutput returns the transition of a gate node whose condition is met, writing the value of the optional
+// output
+func writeConditionOutput(ctx context.Context, nCtx interfaces.NodeExecutionContext, outputVariableName string,
+	value *core.Literal) handler.Transition {
+
+	if len(outputVariableName) == 0 {
+		return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoSuccess(&handler.ExecutionInfo{}))
+	}
+
+	outputs := &core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			outputVariableName: value,
+		},
+	}
+
+	outputFile := v1alpha1.GetOutputsFile(nCtx.NodeStatus().GetOutputDir())
+	if err := nCtx.DataStore().WriteProtobuf(ctx, outputFile, storage.Options{}, outputs); err != nil {
+		return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoFailure(core.ExecutionError_SYSTEM, "WriteOutputsFailed",
+			fmt.Sprintf("failed to write gate output to [%v] with error: %s", outputFile, err.Error()), nil))
+	}
+
+	o := &handler.OutputInfo{OutputURI: outputFile}
+	return handler.DoTransition(handler.TransitionTypeEphemeral, handler.PhaseInfoSuccess(&handler.ExecutionInfo{
+		OutputInfo: o,
+	}))
+}
+
+// isUnderPrefix returns whether the given uri is located under one of the given prefixes. Uris navigating to parent
+// directories are never considered to be under a prefix.
+func isUnderPrefix(uri string, prefixes []string) bool {
+	for _, segment := range strings.Split(uri, "/") {
+		if segment == ".." {
+			return false
+		}
+	}
+
+	for _, prefix := range prefixes {
+		if len(prefix) > 0 && strings.HasPrefix(uri, strings.TrimSuffix(prefix, "/")+"/") {
+			return true
+		}
+	}
+
+	return false
 }
 
 func getBoolean(literal *core.Literal) (bool, bool) {
@@ -228,3 +457,27 @@ func getBoolean(literal *core.Literal) (bool, bool) {
 
 	return false, false
 }
+
+func getString(literal *core.Literal) (string, bool) {
+	if scalarValue, ok := literal.GetValue().(*core.Literal_Scalar); ok {
+		if primitiveValue, ok := scalarValue.Scalar.GetValue().(*core.Scalar_Primitive); ok {
+			if stringValue, ok := primitiveValue.Primitive.GetValue().(*core.Primitive_StringValue); ok {
+				return stringValue.StringValue, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func getDatetime(literal *core.Literal) (time.Time, bool) {
+	if scalarValue, ok := literal.GetValue().(*core.Literal_Scalar); ok {
+		if primitiveValue, ok := scalarValue.Scalar.GetValue().(*core.Scalar_Primitive); ok {
+			if datetimeValue, ok := primitiveValue.Primitive.GetValue().(*core.Primitive_Datetime); ok {
+				return datetimeValue.Datetime.AsTime(), true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -3,21 +3,28 @@ package gate
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	catalogMocks "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog/mocks"
 	ioMocks "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	flyteMocks "github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
 	executormocks "github.com/flyteorg/flyte/flytepropeller/pkg/controller/executors/mocks"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/catalog"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/gate/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/handler"
 	nodeMocks "github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/interfaces/mocks"
@@ -75,12 +82,60 @@ var (
 	}
 )
 
+func newWaitUntilGateNode(timeout *core.GateTimeout) *v1alpha1.GateNodeSpec {
+	return &v1alpha1.GateNodeSpec{
+		Kind: v1alpha1.ConditionKindWaitUntil,
+		WaitUntil: &v1alpha1.WaitUntilCondition{
+			WaitUntilCondition: &core.WaitUntilCondition{
+				InputVariableName:  "until",
+				OutputVariableName: "reached",
+				Timeout:            timeout,
+			},
+		},
+	}
+}
+
+func newExternalGateNode(externalCondition *core.ExternalCondition) *v1alpha1.GateNodeSpec {
+	return &v1alpha1.GateNodeSpec{
+		Kind: v1alpha1.ConditionKindExternal,
+		External: &v1alpha1.ExternalCondition{
+			ExternalCondition: externalCondition,
+		},
+	}
+}
+
+func readBooleanOutput(ctx context.Context, t *testing.T, nCtx *nodeMocks.NodeExecutionContext, name string) bool {
+	outputs := &core.LiteralMap{}
+	assert.NoError(t, nCtx.DataStore().ReadProtobuf(ctx, v1alpha1.GetOutputsFile("data-dir"), outputs))
+	value, ok := getBoolean(outputs.GetLiterals()[name])
+	assert.True(t, ok)
+	return value
+}
+
+func putGateNodeState(t *testing.T, nCtx *nodeMocks.NodeExecutionContext) handler.GateNodeState {
+	w := nCtx.NodeStateWriter().(*nodeMocks.NodeStateWriter)
+	for _, call := range w.Calls {
+		if call.Method == "PutGateNodeState" {
+			return call.Arguments.Get(0).(handler.GateNodeState)
+		}
+	}
+
+	t.Fatal("gate node state was not written")
+	return handler.GateNodeState{}
+}
+
 func init() {
 	labeled.SetMetricKeys(contextutils.ProjectKey, contextutils.DomainKey, contextutils.WorkflowIDKey,
 		contextutils.TaskIDKey)
 }
 
 func createNodeExecutionContext(gateNode *v1alpha1.GateNodeSpec) *nodeMocks.NodeExecutionContext {
+	return createNodeExecutionContextWithState(gateNode, &core.LiteralMap{}, handler.GateNodeState{}, time.Now())
+}
+
+func createNodeExecutionContextWithState(gateNode *v1alpha1.GateNodeSpec, inputs *core.LiteralMap,
+	gateNodeState handler.GateNodeState, startedAt time.Time) *nodeMocks.NodeExecutionContext {
+
 	wfExecID := v1alpha1.WorkflowExecutionIdentifier{
 		WorkflowExecutionIdentifier: &core.WorkflowExecutionIdentifier{
 			Project: "project",
@@ -98,26 +153,28 @@ func createNodeExecutionContext(gateNode *v1alpha1.GateNodeSpec) *nodeMocks.Node
 	ns.OnGetDataDir().Return(storage.DataReference("data-dir"))
 	ns.OnGetOutputDir().Return(storage.DataReference("data-dir"))
 
-	t := v1.NewTime(time.Now())
+	t := v1.NewTime(startedAt)
 	ns.OnGetLastAttemptStartedAt().Return(&t)
 
 	inputReader := &ioMocks.InputReader{}
-	inputReader.OnGetMatch(mock.Anything).Return(&core.LiteralMap{}, nil)
+	inputReader.OnGetMatch(mock.Anything).Return(inputs, nil)
 	dataStore, _ := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
 
 	eCtx := &executormocks.ExecutionContext{}
 	eCtx.OnGetExecutionID().Return(wfExecID)
 
 	nCtx := &nodeMocks.NodeExecutionContext{}
+	nCtx.OnNodeID().Return("n1")
 	nCtx.OnNodeExecutionMetadata().Return(nm)
 	nCtx.OnNode().Return(n)
 	nCtx.OnNodeStatus().Return(ns)
 	nCtx.OnDataStore().Return(dataStore)
+	nCtx.OnRawOutputPrefix().Return(storage.DataReference("s3://bucket/raw"))
 	nCtx.OnExecutionContext().Return(eCtx)
 	nCtx.OnInputReader().Return(inputReader)
 
 	r := &nodeMocks.NodeStateReader{}
-	r.OnGetGateNodeState().Return(handler.GateNodeState{})
+	r.OnGetGateNodeState().Return(gateNodeState)
 	nCtx.OnNodeStateReader().Return(r)
 
 	w := &nodeMocks.NodeStateWriter{}
@@ -131,7 +188,7 @@ func TestAbort(t *testing.T) {
 	signalClient := mocks.SignalServiceClient{}
 	scope := promutils.NewTestScope()
 
-	handler := New(eventConfig, &signalClient, scope)
+	handler := New(eventConfig, &signalClient, nil, scope)
 
 	assert.NoError(t, handler.Abort(ctx, nil, ""))
 }
@@ -141,7 +198,7 @@ func TestFinalize(t *testing.T) {
 	signalClient := mocks.SignalServiceClient{}
 	scope := promutils.NewTestScope()
 
-	handler := New(eventConfig, &signalClient, scope)
+	handler := New(eventConfig, &signalClient, nil, scope)
 
 	assert.NoError(t, handler.Finalize(ctx, nil))
 }
@@ -155,7 +212,7 @@ func TestHandle(t *testing.T) {
 		signalClient := mocks.SignalServiceClient{}
 		signalClient.OnGetOrCreateSignalMatch(mock.Anything, mock.Anything).Return(&admin.Signal{}, nil)
 
-		gateNodeHandler := New(eventConfig, &signalClient, scope)
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
 
 		transition, err := gateNodeHandler.Handle(ctx, nCtx)
 		assert.NoError(t, err)
@@ -181,13 +238,39 @@ func TestHandle(t *testing.T) {
 			},
 		}, nil)
 
-		gateNodeHandler := New(eventConfig, &signalClient, scope)
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
 
 		transition, err := gateNodeHandler.Handle(ctx, nCtx)
 		assert.NoError(t, err)
 		assert.Equal(t, handler.EPhaseSuccess, transition.Info().GetPhase())
 	})
 
+	t.Run("ApprovePolicy", func(t *testing.T) {
+		policy := &core.ApprovalPolicy{
+			MinApprovals:  2,
+			AllowedGroups: []string{"reviewers"},
+		}
+		nCtx := createNodeExecutionContext(&v1alpha1.GateNodeSpec{
+			Kind: v1alpha1.ConditionKindApprove,
+			Approve: &v1alpha1.ApproveCondition{
+				ApproveCondition: &core.ApproveCondition{
+					SignalId: "foo",
+					Policy:   policy,
+				},
+			},
+		})
+		signalClient := mocks.SignalServiceClient{}
+		signalClient.OnGetOrCreateSignalMatch(mock.Anything, mock.MatchedBy(func(request *admin.SignalGetOrCreateRequest) bool {
+			return request.GetApprovalPolicy() == policy
+		})).Return(&admin.Signal{}, nil)
+
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseRunning, transition.Info().GetPhase())
+	})
+
 	t.Run("ApproveRejected", func(t *testing.T) {
 		nCtx := createNodeExecutionContext(approveGateNode)
 		signalClient := mocks.SignalServiceClient{}
@@ -207,7 +290,7 @@ func TestHandle(t *testing.T) {
 			},
 		}, nil)
 
-		gateNodeHandler := New(eventConfig, &signalClient, scope)
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
 
 		transition, err := gateNodeHandler.Handle(ctx, nCtx)
 		assert.NoError(t, err)
@@ -219,7 +302,7 @@ func TestHandle(t *testing.T) {
 		signalClient := mocks.SignalServiceClient{}
 		signalClient.OnGetOrCreateSignalMatch(mock.Anything, mock.Anything).Return(&admin.Signal{}, errors.New("foo"))
 
-		gateNodeHandler := New(eventConfig, &signalClient, scope)
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
 
 		transition, err := gateNodeHandler.Handle(ctx, nCtx)
 		assert.Error(t, err)
@@ -231,7 +314,7 @@ func TestHandle(t *testing.T) {
 		signalClient := mocks.SignalServiceClient{}
 		signalClient.OnGetOrCreateSignalMatch(mock.Anything, mock.Anything).Return(&admin.Signal{}, nil)
 
-		gateNodeHandler := New(eventConfig, &signalClient, scope)
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
 
 		transition, err := gateNodeHandler.Handle(ctx, nCtx)
 		assert.NoError(t, err)
@@ -257,7 +340,7 @@ func TestHandle(t *testing.T) {
 			},
 		}, nil)
 
-		gateNodeHandler := New(eventConfig, &signalClient, scope)
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
 
 		transition, err := gateNodeHandler.Handle(ctx, nCtx)
 		assert.NoError(t, err)
@@ -269,7 +352,7 @@ func TestHandle(t *testing.T) {
 		signalClient := mocks.SignalServiceClient{}
 		signalClient.OnGetOrCreateSignalMatch(mock.Anything, mock.Anything).Return(&admin.Signal{}, errors.New("foo"))
 
-		gateNodeHandler := New(eventConfig, &signalClient, scope)
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
 
 		transition, err := gateNodeHandler.Handle(ctx, nCtx)
 		assert.Error(t, err)
@@ -280,7 +363,7 @@ func TestHandle(t *testing.T) {
 		nCtx := createNodeExecutionContext(sleepMinuteGateNode)
 		signalClient := mocks.SignalServiceClient{}
 
-		gateNodeHandler := New(eventConfig, &signalClient, scope)
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
 
 		transition, err := gateNodeHandler.Handle(ctx, nCtx)
 		assert.NoError(t, err)
@@ -291,10 +374,225 @@ func TestHandle(t *testing.T) {
 		nCtx := createNodeExecutionContext(sleepNowGateNode)
 		signalClient := mocks.SignalServiceClient{}
 
-		gateNodeHandler := New(eventConfig, &signalClient, scope)
+		gateNodeHandler := New(eventConfig, &signalClient, nil, scope)
 
 		transition, err := gateNodeHandler.Handle(ctx, nCtx)
 		assert.NoError(t, err)
 		assert.Equal(t, handler.EPhaseSuccess, transition.Info().GetPhase())
 	})
+
+	t.Run("WaitUntilCheck", func(t *testing.T) {
+		inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"until": coreutils.MustMakeLiteral(time.Now().Add(time.Hour))}}
+		nCtx := createNodeExecutionContextWithState(newWaitUntilGateNode(nil), inputs, handler.GateNodeState{}, time.Now())
+
+		gateNodeHandler := New(eventConfig, &mocks.SignalServiceClient{}, nil, scope)
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseRunning, transition.Info().GetPhase())
+	})
+
+	t.Run("WaitUntilComplete", func(t *testing.T) {
+		inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"until": coreutils.MustMakeLiteral(time.Now().Add(-time.Minute))}}
+		nCtx := createNodeExecutionContextWithState(newWaitUntilGateNode(nil), inputs, handler.GateNodeState{}, time.Now())
+
+		gateNodeHandler := New(eventConfig, &mocks.SignalServiceClient{}, nil, scope)
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseSuccess, transition.Info().GetPhase())
+		assert.True(t, readBooleanOutput(ctx, t, nCtx, "reached"))
+	})
+
+	t.Run("WaitUntilNotDatetime", func(t *testing.T) {
+		inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"until": coreutils.MustMakeLiteral("tomorrow")}}
+		nCtx := createNodeExecutionContextWithState(newWaitUntilGateNode(nil), inputs, handler.GateNodeState{}, time.Now())
+
+		gateNodeHandler := New(eventConfig, &mocks.SignalServiceClient{}, nil, scope)
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseFailed, transition.Info().GetPhase())
+	})
+
+	t.Run("WaitUntilTimeoutDefaultOutput", func(t *testing.T) {
+		timeout := &core.GateTimeout{
+			Duration:      durationpb.New(time.Minute),
+			DefaultOutput: coreutils.MustMakeLiteral(false),
+		}
+
+		inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"until": coreutils.MustMakeLiteral(time.Now().Add(time.Hour))}}
+		nCtx := createNodeExecutionContextWithState(newWaitUntilGateNode(timeout), inputs, handler.GateNodeState{}, time.Now().Add(-2*time.Minute))
+
+		gateNodeHandler := New(eventConfig, &mocks.SignalServiceClient{}, nil, scope)
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseSuccess, transition.Info().GetPhase())
+		assert.False(t, readBooleanOutput(ctx, t, nCtx, "reached"))
+	})
+
+	t.Run("WaitUntilTimeout", func(t *testing.T) {
+		timeout := &core.GateTimeout{
+			Duration: durationpb.New(time.Minute),
+		}
+
+		inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"until": coreutils.MustMakeLiteral(time.Now().Add(time.Hour))}}
+		nCtx := createNodeExecutionContextWithState(newWaitUntilGateNode(timeout), inputs, handler.GateNodeState{}, time.Now().Add(-2*time.Minute))
+
+		gateNodeHandler := New(eventConfig, &mocks.SignalServiceClient{}, nil, scope)
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseFailed, transition.Info().GetPhase())
+		assert.Equal(t, "GateTimedOut", transition.Info().GetErr().GetCode())
+	})
+
+	storageObjectGateNode := newExternalGateNode(&core.ExternalCondition{
+		Condition: &core.ExternalCondition_StorageObject{
+			StorageObject: &core.StorageObjectCondition{
+				UriSource: &core.StorageObjectCondition_UriInputVariableName{UriInputVariableName: "uri"},
+			},
+		},
+		OutputVariableName: "exists",
+	})
+
+	t.Run("ExternalStorageObjectCheck", func(t *testing.T) {
+		inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"uri": coreutils.MustMakeLiteral("s3://bucket/raw/marker")}}
+		nCtx := createNodeExecutionContextWithState(storageObjectGateNode, inputs, handler.GateNodeState{}, time.Now())
+
+		gateNodeHandler := New(eventConfig, &mocks.SignalServiceClient{}, nil, scope)
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseRunning, transition.Info().GetPhase())
+
+		gateNodeState := putGateNodeState(t, nCtx)
+		assert.Equal(t, uint32(1), gateNodeState.PollAttempts)
+		assert.False(t, gateNodeState.LastPolledAt.IsZero())
+	})
+
+	t.Run("ExternalStorageObjectComplete", func(t *testing.T) {
+		inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"uri": coreutils.MustMakeLiteral("s3://bucket/raw/marker")}}
+		nCtx := createNodeExecutionContextWithState(storageObjectGateNode, inputs, handler.GateNodeState{}, time.Now())
+		assert.NoError(t, nCtx.DataStore().WriteRaw(ctx, "s3://bucket/raw/marker", 0, storage.Options{}, strings.NewReader("")))
+
+		gateNodeHandler := New(eventConfig, &mocks.SignalServiceClient{}, nil, scope)
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseSuccess, transition.Info().GetPhase())
+		assert.True(t, readBooleanOutput(ctx, t, nCtx, "exists"))
+	})
+
+	t.Run("ExternalStorageObjectAllowedPrefix", func(t *testing.T) {
+		inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"uri": coreutils.MustMakeLiteral("s3://shared/markers/marker")}}
+		nCtx := createNodeExecutionContextWithState(storageObjectGateNode, inputs, handler.GateNodeState{}, time.Now())
+		assert.NoError(t, nCtx.DataStore().WriteRaw(ctx, "s3://shared/markers/marker", 0, storage.Options{}, strings.NewReader("")))
+
+		gateNodeHandler := &gateNodeHandler{
+			cfg:     config.GateNodeConfig{AllowedStoragePrefixes: []string{"s3://shared/markers/"}},
+			metrics: newMetrics(scope),
+		}
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseSuccess, transition.Info().GetPhase())
+	})
+
+	t.Run("ExternalStorageObjectOutsideAllowedPrefixes", func(t *testing.T) {
+		for _, uri := range []string{"s3://other/marker", "s3://bucket/raw2/marker", "s3://bucket/raw/../secret"} {
+			inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"uri": coreutils.MustMakeLiteral(uri)}}
+			nCtx := createNodeExecutionContextWithState(storageObjectGateNode, inputs, handler.GateNodeState{}, time.Now())
+
+			gateNodeHandler := New(eventConfig, &mocks.SignalServiceClient{}, nil, scope)
+
+			transition, err := gateNodeHandler.Handle(ctx, nCtx)
+			assert.NoError(t, err)
+			assert.Equal(t, handler.EPhaseFailed, transition.Info().GetPhase(), uri)
+		}
+	})
+
+	catalogArtifactGateNode := newExternalGateNode(&core.ExternalCondition{
+		Condition: &core.ExternalCondition_CatalogArtifact{
+			CatalogArtifact: &core.CatalogArtifactCondition{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "dataset",
+				Version: "1",
+				Tag:     "latest",
+			},
+		},
+	})
+
+	t.Run("ExternalCatalogArtifactComplete", func(t *testing.T) {
+		nCtx := createNodeExecutionContextWithState(catalogArtifactGateNode, &core.LiteralMap{}, handler.GateNodeState{}, time.Now())
+		catalogClient := &catalogMocks.Client{}
+		catalogClient.OnLookupArtifactMatch(mock.Anything, mock.MatchedBy(func(datasetID *datacatalog.DatasetID) bool {
+			return datasetID.GetName() == "dataset" && datasetID.GetVersion() == "1"
+		}), "latest").Return(&datacatalog.Artifact{Id: "artifact"}, nil)
+
+		gateNodeHandler := &gateNodeHandler{catalogClient: catalogClient, metrics: newMetrics(scope)}
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseSuccess, transition.Info().GetPhase())
+	})
+
+	t.Run("ExternalCatalogArtifactNotFound", func(t *testing.T) {
+		nCtx := createNodeExecutionContextWithState(catalogArtifactGateNode, &core.LiteralMap{}, handler.GateNodeState{}, time.Now())
+		catalogClient := &catalogMocks.Client{}
+		catalogClient.OnLookupArtifactMatch(mock.Anything, mock.Anything, mock.Anything).Return(nil, status.Error(codes.NotFound, "not found"))
+
+		gateNodeHandler := &gateNodeHandler{catalogClient: catalogClient, metrics: newMetrics(scope)}
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseRunning, transition.Info().GetPhase())
+	})
+
+	t.Run("ExternalCatalogArtifactBackoff", func(t *testing.T) {
+		gateNodeState := handler.GateNodeState{PollAttempts: 3, LastPolledAt: time.Now().Add(-time.Second)}
+		nCtx := createNodeExecutionContextWithState(catalogArtifactGateNode, &core.LiteralMap{}, gateNodeState, time.Now())
+		catalogClient := &catalogMocks.Client{}
+
+		gateNodeHandler := &gateNodeHandler{catalogClient: catalogClient, metrics: newMetrics(scope)}
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseRunning, transition.Info().GetPhase())
+		catalogClient.AssertNotCalled(t, "LookupArtifact", mock.Anything, mock.Anything, mock.Anything)
+		assert.Equal(t, uint32(3), putGateNodeState(t, nCtx).PollAttempts)
+	})
+
+	t.Run("ExternalCatalogArtifactUnsupported", func(t *testing.T) {
+		nCtx := createNodeExecutionContextWithState(catalogArtifactGateNode, &core.LiteralMap{}, handler.GateNodeState{}, time.Now())
+
+		gateNodeHandler := New(eventConfig, &mocks.SignalServiceClient{}, catalog.NOOPCatalog{}, scope)
+
+		transition, err := gateNodeHandler.Handle(ctx, nCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, handler.EPhaseFailed, transition.Info().GetPhase())
+	})
+}
+
+func TestPollInterval(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		externalCondition := &core.ExternalCondition{}
+		assert.Equal(t, defaultPollInterval, pollInterval(externalCondition, 0))
+		assert.Equal(t, defaultPollInterval, pollInterval(externalCondition, 1))
+		assert.Equal(t, 2*defaultPollInterval, pollInterval(externalCondition, 2))
+		assert.Equal(t, defaultMaxPollInterval, pollInterval(externalCondition, 100))
+	})
+
+	t.Run("Configured", func(t *testing.T) {
+		externalCondition := &core.ExternalCondition{
+			PollInterval:    durationpb.New(time.Second),
+			MaxPollInterval: durationpb.New(5 * time.Second),
+		}
+
+		assert.Equal(t, time.Second, pollInterval(externalCondition, 1))
+		assert.Equal(t, 4*time.Second, pollInterval(externalCondition, 3))
+		assert.Equal(t, 5*time.Second, pollInterval(externalCondition, 4))
+	})
 }
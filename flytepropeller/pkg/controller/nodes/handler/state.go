@@ -20,6 +20,7 @@ type TaskNodeState struct {
 	PluginStateVersion                 uint32
 	LastPhaseUpdatedAt                 time.Time
 	PreviousNodeExecutionCheckpointURI storage.DataReference
+	PreviousAttemptCheckpointURI       storage.DataReference
 	CleanupOnFailure                   bool
 }
 
@@ -43,8 +44,10 @@ type WorkflowNodeState struct {
 }
 
 type GateNodeState struct {
-	Phase     v1alpha1.GateNodePhase
-	StartedAt time.Time
+	Phase        v1alpha1.GateNodePhase
+	StartedAt    time.Time
+	PollAttempts uint32
+	LastPolledAt time.Time
 }
 
 type ArrayNodeState struct {
@@ -55,4 +58,6 @@ type ArrayNodeState struct {
 	SubNodeTaskPhases     bitarray.CompactArray
 	SubNodeRetryAttempts  bitarray.CompactArray
 	SubNodeSystemFailures bitarray.CompactArray
+	SubNodePreemptions    bitarray.CompactArray
+	Parallelism           uint32
 }
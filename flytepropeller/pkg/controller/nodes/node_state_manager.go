@@ -86,6 +86,7 @@ func (n nodeStateManager) GetTaskNodeState() handler.TaskNodeState {
 			PluginState:                        tn.GetPluginState(),
 			LastPhaseUpdatedAt:                 tn.GetLastPhaseUpdatedAt(),
 			PreviousNodeExecutionCheckpointURI: tn.GetPreviousNodeExecutionCheckpointPath(),
+			PreviousAttemptCheckpointURI:       tn.GetPreviousAttemptCheckpointPath(),
 			CleanupOnFailure:                   tn.GetCleanupOnFailure(),
 		}
 	}
@@ -146,6 +147,8 @@ func (n nodeStateManager) GetGateNodeState() handler.GateNodeState {
 	gs := handler.GateNodeState{}
 	if gn != nil {
 		gs.Phase = gn.GetGateNodePhase()
+		gs.PollAttempts = gn.GetPollAttempts()
+		gs.LastPolledAt = gn.GetLastPolledAt()
 	}
 	return gs
 }
@@ -161,6 +164,7 @@ func (n nodeStateManager) GetArrayNodeState() handler.ArrayNodeState {
 		as.Phase = an.GetArrayNodePhase()
 		as.Error = an.GetExecutionError()
 		as.TaskPhaseVersion = an.GetTaskPhaseVersion()
+		as.Parallelism = an.GetParallelism()
 
 		subNodePhases := an.GetSubNodePhases()
 		if subNodePhasesCopy := subNodePhases.DeepCopy(); subNodePhasesCopy != nil {
@@ -181,6 +185,11 @@ func (n nodeStateManager) GetArrayNodeState() handler.ArrayNodeState {
 		if subNodeSystemFailuresCopy := subNodeSystemFailures.DeepCopy(); subNodeSystemFailuresCopy != nil {
 			as.SubNodeSystemFailures = *subNodeSystemFailuresCopy
 		}
+
+		subNodePreemptions := an.GetSubNodePreemptions()
+		if subNodePreemptionsCopy := subNodePreemptions.DeepCopy(); subNodePreemptionsCopy != nil {
+			as.SubNodePreemptions = *subNodePreemptionsCopy
+		}
 	}
 	return as
 }
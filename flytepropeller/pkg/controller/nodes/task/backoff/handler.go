@@ -2,6 +2,7 @@ package backoff
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"regexp"
 	"strings"
@@ -191,6 +192,21 @@ func (h *ComputeResourceAwareBackOffHandler) Handle(ctx context.Context, operati
 
 }
 
+// resourceQuotaExceededReason prefixes the reason of tasks waiting for resources because creating their resources
+// exceeded a resource quota.
+const resourceQuotaExceededReason = "Exceeded resourcequota"
+
+// ResourceQuotaExceededReason returns the reason of tasks waiting for resources because of the given quota error.
+func ResourceQuotaExceededReason(err error) string {
+	return fmt.Sprintf("%s: %s", resourceQuotaExceededReason, err.Error())
+}
+
+// IsResourceQuotaExceededReason returns whether the reason is that of a task waiting because a resource quota was
+// exceeded, as opposed to e.g. waiting for resource manager tokens or for a queue to admit it.
+func IsResourceQuotaExceededReason(reason string) bool {
+	return strings.HasPrefix(reason, resourceQuotaExceededReason+":")
+}
+
 func IsResourceQuotaExceeded(err error) bool {
 	return apiErrors.IsForbidden(err) && strings.Contains(err.Error(), "exceeded quota")
 }
@@ -540,6 +540,10 @@ func (t Handler) Handle(ctx context.Context, nCtx interfaces.NodeExecutionContex
 		return handler.UnknownTransition, errors.Wrapf(errors.UnsupportedTaskTypeError, nCtx.NodeID(), err, "unable to resolve plugin")
 	}
 
+	if err := t.resolvePreemptedCheckpoint(ctx, nCtx, p); err != nil {
+		return handler.UnknownTransition, errors.Wrapf(errors.RuntimeExecutionError, nCtx.NodeID(), err, "unable to resolve the checkpoint of the preempted attempt")
+	}
+
 	tCtx, err := t.newTaskExecutionContext(ctx, nCtx, p)
 	if err != nil {
 		return handler.UnknownTransition, errors.Wrapf(errors.IllegalStateError, nCtx.NodeID(), err, "unable to create Handler execution context")
@@ -619,6 +623,7 @@ func (t Handler) Handle(ctx context.Context, nCtx interfaces.NodeExecutionContex
 			PluginID:              p.GetID(),
 			ResourcePoolInfo:      tCtx.rm.GetResourcePoolInfo(),
 			ClusterID:             t.clusterID,
+			EscalatedResources:    tCtx.tm.escalatedResources,
 			OccurredAt:            occurredAt,
 		})
 		if err != nil {
@@ -646,6 +651,7 @@ func (t Handler) Handle(ctx context.Context, nCtx interfaces.NodeExecutionContex
 		PluginID:              p.GetID(),
 		ResourcePoolInfo:      tCtx.rm.GetResourcePoolInfo(),
 		ClusterID:             t.clusterID,
+		EscalatedResources:    tCtx.tm.escalatedResources,
 		OccurredAt:            occurredAt,
 	})
 	if err != nil {
@@ -671,6 +677,7 @@ func (t Handler) Handle(ctx context.Context, nCtx interfaces.NodeExecutionContex
 		PluginPhaseVersion:                 pluginTrns.pInfo.Version(),
 		LastPhaseUpdatedAt:                 time.Now(),
 		PreviousNodeExecutionCheckpointURI: ts.PreviousNodeExecutionCheckpointURI,
+		PreviousAttemptCheckpointURI:       ts.PreviousAttemptCheckpointURI,
 		CleanupOnFailure:                   ts.CleanupOnFailure || pluginTrns.pInfo.CleanupOnFailure(),
 	})
 	if err != nil {
@@ -829,6 +836,7 @@ func (t Handler) Abort(ctx context.Context, nCtx interfaces.NodeExecutionContext
 			PluginID:              p.GetID(),
 			ResourcePoolInfo:      tCtx.rm.GetResourcePoolInfo(),
 			ClusterID:             t.clusterID,
+			EscalatedResources:    tCtx.tm.escalatedResources,
 		})
 		if err != nil {
 			return err
@@ -862,6 +870,7 @@ func (t Handler) Abort(ctx context.Context, nCtx interfaces.NodeExecutionContext
 		PluginID:              p.GetID(),
 		ResourcePoolInfo:      tCtx.rm.GetResourcePoolInfo(),
 		ClusterID:             t.clusterID,
+		EscalatedResources:    tCtx.tm.escalatedResources,
 		OccurredAt:            time.Now(),
 	})
 	if err != nil {
@@ -487,6 +487,7 @@ func Test_task_Handle_NoCatalog(t *testing.T) {
 		ns := &flyteMocks.ExecutableNodeStatus{}
 		ns.OnGetDataDir().Return("data-dir")
 		ns.OnGetOutputDir().Return("data-dir")
+		ns.OnGetExecutionError().Return(nil)
 
 		res := &v1.ResourceRequirements{}
 		n := &flyteMocks.ExecutableNode{}
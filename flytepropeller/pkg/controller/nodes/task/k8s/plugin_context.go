@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
@@ -12,12 +14,14 @@ import (
 )
 
 var _ k8s.PluginContext = &pluginContext{}
+var _ k8s.K8sReaderProvider = &pluginContext{}
 
 type pluginContext struct {
 	pluginsCore.TaskExecutionContext
 	// Lazily creates a buffered outputWriter, overriding the input outputWriter.
 	ow             *ioutils.BufferedOutputWriter
 	k8sPluginState *k8s.PluginState
+	k8sReader      client.Reader
 }
 
 // Provides an output sync of type io.OutputWriter
@@ -56,10 +60,16 @@ func (p *pluginContext) PluginStateReader() pluginsCore.PluginStateReader {
 	}
 }
 
-func newPluginContext(tCtx pluginsCore.TaskExecutionContext, k8sPluginState *k8s.PluginState) *pluginContext {
+func (p *pluginContext) K8sReader() client.Reader {
+	return p.k8sReader
+}
+
+func newPluginContext(tCtx pluginsCore.TaskExecutionContext, k8sPluginState *k8s.PluginState,
+	k8sReader client.Reader) *pluginContext {
 	return &pluginContext{
 		TaskExecutionContext: tCtx,
 		ow:                   nil,
 		k8sPluginState:       k8sPluginState,
+		k8sReader:            k8sReader,
 	}
 }
@@ -10,6 +10,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8stypes "k8s.io/apimachinery/pkg/types"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/errors"
 	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
@@ -57,6 +59,11 @@ type PluginState struct {
 	Phase           PluginPhase
 	K8sPluginState  k8s.PluginState
 	LastEventUpdate time.Time
+	// Peak resource usage of the containers of the task, sampled while it runs if resource usage reporting is enabled.
+	PeakResourceUsage       flytek8s.ResourceUsage
+	LastResourceUsageSample time.Time
+	// Why the pod of the task is being preempted, set once its preemption is detected if preemption handling is enabled.
+	Preemption string
 }
 
 type PluginMetrics struct {
@@ -88,6 +95,12 @@ func isK8sObjectNotExists(err error) bool {
 	return k8serrors.IsNotFound(err) || k8serrors.IsGone(err) || k8serrors.IsResourceExpired(err)
 }
 
+// apiReaderProvider is implemented by kube clients providing a reader that bypasses the informer cache, e.g. the
+// controller manager.
+type apiReaderProvider interface {
+	GetAPIReader() client.Reader
+}
+
 // A generic Plugin for managing k8s-resources. Plugin writers wishing to use K8s resource can use the simplified api specified in
 // pluginmachinery.core
 type PluginManager struct {
@@ -206,6 +219,10 @@ func (e *PluginManager) launchResource(ctx context.Context, tCtx pluginsCore.Tas
 	}
 
 	e.addObjectMetadata(k8sTaskCtxMetadata, o, config.GetK8sPluginConfig())
+	gangScheduled := e.isGangScheduled()
+	if gangScheduled {
+		flytek8s.ApplyGangSchedulingMetadata(k8sTaskCtxMetadata, o)
+	}
 	logger.Infof(ctx, "Creating Object: Type:[%v], Object:[%v/%v]", o.GetObjectKind().GroupVersionKind(), o.GetNamespace(), o.GetName())
 
 	key := backoff.ComposeResourceKey(o)
@@ -233,10 +250,10 @@ func (e *PluginManager) launchResource(ctx context.Context, tCtx pluginsCore.Tas
 				fmt.Sprintf("requested resources exceed limits: %v", err.Error()), nil)), nil
 		} else if stdErrors.IsCausedBy(err, errors.BackOffError) {
 			logger.Warnf(ctx, "Failed to launch job, resource quota exceeded. err: %v", err)
-			return pluginsCore.DoTransition(pluginsCore.PhaseInfoWaitingForResourcesInfo(time.Now(), pluginsCore.DefaultPhaseVersion, fmt.Sprintf("Exceeded resourcequota: %s", err.Error()), nil)), nil
+			return pluginsCore.DoTransition(pluginsCore.PhaseInfoWaitingForResourcesInfo(time.Now(), pluginsCore.DefaultPhaseVersion, backoff.ResourceQuotaExceededReason(err), nil)), nil
 		} else if e.backOffController == nil && backoff.IsResourceQuotaExceeded(err) {
 			logger.Warnf(ctx, "Failed to launch job, resource quota exceeded and the operation is not guarded by back-off. err: %v", err)
-			return pluginsCore.DoTransition(pluginsCore.PhaseInfoWaitingForResourcesInfo(time.Now(), pluginsCore.DefaultPhaseVersion, fmt.Sprintf("Exceeded resourcequota: %s", err.Error()), nil)), nil
+			return pluginsCore.DoTransition(pluginsCore.PhaseInfoWaitingForResourcesInfo(time.Now(), pluginsCore.DefaultPhaseVersion, backoff.ResourceQuotaExceededReason(err), nil)), nil
 		} else if k8serrors.IsForbidden(err) {
 			return pluginsCore.DoTransition(pluginsCore.PhaseInfoRetryableFailure("RuntimeFailure", err.Error(), nil)), nil
 		} else if k8serrors.IsBadRequest(err) || k8serrors.IsInvalid(err) {
@@ -251,9 +268,101 @@ func (e *PluginManager) launchResource(ctx context.Context, tCtx pluginsCore.Tas
 		return pluginsCore.UnknownTransition, errors.Wrapf(stdErrors.ErrorCode(reason), err, "failed to create resource")
 	}
 
+	if gangScheduled && flytek8s.GetGangScheduler() == flytek8s.GangSchedulerVolcano {
+		if err := e.createVolcanoPodGroup(ctx, k8sTaskCtxMetadata, o); err != nil {
+			logger.Errorf(ctx, "Failed to create the pod group of [%v/%v], system error. err: %v", o.GetNamespace(), o.GetName(), err)
+			return pluginsCore.UnknownTransition, errors.Wrapf(stdErrors.ErrorCode(k8serrors.ReasonForError(err)), err, "failed to create pod group")
+		}
+	}
+
 	return pluginsCore.DoTransition(pluginsCore.PhaseInfoQueued(time.Now(), pluginsCore.DefaultPhaseVersion, "task submitted to K8s")), nil
 }
 
+// isGangScheduled returns whether the resources of the plugin are submitted to the configured gang scheduler.
+func (e *PluginManager) isGangScheduled() bool {
+	if len(flytek8s.GetGangScheduler()) == 0 {
+		return false
+	}
+
+	gvk, err := getPluginGvk(e.resourceToWatch)
+	return err == nil && flytek8s.IsGangSchedulingSupported(gvk)
+}
+
+// createVolcanoPodGroup creates the PodGroup gang scheduling the pods of the created resource. It's sized by the plugin
+// if the plugin implements k8s.GangSchedulingPlugin.
+func (e *PluginManager) createVolcanoPodGroup(ctx context.Context, taskCtx pluginsCore.TaskExecutionMetadata, o client.Object) error {
+	if len(o.GetUID()) == 0 {
+		// The resource was created during a previous round, it's needed to own the pod group.
+		nsName := k8stypes.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}
+		if err := e.kubeClient.GetClient().Get(ctx, nsName, o); err != nil {
+			return err
+		}
+	}
+
+	minMember := int32(1)
+	if gangPlugin, ok := e.plugin.(k8s.GangSchedulingPlugin); ok {
+		size, err := gangPlugin.GetGangSize(ctx, o)
+		if err != nil {
+			return err
+		}
+
+		minMember = size
+	}
+
+	gvk, err := getPluginGvk(e.resourceToWatch)
+	if err != nil {
+		return err
+	}
+
+	podGroup := flytek8s.BuildVolcanoPodGroup(o, gvk, flytek8s.GetGangSchedulingQueue(taskCtx), minMember)
+	if err := e.kubeClient.GetClient().Create(ctx, podGroup); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// getAPIReader returns the reader of resources no informer should be started for, because they can't be watched or would
+// be watched cluster-wide. It bypasses the informer cache if the kube client provides such a reader.
+func (e *PluginManager) getAPIReader() client.Reader {
+	if provider, ok := e.kubeClient.(apiReaderProvider); ok {
+		return provider.GetAPIReader()
+	}
+
+	return e.kubeClient.GetClient()
+}
+
+// getGangSchedulingStatus returns whether the resource was admitted by the configured gang scheduler. The workloads
+// and pod groups are read from the API server, only the resources of the plugins are watched.
+func (e *PluginManager) getGangSchedulingStatus(ctx context.Context, o client.Object) (flytek8s.GangSchedulingStatus, error) {
+	switch flytek8s.GetGangScheduler() {
+	case flytek8s.GangSchedulerKueue:
+		workloads := &unstructured.UnstructuredList{}
+		workloads.SetGroupVersionKind(flytek8s.KueueWorkloadListGVK)
+		if err := e.getAPIReader().List(ctx, workloads, client.InNamespace(o.GetNamespace()),
+			client.MatchingLabels{flytek8s.KueueJobUIDLabel: string(o.GetUID())}); err != nil {
+			return flytek8s.GangSchedulingStatus{}, err
+		}
+
+		return flytek8s.GetKueueWorkloadStatus(workloads.Items), nil
+	case flytek8s.GangSchedulerVolcano:
+		podGroup := &unstructured.Unstructured{}
+		podGroup.SetGroupVersionKind(flytek8s.VolcanoPodGroupGVK)
+		nsName := k8stypes.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}
+		if err := e.getAPIReader().Get(ctx, nsName, podGroup); err != nil {
+			if isK8sObjectNotExists(err) {
+				return flytek8s.GangSchedulingStatus{Message: "waiting for the pod group to be created"}, nil
+			}
+
+			return flytek8s.GangSchedulingStatus{}, err
+		}
+
+		return flytek8s.GetVolcanoPodGroupStatus(podGroup), nil
+	default:
+		return flytek8s.GangSchedulingStatus{Admitted: true}, nil
+	}
+}
+
 func (e *PluginManager) getResource(ctx context.Context, tCtx pluginsCore.TaskExecutionContext) (client.Object, error) {
 	o, err := e.plugin.BuildIdentityResource(ctx, tCtx.TaskExecutionMetadata())
 	if err != nil {
@@ -264,11 +373,16 @@ func (e *PluginManager) getResource(ctx context.Context, tCtx pluginsCore.TaskEx
 	return o, nil
 }
 
-func (e *PluginManager) checkResourcePhase(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, o client.Object, k8sPluginState *k8s.PluginState) (pluginsCore.Transition, error) {
+func (e *PluginManager) checkResourcePhase(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, o client.Object, k8sPluginState *k8s.PluginState, preemption *string) (pluginsCore.Transition, error) {
 	nsName := k8stypes.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}
 	// Attempt to get resource from informer cache, if not found, retrieve it from API server.
 	if err := e.kubeClient.GetClient().Get(ctx, nsName, o); err != nil {
 		if isK8sObjectNotExists(err) {
+			if len(*preemption) > 0 {
+				// The preempted pod terminated, and was deleted before its termination was observed.
+				return pluginsCore.DoTransition(pluginsCore.PhaseInfoSystemRetryableFailure(flytek8s.Preempted, *preemption, nil)), nil
+			}
+
 			// This happens sometimes because a node gets removed and K8s deletes the pod. This will result in a
 			// Pod does not exist error. This should be retried using the retry policy
 			logger.Warningf(ctx, "Failed to find the Resource with name: %v. Error: %v", nsName, err)
@@ -283,13 +397,29 @@ func (e *PluginManager) checkResourcePhase(ctx context.Context, tCtx pluginsCore
 		e.metrics.ResourceDeleted.Inc(ctx)
 	}
 
-	pCtx := newPluginContext(tCtx, k8sPluginState)
+	pCtx := newPluginContext(tCtx, k8sPluginState, e.kubeClient.GetClient())
 	p, err := e.plugin.GetTaskPhase(ctx, pCtx, o)
 	if err != nil {
 		logger.Warnf(ctx, "failed to check status of resource in plugin [%s], with error: %s", e.GetID(), err.Error())
 		return pluginsCore.UnknownTransition, err
 	}
 
+	if flytek8s.IsGangSchedulingPending(p.Phase()) && o.GetDeletionTimestamp() == nil && e.isGangScheduled() {
+		status, err := e.getGangSchedulingStatus(ctx, o)
+		if err != nil {
+			logger.Warnf(ctx, "failed to check the gang scheduling status of [%v], with error: %s", nsName, err.Error())
+		} else {
+			p = flytek8s.ApplyGangSchedulingStatus(p, flytek8s.GetGangSchedulingQueue(tCtx.TaskExecutionMetadata()), status)
+			k8s.MaybeUpdatePhaseVersion(&p, k8sPluginState)
+		}
+	}
+
+	if p.Phase() != pluginsCore.PhaseSuccess {
+		if phaseInfo, preempted := e.checkPreemption(ctx, tCtx, o, p, preemption); preempted {
+			return pluginsCore.DoTransition(phaseInfo), nil
+		}
+	}
+
 	if p.Phase() == pluginsCore.PhaseSuccess {
 		var opReader io.OutputReader
 		if pCtx.ow == nil {
@@ -324,6 +454,148 @@ func (e *PluginManager) checkResourcePhase(ctx context.Context, tCtx pluginsCore
 	return pluginsCore.DoTransition(p), nil
 }
 
+// checkPreemption detects the preemption of the pods of interruptible tasks. Preempted resources are deleted with the
+// configured grace period to checkpoint, and the task keeps running until their pods terminated so that the next
+// attempt starts from a complete checkpoint. The attempt then fails as preempted, whatever the outcome of the pods.
+// Resources running their pods through an operator are deleted in the foreground, so that their pods get deleted even
+// though the finalizer of the resource keeps it around until the task is finalized.
+func (e *PluginManager) checkPreemption(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, o client.Object,
+	p pluginsCore.PhaseInfo, preemption *string) (pluginsCore.PhaseInfo, bool) {
+	if !flytek8s.IsPreemptionEnabled(tCtx.TaskExecutionMetadata()) {
+		return p, false
+	}
+
+	pods, err := e.getPods(ctx, o)
+	if err != nil {
+		logger.Warnf(ctx, "failed to list the pods of [%s/%s], with error: %s", o.GetNamespace(), o.GetName(), err.Error())
+		return p, len(*preemption) > 0
+	}
+
+	if len(*preemption) == 0 {
+		for _, pod := range pods {
+			*preemption = flytek8s.GetPreemptionMessage(pod, e.getNode(ctx, pod))
+			if len(*preemption) > 0 {
+				logger.Infof(ctx, "Detected the preemption of [%s/%s]: %s", pod.GetNamespace(), pod.GetName(), *preemption)
+				break
+			}
+		}
+
+		if len(*preemption) == 0 {
+			return p, false
+		}
+	}
+
+	_, isPod := o.(*v1.Pod)
+	if p.Phase().IsTerminal() || (!isPod && !hasRunningPods(pods)) {
+		return pluginsCore.PhaseInfoSystemRetryableFailure(flytek8s.Preempted, *preemption, p.Info()), true
+	}
+
+	if o.GetDeletionTimestamp() == nil {
+		deleteOptions := []client.DeleteOption{client.PropagationPolicy(metav1.DeletePropagationForeground)}
+		if isPod {
+			gracePeriod := int64(config.GetK8sPluginConfig().Preemption.GracePeriod.Seconds())
+			deleteOptions = []client.DeleteOption{client.GracePeriodSeconds(gracePeriod),
+				client.PropagationPolicy(metav1.DeletePropagationBackground)}
+		}
+
+		err := e.kubeClient.GetClient().Delete(ctx, o, deleteOptions...)
+		if err != nil && !isK8sObjectNotExists(err) {
+			logger.Warnf(ctx, "failed to delete preempted resource [%s/%s], with error: %s", o.GetNamespace(), o.GetName(),
+				err.Error())
+		}
+	}
+
+	return p, true
+}
+
+// getPods returns the pods of the resource, the resource itself if it's a pod. The pods of other resources are listed
+// if the plugin implements k8s.PreemptionPlugin, they have none otherwise.
+func (e *PluginManager) getPods(ctx context.Context, o client.Object) ([]*v1.Pod, error) {
+	if pod, ok := o.(*v1.Pod); ok {
+		return []*v1.Pod{pod}, nil
+	}
+
+	preemptionPlugin, ok := e.plugin.(k8s.PreemptionPlugin)
+	if !ok {
+		return nil, nil
+	}
+
+	podLabels, err := preemptionPlugin.GetPodLabels(ctx, o)
+	if err != nil || len(podLabels) == 0 {
+		return nil, err
+	}
+
+	podList := &v1.PodList{}
+	err = e.kubeClient.GetClient().List(ctx, podList, client.InNamespace(o.GetNamespace()), client.MatchingLabels(podLabels))
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make([]*v1.Pod, 0, len(podList.Items))
+	for i := range podList.Items {
+		pods = append(pods, &podList.Items[i])
+	}
+
+	return pods, nil
+}
+
+// hasRunningPods returns whether any of the pods didn't terminate yet.
+func hasRunningPods(pods []*v1.Pod) bool {
+	for _, pod := range pods {
+		if pod.Status.Phase != v1.PodSucceeded && pod.Status.Phase != v1.PodFailed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getNode returns the node the pod runs on, if node taints mark the preemption of pods. It returns nil if the node
+// can't be retrieved, in which case the preemption of the pod is only detected from its conditions.
+func (e *PluginManager) getNode(ctx context.Context, pod *v1.Pod) *v1.Node {
+	if len(config.GetK8sPluginConfig().Preemption.NodeTaints) == 0 || len(pod.Spec.NodeName) == 0 {
+		return nil
+	}
+
+	node := &v1.Node{}
+	if err := e.kubeClient.GetClient().Get(ctx, k8stypes.NamespacedName{Name: pod.Spec.NodeName}, node); err != nil {
+		logger.Warnf(ctx, "failed to get node [%s] of pod [%s/%s], with error: %s", pod.Spec.NodeName, pod.GetNamespace(),
+			pod.GetName(), err.Error())
+		return nil
+	}
+
+	return node
+}
+
+// sampleResourceUsage updates the peak resource usage of the containers of the task. Running containers are sampled
+// from the metrics API at most once per sampling interval, OOMKilled containers are known to have used their limit.
+func (e *PluginManager) sampleResourceUsage(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, o client.Object,
+	phase pluginsCore.Phase, pluginState PluginState) (flytek8s.ResourceUsage, time.Time) {
+	peakResourceUsage := pluginState.PeakResourceUsage
+	lastSample := pluginState.LastResourceUsageSample
+	if pod, ok := o.(*v1.Pod); ok {
+		peakResourceUsage = peakResourceUsage.Max(flytek8s.GetOOMKilledUsage(pod))
+	}
+
+	samplingInterval := config.GetK8sPluginConfig().ResourceUsage.SamplingInterval.Duration
+	if phase != pluginsCore.PhaseRunning || time.Since(lastSample) < samplingInterval {
+		return peakResourceUsage, lastSample
+	}
+
+	podMetrics := &unstructured.UnstructuredList{}
+	podMetrics.SetGroupVersionKind(flytek8s.PodMetricsListGVK)
+	// The metrics API doesn't support watches, the pod metrics are listed from the API server.
+	err := e.getAPIReader().List(ctx, podMetrics, client.InNamespace(o.GetNamespace()),
+		client.MatchingLabels(tCtx.TaskExecutionMetadata().GetLabels()))
+	if err != nil {
+		logger.Warnf(ctx, "failed to sample the resource usage of [%s/%s], with error: %s", o.GetNamespace(), o.GetName(),
+			err.Error())
+		return peakResourceUsage, lastSample
+	}
+
+	return peakResourceUsage.Max(flytek8s.GetPodMetricsUsage(podMetrics.Items)), time.Now()
+}
+
 func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutionContext) (pluginsCore.Transition, error) {
 	// read phase state
 	pluginState := PluginState{}
@@ -340,6 +612,7 @@ func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutio
 	var transition pluginsCore.Transition
 	var o client.Object
 	pluginPhase := pluginState.Phase
+	preemption := pluginState.Preemption
 	if pluginState.Phase == PluginPhaseNotStarted {
 		transition, err = e.launchResource(ctx, tCtx)
 		if err == nil && transition.Info().Phase() == pluginsCore.PhaseQueued {
@@ -351,7 +624,7 @@ func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutio
 			transition, err = pluginsCore.DoTransition(pluginsCore.PhaseInfoFailure("BadTaskDefinition",
 				fmt.Sprintf("Failed to build resource, caused by: %s", err.Error()), nil)), nil
 		} else {
-			transition, err = e.checkResourcePhase(ctx, tCtx, o, &pluginState.K8sPluginState)
+			transition, err = e.checkResourcePhase(ctx, tCtx, o, &pluginState.K8sPluginState, &preemption)
 		}
 	}
 
@@ -383,6 +656,15 @@ func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutio
 		}
 	}
 
+	peakResourceUsage := pluginState.PeakResourceUsage
+	lastResourceUsageSample := pluginState.LastResourceUsageSample
+	if config.GetK8sPluginConfig().ResourceUsage.Enabled && o != nil {
+		peakResourceUsage, lastResourceUsageSample = e.sampleResourceUsage(ctx, tCtx, o, phaseInfo.Phase(), pluginState)
+		if phaseInfo.Phase().IsTerminal() && !peakResourceUsage.IsZero() && phaseInfo.Info() != nil {
+			phaseInfo.Info().PeakResourceUsage = peakResourceUsage.ToResourceEntries()
+		}
+	}
+
 	// persist any changes in phase state
 	newPluginState := PluginState{
 		Phase: pluginPhase,
@@ -391,7 +673,10 @@ func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutio
 			PhaseVersion: version,
 			Reason:       phaseInfo.Reason(),
 		},
-		LastEventUpdate: lastEventUpdate,
+		LastEventUpdate:         lastEventUpdate,
+		PeakResourceUsage:       peakResourceUsage,
+		LastResourceUsageSample: lastResourceUsageSample,
+		Preemption:              preemption,
 	}
 	if pluginState != newPluginState {
 		if err := tCtx.PluginStateWriter().Put(pluginStateVersion, &newPluginState); err != nil {
@@ -428,7 +713,7 @@ func (e PluginManager) Abort(ctx context.Context, tCtx pluginsCore.TaskExecution
 
 	if err != nil {
 	} else if deleteResource {
-		err = e.kubeClient.GetClient().Delete(ctx, resourceToFinalize)
+		err = e.kubeClient.GetClient().Delete(ctx, resourceToFinalize, e.deleteOptions()...)
 	} else {
 		if behavior.Patch != nil && behavior.Update == nil {
 			err = e.kubeClient.GetClient().Patch(ctx, resourceToFinalize, behavior.Patch.Patch, behavior.Patch.Options...)
@@ -439,7 +724,7 @@ func (e PluginManager) Abort(ctx context.Context, tCtx pluginsCore.TaskExecution
 		}
 		if behavior.DeleteOnErr && err != nil {
 			logger.Warningf(ctx, "Failed to apply AbortBehavior for resource %v with error %v. Will attempt to delete resource.", resourceToFinalize.GetName(), err)
-			err = e.kubeClient.GetClient().Delete(ctx, resourceToFinalize)
+			err = e.kubeClient.GetClient().Delete(ctx, resourceToFinalize, e.deleteOptions()...)
 		}
 	}
 
@@ -452,6 +737,15 @@ func (e PluginManager) Abort(ctx context.Context, tCtx pluginsCore.TaskExecution
 	return nil
 }
 
+// deleteOptions returns the options deleting the resource of the plugin with its propagation policy, if any.
+func (e *PluginManager) deleteOptions() []client.DeleteOption {
+	if policy := e.plugin.GetProperties().DeletePropagationPolicy; len(policy) > 0 {
+		return []client.DeleteOption{client.PropagationPolicy(policy)}
+	}
+
+	return nil
+}
+
 func (e *PluginManager) clearFinalizers(ctx context.Context, o client.Object) error {
 	if len(o.GetFinalizers()) > 0 {
 		o.SetFinalizers([]string{})
@@ -525,9 +819,10 @@ func (e *PluginManager) Finalize(ctx context.Context, tCtx pluginsCore.TaskExecu
 	}
 
 	// If we should delete the resource when finalize is called, do a best effort delete.
-	if cfg.DeleteResourceOnFinalize && !e.plugin.GetProperties().DisableDeleteResourceOnFinalize {
+	properties := e.plugin.GetProperties()
+	if (cfg.DeleteResourceOnFinalize || properties.ForceDeleteResourceOnFinalize) && !properties.DisableDeleteResourceOnFinalize {
 		// Attempt to delete resource, if not found, return success.
-		if err := e.kubeClient.GetClient().Delete(ctx, o); err != nil {
+		if err := e.kubeClient.GetClient().Delete(ctx, o, e.deleteOptions()...); err != nil {
 			if isK8sObjectNotExists(err) {
 				return errs.ErrorOrDefault()
 			}
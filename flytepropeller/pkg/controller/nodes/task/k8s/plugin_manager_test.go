@@ -14,6 +14,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -30,6 +31,7 @@ import (
 	pluginsk8sMock "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/executors/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/backoff"
+	flytestdlibConfig "github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/contextutils"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
@@ -206,6 +208,7 @@ func getMockTaskExecutionMetadata() pluginsCore.TaskExecutionMetadata {
 	taskExecutionMetadata.On("GetLabels").Return(map[string]string{"lKey": "lVal"})
 	taskExecutionMetadata.On("GetOwnerReference").Return(metav1.OwnerReference{Name: "x"})
 	taskExecutionMetadata.On("GetSecurityContext").Return(core.SecurityContext{RunAs: &core.Identity{}})
+	taskExecutionMetadata.On("IsInterruptible").Return(true)
 
 	id := &pluginsCoreMock.TaskExecutionID{}
 	id.On("GetGeneratedName").Return("test")
@@ -233,7 +236,22 @@ func getMockTaskExecutionMetadataCustom(
 	return taskExecutionMetadata
 }
 
+// apiReaderKubeClient provides a reader bypassing the informer cache, like the controller manager.
+type apiReaderKubeClient struct {
+	pluginsCore.KubeClient
+	apiReader client.Reader
+}
+
+func (k apiReaderKubeClient) GetAPIReader() client.Reader {
+	return k.apiReader
+}
+
 func dummySetupContext(fakeClient client.Client) pluginsCore.SetupContext {
+	return dummySetupContextWithAPIReader(fakeClient, nil)
+}
+
+// dummySetupContextWithAPIReader creates a setup context whose kube client provides the API reader, if set.
+func dummySetupContextWithAPIReader(fakeClient client.Client, apiReader client.Reader) pluginsCore.SetupContext {
 	setupContext := &pluginsCoreMock.SetupContext{}
 	var enqueueOwnerFunc = pluginsCore.EnqueueOwner(func(ownerId k8stypes.NamespacedName) error { return nil })
 	setupContext.On("EnqueueOwner").Return(enqueueOwnerFunc)
@@ -241,7 +259,11 @@ func dummySetupContext(fakeClient client.Client) pluginsCore.SetupContext {
 	kubeClient := &pluginsCoreMock.KubeClient{}
 	kubeClient.On("GetClient").Return(fakeClient)
 	kubeClient.On("GetCache").Return(&mocks.FakeInformers{})
-	setupContext.On("KubeClient").Return(kubeClient)
+	if apiReader != nil {
+		setupContext.On("KubeClient").Return(apiReaderKubeClient{KubeClient: kubeClient, apiReader: apiReader})
+	} else {
+		setupContext.On("KubeClient").Return(kubeClient)
+	}
 
 	setupContext.On("OwnerKind").Return("x")
 	setupContext.On("MetricsScope").Return(promutils.NewTestScope())
@@ -1079,6 +1101,578 @@ func TestFinalize(t *testing.T) {
 		}, actualO))
 	})
 
+	t.Run("ForceDeleteResourceOnFinalize=True", func(t *testing.T) {
+		ctx := context.Background()
+		fakeKubeClient := mocks.NewFakeKubeClient()
+
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{DeleteResourceOnFinalize: false}))
+		p := pluginsk8sMock.Plugin{}
+		p.OnGetProperties().Return(k8s.PluginProperties{DisableInjectFinalizer: true, ForceDeleteResourceOnFinalize: true})
+		tctx := getMockTaskContext(PluginPhaseStarted, PluginPhaseStarted)
+		o := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tctx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName(),
+				Namespace: tctx.TaskExecutionMetadata().GetNamespace(),
+			},
+		}
+
+		assert.NoError(t, fakeKubeClient.GetClient().Create(ctx, o))
+
+		p.OnBuildIdentityResource(ctx, tctx.TaskExecutionMetadata()).Return(o, nil)
+		pluginManager := PluginManager{plugin: &p, kubeClient: fakeKubeClient}
+
+		// Finalize should delete the object although the config doesn't ask for it
+		assert.NoError(t, pluginManager.Finalize(ctx, tctx))
+
+		// Assert the object is now deleted.
+		actualO := &v1.Pod{}
+		assert.Error(t, fakeKubeClient.GetClient().Get(ctx, k8stypes.NamespacedName{
+			Name:      o.Name,
+			Namespace: o.Namespace,
+		}, actualO))
+	})
+}
+
+type gangSchedulingPlugin struct {
+	pluginsk8sMock.Plugin
+	pluginsk8sMock.GangSchedulingPlugin
+}
+
+func TestPluginManager_GangScheduling(t *testing.T) {
+	ctx := context.TODO()
+	defer func() {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+	}()
+
+	t.Run("Volcano PodGroup created on launch", func(t *testing.T) {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+			GangScheduling: config.GangSchedulingConfig{
+				Scheduler:    flytek8s.GangSchedulerVolcano,
+				DefaultQueue: "queue",
+			},
+		}))
+
+		tCtx := getMockTaskContext(PluginPhaseNotStarted, PluginPhaseStarted)
+		p := &gangSchedulingPlugin{}
+		p.Plugin.OnGetProperties().Return(k8s.PluginProperties{})
+		p.Plugin.OnBuildResourceMatch(mock.Anything, mock.Anything).Return(&v1.Pod{}, nil)
+		p.GangSchedulingPlugin.OnGetGangSizeMatch(mock.Anything, mock.Anything).Return(int32(3), nil)
+		fakeClient := fake.NewClientBuilder().Build()
+		pluginManager, err := NewPluginManager(ctx, dummySetupContext(fakeClient), k8s.PluginEntry{
+			ID:              "x",
+			ResourceToWatch: &v1.Pod{},
+			Plugin:          p,
+		}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+		assert.NoError(t, err)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseQueued, transition.Info().Phase())
+
+		nsName := k8stypes.NamespacedName{Namespace: tCtx.TaskExecutionMetadata().GetNamespace(),
+			Name: tCtx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName()}
+		createdPod := &v1.Pod{}
+		assert.NoError(t, fakeClient.Get(ctx, nsName, createdPod))
+		assert.Equal(t, "queue", createdPod.Labels[flytek8s.VolcanoQueueNameLabel])
+
+		podGroup := &unstructured.Unstructured{}
+		podGroup.SetGroupVersionKind(flytek8s.VolcanoPodGroupGVK)
+		assert.NoError(t, fakeClient.Get(ctx, nsName, podGroup))
+		minMember, _, _ := unstructured.NestedInt64(podGroup.Object, "spec", "minMember")
+		assert.Equal(t, int64(3), minMember)
+		assert.Equal(t, createdPod.UID, podGroup.GetOwnerReferences()[0].UID)
+	})
+
+	t.Run("Volcano PodGroup pending", func(t *testing.T) {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+			GangScheduling: config.GangSchedulingConfig{
+				Scheduler:    flytek8s.GangSchedulerVolcano,
+				DefaultQueue: "queue",
+			},
+		}))
+
+		tCtx := getMockTaskContext(PluginPhaseStarted, PluginPhaseStarted)
+		res := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tCtx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName(),
+				Namespace: tCtx.TaskExecutionMetadata().GetNamespace(),
+			},
+		}
+		podGroup := flytek8s.BuildVolcanoPodGroup(res, v1.SchemeGroupVersion.WithKind("Pod"), "queue", 1)
+		assert.NoError(t, unstructured.SetNestedField(podGroup.Object, map[string]interface{}{
+			"phase": "Pending",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Unschedulable", "status": "True", "message": "1/1 tasks in gang unschedulable"},
+			},
+		}, "status"))
+
+		p := &pluginsk8sMock.Plugin{}
+		p.OnGetProperties().Return(k8s.PluginProperties{})
+		p.OnBuildIdentityResourceMatch(mock.Anything, mock.Anything).Return(&v1.Pod{}, nil)
+		p.OnGetTaskPhaseMatch(mock.Anything, mock.Anything, mock.Anything).Return(
+			pluginsCore.PhaseInfoQueued(time.Now(), pluginsCore.DefaultPhaseVersion, "Scheduling"), nil)
+		// The pod group is only read from the API server.
+		fakeClient := fake.NewClientBuilder().WithObjects(res).Build()
+		apiReader := fake.NewClientBuilder().WithObjects(podGroup).Build()
+		pluginManager, err := NewPluginManager(ctx, dummySetupContextWithAPIReader(fakeClient, apiReader), k8s.PluginEntry{
+			ID:              "x",
+			ResourceToWatch: &v1.Pod{},
+			Plugin:          p,
+		}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+		assert.NoError(t, err)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseWaitingForResources, transition.Info().Phase())
+		assert.Equal(t, "Queued in queue [queue]: 1/1 tasks in gang unschedulable", transition.Info().Reason())
+	})
+
+	t.Run("Kueue Workload admitted", func(t *testing.T) {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+			GangScheduling: config.GangSchedulingConfig{
+				Scheduler:    flytek8s.GangSchedulerKueue,
+				DefaultQueue: "queue",
+			},
+		}))
+
+		tCtx := getMockTaskContext(PluginPhaseStarted, PluginPhaseStarted)
+		res := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tCtx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName(),
+				Namespace: tCtx.TaskExecutionMetadata().GetNamespace(),
+				UID:       "uid",
+			},
+		}
+		workload := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Admitted", "status": "True"},
+					},
+				},
+			},
+		}
+		workload.SetGroupVersionKind(flytek8s.KueueWorkloadListGVK.GroupVersion().WithKind("Workload"))
+		workload.SetNamespace(res.Namespace)
+		workload.SetName("pod-" + res.Name)
+		workload.SetLabels(map[string]string{flytek8s.KueueJobUIDLabel: "uid"})
+
+		p := &pluginsk8sMock.Plugin{}
+		p.OnGetProperties().Return(k8s.PluginProperties{})
+		p.OnBuildIdentityResourceMatch(mock.Anything, mock.Anything).Return(&v1.Pod{}, nil)
+		p.OnGetTaskPhaseMatch(mock.Anything, mock.Anything, mock.Anything).Return(
+			pluginsCore.PhaseInfoQueued(time.Now(), pluginsCore.DefaultPhaseVersion, "Scheduling"), nil)
+		// The workload is only read from the API server.
+		fakeClient := fake.NewClientBuilder().WithObjects(res).Build()
+		apiReader := fake.NewClientBuilder().WithObjects(workload).Build()
+		pluginManager, err := NewPluginManager(ctx, dummySetupContextWithAPIReader(fakeClient, apiReader), k8s.PluginEntry{
+			ID:              "x",
+			ResourceToWatch: &v1.Pod{},
+			Plugin:          p,
+		}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+		assert.NoError(t, err)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseQueued, transition.Info().Phase())
+		assert.Equal(t, "Admitted to queue [queue], Scheduling", transition.Info().Reason())
+	})
+
+	t.Run("Kueue unsupported kind", func(t *testing.T) {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+			GangScheduling: config.GangSchedulingConfig{
+				Scheduler:    flytek8s.GangSchedulerKueue,
+				DefaultQueue: "queue",
+			},
+		}))
+
+		tCtx := getMockTaskContext(PluginPhaseNotStarted, PluginPhaseStarted)
+		p := &pluginsk8sMock.Plugin{}
+		p.OnGetProperties().Return(k8s.PluginProperties{})
+		p.OnBuildResourceMatch(mock.Anything, mock.Anything).Return(&v1.ConfigMap{}, nil)
+		p.OnBuildIdentityResourceMatch(mock.Anything, mock.Anything).Return(&v1.ConfigMap{}, nil)
+		p.OnGetTaskPhaseMatch(mock.Anything, mock.Anything, mock.Anything).Return(
+			pluginsCore.PhaseInfoQueued(time.Now(), pluginsCore.DefaultPhaseVersion, "Scheduling"), nil)
+		fakeClient := fake.NewClientBuilder().Build()
+		pluginManager, err := NewPluginManager(ctx, dummySetupContext(fakeClient), k8s.PluginEntry{
+			ID:              "x",
+			ResourceToWatch: &v1.ConfigMap{},
+			Plugin:          p,
+		}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+		assert.NoError(t, err)
+
+		_, err = pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+
+		nsName := k8stypes.NamespacedName{Namespace: tCtx.TaskExecutionMetadata().GetNamespace(),
+			Name: tCtx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName()}
+		created := &v1.ConfigMap{}
+		assert.NoError(t, fakeClient.Get(ctx, nsName, created))
+		assert.NotContains(t, created.Labels, flytek8s.KueueQueueNameLabel)
+
+		// The resource is never admitted by Kueue, the phase of the plugin is kept.
+		transition, err := pluginManager.Handle(ctx, getMockTaskContext(PluginPhaseStarted, PluginPhaseStarted))
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseQueued, transition.Info().Phase())
+		assert.Equal(t, "Scheduling", transition.Info().Reason())
+	})
+}
+
+func getResourceUsageTaskContext(state PluginState, writtenState *PluginState) pluginsCore.TaskExecutionContext {
+	taskExecutionContext := &pluginsCoreMock.TaskExecutionContext{}
+	taskExecutionContext.OnTaskExecutionMetadata().Return(getMockTaskExecutionMetadata())
+
+	customStateReader := &pluginsCoreMock.PluginStateReader{}
+	customStateReader.OnGetMatch(mock.MatchedBy(func(i interface{}) bool {
+		ps, ok := i.(*PluginState)
+		if ok {
+			*ps = state
+		}
+		return ok
+	})).Return(uint8(0), nil)
+	taskExecutionContext.OnPluginStateReader().Return(customStateReader)
+
+	customStateWriter := &pluginsCoreMock.PluginStateWriter{}
+	customStateWriter.OnPutMatch(mock.Anything, mock.MatchedBy(func(i interface{}) bool {
+		ps, ok := i.(*PluginState)
+		if ok {
+			*writtenState = *ps
+		}
+		return ok
+	})).Return(nil)
+	taskExecutionContext.OnPluginStateWriter().Return(customStateWriter)
+	taskExecutionContext.OnOutputWriter().Return(&dummyOutputWriter{})
+	return taskExecutionContext
+}
+
+func TestPluginManager_ResourceUsage(t *testing.T) {
+	ctx := context.TODO()
+	defer func() {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+	}()
+
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+		ResourceUsage: config.ResourceUsageConfig{Enabled: true},
+	}))
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "primary",
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+				},
+			},
+		},
+	}
+	podMetrics := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":  "primary",
+					"usage": map[string]interface{}{"cpu": "500m", "memory": "256Mi"},
+				},
+			},
+		},
+	}
+	podMetrics.SetGroupVersionKind(flytek8s.PodMetricsListGVK.GroupVersion().WithKind("PodMetrics"))
+	podMetrics.SetNamespace("ns")
+	podMetrics.SetName("test")
+	podMetrics.SetLabels(map[string]string{"lKey": "lVal"})
+
+	newPluginManager := func(t *testing.T, phaseInfo pluginsCore.PhaseInfo, objects ...client.Object) *PluginManager {
+		p := &pluginsk8sMock.Plugin{}
+		p.OnGetProperties().Return(k8s.PluginProperties{})
+		p.OnBuildIdentityResourceMatch(mock.Anything, mock.Anything).Return(&v1.Pod{}, nil)
+		p.OnGetTaskPhaseMatch(mock.Anything, mock.Anything, mock.Anything).Return(phaseInfo, nil)
+		// The pod metrics are only read from the API server.
+		fakeClient := fake.NewClientBuilder()
+		apiReader := fake.NewClientBuilder()
+		for _, o := range objects {
+			if _, ok := o.(*unstructured.Unstructured); ok {
+				apiReader.WithObjects(o)
+			} else {
+				fakeClient.WithObjects(o)
+			}
+		}
+
+		pluginManager, err := NewPluginManager(ctx, dummySetupContextWithAPIReader(fakeClient.Build(), apiReader.Build()), k8s.PluginEntry{
+			ID:              "x",
+			ResourceToWatch: &v1.Pod{},
+			Plugin:          p,
+		}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+		assert.NoError(t, err)
+		return pluginManager
+	}
+
+	t.Run("sampled while running", func(t *testing.T) {
+		pluginManager := newPluginManager(t, pluginsCore.PhaseInfoRunning(pluginsCore.DefaultPhaseVersion, nil), pod, podMetrics)
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{
+			Phase:             PluginPhaseStarted,
+			PeakResourceUsage: flytek8s.ResourceUsage{CPUMillis: 100, MemoryBytes: 512 * 1024 * 1024},
+		}, &writtenState)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRunning, transition.Info().Phase())
+		assert.Empty(t, transition.Info().Info().PeakResourceUsage)
+		assert.Equal(t, flytek8s.ResourceUsage{CPUMillis: 500, MemoryBytes: 512 * 1024 * 1024}, writtenState.PeakResourceUsage)
+		assert.False(t, writtenState.LastResourceUsageSample.IsZero())
+	})
+
+	t.Run("sampling interval", func(t *testing.T) {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+			ResourceUsage: config.ResourceUsageConfig{
+				Enabled:          true,
+				SamplingInterval: flytestdlibConfig.Duration{Duration: time.Hour},
+			},
+		}))
+
+		pluginManager := newPluginManager(t, pluginsCore.PhaseInfoRunning(pluginsCore.DefaultPhaseVersion, nil), pod, podMetrics)
+		lastSample := time.Now().Add(-time.Minute)
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{
+			Phase:                   PluginPhaseStarted,
+			PeakResourceUsage:       flytek8s.ResourceUsage{CPUMillis: 100},
+			LastResourceUsageSample: lastSample,
+		}, &writtenState)
+
+		_, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, flytek8s.ResourceUsage{CPUMillis: 100}, writtenState.PeakResourceUsage)
+		assert.Equal(t, lastSample, writtenState.LastResourceUsageSample)
+	})
+
+	t.Run("reported on completion", func(t *testing.T) {
+		oomKilledPod := pod.DeepCopy()
+		oomKilledPod.Status.ContainerStatuses = []v1.ContainerStatus{
+			{
+				Name: "primary",
+				State: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{Reason: flytek8s.OOMKilled},
+				},
+			},
+		}
+
+		pluginManager := newPluginManager(t, pluginsCore.PhaseInfoRetryableFailure(flytek8s.OOMKilled, "oom", nil), oomKilledPod)
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{
+			Phase:             PluginPhaseStarted,
+			PeakResourceUsage: flytek8s.ResourceUsage{CPUMillis: 500, MemoryBytes: 512 * 1024 * 1024},
+		}, &writtenState)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRetryableFailure, transition.Info().Phase())
+		assert.Equal(t, []*core.Resources_ResourceEntry{
+			{Name: core.Resources_CPU, Value: "500m"},
+			{Name: core.Resources_MEMORY, Value: "1Gi"},
+		}, transition.Info().Info().PeakResourceUsage)
+	})
+}
+
+func TestPluginManager_Preemption(t *testing.T) {
+	ctx := context.TODO()
+	defer func() {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+	}()
+
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+		Preemption: config.PreemptionConfig{
+			Enabled:     true,
+			NodeTaints:  []string{"spot-termination"},
+			GracePeriod: flytestdlibConfig.Duration{Duration: time.Minute},
+		},
+	}))
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: v1.PodSpec{
+			NodeName: "node",
+		},
+	}
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node"},
+	}
+
+	newPluginManager := func(t *testing.T, phaseInfo pluginsCore.PhaseInfo, objects ...client.Object) (*PluginManager, client.Client) {
+		p := &pluginsk8sMock.Plugin{}
+		p.OnGetProperties().Return(k8s.PluginProperties{})
+		p.OnBuildIdentityResourceMatch(mock.Anything, mock.Anything).Return(&v1.Pod{}, nil)
+		p.OnGetTaskPhaseMatch(mock.Anything, mock.Anything, mock.Anything).Return(phaseInfo, nil)
+		fakeClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+		pluginManager, err := NewPluginManager(ctx, dummySetupContext(fakeClient), k8s.PluginEntry{
+			ID:              "x",
+			ResourceToWatch: &v1.Pod{},
+			Plugin:          p,
+		}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+		assert.NoError(t, err)
+		return pluginManager, fakeClient
+	}
+
+	t.Run("not preempted", func(t *testing.T) {
+		pluginManager, _ := newPluginManager(t, pluginsCore.PhaseInfoRetryableFailure("Interrupted", "interrupted", nil), pod, node)
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{Phase: PluginPhaseStarted}, &writtenState)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, "Interrupted", transition.Info().Err().GetCode())
+		assert.Empty(t, writtenState.Preemption)
+	})
+
+	t.Run("node reclaimed", func(t *testing.T) {
+		taintedNode := node.DeepCopy()
+		taintedNode.Spec.Taints = []v1.Taint{{Key: "spot-termination", Effect: v1.TaintEffectNoSchedule}}
+		pluginManager, fakeClient := newPluginManager(t, pluginsCore.PhaseInfoRunning(pluginsCore.DefaultPhaseVersion, nil), pod, taintedNode)
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{Phase: PluginPhaseStarted}, &writtenState)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRunning, transition.Info().Phase())
+		assert.Equal(t, "node [node] of pod [test] is being reclaimed [spot-termination]", writtenState.Preemption)
+		err = fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "test"}, &v1.Pod{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("disrupted pod terminated", func(t *testing.T) {
+		disruptedPod := pod.DeepCopy()
+		disruptedPod.Status.Conditions = []v1.PodCondition{
+			{Type: v1.DisruptionTarget, Status: v1.ConditionTrue, Reason: v1.PodReasonPreemptionByScheduler, Message: "preempted"},
+		}
+		pluginManager, _ := newPluginManager(t, pluginsCore.PhaseInfoFailure("Error", "exit code 1", nil), disruptedPod, node)
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{Phase: PluginPhaseStarted}, &writtenState)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRetryableFailure, transition.Info().Phase())
+		assert.Equal(t, flytek8s.Preempted, transition.Info().Err().GetCode())
+		assert.Equal(t, core.ExecutionError_SYSTEM, transition.Info().Err().GetKind())
+	})
+
+	t.Run("preempted pod deleted", func(t *testing.T) {
+		pluginManager, _ := newPluginManager(t, pluginsCore.PhaseInfoRunning(pluginsCore.DefaultPhaseVersion, nil))
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{Phase: PluginPhaseStarted, Preemption: "preempted"}, &writtenState)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, flytek8s.Preempted, transition.Info().Err().GetCode())
+		assert.Equal(t, "preempted", transition.Info().Err().GetMessage())
+	})
+}
+
+type preemptionPlugin struct {
+	pluginsk8sMock.Plugin
+	pluginsk8sMock.PreemptionPlugin
+}
+
+func TestPluginManager_PreemptionOperatorPods(t *testing.T) {
+	ctx := context.TODO()
+	defer func() {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+	}()
+
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+		Preemption: config.PreemptionConfig{
+			Enabled:     true,
+			GracePeriod: flytestdlibConfig.Duration{Duration: time.Minute},
+		},
+	}))
+
+	job := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test",
+			Namespace:  "ns",
+			Finalizers: []string{"flyte/flytek8s"},
+		},
+	}
+	workerPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-worker-0",
+			Namespace: "ns",
+			Labels:    map[string]string{"job-name": "test"},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+		},
+	}
+
+	newPluginManager := func(t *testing.T, objects ...client.Object) (*PluginManager, client.Client) {
+		p := &preemptionPlugin{}
+		p.Plugin.OnGetProperties().Return(k8s.PluginProperties{})
+		p.Plugin.OnBuildIdentityResourceMatch(mock.Anything, mock.Anything).Return(&v1.ConfigMap{}, nil)
+		p.Plugin.OnGetTaskPhaseMatch(mock.Anything, mock.Anything, mock.Anything).Return(
+			pluginsCore.PhaseInfoRunning(pluginsCore.DefaultPhaseVersion, nil), nil)
+		p.PreemptionPlugin.OnGetPodLabelsMatch(mock.Anything, mock.Anything).Return(
+			map[string]string{"job-name": "test"}, nil)
+		fakeClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+		pluginManager, err := NewPluginManager(ctx, dummySetupContext(fakeClient), k8s.PluginEntry{
+			ID:              "x",
+			ResourceToWatch: &v1.ConfigMap{},
+			Plugin:          p,
+		}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+		assert.NoError(t, err)
+		return pluginManager, fakeClient
+	}
+
+	t.Run("not preempted", func(t *testing.T) {
+		pluginManager, fakeClient := newPluginManager(t, job, workerPod)
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{Phase: PluginPhaseStarted}, &writtenState)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRunning, transition.Info().Phase())
+		assert.Empty(t, writtenState.Preemption)
+		actual := &v1.ConfigMap{}
+		assert.NoError(t, fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "test"}, actual))
+		assert.Nil(t, actual.GetDeletionTimestamp())
+	})
+
+	t.Run("worker preempted", func(t *testing.T) {
+		disruptedPod := workerPod.DeepCopy()
+		disruptedPod.Status.Conditions = []v1.PodCondition{
+			{Type: v1.DisruptionTarget, Status: v1.ConditionTrue, Reason: v1.PodReasonPreemptionByScheduler, Message: "preempted"},
+		}
+		pluginManager, fakeClient := newPluginManager(t, job, disruptedPod)
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{Phase: PluginPhaseStarted}, &writtenState)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRunning, transition.Info().Phase())
+		assert.Equal(t, "pod [test-worker-0] was preempted [PreemptionByScheduler]: preempted", writtenState.Preemption)
+		actual := &v1.ConfigMap{}
+		assert.NoError(t, fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "test"}, actual))
+		assert.NotNil(t, actual.GetDeletionTimestamp())
+	})
+
+	t.Run("pods terminated", func(t *testing.T) {
+		terminatedPod := workerPod.DeepCopy()
+		terminatedPod.Status.Phase = v1.PodFailed
+		pluginManager, _ := newPluginManager(t, job, terminatedPod)
+		writtenState := PluginState{}
+		tCtx := getResourceUsageTaskContext(PluginState{Phase: PluginPhaseStarted, Preemption: "preempted"}, &writtenState)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRetryableFailure, transition.Info().Phase())
+		assert.Equal(t, flytek8s.Preempted, transition.Info().Err().GetCode())
+		assert.Equal(t, "preempted", transition.Info().Err().GetMessage())
+	})
 }
 
 func init() {
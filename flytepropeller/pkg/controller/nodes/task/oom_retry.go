@@ -0,0 +1,136 @@
+package task
+
+import (
+	"context"
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
+	controllerconfig "github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/errors"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/interfaces"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// escalatedTaskOverrides overrides the resources of a task with its memory escalated after previous attempts were
+// OOMKilled.
+type escalatedTaskOverrides struct {
+	pluginCore.TaskOverrides
+	resources *v1.ResourceRequirements
+}
+
+func (o escalatedTaskOverrides) GetResources() *v1.ResourceRequirements {
+	return o.resources
+}
+
+// getTaskOverrides returns the overrides of the task, with its memory escalated if the OOM retry policy is enabled and
+// previous attempts were OOMKilled. The memory is escalated from the effective resources of the task, those of its
+// template's container with the resources of the node overriding them. The escalated resources are returned as well to
+// be reported in task events, they are nil if the memory wasn't escalated.
+func getTaskOverrides(ctx context.Context, nCtx interfaces.NodeExecutionContext, platformResources *v1.ResourceRequirements) (
+	pluginCore.TaskOverrides, *core.Resources, error) {
+	policy := controllerconfig.GetConfig().NodeConfig.OOMRetryPolicy
+	if !policy.Enabled {
+		return nCtx.Node(), nil, nil
+	}
+
+	oomFailures := nCtx.NodeStatus().GetOOMFailures()
+	if oomFailures == 0 {
+		return nCtx.Node(), nil, nil
+	}
+
+	taskTemplate, err := nCtx.TaskReader().Read(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrapf(errors.BadSpecificationError, nCtx.NodeID(), err, "failed to read task template")
+	}
+
+	resources, err := flytek8s.ToK8sResourceRequirements(taskTemplate.GetContainer().GetResources())
+	if err != nil {
+		return nil, nil, errors.Wrapf(errors.BadSpecificationError, nCtx.NodeID(), err, "invalid task resources")
+	}
+
+	if nodeResources := nCtx.Node().GetResources(); nodeResources != nil {
+		flytek8s.MergeResources(*nodeResources.DeepCopy(), resources)
+	}
+
+	resources, escalatedResources := escalateMemory(resources, platformResources, policy.MemoryMultiplier, oomFailures)
+	if escalatedResources == nil {
+		return nCtx.Node(), nil, nil
+	}
+
+	logger.Infof(ctx, "Escalated memory of the task to [%v] after [%d] OOMKilled attempts", escalatedResources, oomFailures)
+	return escalatedTaskOverrides{
+		TaskOverrides: nCtx.Node(),
+		resources:     resources,
+	}, escalatedResources, nil
+}
+
+// escalateMemory multiplies the memory requests and limits by the multiplier for each OOMKilled attempt, capped by the
+// platform memory limit. If the task sets no memory at all, the platform default is escalated instead. It returns nil
+// if there's no memory to escalate.
+func escalateMemory(resources, platformResources *v1.ResourceRequirements, multiplier float64, oomFailures uint32) (
+	*v1.ResourceRequirements, *core.Resources) {
+	if oomFailures == 0 || multiplier <= 1 {
+		return nil, nil
+	}
+
+	escalated := &v1.ResourceRequirements{}
+	if resources != nil {
+		escalated = resources.DeepCopy()
+	}
+
+	if platformResources == nil {
+		platformResources = &v1.ResourceRequirements{}
+	}
+
+	request := escalated.Requests[v1.ResourceMemory]
+	limit := escalated.Limits[v1.ResourceMemory]
+	if request.IsZero() && limit.IsZero() {
+		request = platformResources.Requests[v1.ResourceMemory]
+	}
+
+	factor := math.Pow(multiplier, float64(oomFailures))
+	ceiling := platformResources.Limits[v1.ResourceMemory]
+	escalatedResources := &core.Resources{}
+	if !request.IsZero() {
+		if escalated.Requests == nil {
+			escalated.Requests = v1.ResourceList{}
+		}
+
+		escalated.Requests[v1.ResourceMemory] = scaleQuantity(request, factor, ceiling)
+		escalatedResources.Requests = append(escalatedResources.Requests, toMemoryResourceEntry(escalated.Requests))
+	}
+
+	if !limit.IsZero() {
+		escalated.Limits[v1.ResourceMemory] = scaleQuantity(limit, factor, ceiling)
+		escalatedResources.Limits = append(escalatedResources.Limits, toMemoryResourceEntry(escalated.Limits))
+	}
+
+	if len(escalatedResources.Requests) == 0 && len(escalatedResources.Limits) == 0 {
+		return nil, nil
+	}
+
+	return escalated, escalatedResources
+}
+
+// scaleQuantity multiplies the quantity by the factor, capped by the ceiling if one is set.
+func scaleQuantity(quantity resource.Quantity, factor float64, ceiling resource.Quantity) resource.Quantity {
+	scaled := *resource.NewQuantity(int64(math.Ceil(float64(quantity.Value())*factor)), resource.BinarySI)
+	if !ceiling.IsZero() && scaled.Cmp(ceiling) > 0 {
+		return ceiling
+	}
+
+	return scaled
+}
+
+func toMemoryResourceEntry(resources v1.ResourceList) *core.Resources_ResourceEntry {
+	memory := resources[v1.ResourceMemory]
+	return &core.Resources_ResourceEntry{
+		Name:  core.Resources_MEMORY,
+		Value: memory.String(),
+	}
+}
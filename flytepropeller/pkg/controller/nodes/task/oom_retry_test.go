@@ -0,0 +1,165 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	flyteMocks "github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1/mocks"
+	controllerconfig "github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+	nodeMocks "github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/interfaces/mocks"
+)
+
+func TestEscalateMemory(t *testing.T) {
+	platformResources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("500Mi")},
+		Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+	}
+
+	t.Run("requests and limits", func(t *testing.T) {
+		resources := &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1536Mi")},
+		}
+
+		escalated, escalatedResources := escalateMemory(resources, platformResources, 2, 2)
+		assert.Equal(t, resource.MustParse("1"), escalated.Requests[corev1.ResourceCPU])
+		assert.Equal(t, "4Gi", escalated.Requests.Memory().String())
+		assert.Equal(t, "6Gi", escalated.Limits.Memory().String())
+		assert.True(t, proto.Equal(&core.Resources{
+			Requests: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "4Gi"}},
+			Limits:   []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "6Gi"}},
+		}, escalatedResources))
+		assert.Equal(t, "1Gi", resources.Requests.Memory().String())
+	})
+
+	t.Run("capped by platform limit", func(t *testing.T) {
+		resources := &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("3Gi")},
+		}
+
+		escalated, escalatedResources := escalateMemory(resources, platformResources, 2, 2)
+		assert.Equal(t, "8Gi", escalated.Limits.Memory().String())
+		assert.Empty(t, escalated.Requests)
+		assert.True(t, proto.Equal(&core.Resources{
+			Limits: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "8Gi"}},
+		}, escalatedResources))
+	})
+
+	t.Run("platform default", func(t *testing.T) {
+		escalated, escalatedResources := escalateMemory(nil, platformResources, 1.5, 1)
+		assert.Equal(t, "750Mi", escalated.Requests.Memory().String())
+		assert.True(t, proto.Equal(&core.Resources{
+			Requests: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "750Mi"}},
+		}, escalatedResources))
+	})
+
+	t.Run("nothing to escalate", func(t *testing.T) {
+		escalated, escalatedResources := escalateMemory(&corev1.ResourceRequirements{}, nil, 2, 1)
+		assert.Nil(t, escalated)
+		assert.Nil(t, escalatedResources)
+	})
+
+	t.Run("not OOMKilled", func(t *testing.T) {
+		escalated, escalatedResources := escalateMemory(resources, platformResources, 2, 0)
+		assert.Nil(t, escalated)
+		assert.Nil(t, escalatedResources)
+	})
+}
+
+func TestGetTaskOverrides(t *testing.T) {
+	getNodeExecutionContext := func(nodeResources *corev1.ResourceRequirements, templateResources *core.Resources) *nodeMocks.NodeExecutionContext {
+		n := &flyteMocks.ExecutableNode{}
+		n.OnGetResources().Return(nodeResources)
+		ns := &flyteMocks.ExecutableNodeStatus{}
+		ns.OnGetOOMFailures().Return(1)
+		tr := &nodeMocks.TaskReader{}
+		tr.OnReadMatch(mock.Anything).Return(&core.TaskTemplate{
+			Target: &core.TaskTemplate_Container{
+				Container: &core.Container{Resources: templateResources},
+			},
+		}, nil)
+		nCtx := &nodeMocks.NodeExecutionContext{}
+		nCtx.OnNode().Return(n)
+		nCtx.OnNodeStatus().Return(ns)
+		nCtx.OnTaskReader().Return(tr)
+		nCtx.OnNodeID().Return("n1")
+		return nCtx
+	}
+
+	policy := &controllerconfig.GetConfig().NodeConfig.OOMRetryPolicy
+	defer func(p controllerconfig.OOMRetryPolicy) { *policy = p }(*policy)
+
+	t.Run("disabled", func(t *testing.T) {
+		policy.Enabled = false
+		nodeResources := &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+		}
+
+		overrides, escalatedResources, err := getTaskOverrides(context.TODO(), getNodeExecutionContext(nodeResources, nil), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, nodeResources, overrides.GetResources())
+		assert.Nil(t, escalatedResources)
+	})
+
+	policy.Enabled = true
+	policy.MemoryMultiplier = 2
+
+	t.Run("node resources", func(t *testing.T) {
+		nodeResources := &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+		}
+
+		overrides, escalatedResources, err := getTaskOverrides(context.TODO(), getNodeExecutionContext(nodeResources, nil), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "2Gi", overrides.GetResources().Requests.Memory().String())
+		assert.True(t, proto.Equal(&core.Resources{
+			Requests: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "2Gi"}},
+		}, escalatedResources))
+		assert.Equal(t, "1Gi", nodeResources.Requests.Memory().String())
+	})
+
+	t.Run("template resources", func(t *testing.T) {
+		nCtx := getNodeExecutionContext(nil, &core.Resources{
+			Requests: []*core.Resources_ResourceEntry{
+				{Name: core.Resources_CPU, Value: "1"},
+				{Name: core.Resources_MEMORY, Value: "1Gi"},
+			},
+			Limits: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "2Gi"}},
+		})
+
+		overrides, escalatedResources, err := getTaskOverrides(context.TODO(), nCtx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, resource.MustParse("1"), overrides.GetResources().Requests[corev1.ResourceCPU])
+		assert.Equal(t, "2Gi", overrides.GetResources().Requests.Memory().String())
+		assert.Equal(t, "4Gi", overrides.GetResources().Limits.Memory().String())
+		assert.True(t, proto.Equal(&core.Resources{
+			Requests: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "2Gi"}},
+			Limits:   []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "4Gi"}},
+		}, escalatedResources))
+	})
+
+	t.Run("node resources override template resources", func(t *testing.T) {
+		nodeResources := &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("3Gi")},
+		}
+		nCtx := getNodeExecutionContext(nodeResources, &core.Resources{
+			Requests: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "1Gi"}},
+			Limits:   []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "4Gi"}},
+		})
+
+		overrides, _, err := getTaskOverrides(context.TODO(), nCtx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "6Gi", overrides.GetResources().Requests.Memory().String())
+		assert.Equal(t, "8Gi", overrides.GetResources().Limits.Memory().String())
+	})
+}
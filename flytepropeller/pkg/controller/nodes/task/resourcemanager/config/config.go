@@ -1,7 +1,10 @@
 package config
 
 import (
+	"time"
+
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+	stdConfig "github.com/flyteorg/flyte/flytestdlib/config"
 )
 
 //go:generate pflags Config --default-var=defaultConfig
@@ -13,6 +16,8 @@ type Type = string
 const (
 	TypeNoop  Type = "noop"
 	TypeRedis Type = "redis"
+	// TypePostgres stores the allocated tokens in a Postgres database
+	TypePostgres Type = "postgres"
 )
 
 var (
@@ -20,6 +25,10 @@ var (
 		Type: TypeNoop,
 		// TODO: Noop Resource Manager doesn't use MaxQuota. Maybe we can remove it?
 		ResourceMaxQuota: 1000,
+		PostgresConfig: PostgresConfig{
+			LeaseDuration: stdConfig.Duration{Duration: 5 * time.Minute},
+			RenewInterval: stdConfig.Duration{Duration: time.Minute},
+		},
 	}
 
 	configSection = config.MustRegisterSubSection(configSectionKey, &defaultConfig)
@@ -27,9 +36,10 @@ var (
 
 // Configs for Resource Manager
 type Config struct {
-	Type             Type        `json:"type" pflag:"noop, Which resource manager to use, redis or noop. Default is noop."`
-	ResourceMaxQuota int         `json:"resourceMaxQuota" pflag:",Global limit for concurrent Qubole queries"`
-	RedisConfig      RedisConfig `json:"redis" pflag:",Config for Redis resourcemanager."`
+	Type             Type           `json:"type" pflag:"noop, Which resource manager to use, redis, postgres or noop. Default is noop."`
+	ResourceMaxQuota int            `json:"resourceMaxQuota" pflag:",Global limit for concurrent Qubole queries"`
+	RedisConfig      RedisConfig    `json:"redis" pflag:",Config for Redis resourcemanager."`
+	PostgresConfig   PostgresConfig `json:"postgres" pflag:",Config for Postgres resourcemanager."`
 }
 
 // Specific configs for Redis resource manager
@@ -43,6 +53,16 @@ type RedisConfig struct {
 	MaxRetries int    `json:"maxRetries" pflag:",See Redis client options for more info"`
 }
 
+// Specific configs for Postgres resource manager. The tokens are stored in the database configured in the database
+// section.
+type PostgresConfig struct {
+	// Owner identifies the tokens allocated by this propeller. The leases of all live tokens of the owner are renewed,
+	// including the ones allocated before a restart. Defaults to the pod name, or the hostname outside of a pod.
+	Owner         string             `json:"owner" pflag:",Identifies the propeller allocating the tokens. Has to be unique among the propellers sharing the database. Defaults to the pod name or hostname."`
+	LeaseDuration stdConfig.Duration `json:"leaseDuration" pflag:",Duration after which an allocated token expires unless its lease is renewed."`
+	RenewInterval stdConfig.Duration `json:"renewInterval" pflag:",Interval in which the leases of the tokens of the owner are renewed."`
+}
+
 // Retrieves the current config value or default.
 func GetConfig() *Config {
 	return configSection.GetConfig().(*Config)
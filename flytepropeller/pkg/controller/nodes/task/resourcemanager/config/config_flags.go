@@ -50,12 +50,15 @@ func (Config) mustMarshalJSON(v json.Marshaler) string {
 // flags is json-name.json-sub-name... etc.
 func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags := pflag.NewFlagSet("Config", pflag.ExitOnError)
-	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "type"), defaultConfig.Type, " Which resource manager to use,  redis or noop. Default is noop.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "type"), defaultConfig.Type, " Which resource manager to use,  redis,  postgres or noop. Default is noop.")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "resourceMaxQuota"), defaultConfig.ResourceMaxQuota, "Global limit for concurrent Qubole queries")
 	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "redis.hostPaths"), defaultConfig.RedisConfig.HostPaths, "Redis hosts locations.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "redis.primaryName"), defaultConfig.RedisConfig.PrimaryName, "Redis primary name,  fill in only if you are connecting to a redis sentinel cluster.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "redis.hostPath"), defaultConfig.RedisConfig.HostPath, "Redis host location")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "redis.hostKey"), defaultConfig.RedisConfig.HostKey, "Key for local Redis access")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "redis.maxRetries"), defaultConfig.RedisConfig.MaxRetries, "See Redis client options for more info")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "postgres.owner"), defaultConfig.PostgresConfig.Owner, "Identifies the propeller allocating the tokens. Has to be unique among the propellers sharing the database. Defaults to the pod name or hostname.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "postgres.leaseDuration"), defaultConfig.PostgresConfig.LeaseDuration.String(), "Duration after which an allocated token expires unless its lease is renewed.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "postgres.renewInterval"), defaultConfig.PostgresConfig.RenewInterval.String(), "Interval in which the leases of the tokens of the owner are renewed.")
 	return cmdFlags
 }
@@ -197,4 +197,46 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_postgres.owner", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("postgres.owner", testValue)
+			if vString, err := cmdFlags.GetString("postgres.owner"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.PostgresConfig.Owner)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_postgres.leaseDuration", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultConfig.PostgresConfig.LeaseDuration.String()
+
+			cmdFlags.Set("postgres.leaseDuration", testValue)
+			if vString, err := cmdFlags.GetString("postgres.leaseDuration"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.PostgresConfig.LeaseDuration)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_postgres.renewInterval", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultConfig.PostgresConfig.RenewInterval.String()
+
+			cmdFlags.Set("postgres.renewInterval", testValue)
+			if vString, err := cmdFlags.GetString("postgres.renewInterval"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.PostgresConfig.RenewInterval)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
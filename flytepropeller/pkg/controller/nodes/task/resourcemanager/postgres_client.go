@@ -0,0 +1,63 @@
+package resourcemanager
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/flyteorg/flyte/flytestdlib/database"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// tokenMigrationsTable keeps the applied migrations of the token table apart from the migrations of other components
+// sharing the database.
+const tokenMigrationsTable = "resource_manager_migrations"
+
+const postgresDialector = "postgres"
+
+// TokenMigrations create and update the table of the allocated tokens. The models are copied into the migrations so
+// that later changes of the table don't alter past migrations.
+var TokenMigrations = []*gormigrate.Migration{
+	{
+		ID: "2026-10-18-resource-manager-tokens",
+		Migrate: func(tx *gorm.DB) error {
+			type ResourceManagerToken struct {
+				Namespace string    `gorm:"primaryKey;index:idx_resource_manager_tokens_owner,priority:1"`
+				Token     string    `gorm:"primaryKey"`
+				Owner     string    `gorm:"not null;index:idx_resource_manager_tokens_owner,priority:2"`
+				ExpiresAt time.Time `gorm:"not null"`
+				CreatedAt time.Time `gorm:"not null"`
+			}
+
+			return tx.Table("resource_manager_tokens").AutoMigrate(&ResourceManagerToken{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("resource_manager_tokens")
+		},
+	},
+}
+
+// NewPostgresClient connects to the configured database and runs the migrations of the token table. Allocations rely
+// on Postgres advisory locks, so other databases are rejected.
+func NewPostgresClient(ctx context.Context, dbConfig *database.DbConfig) (*sql.DB, error) {
+	gormDb, err := database.GetDB(ctx, dbConfig, logger.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	if name := gormDb.Dialector.Name(); name != postgresDialector {
+		return nil, errors.Errorf("the postgres resource manager requires a postgres database, got [%v]", name)
+	}
+
+	options := *gormigrate.DefaultOptions
+	options.TableName = tokenMigrationsTable
+	if err := gormigrate.New(gormDb.WithContext(ctx), &options, TokenMigrations).Migrate(); err != nil {
+		return nil, errors.Wrapf(err, "failed to migrate the resource token table")
+	}
+
+	return gormDb.DB()
+}
@@ -0,0 +1,352 @@
+package resourcemanager
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+
+	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	rmConfig "github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/resourcemanager/config"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+const PostgresResourceManagerID = "postgresresourcemanager"
+
+const podNameEnvVar = "POD_NAME"
+
+type PostgresResourceManagerBuilder struct {
+	db                          *sql.DB
+	clock                       clock.Clock
+	MetricsScope                promutils.Scope
+	namespacedResourcesQuotaMap map[pluginCore.ResourceNamespace]int
+}
+
+func (r *PostgresResourceManagerBuilder) GetID() string {
+	return PostgresResourceManagerID
+}
+
+func (r *PostgresResourceManagerBuilder) GetResourceRegistrar(namespacePrefix pluginCore.ResourceNamespace) pluginCore.ResourceRegistrar {
+	return ResourceRegistrarProxy{
+		ResourceRegistrar:       r,
+		ResourceNamespacePrefix: namespacePrefix,
+	}
+}
+
+func (r *PostgresResourceManagerBuilder) RegisterResourceQuota(ctx context.Context, namespace pluginCore.ResourceNamespace, quota int) error {
+	if r.db == nil {
+		return errors.Errorf("Database connection does not exist.")
+	}
+
+	config := rmConfig.GetConfig()
+	if quota <= 0 || quota > config.ResourceMaxQuota {
+		return errors.Errorf("Invalid request for resource quota (<= 0 || > %v): [%v]", config.ResourceMaxQuota, quota)
+	}
+
+	if _, ok := r.namespacedResourcesQuotaMap[namespace]; ok {
+		return errors.Errorf("Resource namespace already exists [%v]", namespace)
+	}
+
+	r.namespacedResourcesQuotaMap[namespace] = quota
+	logger.Infof(ctx, "Registering resource quota for Namespace [%v]. Quota [%v]", namespace, quota)
+	return nil
+}
+
+func (r *PostgresResourceManagerBuilder) BuildResourceManager(ctx context.Context) (BaseResourceManager, error) {
+	if r.db == nil || r.MetricsScope == nil || r.namespacedResourcesQuotaMap == nil {
+		return nil, errors.Errorf("Failed to build a postgres resource manager. Missing key property(s)")
+	}
+
+	config := rmConfig.GetConfig().PostgresConfig
+	owner := config.Owner
+	if len(owner) == 0 {
+		owner = getDefaultOwner()
+	}
+	if len(owner) == 0 {
+		return nil, errors.Errorf("Invalid postgres resource manager config, the owner of the allocated tokens is required")
+	}
+	if config.LeaseDuration.Duration <= 0 || config.RenewInterval.Duration <= 0 || config.RenewInterval.Duration >= config.LeaseDuration.Duration {
+		return nil, errors.Errorf("Invalid postgres resource manager config, the renew interval [%v] has to be shorter than the lease duration [%v]",
+			config.RenewInterval.Duration, config.LeaseDuration.Duration)
+	}
+
+	rm := &PostgresResourceManager{
+		db:                     r.db,
+		clock:                  r.clock,
+		owner:                  owner,
+		leaseDuration:          config.LeaseDuration.Duration,
+		MetricsScope:           r.MetricsScope,
+		namespacedResourcesMap: map[pluginCore.ResourceNamespace]*Resource{},
+	}
+
+	for namespace, quota := range r.namespacedResourcesQuotaMap {
+		metrics := NewPostgresResourceManagerMetrics(r.MetricsScope.NewSubScope(getValidMetricScopeName(string(namespace))))
+		rm.namespacedResourcesMap[namespace] = &Resource{
+			quota:          BaseResourceConstraint{Value: int64(quota)},
+			metrics:        metrics,
+			rejectedTokens: sync.Map{},
+		}
+		logger.Infof(ctx, "Creating namespacedResourcesMap: added namespace [%v] and resource [%v]", namespace, rm.namespacedResourcesMap[namespace])
+	}
+
+	rm.startLeaseRenewal(ctx, config.RenewInterval.Duration)
+	return rm, nil
+}
+
+// getDefaultOwner identifies this propeller by its pod name, or by the hostname outside of a pod
+func getDefaultOwner() string {
+	if podName, found := os.LookupEnv(podNameEnvVar); found {
+		return podName
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return hostname
+}
+
+func NewPostgresResourceManagerBuilder(_ context.Context, db *sql.DB, scope promutils.Scope) (*PostgresResourceManagerBuilder, error) {
+	return &PostgresResourceManagerBuilder{
+		db:                          db,
+		clock:                       clock.RealClock{},
+		MetricsScope:                scope,
+		namespacedResourcesQuotaMap: map[pluginCore.ResourceNamespace]int{},
+	}, nil
+}
+
+// PostgresResourceManager stores the allocated tokens in the resource_manager_tokens table. Allocations of a namespace
+// are serialized with a transaction scoped advisory lock, so the quota and constraints are never exceeded. Every token
+// records the owner which allocated it, and the owner periodically renews the leases of all its live tokens. Tokens
+// expire if their lease is not renewed, which frees the tokens of an owner which went away without releasing them.
+type PostgresResourceManager struct {
+	db                     *sql.DB
+	clock                  clock.Clock
+	owner                  string
+	leaseDuration          time.Duration
+	MetricsScope           promutils.Scope
+	namespacedResourcesMap map[pluginCore.ResourceNamespace]*Resource
+}
+
+type PostgresResourceManagerMetrics struct {
+	Scope                     promutils.Scope
+	LeaseRenewalTime          promutils.StopWatch
+	LeaseRenewalFailures      prometheus.Counter
+	AllocatedTokensGauge      prometheus.Gauge
+	ApproximateBackedUpLength prometheus.Gauge
+}
+
+func (m PostgresResourceManagerMetrics) GetScope() promutils.Scope {
+	return m.Scope
+}
+
+func NewPostgresResourceManagerMetrics(scope promutils.Scope) *PostgresResourceManagerMetrics {
+	return &PostgresResourceManagerMetrics{
+		Scope: scope,
+		LeaseRenewalTime: scope.MustNewStopWatch("lease_renewal_time",
+			"The time it takes to renew the leases of the tokens of this owner", time.Millisecond),
+		LeaseRenewalFailures: scope.MustNewCounter("lease_renewal_failures",
+			"The number of times renewing the leases of the tokens of this owner failed"),
+		AllocatedTokensGauge: scope.MustNewGauge("size",
+			"The number of allocation resourceRegistryTokens currently held in the database"),
+		ApproximateBackedUpLength: scope.MustNewGauge("approx_backup",
+			"Approximation for how long the current not-fulfilled-tokens queue is."),
+	}
+}
+
+func (r *PostgresResourceManager) GetID() string {
+	return PostgresResourceManagerID
+}
+
+func (r *PostgresResourceManager) getResource(namespace pluginCore.ResourceNamespace) (*Resource, error) {
+	if resource, ok := r.namespacedResourcesMap[namespace]; ok {
+		return resource, nil
+	}
+	return nil, errors.Errorf("Requested resource [%v] not found in namespacedResourceMap", namespace)
+}
+
+// lockNamespace serializes the allocations of the namespace until the end of the transaction
+func (r *PostgresResourceManager) lockNamespace(ctx context.Context, tx *sql.Tx, namespace pluginCore.ResourceNamespace) error {
+	rows, err := tx.QueryContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", string(namespace))
+	if err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+func (r *PostgresResourceManager) getAllocatedTokens(ctx context.Context, tx *sql.Tx, namespace pluginCore.ResourceNamespace) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT token FROM resource_manager_tokens WHERE namespace = $1", string(namespace))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []string
+	for rows.Next() {
+		var token string
+		if err := rows.Scan(&token); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, rows.Err()
+}
+
+// allocate grants the token if it's already allocated or if the namespace quota and constraints allow allocating it
+func (r *PostgresResourceManager) allocate(ctx context.Context, tx *sql.Tx, namespace pluginCore.ResourceNamespace, allocationToken Token,
+	namespacedResource *Resource, composedResourceConstraintList []FullyQualifiedResourceConstraint) (pluginCore.AllocationStatus, error) {
+	if err := r.lockNamespace(ctx, tx, namespace); err != nil {
+		return pluginCore.AllocationUndefined, err
+	}
+
+	now := r.clock.Now()
+	// Renewing the lease of a token which is already allocated grants it again
+	result, err := tx.ExecContext(ctx,
+		"UPDATE resource_manager_tokens SET expires_at = $1, owner = $2 WHERE namespace = $3 AND token = $4",
+		now.Add(r.leaseDuration), r.owner, string(namespace), string(allocationToken))
+	if err != nil {
+		return pluginCore.AllocationUndefined, err
+	}
+	if renewed, err := result.RowsAffected(); err != nil {
+		return pluginCore.AllocationUndefined, err
+	} else if renewed > 0 {
+		logger.Infof(ctx, "Already allocated [%s:%s]", namespace, allocationToken)
+		return pluginCore.AllocationStatusGranted, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM resource_manager_tokens WHERE namespace = $1 AND expires_at <= $2",
+		string(namespace), now); err != nil {
+		return pluginCore.AllocationUndefined, err
+	}
+
+	allAllocated, err := r.getAllocatedTokens(ctx, tx, namespace)
+	if err != nil {
+		return pluginCore.AllocationUndefined, err
+	}
+
+	if !namespacedResource.quota.IsAllowed(int64(len(allAllocated))) {
+		logger.Infof(ctx, "Too many allocations (total [%d]), rejecting [%s:%s]", len(allAllocated), namespace, allocationToken)
+		return pluginCore.AllocationStatusExhausted, nil
+	}
+
+	for _, constraint := range composedResourceConstraintList {
+		if !constraint.isAllowedFor(allAllocated) {
+			logger.Infof(ctx, "Too many allocations for resource [%v], scope [%v] (max allocation: [%d]), rejecting token [%s]",
+				namespace, constraint.TargetedPrefixString, constraint.Value, allocationToken)
+			return pluginCore.AllocationStatusExhausted, nil
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO resource_manager_tokens (namespace, token, owner, expires_at, created_at) VALUES ($1, $2, $3, $4, $5)",
+		string(namespace), string(allocationToken), r.owner, now.Add(r.leaseDuration), now); err != nil {
+		return pluginCore.AllocationUndefined, err
+	}
+
+	return pluginCore.AllocationStatusGranted, nil
+}
+
+func (r *PostgresResourceManager) AllocateResource(ctx context.Context, namespace pluginCore.ResourceNamespace, allocationToken Token,
+	composedResourceConstraintList []FullyQualifiedResourceConstraint) (pluginCore.AllocationStatus, error) {
+	namespacedResource, err := r.getResource(namespace)
+	if err != nil {
+		logger.Errorf(ctx, "Error finding resource [%v] during allocation", namespace)
+		return pluginCore.AllocationUndefined, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Errorf(ctx, "Error allocating token [%s:%s] %v", namespace, allocationToken, err)
+		return pluginCore.AllocationUndefined, err
+	}
+
+	status, err := r.allocate(ctx, tx, namespace, allocationToken, namespacedResource, composedResourceConstraintList)
+	if err == nil {
+		err = tx.Commit()
+	} else if rollbackErr := tx.Rollback(); rollbackErr != nil {
+		logger.Warnf(ctx, "Error rolling back the allocation of token [%s:%s] %v", namespace, allocationToken, rollbackErr)
+	}
+	if err != nil {
+		logger.Errorf(ctx, "Error allocating token [%s:%s] %v", namespace, allocationToken, err)
+		return pluginCore.AllocationUndefined, err
+	}
+
+	if status == pluginCore.AllocationStatusExhausted {
+		namespacedResource.rejectedTokens.Store(allocationToken, struct{}{})
+		return status, nil
+	}
+
+	namespacedResource.rejectedTokens.Delete(allocationToken)
+	return status, nil
+}
+
+func (r *PostgresResourceManager) ReleaseResource(ctx context.Context, namespace pluginCore.ResourceNamespace, allocationToken Token) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM resource_manager_tokens WHERE namespace = $1 AND token = $2",
+		string(namespace), string(allocationToken))
+	if err != nil {
+		logger.Errorf(ctx, "Error removing token [%v:%s] %v", namespace, allocationToken, err)
+		return err
+	}
+
+	namespacedResource, err := r.getResource(namespace)
+	if err != nil {
+		logger.Errorf(ctx, "Error finding resource [%v] during releasing", namespace)
+		return err
+	}
+
+	namespacedResource.rejectedTokens.Delete(allocationToken)
+	if removed, err := result.RowsAffected(); err == nil {
+		logger.Infof(ctx, "Removed %d token: %s", removed, allocationToken)
+	}
+	return nil
+}
+
+// renewLeases extends the leases of all live tokens of the owner in the namespace and updates the metrics. The tokens
+// are read from the database, so tokens allocated before a restart of the owner keep being renewed.
+func (r *PostgresResourceManager) renewLeases(ctx context.Context, namespace pluginCore.ResourceNamespace) {
+	resource, err := r.getResource(namespace)
+	if err != nil {
+		return
+	}
+	metrics := resource.metrics.(*PostgresResourceManagerMetrics)
+	stopWatch := metrics.LeaseRenewalTime.Start()
+	defer stopWatch.Stop()
+
+	now := r.clock.Now()
+	if _, err := r.db.ExecContext(ctx,
+		"UPDATE resource_manager_tokens SET expires_at = $1 WHERE namespace = $2 AND owner = $3 AND expires_at > $4",
+		now.Add(r.leaseDuration), string(namespace), r.owner, now); err != nil {
+		logger.Errorf(ctx, "Error renewing the leases of the tokens of [%s] in [%v] %v", r.owner, namespace, err)
+		metrics.LeaseRenewalFailures.Inc()
+	}
+
+	var size int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM resource_manager_tokens WHERE namespace = $1 AND expires_at > $2",
+		string(namespace), now).Scan(&size); err != nil {
+		logger.Errorf(ctx, "Error counting the allocated tokens in metrics poller %v", err)
+		return
+	}
+	metrics.AllocatedTokensGauge.Set(float64(size))
+
+	rejectedTokensCount := 0
+	resource.rejectedTokens.Range(func(key, value interface{}) bool {
+		rejectedTokensCount++
+		return true
+	})
+	metrics.ApproximateBackedUpLength.Set(float64(rejectedTokensCount))
+}
+
+func (r *PostgresResourceManager) startLeaseRenewal(ctx context.Context, interval time.Duration) {
+	go wait.Until(func() {
+		for namespace := range r.namespacedResourcesMap {
+			r.renewLeases(ctx, namespace)
+		}
+	}, interval, ctx.Done())
+}
@@ -0,0 +1,165 @@
+package resourcemanager
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+const (
+	renewAllocatedTokenQuery = "UPDATE resource_manager_tokens SET expires_at = $1, owner = $2 WHERE namespace = $3 AND token = $4"
+	allocatedTokensQuery     = "SELECT token FROM resource_manager_tokens WHERE namespace = $1"
+	insertTokenQuery         = "INSERT INTO resource_manager_tokens"
+	deleteExpiredTokensQuery = "DELETE FROM resource_manager_tokens WHERE namespace = $1 AND expires_at <= $2"
+	renewOwnerLeasesQuery    = "UPDATE resource_manager_tokens SET expires_at = $1 WHERE namespace = $2 AND owner = $3 AND expires_at > $4"
+)
+
+const testOwner = "flytepropeller-0"
+
+func newTestPostgresResourceManager(t *testing.T, quotas map[core.ResourceNamespace]int) (*PostgresResourceManager, *testingclock.FakeClock) {
+	t.Setenv(podNameEnvVar, testOwner)
+	mocket.Catcher.Register()
+	mocket.Catcher.Reset()
+	db, err := sql.Open(mocket.DriverName, "resourcemanager")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, db.Close())
+	})
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	builder, err := NewPostgresResourceManagerBuilder(ctx, db, promutils.NewTestScope())
+	require.NoError(t, err)
+	builder.clock = fakeClock
+	for namespace, quota := range quotas {
+		require.NoError(t, builder.RegisterResourceQuota(ctx, namespace, quota))
+	}
+
+	rm, err := builder.BuildResourceManager(ctx)
+	require.NoError(t, err)
+	return rm.(*PostgresResourceManager), fakeClock
+}
+
+func allocatedTokens(tokens ...string) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(tokens))
+	for _, token := range tokens {
+		rows = append(rows, map[string]interface{}{"token": token})
+	}
+	return rows
+}
+
+func TestPostgresResourceManager_AllocateResource(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("Namespace quota is enforced", func(t *testing.T) {
+		r, _ := newTestPostgresResourceManager(t, map[core.ResourceNamespace]int{"test-resource1": 2})
+		mocket.Catcher.NewMock().WithQuery(allocatedTokensQuery).WithReply(allocatedTokens("ns1-token1", "ns1-token2"))
+		inserted := false
+		mocket.Catcher.NewMock().WithQuery(insertTokenQuery).WithCallback(func(string, []driver.NamedValue) {
+			inserted = true
+		})
+
+		got, err := r.AllocateResource(ctx, "test-resource1", "ns1-token3", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, core.AllocationStatusExhausted, got)
+		assert.False(t, inserted)
+		_, rejected := r.namespacedResourcesMap["test-resource1"].rejectedTokens.Load(Token("ns1-token3"))
+		assert.True(t, rejected)
+	})
+
+	t.Run("Token is inserted with the owner and lease when the quota allows it", func(t *testing.T) {
+		r, fakeClock := newTestPostgresResourceManager(t, map[core.ResourceNamespace]int{"test-resource1": 2})
+		mocket.Catcher.NewMock().WithQuery(allocatedTokensQuery).WithReply(allocatedTokens("ns1-token1"))
+		var insertArgs []driver.NamedValue
+		mocket.Catcher.NewMock().WithQuery(insertTokenQuery).WithCallback(func(_ string, args []driver.NamedValue) {
+			insertArgs = args
+		})
+		expiredDeleted := false
+		mocket.Catcher.NewMock().WithQuery(deleteExpiredTokensQuery).WithCallback(func(string, []driver.NamedValue) {
+			expiredDeleted = true
+		})
+
+		got, err := r.AllocateResource(ctx, "test-resource1", "ns1-token2", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, core.AllocationStatusGranted, got)
+		assert.True(t, expiredDeleted)
+		require.Len(t, insertArgs, 5)
+		assert.Equal(t, "test-resource1", insertArgs[0].Value)
+		assert.Equal(t, "ns1-token2", insertArgs[1].Value)
+		assert.Equal(t, testOwner, insertArgs[2].Value)
+		assert.Equal(t, fakeClock.Now().Add(r.leaseDuration), insertArgs[3].Value)
+	})
+
+	t.Run("An already allocated token is granted again", func(t *testing.T) {
+		r, _ := newTestPostgresResourceManager(t, map[core.ResourceNamespace]int{"test-resource1": 1})
+		mocket.Catcher.NewMock().WithQuery(renewAllocatedTokenQuery).WithRowsNum(1)
+		mocket.Catcher.NewMock().WithQuery(allocatedTokensQuery).WithReply(allocatedTokens("ns1-token1"))
+
+		got, err := r.AllocateResource(ctx, "test-resource1", "ns1-token1", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, core.AllocationStatusGranted, got)
+	})
+
+	t.Run("Constraints are enforced. Namespace1 should not be granted while namespace2 should", func(t *testing.T) {
+		r, _ := newTestPostgresResourceManager(t, map[core.ResourceNamespace]int{"test-resource1": 3})
+		mocket.Catcher.NewMock().WithQuery(allocatedTokensQuery).WithReply(allocatedTokens("ns1-token1"))
+
+		got, err := r.AllocateResource(ctx, "test-resource1", "ns1-token2", createMockComposedResourceConstraintList())
+		assert.NoError(t, err)
+		assert.Equal(t, core.AllocationStatusExhausted, got)
+
+		got, err = r.AllocateResource(ctx, "test-resource1", "ns2-token1", []FullyQualifiedResourceConstraint{})
+		assert.NoError(t, err)
+		assert.Equal(t, core.AllocationStatusGranted, got)
+	})
+
+	t.Run("Unknown namespace", func(t *testing.T) {
+		r, _ := newTestPostgresResourceManager(t, map[core.ResourceNamespace]int{"test-resource1": 1})
+
+		got, err := r.AllocateResource(ctx, "test-resource2", "ns1-token1", nil)
+		assert.Error(t, err)
+		assert.Equal(t, core.AllocationUndefined, got)
+	})
+}
+
+func TestPostgresResourceManager_RenewLeases(t *testing.T) {
+	ctx := context.TODO()
+	r, fakeClock := newTestPostgresResourceManager(t, map[core.ResourceNamespace]int{"test-resource1": 1})
+
+	// all live tokens of the owner are renewed, whether or not they were allocated since the last restart
+	var renewArgs []driver.NamedValue
+	mocket.Catcher.NewMock().WithQuery(renewOwnerLeasesQuery).WithCallback(func(_ string, args []driver.NamedValue) {
+		renewArgs = args
+	})
+	mocket.Catcher.NewMock().WithQuery("SELECT COUNT(*) FROM resource_manager_tokens").
+		WithReply([]map[string]interface{}{{"count": int64(1)}})
+
+	r.renewLeases(ctx, "test-resource1")
+	require.Len(t, renewArgs, 4)
+	assert.Equal(t, fakeClock.Now().Add(r.leaseDuration), renewArgs[0].Value)
+	assert.Equal(t, "test-resource1", renewArgs[1].Value)
+	assert.Equal(t, testOwner, renewArgs[2].Value)
+	assert.Equal(t, fakeClock.Now(), renewArgs[3].Value)
+}
+
+func TestPostgresResourceManagerBuilder_RegisterResourceQuota(t *testing.T) {
+	ctx := context.TODO()
+	builder, err := NewPostgresResourceManagerBuilder(ctx, nil, promutils.NewTestScope())
+	assert.NoError(t, err)
+	assert.Error(t, builder.RegisterResourceQuota(ctx, "test-resource1", 1))
+
+	_, err = builder.BuildResourceManager(ctx)
+	assert.Error(t, err)
+}
@@ -178,16 +178,7 @@ func (r *RedisResourceManager) GetID() string {
 
 func (r *RedisResourceManager) checkAgainstOneConstraint(_ context.Context, allAllocated []string,
 	constraint FullyQualifiedResourceConstraint) bool {
-	var count int64 = 0
-	for _, allocated := range allAllocated {
-		if strings.HasPrefix(allocated, constraint.TargetedPrefixString) {
-			count++
-		}
-		if !constraint.IsAllowed(count) {
-			return false
-		}
-	}
-	return true
+	return constraint.isAllowedFor(allAllocated)
 }
 
 func (r *RedisResourceManager) checkAgainstConstraints(ctx context.Context, client RedisClient, resource pluginCore.ResourceNamespace,
@@ -1,6 +1,8 @@
 package resourcemanager
 
 import (
+	"strings"
+
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 )
@@ -30,6 +32,20 @@ func (fqrc *FullyQualifiedResourceConstraint) IsAllowed(actualValue int64) bool
 	return isAllowed(fqrc.Value, actualValue)
 }
 
+// isAllowedFor checks the number of allocated tokens targeted by the constraint against the constraint value
+func (fqrc *FullyQualifiedResourceConstraint) isAllowedFor(allAllocated []string) bool {
+	var count int64 = 0
+	for _, allocated := range allAllocated {
+		if strings.HasPrefix(allocated, fqrc.TargetedPrefixString) {
+			count++
+		}
+		if !fqrc.IsAllowed(count) {
+			return false
+		}
+	}
+	return true
+}
+
 func composeFullyQualifiedProjectScopeResourceConstraint(spec pluginCore.ResourceConstraintsSpec, id *core.TaskExecutionIdentifier) FullyQualifiedResourceConstraint {
 	return FullyQualifiedResourceConstraint{
 		TargetedPrefixString: string(composeProjectScopePrefix(id)),
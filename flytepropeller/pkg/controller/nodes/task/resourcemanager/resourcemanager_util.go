@@ -4,13 +4,15 @@ import (
 	"context"
 
 	rmConfig "github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/resourcemanager/config"
+	"github.com/flyteorg/flyte/flytestdlib/database"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 const (
-	resourceManagerPrometheusScope      = "resourcemanager"
-	redisResourceManagerPrometheusScope = "redis"
+	resourceManagerPrometheusScope         = "resourcemanager"
+	redisResourceManagerPrometheusScope    = "redis"
+	postgresResourceManagerPrometheusScope = "postgres"
 )
 
 func GetResourceManagerBuilderByType(ctx context.Context, managerType rmConfig.Type, scope promutils.Scope) (
@@ -30,6 +32,14 @@ func GetResourceManagerBuilderByType(ctx context.Context, managerType rmConfig.T
 			return nil, err
 		}
 		return NewRedisResourceManagerBuilder(ctx, redisClient, rmScope.NewSubScope(redisResourceManagerPrometheusScope))
+	case rmConfig.TypePostgres:
+		logger.Infof(ctx, "Using Postgres based resource manager")
+		db, err := NewPostgresClient(ctx, database.GetConfig())
+		if err != nil {
+			logger.Errorf(ctx, "Unable to initialize a database connection for the resource manager: [%v]", err)
+			return nil, err
+		}
+		return NewPostgresResourceManagerBuilder(ctx, db, rmScope.NewSubScope(postgresResourceManagerPrometheusScope))
 	}
 	logger.Infof(ctx, "Using the NOOP resource manager by default")
 	return &NoopResourceManagerBuilder{}, nil
@@ -13,6 +13,7 @@ import (
 	pluginCatalog "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
 	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/encoding"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
@@ -70,6 +71,8 @@ type taskExecutionMetadata struct {
 	maxAttempts          uint32
 	platformResources    *v1.ResourceRequirements
 	environmentVariables map[string]string
+	// escalatedResources are the resources escalated by the OOM retry policy, nil if none were escalated.
+	escalatedResources *core.Resources
 }
 
 func (t taskExecutionMetadata) GetTaskExecutionID() pluginCore.TaskExecutionID {
@@ -228,9 +231,14 @@ func ComputeRawOutputPrefix(ctx context.Context, length int, nCtx interfaces.Nod
 
 // ComputePreviousCheckpointPath returns the checkpoint path for the previous attempt, if this is the first attempt then returns an empty path
 func ComputePreviousCheckpointPath(ctx context.Context, length int, nCtx interfaces.NodeExecutionContext, currentNodeUniqueID v1alpha1.NodeID, currentAttempt uint32) (storage.DataReference, error) {
+	ts := nCtx.NodeStateReader().GetTaskNodeState()
 	// If first attempt for this node execution, look for a checkpoint path in a prior execution
 	if currentAttempt == 0 {
-		return nCtx.NodeStateReader().GetTaskNodeState().PreviousNodeExecutionCheckpointURI, nil
+		return ts.PreviousNodeExecutionCheckpointURI, nil
+	}
+	// Retries that followed a preemption use the checkpoint path resolved at the start of the attempt
+	if len(ts.PreviousAttemptCheckpointURI) > 0 {
+		return ts.PreviousAttemptCheckpointURI, nil
 	}
 	// Otherwise derive previous checkpoint path from the prior attempt
 	prevAttempt := currentAttempt - 1
@@ -241,15 +249,41 @@ func ComputePreviousCheckpointPath(ctx context.Context, length int, nCtx interfa
 	return ioutils.ConstructCheckpointPath(nCtx.DataStore(), prevRawOutputPrefix.GetRawOutputPrefix()), nil
 }
 
-func (t *Handler) newTaskExecutionContext(ctx context.Context, nCtx interfaces.NodeExecutionContext, plugin pluginCore.Plugin) (*taskExecutionContext, error) {
-	id := GetTaskExecutionIdentifier(nCtx)
+// ResolvePreemptedCheckpointPath returns the checkpoint path of the last prior attempt that wrote a checkpoint, falling
+// back to the path of the previous attempt if none did. A preempted attempt may not have gotten to write a checkpoint,
+// in which case its retry resumes from an earlier attempt's checkpoint.
+func ResolvePreemptedCheckpointPath(ctx context.Context, length int, nCtx interfaces.NodeExecutionContext, currentNodeUniqueID v1alpha1.NodeID, currentAttempt uint32) (storage.DataReference, error) {
+	var prevCheckpointPath storage.DataReference
+	for prevAttempt := int64(currentAttempt) - 1; prevAttempt >= 0; prevAttempt-- {
+		prevRawOutputPrefix, _, err := ComputeRawOutputPrefix(ctx, length, nCtx, currentNodeUniqueID, uint32(prevAttempt)) // #nosec G115
+		if err != nil {
+			return "", err
+		}
+		checkpointPath := ioutils.ConstructCheckpointPath(nCtx.DataStore(), prevRawOutputPrefix.GetRawOutputPrefix())
+		if len(prevCheckpointPath) == 0 {
+			prevCheckpointPath = checkpointPath
+		}
 
+		checkpoints, _, err := nCtx.DataStore().List(ctx, checkpointPath, 1, storage.NewCursorAtStart())
+		if err != nil && !storage.IsNotFound(err) {
+			return "", errors.Wrapf(errors.StorageError, nCtx.NodeID(), err, "failed to list checkpoints of attempt [%d]", prevAttempt)
+		}
+		if len(checkpoints) > 0 {
+			return checkpointPath, nil
+		}
+	}
+	return prevCheckpointPath, nil
+}
+
+// getUniqueNodeIDAndLength returns the unique id of the node across the workflow and the max length of the names
+// generated from it for the plugin.
+func getUniqueNodeIDAndLength(nCtx interfaces.NodeExecutionContext, plugin pluginCore.Plugin) (v1alpha1.NodeID, int, error) {
 	currentNodeUniqueID := nCtx.NodeID()
 	if nCtx.ExecutionContext().GetEventVersion() != v1alpha1.EventVersion0 {
 		var err error
 		currentNodeUniqueID, err = common.GenerateUniqueID(nCtx.ExecutionContext().GetParentInfo(), nCtx.NodeID())
 		if err != nil {
-			return nil, err
+			return "", 0, err
 		}
 	}
 
@@ -257,6 +291,38 @@ func (t *Handler) newTaskExecutionContext(ctx context.Context, nCtx interfaces.N
 	if l := plugin.GetProperties().GeneratedNameMaxLength; l != nil {
 		length = *l
 	}
+	return currentNodeUniqueID, length, nil
+}
+
+// resolvePreemptedCheckpoint stores the checkpoint path to resume from in the task node state when an attempt that
+// followed a preemption starts. Resolving it lists the checkpoints of prior attempts, so it's only done once per attempt.
+func (t *Handler) resolvePreemptedCheckpoint(ctx context.Context, nCtx interfaces.NodeExecutionContext, plugin pluginCore.Plugin) error {
+	ts := nCtx.NodeStateReader().GetTaskNodeState()
+	currentAttempt := nCtx.CurrentAttempt()
+	if ts.PluginPhase != pluginCore.PhaseUndefined || currentAttempt == 0 || len(ts.PreviousAttemptCheckpointURI) > 0 ||
+		nCtx.NodeStatus().GetExecutionError().GetCode() != flytek8s.Preempted {
+		return nil
+	}
+
+	currentNodeUniqueID, length, err := getUniqueNodeIDAndLength(nCtx, plugin)
+	if err != nil {
+		return err
+	}
+
+	ts.PreviousAttemptCheckpointURI, err = ResolvePreemptedCheckpointPath(ctx, length, nCtx, currentNodeUniqueID, currentAttempt)
+	if err != nil {
+		return err
+	}
+	return nCtx.NodeStateWriter().PutTaskNodeState(ts)
+}
+
+func (t *Handler) newTaskExecutionContext(ctx context.Context, nCtx interfaces.NodeExecutionContext, plugin pluginCore.Plugin) (*taskExecutionContext, error) {
+	id := GetTaskExecutionIdentifier(nCtx)
+
+	currentNodeUniqueID, length, err := getUniqueNodeIDAndLength(nCtx, plugin)
+	if err != nil {
+		return nil, err
+	}
 
 	rawOutputPrefix, uniqueID, err := ComputeRawOutputPrefix(ctx, length, nCtx, currentNodeUniqueID, id.GetRetryAttempt())
 	if err != nil {
@@ -290,6 +356,12 @@ func (t *Handler) newTaskExecutionContext(ctx context.Context, nCtx interfaces.N
 		return nil, err
 	}
 
+	platformResources := convertTaskResourcesToRequirements(nCtx.ExecutionContext().GetExecutionConfig().TaskResources)
+	overrides, escalatedResources, err := getTaskOverrides(ctx, nCtx, platformResources)
+	if err != nil {
+		return nil, err
+	}
+
 	return &taskExecutionContext{
 		NodeExecutionContext: nCtx,
 		tm: taskExecutionMetadata{
@@ -299,10 +371,11 @@ func (t *Handler) newTaskExecutionContext(ctx context.Context, nCtx interfaces.N
 				id:           id,
 				uniqueNodeID: currentNodeUniqueID,
 			},
-			o:                    nCtx.Node(),
+			o:                    overrides,
 			maxAttempts:          maxAttempts,
-			platformResources:    convertTaskResourcesToRequirements(nCtx.ExecutionContext().GetExecutionConfig().TaskResources),
+			platformResources:    platformResources,
 			environmentVariables: nCtx.ExecutionContext().GetExecutionConfig().EnvironmentVariables,
+			escalatedResources:   escalatedResources,
 		},
 		rm: resourcemanager.GetTaskResourceManager(
 			t.resourceManager, resourceNamespacePrefix, id),
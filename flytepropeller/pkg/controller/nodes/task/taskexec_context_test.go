@@ -16,6 +16,7 @@ import (
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog/mocks"
 	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	pluginCoreMocks "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
 	ioMocks "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io/mocks"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
@@ -469,6 +470,49 @@ func TestComputePreviousCheckpointPath(t *testing.T) {
 	})
 }
 
+func TestComputePreviousCheckpointPath_Preemption(t *testing.T) {
+	nCtx := &nodeMocks.NodeExecutionContext{}
+	reader := &nodeMocks.NodeStateReader{}
+	reader.OnGetTaskNodeState().Return(handler.TaskNodeState{
+		PreviousAttemptCheckpointURI: storage.DataReference("s3://sandbox/x/name-n1-0/_flytecheckpoints"),
+	})
+	nCtx.OnNodeStateReader().Return(reader)
+
+	c, err := ComputePreviousCheckpointPath(context.TODO(), 100, nCtx, "n1", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.DataReference("s3://sandbox/x/name-n1-0/_flytecheckpoints"), c)
+}
+
+func TestResolvePreemptedCheckpointPath(t *testing.T) {
+	nCtx := &nodeMocks.NodeExecutionContext{}
+	nm := &nodeMocks.NodeExecutionMetadata{}
+	nm.OnGetOwnerID().Return(types.NamespacedName{Namespace: "namespace", Name: "name"})
+	nCtx.OnOutputShardSelector().Return(ioutils.NewConstantShardSelector([]string{"x"}))
+	nCtx.OnRawOutputPrefix().Return("s3://sandbox/")
+	ds, err := storage.NewDataStore(
+		&storage.Config{
+			Type: storage.TypeMemory,
+		},
+		promutils.NewTestScope(),
+	)
+	assert.NoError(t, err)
+	nCtx.OnDataStore().Return(ds)
+	nCtx.OnNodeExecutionMetadata().Return(nm)
+
+	t.Run("no-checkpoint", func(t *testing.T) {
+		c, err := ResolvePreemptedCheckpointPath(context.TODO(), 100, nCtx, "n1", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, storage.DataReference("s3://sandbox/x/name-n1-1/_flytecheckpoints"), c)
+	})
+
+	t.Run("checkpoint-of-attempt-0", func(t *testing.T) {
+		assert.NoError(t, ds.WriteRaw(context.TODO(), "s3://sandbox/x/name-n1-0/_flytecheckpoints/ckpt", 4, storage.Options{}, bytes.NewReader([]byte("ckpt"))))
+		c, err := ResolvePreemptedCheckpointPath(context.TODO(), 100, nCtx, "n1", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, storage.DataReference("s3://sandbox/x/name-n1-0/_flytecheckpoints"), c)
+	})
+}
+
 func TestComputePreviousCheckpointPath_Recovery(t *testing.T) {
 	nCtx := &nodeMocks.NodeExecutionContext{}
 	nm := &nodeMocks.NodeExecutionMetadata{}
@@ -501,3 +545,65 @@ func TestComputePreviousCheckpointPath_Recovery(t *testing.T) {
 		assert.Equal(t, storage.DataReference("s3://sandbox/x/name-n1-0/_flytecheckpoints"), c)
 	})
 }
+
+func TestHandler_resolvePreemptedCheckpoint(t *testing.T) {
+	createNodeCtx := func(t *testing.T, ts handler.TaskNodeState, execErr *core.ExecutionError, s *taskNodeStateHolder) *nodeMocks.NodeExecutionContext {
+		nCtx := &nodeMocks.NodeExecutionContext{}
+		nm := &nodeMocks.NodeExecutionMetadata{}
+		nm.OnGetOwnerID().Return(types.NamespacedName{Namespace: "namespace", Name: "name"})
+		nCtx.OnNodeExecutionMetadata().Return(nm)
+		nCtx.OnNodeID().Return("n1")
+		nCtx.OnCurrentAttempt().Return(uint32(2))
+		nCtx.OnOutputShardSelector().Return(ioutils.NewConstantShardSelector([]string{"x"}))
+		nCtx.OnRawOutputPrefix().Return("s3://sandbox/")
+		ds, err := storage.NewDataStore(
+			&storage.Config{
+				Type: storage.TypeMemory,
+			},
+			promutils.NewTestScope(),
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, ds.WriteRaw(context.TODO(), "s3://sandbox/x/name-n1-0/_flytecheckpoints/ckpt", 4, storage.Options{}, bytes.NewReader([]byte("ckpt"))))
+		nCtx.OnDataStore().Return(ds)
+
+		executionContext := &mocks2.ExecutionContext{}
+		executionContext.OnGetEventVersion().Return(v1alpha1.EventVersion0)
+		nCtx.OnExecutionContext().Return(executionContext)
+
+		ns := &flyteMocks.ExecutableNodeStatus{}
+		ns.OnGetExecutionError().Return(execErr)
+		nCtx.OnNodeStatus().Return(ns)
+
+		reader := &nodeMocks.NodeStateReader{}
+		reader.OnGetTaskNodeState().Return(ts)
+		nCtx.OnNodeStateReader().Return(reader)
+		nCtx.OnNodeStateWriter().Return(s)
+		return nCtx
+	}
+
+	plugin := &pluginCoreMocks.Plugin{}
+	plugin.OnGetProperties().Return(pluginCore.PluginProperties{})
+	tk := &Handler{}
+	preempted := &core.ExecutionError{Code: flytek8s.Preempted, Kind: core.ExecutionError_SYSTEM}
+
+	t.Run("preempted", func(t *testing.T) {
+		s := &taskNodeStateHolder{}
+		nCtx := createNodeCtx(t, handler.TaskNodeState{}, preempted, s)
+		assert.NoError(t, tk.resolvePreemptedCheckpoint(context.TODO(), nCtx, plugin))
+		assert.Equal(t, storage.DataReference("s3://sandbox/x/name-n1-0/_flytecheckpoints"), s.s.PreviousAttemptCheckpointURI)
+	})
+
+	t.Run("not-preempted", func(t *testing.T) {
+		s := &taskNodeStateHolder{}
+		nCtx := createNodeCtx(t, handler.TaskNodeState{}, &core.ExecutionError{Kind: core.ExecutionError_USER}, s)
+		assert.NoError(t, tk.resolvePreemptedCheckpoint(context.TODO(), nCtx, plugin))
+		nCtx.AssertNotCalled(t, "NodeStateWriter")
+	})
+
+	t.Run("already-started", func(t *testing.T) {
+		s := &taskNodeStateHolder{}
+		nCtx := createNodeCtx(t, handler.TaskNodeState{PluginPhase: pluginCore.PhaseRunning}, preempted, s)
+		assert.NoError(t, tk.resolvePreemptedCheckpoint(context.TODO(), nCtx, plugin))
+		nCtx.AssertNotCalled(t, "NodeStateWriter")
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
 	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
 	flytek8sConfig "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
@@ -85,6 +86,7 @@ type ToTaskExecutionEventInputs struct {
 	ResourcePoolInfo      []*event.ResourcePoolInfo
 	ClusterID             string
 	OccurredAt            time.Time
+	EscalatedResources    *core.Resources
 }
 
 func ToTaskExecutionEvent(input ToTaskExecutionEventInputs) (*event.TaskExecutionEvent, error) {
@@ -109,9 +111,10 @@ func ToTaskExecutionEvent(input ToTaskExecutionEventInputs) (*event.TaskExecutio
 	}
 
 	metadata := &event.TaskExecutionMetadata{
-		GeneratedName:    input.TaskExecContext.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName(),
-		PluginIdentifier: input.PluginID,
-		ResourcePoolInfo: input.ResourcePoolInfo,
+		GeneratedName:      input.TaskExecContext.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName(),
+		PluginIdentifier:   input.PluginID,
+		ResourcePoolInfo:   input.ResourcePoolInfo,
+		EscalatedResources: input.EscalatedResources,
 	}
 
 	if input.Info.Info() != nil && input.Info.Info().ExternalResources != nil {
@@ -131,6 +134,10 @@ func ToTaskExecutionEvent(input ToTaskExecutionEventInputs) (*event.TaskExecutio
 		}
 	}
 
+	if input.Info.Info() != nil {
+		metadata.PeakResourceUsage = input.Info.Info().PeakResourceUsage
+	}
+
 	var reasons []*event.EventReason
 	if len(input.Info.Reason()) > 0 {
 		reasons = append(reasons, &event.EventReason{
@@ -174,6 +181,7 @@ func ToTaskExecutionEvent(input ToTaskExecutionEventInputs) (*event.TaskExecutio
 		tev.OutputResult = &event.TaskExecutionEvent_Error{
 			Error: input.Info.Err(),
 		}
+		tev.Metadata.Preempted = input.Info.Err().GetCode() == flytek8s.Preempted
 	}
 
 	if input.Info.Info() != nil {
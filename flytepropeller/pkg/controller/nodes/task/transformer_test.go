@@ -14,6 +14,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
 	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	pluginMocks "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
@@ -157,14 +158,22 @@ func TestToTaskExecutionEvent(t *testing.T) {
 
 	defaultNodeExecutionMetadata := nodemocks.NodeExecutionMetadata{}
 	defaultNodeExecutionMetadata.OnIsInterruptible().Return(false)
+	peakResourceUsage := []*core.Resources_ResourceEntry{
+		{Name: core.Resources_CPU, Value: "250m"},
+		{Name: core.Resources_MEMORY, Value: "1Gi"},
+	}
+	escalatedResources := &core.Resources{
+		Requests: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: "2Gi"}},
+	}
 	tev, err = ToTaskExecutionEvent(ToTaskExecutionEventInputs{
 		TaskExecContext: tCtx,
 		InputReader:     in,
 		OutputWriter:    out,
 		Info: pluginCore.PhaseInfoSuccess(&pluginCore.TaskInfo{
-			OccurredAt: &n,
-			Logs:       l,
-			CustomInfo: c,
+			OccurredAt:        &n,
+			Logs:              l,
+			CustomInfo:        c,
+			PeakResourceUsage: peakResourceUsage,
 		}),
 		NodeExecutionMetadata: &defaultNodeExecutionMetadata,
 		ExecContext:           mockExecContext,
@@ -172,6 +181,7 @@ func TestToTaskExecutionEvent(t *testing.T) {
 		PluginID:              containerPluginIdentifier,
 		ResourcePoolInfo:      resourcePoolInfo,
 		ClusterID:             testClusterID,
+		EscalatedResources:    escalatedResources,
 		EventConfig: &config.EventConfig{
 			RawOutputPolicy: config.RawOutputPolicyReference,
 		},
@@ -193,6 +203,8 @@ func TestToTaskExecutionEvent(t *testing.T) {
 	assert.Equal(t, containerPluginIdentifier, tev.GetMetadata().GetPluginIdentifier())
 	assert.Equal(t, generatedName, tev.GetMetadata().GetGeneratedName())
 	assert.EqualValues(t, resourcePoolInfo, tev.GetMetadata().GetResourcePoolInfo())
+	assert.Equal(t, peakResourceUsage, tev.GetMetadata().GetPeakResourceUsage())
+	assert.Equal(t, escalatedResources, tev.GetMetadata().GetEscalatedResources())
 	assert.Equal(t, testClusterID, tev.GetProducerId())
 
 	t.Run("inline event policy", func(t *testing.T) {
@@ -220,6 +232,27 @@ func TestToTaskExecutionEvent(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, proto.Equal(inputs, tev.GetInputData()))
 	})
+
+	t.Run("preempted", func(t *testing.T) {
+		tev, err := ToTaskExecutionEvent(ToTaskExecutionEventInputs{
+			TaskExecContext:       tCtx,
+			InputReader:           in,
+			OutputWriter:          out,
+			Info:                  pluginCore.PhaseInfoSystemRetryableFailure(flytek8s.Preempted, "node [node] of pod [pod] is being reclaimed [spot-termination]", nil),
+			NodeExecutionMetadata: &nodeExecutionMetadata,
+			ExecContext:           mockExecContext,
+			TaskType:              containerTaskType,
+			PluginID:              containerPluginIdentifier,
+			ClusterID:             testClusterID,
+			EventConfig: &config.EventConfig{
+				RawOutputPolicy: config.RawOutputPolicyReference,
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, core.TaskExecution_FAILED, tev.GetPhase())
+		assert.Equal(t, flytek8s.Preempted, tev.GetError().GetCode())
+		assert.True(t, tev.GetMetadata().GetPreempted())
+	})
 }
 
 func TestToTransitionType(t *testing.T) {
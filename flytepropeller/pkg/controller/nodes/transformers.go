@@ -263,6 +263,7 @@ func UpdateNodeStatus(np v1alpha1.NodePhase, p handler.PhaseInfo, n interfaces.N
 		t.SetPluginState(nt.PluginState)
 		t.SetPluginStateVersion(nt.PluginStateVersion)
 		t.SetPreviousNodeExecutionCheckpointPath(nt.PreviousNodeExecutionCheckpointURI)
+		t.SetPreviousAttemptCheckpointPath(nt.PreviousAttemptCheckpointURI)
 		t.SetCleanupOnFailure(nt.CleanupOnFailure)
 	}
 
@@ -302,6 +303,8 @@ func UpdateNodeStatus(np v1alpha1.NodePhase, p handler.PhaseInfo, n interfaces.N
 		ng := n.GetGateNodeState()
 		t := s.GetOrCreateGateNodeStatus()
 		t.SetGateNodePhase(ng.Phase)
+		t.SetPollAttempts(ng.PollAttempts)
+		t.SetLastPolledAt(ng.LastPolledAt)
 	}
 
 	// Update array node status
@@ -314,6 +317,8 @@ func UpdateNodeStatus(np v1alpha1.NodePhase, p handler.PhaseInfo, n interfaces.N
 		t.SetSubNodeTaskPhases(na.SubNodeTaskPhases)
 		t.SetSubNodeRetryAttempts(na.SubNodeRetryAttempts)
 		t.SetSubNodeSystemFailures(na.SubNodeSystemFailures)
+		t.SetSubNodePreemptions(na.SubNodePreemptions)
 		t.SetTaskPhaseVersion(na.TaskPhaseVersion)
+		t.SetParallelism(na.Parallelism)
 	}
 }
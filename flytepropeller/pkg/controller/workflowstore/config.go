@@ -1,7 +1,10 @@
 package workflowstore
 
 import (
+	"time"
+
 	ctrlConfig "github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+	"github.com/flyteorg/flyte/flytestdlib/config"
 )
 
 //go:generate pflags Config --default-var=defaultConfig
@@ -18,21 +21,46 @@ const (
 	// PolicyResourceVersionCache uses the resource version on the Workflow object, to determine if the inmemory copy
 	// of the workflow is stale
 	PolicyResourceVersionCache = "ResourceVersionCache"
+	// PolicyExternalStatus keeps the status of workflows in an external store instead of the Workflow object, only a
+	// pointer status without the node statuses is written to the Workflow object
+	PolicyExternalStatus = "ExternalStatus"
+)
+
+type StatusStoreType = string
+
+const (
+	// StatusStoreTypePostgres keeps the workflow statuses in the database configured in the database section
+	StatusStoreTypePostgres StatusStoreType = "postgres"
+	// StatusStoreTypeInMemory keeps the workflow statuses in memory which is useful for testing
+	StatusStoreTypeInMemory StatusStoreType = "inmemory"
 )
 
 // By default we will use the ResourceVersionCache example
 var (
 	defaultConfig = &Config{
 		Policy: PolicyResourceVersionCache,
+		ExternalStatus: ExternalStatusConfig{
+			Store:           StatusStoreTypePostgres,
+			StatusRetention: config.Duration{Duration: 72 * time.Hour},
+			SweepInterval:   config.Duration{Duration: time.Hour},
+		},
 	}
 
 	configSection = ctrlConfig.MustRegisterSubSection("workflowStore", defaultConfig)
 )
 
 // Config for Workflow access in the controller.
-// Various policies are available like - InMemory, PassThrough, TrackTerminated, ResourceVersionCache
+// Various policies are available like - InMemory, PassThrough, TrackTerminated, ResourceVersionCache, ExternalStatus
 type Config struct {
-	Policy Policy `json:"policy" pflag:",Workflow Store Policy to initialize"`
+	Policy         Policy               `json:"policy" pflag:",Workflow Store Policy to initialize"`
+	ExternalStatus ExternalStatusConfig `json:"external-status" pflag:",Config for the ExternalStatus policy"`
+}
+
+// ExternalStatusConfig configures where the ExternalStatus policy keeps the status of workflows.
+type ExternalStatusConfig struct {
+	Store           StatusStoreType `json:"store" pflag:",Store to keep the workflow statuses in. Supported values: postgres or inmemory"`
+	StatusRetention config.Duration `json:"status-retention" pflag:",Minimum duration since the last write after which the status of a workflow is deleted, once the workflow itself has been deleted. 0 keeps them forever"`
+	SweepInterval   config.Duration `json:"sweep-interval" pflag:",Interval at which the statuses of deleted workflows past the retention are deleted"`
 }
 
 func GetConfig() *Config {
@@ -51,5 +51,8 @@ func (Config) mustMarshalJSON(v json.Marshaler) string {
 func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags := pflag.NewFlagSet("Config", pflag.ExitOnError)
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "policy"), defaultConfig.Policy, "Workflow Store Policy to initialize")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "external-status.store"), defaultConfig.ExternalStatus.Store, "Store to keep the workflow statuses in. Supported values: postgres or inmemory")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "external-status.status-retention"), defaultConfig.ExternalStatus.StatusRetention.String(), "Minimum duration since the last write after which the status of a workflow is deleted, once the workflow itself has been deleted. 0 keeps them forever")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "external-status.sweep-interval"), defaultConfig.ExternalStatus.SweepInterval.String(), "Interval at which the statuses of deleted workflows past the retention are deleted")
 	return cmdFlags
 }
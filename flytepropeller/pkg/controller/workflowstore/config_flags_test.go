@@ -113,4 +113,46 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_external-status.store", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("external-status.store", testValue)
+			if vString, err := cmdFlags.GetString("external-status.store"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.ExternalStatus.Store)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_external-status.status-retention", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultConfig.ExternalStatus.StatusRetention.String()
+
+			cmdFlags.Set("external-status.status-retention", testValue)
+			if vString, err := cmdFlags.GetString("external-status.status-retention"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.ExternalStatus.StatusRetention)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_external-status.sweep-interval", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultConfig.ExternalStatus.SweepInterval.String()
+
+			cmdFlags.Set("external-status.sweep-interval", testValue)
+			if vString, err := cmdFlags.GetString("external-status.sweep-interval"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.ExternalStatus.SweepInterval)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
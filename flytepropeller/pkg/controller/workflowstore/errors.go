@@ -39,3 +39,11 @@ func IsWorkflowTerminated(err error) bool {
 func IsWorkflowTooLarge(err error) bool {
 	return errors.Cause(err) == ErrWorkflowToLarge
 }
+
+// ErrStatusNotFound indicates that no status has been stored for the workflow in the external status store
+var ErrStatusNotFound = fmt.Errorf("workflow status not-found error")
+
+// IsStatusNotFound returns true if the error is caused by ErrStatusNotFound
+func IsStatusNotFound(err error) bool {
+	return errors.Cause(err) == ErrStatusNotFound
+}
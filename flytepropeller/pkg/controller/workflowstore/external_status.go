@@ -0,0 +1,227 @@
+package workflowstore
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	flyteworkflowv1alpha1 "github.com/flyteorg/flyte/flytepropeller/pkg/client/clientset/versioned/typed/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// StatusVersionAnnotation records the version of the externally stored status a FlyteWorkflow was read at.
+const StatusVersionAnnotation = "flyte.org/status-version"
+
+// sweepBatchSize is the number of stale statuses checked against their CRs at a time.
+const sweepBatchSize = 500
+
+type externalStatusMetrics struct {
+	statusGetLatency       promutils.StopWatch
+	statusPutLatency       promutils.StopWatch
+	statusPutConflict      prometheus.Counter
+	statusNotFound         prometheus.Counter
+	statusRollbackFailures prometheus.Counter
+	statusSwept            prometheus.Counter
+	statusSweepFailures    prometheus.Counter
+}
+
+// externalStatus is a store that keeps the status of workflows in a StatusStore instead of the FlyteWorkflow CR. Only a
+// pointer status without the node statuses and the error is written to the CR, along with the version of the stored
+// status, so the size of the CR no longer grows with the number of nodes.
+// Workflows whose status hasn't been stored yet, e.g. new or in-flight workflows when the policy is enabled, use the
+// status of the CR.
+type externalStatus struct {
+	w         FlyteWorkflow
+	workflows flyteworkflowv1alpha1.FlyteworkflowV1alpha1Interface
+	statuses  StatusStore
+	metrics   *externalStatusMetrics
+}
+
+func getStatusVersion(workflow *v1alpha1.FlyteWorkflow) (uint64, error) {
+	version, ok := workflow.GetAnnotations()[StatusVersionAnnotation]
+	if !ok {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(version, 10, 64)
+}
+
+func setStatusVersion(workflow *v1alpha1.FlyteWorkflow, version uint64) {
+	if workflow.Annotations == nil {
+		workflow.Annotations = map[string]string{}
+	}
+
+	workflow.Annotations[StatusVersionAnnotation] = strconv.FormatUint(version, 10)
+}
+
+// newPointerStatus returns the part of the status kept in the CR, which is enough to tell the phase of the workflow.
+func newPointerStatus(status *v1alpha1.WorkflowStatus) v1alpha1.WorkflowStatus {
+	return v1alpha1.WorkflowStatus{
+		Phase:                    status.Phase,
+		StartedAt:                status.StartedAt,
+		StoppedAt:                status.StoppedAt,
+		LastUpdatedAt:            status.LastUpdatedAt,
+		Message:                  status.Message,
+		DataDir:                  status.DataDir,
+		OutputReference:          status.OutputReference,
+		FailedAttempts:           status.FailedAttempts,
+		DefinitionVersion:        status.DefinitionVersion,
+		DataReferenceConstructor: status.DataReferenceConstructor,
+	}
+}
+
+func (e *externalStatus) Get(ctx context.Context, namespace, name string) (*v1alpha1.FlyteWorkflow, error) {
+	w, err := e.w.Get(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	t := e.metrics.statusGetLatency.Start()
+	status, version, err := e.statuses.Get(ctx, namespace, name)
+	t.Stop()
+	if err != nil && !IsStatusNotFound(err) {
+		return nil, err
+	}
+
+	// The CR is shared with the informer cache and must not be modified.
+	w = w.DeepCopy()
+	if IsStatusNotFound(err) {
+		e.metrics.statusNotFound.Inc()
+		logger.Debugf(ctx, "Status of workflow not found in the status store, using the status of the CR.")
+		version = 0
+	} else {
+		w.Status = *status
+	}
+
+	setStatusVersion(w, version)
+	return w, nil
+}
+
+func (e *externalStatus) update(ctx context.Context, workflow *v1alpha1.FlyteWorkflow, priorityClass PriorityClass,
+	write func(context.Context, *v1alpha1.FlyteWorkflow, PriorityClass) (*v1alpha1.FlyteWorkflow, error)) (
+	*v1alpha1.FlyteWorkflow, error) {
+	expectedVersion, err := getStatusVersion(workflow)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid status version annotation [%v]", workflow.GetAnnotations()[StatusVersionAnnotation])
+	}
+
+	t := e.metrics.statusPutLatency.Start()
+	version, err := e.statuses.Put(ctx, workflow.Namespace, workflow.Name, &workflow.Status, expectedVersion)
+	t.Stop()
+	if err != nil {
+		if IsWorkflowStale(err) {
+			e.metrics.statusPutConflict.Inc()
+		}
+
+		return nil, err
+	}
+
+	// Only the pointer status is written to the CR, the full status is restored once the CR has been written.
+	status := workflow.Status
+	workflow.Status = newPointerStatus(&status)
+	setStatusVersion(workflow, version)
+	newWF, err := write(ctx, workflow, priorityClass)
+	workflow.Status = status
+	if err != nil || newWF == nil {
+		// The status written to the store is rolled back so that it matches the CR again. If the CR was written even
+		// though an error was returned, the CR points at the rolled back version and the next Put fails as stale, which
+		// makes the workflow be read again.
+		if rollbackErr := e.statuses.Rollback(ctx, workflow.Namespace, workflow.Name, version); rollbackErr != nil {
+			e.metrics.statusRollbackFailures.Inc()
+			logger.Errorf(ctx, "Failed to roll back the status of the workflow to the version before [%v]. Error [%v]",
+				version, rollbackErr)
+		}
+
+		setStatusVersion(workflow, expectedVersion)
+		return nil, err
+	}
+
+	newWF.Status = status
+	return newWF, nil
+}
+
+func (e *externalStatus) UpdateStatus(ctx context.Context, workflow *v1alpha1.FlyteWorkflow, priorityClass PriorityClass) (
+	newWF *v1alpha1.FlyteWorkflow, err error) {
+	return e.update(ctx, workflow, priorityClass, e.w.UpdateStatus)
+}
+
+func (e *externalStatus) Update(ctx context.Context, workflow *v1alpha1.FlyteWorkflow, priorityClass PriorityClass) (
+	newWF *v1alpha1.FlyteWorkflow, err error) {
+	return e.update(ctx, workflow, priorityClass, e.w.Update)
+}
+
+// sweep deletes the statuses of workflows which no longer exist. Only statuses which haven't been written for longer
+// than the retention are checked, the CRs are read from the API server since deleted workflows are missing from the
+// informer cache as well as workflows ignored by it.
+func (e *externalStatus) sweep(ctx context.Context, retention time.Duration) {
+	stale, err := e.statuses.ListStale(ctx, time.Now().Add(-retention), sweepBatchSize)
+	if err != nil {
+		e.metrics.statusSweepFailures.Inc()
+		logger.Errorf(ctx, "Failed to list the stale workflow statuses. Error [%v]", err)
+		return
+	}
+
+	deleted := 0
+	for _, key := range stale {
+		_, err := e.workflows.FlyteWorkflows(key.Namespace).Get(ctx, key.Name, v1.GetOptions{})
+		if err == nil {
+			continue
+		} else if !kubeerrors.IsNotFound(err) {
+			e.metrics.statusSweepFailures.Inc()
+			logger.Errorf(ctx, "Failed to get workflow [%v]. Error [%v]", key, err)
+			continue
+		}
+
+		if err := e.statuses.Delete(ctx, key.Namespace, key.Name); err != nil {
+			e.metrics.statusSweepFailures.Inc()
+			logger.Errorf(ctx, "Failed to delete the status of workflow [%v]. Error [%v]", key, err)
+			continue
+		}
+
+		deleted++
+	}
+
+	e.metrics.statusSwept.Add(float64(deleted))
+	logger.Debugf(ctx, "Deleted the statuses of [%v] deleted workflows.", deleted)
+}
+
+func (e *externalStatus) startSweeper(ctx context.Context, retention, interval time.Duration) {
+	go wait.Until(func() {
+		e.sweep(ctx, retention)
+	}, interval, ctx.Done())
+}
+
+// NewExternalStatusStore creates a store which keeps the status of workflows in the given StatusStore, the CRs are
+// read and written with the given workflow store. The statuses of workflows whose CRs have been deleted are
+// periodically deleted once they haven't been written for the configured retention.
+func NewExternalStatusStore(ctx context.Context, cfg ExternalStatusConfig, scope promutils.Scope, workflowStore FlyteWorkflow,
+	workflows flyteworkflowv1alpha1.FlyteworkflowV1alpha1Interface, statuses StatusStore) FlyteWorkflow {
+	e := &externalStatus{
+		w:         workflowStore,
+		workflows: workflows,
+		statuses:  statuses,
+		metrics: &externalStatusMetrics{
+			statusGetLatency:       scope.MustNewStopWatch("status_get_latency", "Time taken to read the status from the status store", time.Millisecond),
+			statusPutLatency:       scope.MustNewStopWatch("status_put_latency", "Time taken to write the status to the status store", time.Millisecond),
+			statusPutConflict:      scope.MustNewCounter("status_put_conflict", "Failure to write the status because a newer version was stored"),
+			statusNotFound:         scope.MustNewCounter("status_not_found", "Workflows read whose status was not found in the status store"),
+			statusRollbackFailures: scope.MustNewCounter("status_rollback_failures", "Failures to roll back the status after the CR failed to be written"),
+			statusSwept:            scope.MustNewCounter("status_swept", "Statuses of deleted workflows deleted from the status store"),
+			statusSweepFailures:    scope.MustNewCounter("status_sweep_failures", "Failures to delete the statuses of deleted workflows"),
+		},
+	}
+
+	if cfg.StatusRetention.Duration > 0 {
+		e.startSweeper(ctx, cfg.StatusRetention.Duration, cfg.SweepInterval.Duration)
+	}
+
+	return e
+}
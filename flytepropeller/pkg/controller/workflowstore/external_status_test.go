@@ -0,0 +1,136 @@
+package workflowstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/client/clientset/versioned/fake"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func TestExternalStatus(t *testing.T) {
+	ctx := context.TODO()
+	const namespace = "test-ns"
+
+	clientset := fake.NewSimpleClientset()
+	failUpdates := false
+	clientset.PrependReactor("update", "flyteworkflows", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if failUpdates {
+			return true, nil, kubeerrors.NewConflict(v1alpha1.Resource("flyteworkflows"), "x", fmt.Errorf("conflict"))
+		}
+
+		return false, nil, nil
+	})
+
+	mockClient := clientset.FlyteworkflowV1alpha1()
+	l := &mockWFNamespaceLister{GetCb: func(name string) (*v1alpha1.FlyteWorkflow, error) {
+		return mockClient.FlyteWorkflows(namespace).Get(ctx, name, v1.GetOptions{})
+	}}
+
+	scope := promutils.NewTestScope()
+	statuses := NewInMemoryStatusStore()
+	wfStore := NewExternalStatusStore(ctx, ExternalStatusConfig{}, scope,
+		NewPassthroughWorkflowStore(ctx, scope, mockClient, &mockWFLister{V: l}), mockClient, statuses)
+
+	wf := dummyWf(namespace, "x")
+	wf.GetExecutionStatus().UpdatePhase(v1alpha1.WorkflowPhaseRunning, "running", nil)
+	_, err := mockClient.FlyteWorkflows(namespace).Create(ctx, wf, v1.CreateOptions{})
+	assert.NoError(t, err)
+
+	t.Run("Status not stored yet", func(t *testing.T) {
+		w, err := wfStore.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowPhaseRunning, w.GetExecutionStatus().GetPhase())
+		assert.Equal(t, "0", w.GetAnnotations()[StatusVersionAnnotation])
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		w, err := wfStore.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+		w.Status.NodeStatus = map[v1alpha1.NodeID]*v1alpha1.NodeStatus{"n1": {Phase: v1alpha1.NodePhaseRunning}}
+
+		newWF, err := wfStore.UpdateStatus(ctx, w, PriorityClassCritical)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", newWF.GetAnnotations()[StatusVersionAnnotation])
+		assert.Len(t, newWF.Status.NodeStatus, 1)
+		assert.Len(t, w.Status.NodeStatus, 1)
+
+		cr, err := mockClient.FlyteWorkflows(namespace).Get(ctx, "x", v1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowPhaseRunning, cr.GetExecutionStatus().GetPhase())
+		assert.Equal(t, "running", cr.GetExecutionStatus().GetMessage())
+		assert.Empty(t, cr.Status.NodeStatus)
+		assert.Equal(t, "1", cr.GetAnnotations()[StatusVersionAnnotation])
+
+		stored, version, err := statuses.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), version)
+		assert.Equal(t, v1alpha1.NodePhaseRunning, stored.NodeStatus["n1"].GetPhase())
+
+		w, err = wfStore.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+		assert.Equal(t, "1", w.GetAnnotations()[StatusVersionAnnotation])
+		assert.Equal(t, v1alpha1.NodePhaseRunning, w.Status.NodeStatus["n1"].GetPhase())
+	})
+
+	t.Run("Stale", func(t *testing.T) {
+		w, err := wfStore.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+		setStatusVersion(w, 0)
+
+		_, err = wfStore.Update(ctx, w, PriorityClassCritical)
+		assert.True(t, IsWorkflowStale(err))
+	})
+
+	t.Run("Rollback when the CR fails to be written", func(t *testing.T) {
+		w, err := wfStore.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+		w.Status.NodeStatus["n2"] = &v1alpha1.NodeStatus{Phase: v1alpha1.NodePhaseQueued}
+
+		failUpdates = true
+		_, err = wfStore.Update(ctx, w, PriorityClassCritical)
+		failUpdates = false
+		assert.Error(t, err)
+		assert.Equal(t, "1", w.GetAnnotations()[StatusVersionAnnotation])
+
+		stored, version, err := statuses.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), version)
+		assert.NotContains(t, stored.NodeStatus, "n2")
+	})
+
+	t.Run("Sweep deleted", func(t *testing.T) {
+		w, err := wfStore.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+		w.GetExecutionStatus().UpdatePhase(v1alpha1.WorkflowPhaseSuccess, "done", nil)
+		_, err = wfStore.Update(ctx, w, PriorityClassCritical)
+		assert.NoError(t, err)
+
+		wfStore.(*externalStatus).sweep(ctx, time.Hour)
+		_, _, err = statuses.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+
+		// The status of a workflow which still exists is kept past the retention.
+		wfStore.(*externalStatus).sweep(ctx, -time.Hour)
+		_, _, err = statuses.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+
+		assert.NoError(t, mockClient.FlyteWorkflows(namespace).Delete(ctx, "x", v1.DeleteOptions{}))
+		wfStore.(*externalStatus).sweep(ctx, time.Hour)
+		_, _, err = statuses.Get(ctx, namespace, "x")
+		assert.NoError(t, err)
+
+		wfStore.(*externalStatus).sweep(ctx, -time.Hour)
+		_, _, err = statuses.Get(ctx, namespace, "x")
+		assert.True(t, IsStatusNotFound(err))
+	})
+}
@@ -6,9 +6,28 @@ import (
 
 	flyteworkflowv1alpha1 "github.com/flyteorg/flyte/flytepropeller/pkg/client/clientset/versioned/typed/flyteworkflow/v1alpha1"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/client/listers/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytestdlib/database"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
+// NewStatusStore creates the StatusStore configured for the ExternalStatus policy.
+func NewStatusStore(ctx context.Context, cfg ExternalStatusConfig) (StatusStore, error) {
+	switch cfg.Store {
+	case StatusStoreTypeInMemory:
+		return NewInMemoryStatusStore(), nil
+	case StatusStoreTypePostgres:
+		db, err := database.GetDB(ctx, database.GetConfig(), logger.GetConfig())
+		if err != nil {
+			return nil, err
+		}
+
+		return NewPostgresStatusStore(ctx, db)
+	}
+
+	return nil, fmt.Errorf("unknown workflow status store [%v]", cfg.Store)
+}
+
 func NewWorkflowStore(ctx context.Context, cfg *Config, lister v1alpha1.FlyteWorkflowLister,
 	workflows flyteworkflowv1alpha1.FlyteworkflowV1alpha1Interface, scope promutils.Scope) (FlyteWorkflow, error) {
 
@@ -27,6 +46,17 @@ func NewWorkflowStore(ctx context.Context, cfg *Config, lister v1alpha1.FlyteWor
 		workflowStore = NewPassthroughWorkflowStore(ctx, scope, workflows, lister)
 		workflowStore, err = NewTerminatedTrackingStore(ctx, scope, workflowStore)
 		workflowStore = NewResourceVersionCachingStore(ctx, scope, workflowStore)
+	case PolicyExternalStatus:
+		var statuses StatusStore
+		statuses, err = NewStatusStore(ctx, cfg.ExternalStatus)
+		if err != nil {
+			return nil, err
+		}
+
+		workflowStore = NewPassthroughWorkflowStore(ctx, scope, workflows, lister)
+		workflowStore = NewExternalStatusStore(ctx, cfg.ExternalStatus, scope.NewSubScope("external_status"), workflowStore,
+			workflows, statuses)
+		workflowStore, err = NewTerminatedTrackingStore(ctx, scope, workflowStore)
 	}
 
 	if err != nil {
@@ -2,6 +2,9 @@ package workflowstore
 
 import (
 	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 )
@@ -23,3 +26,20 @@ type FlyteWorkflow interface {
 	Update(ctx context.Context, workflow *v1alpha1.FlyteWorkflow, priorityClass PriorityClass) (
 		newWF *v1alpha1.FlyteWorkflow, err error)
 }
+
+// StatusStore keeps the status of workflows outside the FlyteWorkflow CR. Every write bumps the version of the status,
+// writes with an expected version that is no longer current are rejected with ErrStaleWorkflowError.
+type StatusStore interface {
+	// Get returns the status of the workflow and its version, ErrStatusNotFound if no status has been stored yet.
+	Get(ctx context.Context, namespace, name string) (status *v1alpha1.WorkflowStatus, version uint64, err error)
+	// Put stores the status if the stored version matches the expected version, 0 for a status that doesn't exist yet,
+	// and returns the new version.
+	Put(ctx context.Context, namespace, name string, status *v1alpha1.WorkflowStatus, expectedVersion uint64) (
+		newVersion uint64, err error)
+	// Rollback restores the status preceding the given version, if the given version is still current.
+	Rollback(ctx context.Context, namespace, name string, version uint64) error
+	// ListStale returns up to limit workflows whose status hasn't been written since the given time.
+	ListStale(ctx context.Context, before time.Time, limit int) ([]types.NamespacedName, error)
+	// Delete deletes the status of the workflow, if any.
+	Delete(ctx context.Context, namespace, name string) error
+}
@@ -0,0 +1,114 @@
+package workflowstore
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+)
+
+type inMemoryStatus struct {
+	status    *v1alpha1.WorkflowStatus
+	version   uint64
+	previous  *inMemoryStatus
+	updatedAt time.Time
+}
+
+// inMemoryStatusStore is a StatusStore which keeps the statuses in memory, it is useful for testing.
+type inMemoryStatusStore struct {
+	lock     sync.Mutex
+	statuses map[types.NamespacedName]*inMemoryStatus
+}
+
+func (s *inMemoryStatusStore) Get(_ context.Context, namespace, name string) (*v1alpha1.WorkflowStatus, uint64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stored, ok := s.statuses[types.NamespacedName{Namespace: namespace, Name: name}]
+	if !ok {
+		return nil, 0, ErrStatusNotFound
+	}
+
+	return stored.status.DeepCopy(), stored.version, nil
+}
+
+func (s *inMemoryStatusStore) Put(_ context.Context, namespace, name string, status *v1alpha1.WorkflowStatus,
+	expectedVersion uint64) (uint64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	var version uint64
+	previous, ok := s.statuses[key]
+	if ok {
+		version = previous.version
+		// Only the status preceding the current one is kept for rollbacks.
+		previous = &inMemoryStatus{status: previous.status, version: previous.version, updatedAt: previous.updatedAt}
+	}
+
+	if version != expectedVersion {
+		return 0, ErrStaleWorkflowError
+	}
+
+	s.statuses[key] = &inMemoryStatus{status: status.DeepCopy(), version: version + 1, previous: previous, updatedAt: time.Now()}
+	return version + 1, nil
+}
+
+func (s *inMemoryStatusStore) Rollback(_ context.Context, namespace, name string, version uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	stored, ok := s.statuses[key]
+	if !ok || stored.version != version {
+		return ErrStaleWorkflowError
+	}
+
+	if stored.previous == nil {
+		delete(s.statuses, key)
+	} else {
+		s.statuses[key] = stored.previous
+	}
+
+	return nil
+}
+
+func (s *inMemoryStatusStore) ListStale(_ context.Context, before time.Time, limit int) ([]types.NamespacedName, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var stale []types.NamespacedName
+	for key, stored := range s.statuses {
+		if stored.updatedAt.Before(before) {
+			stale = append(stale, key)
+		}
+	}
+
+	sort.Slice(stale, func(i, j int) bool {
+		return stale[i].String() < stale[j].String()
+	})
+
+	if len(stale) > limit {
+		stale = stale[:limit]
+	}
+
+	return stale, nil
+}
+
+func (s *inMemoryStatusStore) Delete(_ context.Context, namespace, name string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.statuses, types.NamespacedName{Namespace: namespace, Name: name})
+	return nil
+}
+
+func NewInMemoryStatusStore() StatusStore {
+	return &inMemoryStatusStore{
+		statuses: map[types.NamespacedName]*inMemoryStatus{},
+	}
+}
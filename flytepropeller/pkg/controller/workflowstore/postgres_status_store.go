@@ -0,0 +1,189 @@
+package workflowstore
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+)
+
+// statusMigrationsTable keeps the applied migrations of the status table apart from the migrations of other
+// components sharing the database.
+const statusMigrationsTable = "workflow_status_migrations"
+
+// WorkflowStatusRecord is the status of a workflow stored in the database. Version is bumped with every write and used
+// for optimistic concurrency control. The previous status is kept to roll back writes whose CR couldn't be written.
+type WorkflowStatusRecord struct {
+	Namespace      string `gorm:"primaryKey"`
+	Name           string `gorm:"primaryKey"`
+	Version        uint64
+	Status         []byte
+	PreviousStatus []byte
+	CreatedAt      time.Time
+	UpdatedAt      time.Time `gorm:"index"`
+}
+
+func (WorkflowStatusRecord) TableName() string {
+	return "workflow_statuses"
+}
+
+// Migrations create and update the table of the statuses. The models are copied into the migrations so that later
+// changes of WorkflowStatusRecord don't alter past migrations.
+var Migrations = []*gormigrate.Migration{
+	{
+		ID: "2026-10-18-workflow-statuses",
+		Migrate: func(tx *gorm.DB) error {
+			type WorkflowStatusRecord struct {
+				Namespace      string `gorm:"primaryKey"`
+				Name           string `gorm:"primaryKey"`
+				Version        uint64
+				Status         []byte
+				PreviousStatus []byte
+				CreatedAt      time.Time
+				UpdatedAt      time.Time `gorm:"index"`
+			}
+
+			return tx.Table("workflow_statuses").AutoMigrate(&WorkflowStatusRecord{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("workflow_statuses")
+		},
+	},
+}
+
+// postgresStatusStore is a StatusStore which keeps the JSON serialized statuses in a database table.
+type postgresStatusStore struct {
+	db *gorm.DB
+}
+
+func (s *postgresStatusStore) Get(ctx context.Context, namespace, name string) (*v1alpha1.WorkflowStatus, uint64, error) {
+	record := &WorkflowStatusRecord{}
+	err := s.db.WithContext(ctx).Select("version", "status").Where("namespace = ? AND name = ?", namespace, name).Take(record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, ErrStatusNotFound
+		}
+
+		return nil, 0, errors.Wrapf(err, "failed to read the status of workflow [%v]", workflowKey(namespace, name))
+	}
+
+	status := &v1alpha1.WorkflowStatus{}
+	if err := json.Unmarshal(record.Status, status); err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to unmarshal the status of workflow [%v]", workflowKey(namespace, name))
+	}
+
+	return status, record.Version, nil
+}
+
+func (s *postgresStatusStore) Put(ctx context.Context, namespace, name string, status *v1alpha1.WorkflowStatus,
+	expectedVersion uint64) (uint64, error) {
+	raw, err := json.Marshal(status)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to marshal the status of workflow [%v]", workflowKey(namespace, name))
+	}
+
+	now := time.Now().UTC()
+	var result *gorm.DB
+	if expectedVersion == 0 {
+		// A concurrent writer may have inserted the status in the meantime, in which case nothing is inserted.
+		result = s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&WorkflowStatusRecord{
+			Namespace: namespace,
+			Name:      name,
+			Version:   1,
+			Status:    raw,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	} else {
+		// The assignments of an update read the values of the row before the update.
+		result = s.db.WithContext(ctx).Model(&WorkflowStatusRecord{}).
+			Where("namespace = ? AND name = ? AND version = ?", namespace, name, expectedVersion).
+			Updates(map[string]interface{}{
+				"version":         expectedVersion + 1,
+				"status":          raw,
+				"previous_status": gorm.Expr("status"),
+				"updated_at":      now,
+			})
+	}
+
+	if result.Error != nil {
+		return 0, errors.Wrapf(result.Error, "failed to write the status of workflow [%v]", workflowKey(namespace, name))
+	}
+
+	if result.RowsAffected == 0 {
+		return 0, ErrStaleWorkflowError
+	}
+
+	return expectedVersion + 1, nil
+}
+
+func (s *postgresStatusStore) Rollback(ctx context.Context, namespace, name string, version uint64) error {
+	var result *gorm.DB
+	if version == 1 {
+		result = s.db.WithContext(ctx).Where("namespace = ? AND name = ? AND version = ?", namespace, name, version).
+			Delete(&WorkflowStatusRecord{})
+	} else {
+		result = s.db.WithContext(ctx).Model(&WorkflowStatusRecord{}).
+			Where("namespace = ? AND name = ? AND version = ? AND previous_status IS NOT NULL", namespace, name, version).
+			Updates(map[string]interface{}{
+				"version":         version - 1,
+				"status":          gorm.Expr("previous_status"),
+				"previous_status": nil,
+				"updated_at":      time.Now().UTC(),
+			})
+	}
+
+	if result.Error != nil {
+		return errors.Wrapf(result.Error, "failed to roll back the status of workflow [%v]", workflowKey(namespace, name))
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrStaleWorkflowError
+	}
+
+	return nil
+}
+
+func (s *postgresStatusStore) ListStale(ctx context.Context, before time.Time, limit int) ([]types.NamespacedName, error) {
+	var records []WorkflowStatusRecord
+	err := s.db.WithContext(ctx).Select("namespace", "name").Where("updated_at < ?", before.UTC()).
+		Order("updated_at").Limit(limit).Find(&records).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the stale workflow statuses")
+	}
+
+	stale := make([]types.NamespacedName, 0, len(records))
+	for _, r := range records {
+		stale = append(stale, types.NamespacedName{Namespace: r.Namespace, Name: r.Name})
+	}
+
+	return stale, nil
+}
+
+func (s *postgresStatusStore) Delete(ctx context.Context, namespace, name string) error {
+	err := s.db.WithContext(ctx).Where("namespace = ? AND name = ?", namespace, name).Delete(&WorkflowStatusRecord{}).Error
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete the status of workflow [%v]", workflowKey(namespace, name))
+	}
+
+	return nil
+}
+
+// NewPostgresStatusStore creates a StatusStore backed by the given database, running the migrations of the status table
+// first.
+func NewPostgresStatusStore(ctx context.Context, db *gorm.DB) (StatusStore, error) {
+	options := *gormigrate.DefaultOptions
+	options.TableName = statusMigrationsTable
+	if err := gormigrate.New(db.WithContext(ctx), &options, Migrations).Migrate(); err != nil {
+		return nil, errors.Wrapf(err, "failed to migrate the workflow status table")
+	}
+
+	return &postgresStatusStore{db: db}, nil
+}
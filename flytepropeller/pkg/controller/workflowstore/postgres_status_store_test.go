@@ -0,0 +1,97 @@
+package workflowstore
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytestdlib/database"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+func TestPostgresStatusStore(t *testing.T) {
+	ctx := context.TODO()
+	db, err := database.GetDB(ctx, &database.DbConfig{
+		SQLite: database.SQLiteConfig{File: filepath.Join(t.TempDir(), "workflowstore.db")},
+	}, &logger.Config{})
+	assert.NoError(t, err)
+
+	store, err := NewPostgresStatusStore(ctx, db)
+	assert.NoError(t, err)
+
+	_, _, err = store.Get(ctx, "ns", "x")
+	assert.True(t, IsStatusNotFound(err))
+
+	status := &v1alpha1.WorkflowStatus{
+		Phase:      v1alpha1.WorkflowPhaseRunning,
+		NodeStatus: map[v1alpha1.NodeID]*v1alpha1.NodeStatus{"n1": {Phase: v1alpha1.NodePhaseQueued}},
+	}
+
+	version, err := store.Put(ctx, "ns", "x", status, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), version)
+
+	_, err = store.Put(ctx, "ns", "x", status, 0)
+	assert.True(t, IsWorkflowStale(err))
+
+	status.UpdatePhase(v1alpha1.WorkflowPhaseFailed, "failed", &core.ExecutionError{Code: "code"})
+	version, err = store.Put(ctx, "ns", "x", status, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), version)
+
+	_, err = store.Put(ctx, "ns", "x", status, 1)
+	assert.True(t, IsWorkflowStale(err))
+
+	stored, version, err := store.Get(ctx, "ns", "x")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), version)
+	assert.Equal(t, v1alpha1.WorkflowPhaseFailed, stored.GetPhase())
+	assert.Equal(t, "code", stored.GetExecutionError().GetCode())
+	assert.Equal(t, v1alpha1.NodePhaseQueued, stored.NodeStatus["n1"].GetPhase())
+
+	_, err = store.Put(ctx, "ns", "y", &v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowPhaseRunning}, 0)
+	assert.NoError(t, err)
+
+	stale, err := store.ListStale(ctx, time.Now().Add(-time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, stale)
+
+	stale, err = store.ListStale(ctx, time.Now().Add(time.Hour), 1)
+	assert.NoError(t, err)
+	assert.Len(t, stale, 1)
+
+	stale, err = store.ListStale(ctx, time.Now().Add(time.Hour), 10)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []types.NamespacedName{{Namespace: "ns", Name: "x"}, {Namespace: "ns", Name: "y"}}, stale)
+
+	t.Run("Rollback", func(t *testing.T) {
+		assert.True(t, IsWorkflowStale(store.Rollback(ctx, "ns", "x", 1)))
+
+		assert.NoError(t, store.Rollback(ctx, "ns", "x", 2))
+		stored, version, err := store.Get(ctx, "ns", "x")
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), version)
+		assert.Equal(t, v1alpha1.WorkflowPhaseRunning, stored.GetPhase())
+
+		version, err = store.Put(ctx, "ns", "x", status, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), version)
+
+		assert.NoError(t, store.Rollback(ctx, "ns", "y", 1))
+		_, _, err = store.Get(ctx, "ns", "y")
+		assert.True(t, IsStatusNotFound(err))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, store.Delete(ctx, "ns", "x"))
+		_, _, err = store.Get(ctx, "ns", "x")
+		assert.True(t, IsStatusNotFound(err))
+		assert.NoError(t, store.Delete(ctx, "ns", "x"))
+	})
+}
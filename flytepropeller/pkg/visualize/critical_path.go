@@ -0,0 +1,122 @@
+package visualize
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+)
+
+// layers sorts the nodes of the graph topologically and groups them by the length of the longest path reaching them.
+func layers(g *Graph) ([][]*Node, error) {
+	inDegree := make(map[v1alpha1.NodeID]int, len(g.Nodes))
+	downstream := make(map[v1alpha1.NodeID][]v1alpha1.NodeID, len(g.Nodes))
+	for _, e := range g.Edges {
+		inDegree[e.To]++
+		downstream[e.From] = append(downstream[e.From], e.To)
+	}
+
+	nodes := nodesByID(g)
+	depth := make(map[v1alpha1.NodeID]int, len(g.Nodes))
+	var current []v1alpha1.NodeID
+	for _, n := range g.Nodes {
+		if inDegree[n.ID] == 0 {
+			current = append(current, n.ID)
+		}
+	}
+
+	visited := 0
+	var res [][]*Node
+	for len(current) > 0 {
+		id := current[0]
+		current = current[1:]
+		if n, ok := nodes[id]; ok {
+			visited++
+			for len(res) <= depth[id] {
+				res = append(res, nil)
+			}
+
+			res[depth[id]] = append(res[depth[id]], n)
+		}
+
+		for _, child := range downstream[id] {
+			if depth[id]+1 > depth[child] {
+				depth[child] = depth[id] + 1
+			}
+
+			if inDegree[child]--; inDegree[child] == 0 {
+				current = append(current, child)
+			}
+		}
+	}
+
+	if visited < len(g.Nodes) {
+		return nil, errors.Errorf("Cycle detected in Workflow [%v]", g.Name)
+	}
+
+	return res, nil
+}
+
+// MarkCriticalPath marks the nodes and edges of the chain of nodes with the longest total duration and returns the
+// total duration. The critical paths of subgraphs are marked the same way. Nothing is marked if none of the nodes ran.
+func MarkCriticalPath(g *Graph) (time.Duration, error) {
+	sorted, err := layers(g)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, n := range g.Nodes {
+		if n.Subgraph != nil {
+			if _, err := MarkCriticalPath(n.Subgraph); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	upstream := make(map[v1alpha1.NodeID][]*Edge, len(g.Nodes))
+	for _, e := range g.Edges {
+		upstream[e.To] = append(upstream[e.To], e)
+	}
+
+	// The longest duration of a chain ending in the node and the edge the chain reaches the node through.
+	total := make(map[v1alpha1.NodeID]time.Duration, len(g.Nodes))
+	via := make(map[v1alpha1.NodeID]*Edge, len(g.Nodes))
+	var last *Node
+	for _, layer := range sorted {
+		for _, n := range layer {
+			for _, e := range upstream[n.ID] {
+				if via[n.ID] == nil || total[e.From] > total[via[n.ID].From] {
+					via[n.ID] = e
+				}
+			}
+
+			total[n.ID] = n.Duration
+			if e := via[n.ID]; e != nil {
+				total[n.ID] += total[e.From]
+			}
+
+			if last == nil || total[n.ID] > total[last.ID] {
+				last = n
+			}
+		}
+	}
+
+	if last == nil || total[last.ID] == 0 {
+		return 0, nil
+	}
+
+	nodes := nodesByID(g)
+	for n := last; n != nil; {
+		n.Critical = true
+		e := via[n.ID]
+		if e == nil {
+			break
+		}
+
+		e.Critical = true
+		n = nodes[e.From]
+	}
+
+	return total[last.ID], nil
+}
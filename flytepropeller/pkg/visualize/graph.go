@@ -0,0 +1,314 @@
+package visualize
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
+)
+
+// Node is a node of the workflow DAG along with its execution state, if known.
+type Node struct {
+	ID       v1alpha1.NodeID
+	Kind     v1alpha1.NodeKind
+	Phase    v1alpha1.NodePhase
+	Duration time.Duration
+	Message  string
+	// Critical is set if the node is on the critical path of the workflow.
+	Critical bool
+	// Subgraph holds the nodes run by the node: the nodes of a subworkflow, the cases of a branch or the subnodes of an
+	// array. It's nil for all other nodes.
+	Subgraph *Graph
+}
+
+// Edge connects two nodes of the DAG, labeled with the variables bound from the upstream node.
+type Edge struct {
+	From     v1alpha1.NodeID
+	To       v1alpha1.NodeID
+	Label    string
+	Critical bool
+}
+
+// Graph is the renderer agnostic DAG of a workflow.
+type Graph struct {
+	Name  string
+	Nodes []*Node
+	Edges []*Edge
+}
+
+// GetNode returns the node with the given id, nil if it doesn't exist.
+func (g *Graph) GetNode(id v1alpha1.NodeID) *Node {
+	for _, n := range g.Nodes {
+		if n.ID == id {
+			return n
+		}
+	}
+
+	return nil
+}
+
+// nodesByID indexes the nodes of the graph by their ids.
+func nodesByID(g *Graph) map[v1alpha1.NodeID]*Node {
+	nodes := make(map[v1alpha1.NodeID]*Node, len(g.Nodes))
+	for _, n := range g.Nodes {
+		nodes[n.ID] = n
+	}
+
+	return nodes
+}
+
+func edgeLabel(from v1alpha1.NodeID, bindings []*core.Binding) string {
+	flatMap := make(map[common.NodeID]sets.String)
+	for _, binding := range bindings {
+		flatten(binding.GetBinding(), flatMap)
+	}
+
+	if vars, found := flatMap[from]; found {
+		return strings.Join(vars.List(), ",")
+	} else if vars, found := flatMap[""]; found && from == common.StartNodeID {
+		return strings.Join(vars.List(), ",")
+	}
+
+	return executionEdgeLabel
+}
+
+func nodeDuration(status *v1alpha1.NodeStatus, now time.Time) time.Duration {
+	if status.GetStartedAt() == nil {
+		return 0
+	}
+
+	if status.GetStoppedAt() != nil {
+		return status.GetStoppedAt().Sub(status.GetStartedAt().Time)
+	}
+
+	if v1alpha1.IsPhaseTerminal(status.GetPhase()) {
+		return 0
+	}
+
+	return now.Sub(status.GetStartedAt().Time)
+}
+
+func sortGraph(g *Graph) {
+	sort.Slice(g.Nodes, func(i, j int) bool {
+		return g.Nodes[i].ID < g.Nodes[j].ID
+	})
+
+	sort.Slice(g.Edges, func(i, j int) bool {
+		if g.Edges[i].From != g.Edges[j].From {
+			return g.Edges[i].From < g.Edges[j].From
+		}
+
+		return g.Edges[i].To < g.Edges[j].To
+	})
+}
+
+// branchCases returns the ids of the nodes of all the cases of the branch.
+func branchCases(b *v1alpha1.BranchNodeSpec) []v1alpha1.NodeID {
+	var cases []v1alpha1.NodeID
+	for _, block := range append([]*v1alpha1.IfBlock{&b.If}, b.ElseIf...) {
+		if block.ThenNode != nil {
+			cases = append(cases, *block.ThenNode)
+		}
+	}
+
+	if b.Else != nil {
+		cases = append(cases, *b.Else)
+	}
+
+	return cases
+}
+
+// workflowGraphBuilder builds the graphs of the nodes of a FlyteWorkflow CR and of its subworkflows.
+type workflowGraphBuilder struct {
+	w   *v1alpha1.FlyteWorkflow
+	now time.Time
+}
+
+// build returns the graph of the nodes of the workflow spec whose statuses are kept in statuses. The nodes of the cases
+// of branches are shown in the subgraphs of their branch nodes rather than as nodes of the workflow.
+func (b workflowGraphBuilder) build(name string, spec *v1alpha1.WorkflowSpec, statuses map[v1alpha1.NodeID]*v1alpha1.NodeStatus) *Graph {
+	g := &Graph{Name: name}
+	cases := sets.NewString()
+	for _, n := range spec.Nodes {
+		if n.BranchNode != nil {
+			cases.Insert(branchCases(n.BranchNode)...)
+		}
+	}
+
+	for id, n := range spec.Nodes {
+		if !cases.Has(id) {
+			g.Nodes = append(g.Nodes, b.node(n, spec.Nodes, statuses))
+		}
+	}
+
+	for from, downstream := range spec.GetConnections().Downstream {
+		for _, to := range sets.NewString(downstream...).List() {
+			var bindings []*core.Binding
+			if n, ok := spec.Nodes[to]; ok {
+				for _, b := range n.GetInputBindings() {
+					bindings = append(bindings, b.Binding)
+				}
+			}
+
+			g.Edges = append(g.Edges, &Edge{From: from, To: to, Label: edgeLabel(from, bindings)})
+		}
+	}
+
+	sortGraph(g)
+	return g
+}
+
+func (b workflowGraphBuilder) node(n *v1alpha1.NodeSpec, nodes map[v1alpha1.NodeID]*v1alpha1.NodeSpec, statuses map[v1alpha1.NodeID]*v1alpha1.NodeStatus) *Node {
+	node := &Node{ID: n.GetID(), Kind: n.GetKind()}
+	status := statuses[node.ID]
+	if status != nil {
+		node.Phase = status.GetPhase()
+		node.Duration = nodeDuration(status, b.now)
+		node.Message = status.GetMessage()
+	}
+
+	switch {
+	case n.WorkflowNode != nil && n.WorkflowNode.SubWorkflowReference != nil:
+		// The nodes of subworkflows keep their statuses in the status of the subworkflow node.
+		if sub, ok := b.w.SubWorkflows[*n.WorkflowNode.SubWorkflowReference]; ok {
+			var subStatuses map[v1alpha1.NodeID]*v1alpha1.NodeStatus
+			if status != nil {
+				subStatuses = status.SubNodeStatus
+			}
+
+			node.Subgraph = b.build(sub.ID, sub, subStatuses)
+		}
+	case n.BranchNode != nil:
+		// The nodes of the cases of branches keep their statuses next to the status of the branch node.
+		node.Subgraph = &Graph{Name: node.ID}
+		for _, id := range branchCases(n.BranchNode) {
+			if c, ok := nodes[id]; ok {
+				node.Subgraph.Nodes = append(node.Subgraph.Nodes, b.node(c, nodes, statuses))
+			}
+		}
+	case n.ArrayNode != nil && n.ArrayNode.SubNodeSpec != nil:
+		var arrayStatus *v1alpha1.ArrayNodeStatus
+		if status != nil {
+			arrayStatus = status.ArrayNodeStatus
+		}
+
+		node.Subgraph = arraySubgraph(node.ID, n.ArrayNode.SubNodeSpec.GetID(), n.ArrayNode.SubNodeSpec.GetKind(), arrayStatus)
+	}
+
+	return node
+}
+
+// arraySubgraph returns the graph of the subnodes of an array node, one node per subnode along with its phase once the
+// array node started. Durations of subnodes aren't tracked.
+func arraySubgraph(name string, subNodeID v1alpha1.NodeID, kind v1alpha1.NodeKind, status *v1alpha1.ArrayNodeStatus) *Graph {
+	g := &Graph{Name: name}
+	if status == nil || status.SubNodePhases.ItemsCount == 0 {
+		g.Nodes = append(g.Nodes, &Node{ID: subNodeID, Kind: kind})
+		return g
+	}
+
+	for i := 0; i < int(status.SubNodePhases.ItemsCount); i++ { // #nosec G115
+		g.Nodes = append(g.Nodes, &Node{
+			ID:    fmt.Sprintf("%v[%d]", subNodeID, i),
+			Kind:  kind,
+			Phase: v1alpha1.NodePhase(status.SubNodePhases.GetItem(i)), // #nosec G115
+		})
+	}
+
+	return g
+}
+
+// NewGraphFromWorkflow builds the graph of the nodes of a FlyteWorkflow CR, along with the subgraphs of its
+// subworkflow, branch and array nodes. Phases and durations are taken from the node statuses, nodes still running are
+// measured up to now.
+func NewGraphFromWorkflow(w *v1alpha1.FlyteWorkflow, now time.Time) *Graph {
+	return workflowGraphBuilder{w: w, now: now}.build(w.ID, w.WorkflowSpec, w.Status.NodeStatus)
+}
+
+func compiledNodeKind(n *core.Node) v1alpha1.NodeKind {
+	switch {
+	case n.GetId() == common.StartNodeID:
+		return v1alpha1.NodeKindStart
+	case n.GetId() == common.EndNodeID:
+		return v1alpha1.NodeKindEnd
+	case n.GetTaskNode() != nil:
+		return v1alpha1.NodeKindTask
+	case n.GetWorkflowNode() != nil:
+		return v1alpha1.NodeKindWorkflow
+	case n.GetBranchNode() != nil:
+		return v1alpha1.NodeKindBranch
+	case n.GetGateNode() != nil:
+		return v1alpha1.NodeKindGate
+	case n.GetArrayNode() != nil:
+		return v1alpha1.NodeKindArray
+	}
+
+	return ""
+}
+
+// compiledGraphBuilder builds the graphs of the nodes of a compiled workflow and of its subworkflows.
+type compiledGraphBuilder struct {
+	subWorkflows map[string]*core.CompiledWorkflow
+}
+
+func (b compiledGraphBuilder) build(w *core.CompiledWorkflow) *Graph {
+	g := &Graph{Name: w.GetTemplate().GetId().GetName()}
+	nodes := make(map[v1alpha1.NodeID]*core.Node, len(w.GetTemplate().GetNodes()))
+	for _, n := range w.GetTemplate().GetNodes() {
+		nodes[n.GetId()] = n
+		g.Nodes = append(g.Nodes, b.node(n))
+	}
+
+	for from, downstream := range w.GetConnections().GetDownstream() {
+		for _, to := range sets.NewString(downstream.GetIds()...).List() {
+			g.Edges = append(g.Edges, &Edge{From: from, To: to, Label: edgeLabel(from, nodes[to].GetInputs())})
+		}
+	}
+
+	sortGraph(g)
+	return g
+}
+
+func (b compiledGraphBuilder) node(n *core.Node) *Node {
+	node := &Node{ID: n.GetId(), Kind: compiledNodeKind(n)}
+	switch {
+	case n.GetWorkflowNode().GetSubWorkflowRef() != nil:
+		if sub, ok := b.subWorkflows[k8s.WorkflowIDAsString(n.GetWorkflowNode().GetSubWorkflowRef())]; ok {
+			node.Subgraph = b.build(sub)
+		}
+	case n.GetBranchNode() != nil:
+		ifElse := n.GetBranchNode().GetIfElse()
+		node.Subgraph = &Graph{Name: node.ID}
+		for _, block := range append([]*core.IfBlock{ifElse.GetCase()}, ifElse.GetOther()...) {
+			if block.GetThenNode() != nil {
+				node.Subgraph.Nodes = append(node.Subgraph.Nodes, b.node(block.GetThenNode()))
+			}
+		}
+
+		if ifElse.GetElseNode() != nil {
+			node.Subgraph.Nodes = append(node.Subgraph.Nodes, b.node(ifElse.GetElseNode()))
+		}
+	case n.GetArrayNode().GetNode() != nil:
+		node.Subgraph = &Graph{Name: node.ID, Nodes: []*Node{b.node(n.GetArrayNode().GetNode())}}
+	}
+
+	return node
+}
+
+// NewGraphFromCompiledWorkflow builds the graph of the nodes of a compiled workflow, along with the subgraphs of its
+// subworkflow, branch and array nodes. Subworkflows are looked up in subWorkflows. None of the nodes have started.
+func NewGraphFromCompiledWorkflow(w *core.CompiledWorkflow, subWorkflows []*core.CompiledWorkflow) *Graph {
+	b := compiledGraphBuilder{subWorkflows: make(map[string]*core.CompiledWorkflow, len(subWorkflows))}
+	for _, sub := range subWorkflows {
+		b.subWorkflows[k8s.WorkflowIDAsString(sub.GetTemplate().GetId())] = sub
+	}
+
+	return b.build(w)
+}
@@ -0,0 +1,335 @@
+package visualize
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flyte/flytestdlib/bitarray"
+)
+
+func promiseBinding(nodeID, v string) *v1alpha1.Binding {
+	return &v1alpha1.Binding{Binding: &core.Binding{
+		Var: v,
+		Binding: &core.BindingData{
+			Value: &core.BindingData_Promise{Promise: &core.OutputReference{NodeId: nodeID, Var: v}},
+		},
+	}}
+}
+
+func nodeStatus(phase v1alpha1.NodePhase, startedAt time.Time, duration time.Duration) *v1alpha1.NodeStatus {
+	s := &v1alpha1.NodeStatus{Phase: phase, StartedAt: &metav1.Time{Time: startedAt}}
+	if v1alpha1.IsPhaseTerminal(phase) {
+		s.StoppedAt = &metav1.Time{Time: startedAt.Add(duration)}
+	}
+
+	return s
+}
+
+// newDiamondWorkflow creates the workflow start -> (fast, slow) -> join -> end with slow still running.
+func newDiamondWorkflow(now time.Time) *v1alpha1.FlyteWorkflow {
+	return &v1alpha1.FlyteWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "diamond"},
+		WorkflowSpec: &v1alpha1.WorkflowSpec{
+			ID: "diamond",
+			Nodes: map[v1alpha1.NodeID]*v1alpha1.NodeSpec{
+				common.StartNodeID: {ID: common.StartNodeID, Kind: v1alpha1.NodeKindStart},
+				"fast":             {ID: "fast", Kind: v1alpha1.NodeKindTask},
+				"slow":             {ID: "slow", Kind: v1alpha1.NodeKindTask},
+				"join": {ID: "join", Kind: v1alpha1.NodeKindTask, InputBindings: []*v1alpha1.Binding{
+					promiseBinding("fast", "x"), promiseBinding("slow", "y"),
+				}},
+				common.EndNodeID: {ID: common.EndNodeID, Kind: v1alpha1.NodeKindEnd},
+			},
+			Connections: v1alpha1.Connections{
+				Downstream: map[v1alpha1.NodeID][]v1alpha1.NodeID{
+					common.StartNodeID: {"fast", "slow"},
+					"fast":             {"join"},
+					"slow":             {"join"},
+					"join":             {common.EndNodeID},
+				},
+			},
+		},
+		Status: v1alpha1.WorkflowStatus{
+			NodeStatus: map[v1alpha1.NodeID]*v1alpha1.NodeStatus{
+				"fast": nodeStatus(v1alpha1.NodePhaseSucceeded, now.Add(-time.Hour), time.Minute),
+				"slow": nodeStatus(v1alpha1.NodePhaseRunning, now.Add(-time.Hour), 0),
+			},
+		},
+	}
+}
+
+func TestNewGraphFromWorkflow(t *testing.T) {
+	now := time.Now()
+	g := NewGraphFromWorkflow(newDiamondWorkflow(now), now)
+	assert.Equal(t, "diamond", g.Name)
+	assert.Len(t, g.Nodes, 5)
+	assert.Len(t, g.Edges, 5)
+
+	assert.Equal(t, v1alpha1.NodePhaseSucceeded, g.GetNode("fast").Phase)
+	assert.Equal(t, time.Minute, g.GetNode("fast").Duration)
+	assert.Equal(t, v1alpha1.NodePhaseRunning, g.GetNode("slow").Phase)
+	assert.Equal(t, time.Hour, g.GetNode("slow").Duration)
+	assert.Equal(t, v1alpha1.NodePhaseNotYetStarted, g.GetNode("join").Phase)
+
+	for _, e := range g.Edges {
+		switch e.From {
+		case "fast":
+			assert.Equal(t, "x", e.Label)
+		case "slow":
+			assert.Equal(t, "y", e.Label)
+		default:
+			assert.Equal(t, executionEdgeLabel, e.Label)
+		}
+	}
+}
+
+func TestNewGraphFromCompiledWorkflow(t *testing.T) {
+	g := NewGraphFromCompiledWorkflow(&core.CompiledWorkflow{
+		Template: &core.WorkflowTemplate{
+			Id: &core.Identifier{Name: "wf"},
+			Nodes: []*core.Node{
+				{Id: common.StartNodeID},
+				{Id: "n1", Target: &core.Node_TaskNode{TaskNode: &core.TaskNode{}}},
+				{Id: "n2", Target: &core.Node_BranchNode{BranchNode: &core.BranchNode{IfElse: &core.IfElseBlock{
+					Case: &core.IfBlock{ThenNode: &core.Node{Id: "n2-n0", Target: &core.Node_WorkflowNode{WorkflowNode: &core.WorkflowNode{
+						Reference: &core.WorkflowNode_SubWorkflowRef{SubWorkflowRef: &core.Identifier{Name: "sub"}},
+					}}}},
+					Default: &core.IfElseBlock_ElseNode{ElseNode: &core.Node{Id: "n2-n1", Target: &core.Node_ArrayNode{ArrayNode: &core.ArrayNode{
+						Node: &core.Node{Id: "n2-n1-n0", Target: &core.Node_TaskNode{TaskNode: &core.TaskNode{}}},
+					}}}},
+				}}}},
+				{Id: common.EndNodeID},
+			},
+		},
+		Connections: &core.ConnectionSet{
+			Downstream: map[string]*core.ConnectionSet_IdList{
+				common.StartNodeID: {Ids: []string{"n1"}},
+				"n1":               {Ids: []string{"n2", "n2"}},
+				"n2":               {Ids: []string{common.EndNodeID}},
+			},
+		},
+	}, []*core.CompiledWorkflow{{
+		Template: &core.WorkflowTemplate{
+			Id:    &core.Identifier{Name: "sub"},
+			Nodes: []*core.Node{{Id: "s1", Target: &core.Node_TaskNode{TaskNode: &core.TaskNode{}}}},
+		},
+	}})
+
+	assert.Equal(t, "wf", g.Name)
+	assert.Len(t, g.Nodes, 4)
+	assert.Len(t, g.Edges, 3)
+	assert.Equal(t, v1alpha1.NodeKindTask, g.GetNode("n1").Kind)
+	assert.Equal(t, v1alpha1.NodeKindBranch, g.GetNode("n2").Kind)
+	assert.Equal(t, v1alpha1.NodeKindEnd, g.GetNode(common.EndNodeID).Kind)
+
+	branch := g.GetNode("n2").Subgraph
+	if assert.NotNil(t, branch) {
+		assert.Len(t, branch.Nodes, 2)
+		assert.Equal(t, "sub", branch.GetNode("n2-n0").Subgraph.Name)
+		assert.Equal(t, v1alpha1.NodeKindTask, branch.GetNode("n2-n0").Subgraph.GetNode("s1").Kind)
+		assert.Equal(t, v1alpha1.NodeKindTask, branch.GetNode("n2-n1").Subgraph.GetNode("n2-n1-n0").Kind)
+	}
+
+	duration, err := MarkCriticalPath(g)
+	assert.NoError(t, err)
+	assert.Zero(t, duration)
+	for _, n := range g.Nodes {
+		assert.False(t, n.Critical)
+	}
+}
+
+func TestMarkCriticalPath(t *testing.T) {
+	t.Run("Longest chain", func(t *testing.T) {
+		now := time.Now()
+		g := NewGraphFromWorkflow(newDiamondWorkflow(now), now)
+		duration, err := MarkCriticalPath(g)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Hour, duration)
+
+		assert.True(t, g.GetNode("slow").Critical)
+		assert.False(t, g.GetNode("fast").Critical)
+		assert.False(t, g.GetNode("join").Critical)
+		for _, e := range g.Edges {
+			assert.Equal(t, e.From == common.StartNodeID && e.To == "slow", e.Critical, "%v -> %v", e.From, e.To)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		g := &Graph{
+			Nodes: []*Node{{ID: "a"}, {ID: "b"}},
+			Edges: []*Edge{{From: "a", To: "b"}, {From: "b", To: "a"}},
+		}
+
+		_, err := MarkCriticalPath(g)
+		assert.Error(t, err)
+	})
+}
+
+func TestRender(t *testing.T) {
+	now := time.Now()
+	g := NewGraphFromWorkflow(newDiamondWorkflow(now), now)
+	duration, err := MarkCriticalPath(g)
+	assert.NoError(t, err)
+
+	t.Run("Dot", func(t *testing.T) {
+		dot := ToDot(g)
+		assert.True(t, strings.HasPrefix(dot, "digraph G {"))
+		assert.Contains(t, dot, `"slow" [label="slow (task) 1h0m0s",fillcolor="#fff59d"`)
+		assert.Contains(t, dot, `"start-node" -> "slow" [label="execution",style=dashed,color="#d32f2f",penwidth=3];`)
+		assert.Contains(t, dot, `"fast" -> "join" [label="x",style=solid];`)
+	})
+
+	t.Run("Mermaid", func(t *testing.T) {
+		mermaid := ToMermaid(g)
+		assert.True(t, strings.HasPrefix(mermaid, "flowchart LR\n"))
+		assert.Contains(t, mermaid, `n0["end-node (end)"]`)
+		assert.Contains(t, mermaid, `n1 -->|"x"| n2`)
+		assert.Contains(t, mermaid, "class n3 critical")
+		assert.Contains(t, mermaid, "linkStyle 4 stroke:#d32f2f")
+	})
+
+	t.Run("HTML", func(t *testing.T) {
+		page, err := ToHTML(g, duration)
+		assert.NoError(t, err)
+		assert.Contains(t, page, "<title>diamond</title>")
+		assert.Contains(t, page, "critical path (1h0m0s)")
+		assert.Contains(t, page, `<svg xmlns="http://www.w3.org/2000/svg"`)
+		assert.Contains(t, page, `stroke="#d32f2f" stroke-width="3"`)
+		assert.Contains(t, page, "<title>slow\nkind: task\nphase: Running\nduration: 1h0m0s</title>")
+		assert.Equal(t, 5, strings.Count(page, "<rect "))
+	})
+}
+
+// newNestedWorkflow creates the workflow start -> sub -> branch -> array -> end where sub runs a subworkflow, branch
+// took its first case and array ran two subnodes.
+func newNestedWorkflow(now time.Time) *v1alpha1.FlyteWorkflow {
+	subWorkflowID := "sub-wf"
+	thenNode, elseNode := "branch-n0", "branch-n1"
+	subPhases, err := bitarray.NewCompactArray(2, bitarray.Item(v1alpha1.NodePhaseRecovered))
+	if err != nil {
+		panic(err)
+	}
+
+	subPhases.SetItem(0, bitarray.Item(v1alpha1.NodePhaseSucceeded))
+	subPhases.SetItem(1, bitarray.Item(v1alpha1.NodePhaseFailed))
+
+	subStatus := nodeStatus(v1alpha1.NodePhaseSucceeded, now.Add(-time.Hour), 10*time.Minute)
+	subStatus.SubNodeStatus = map[v1alpha1.NodeID]*v1alpha1.NodeStatus{
+		"inner": nodeStatus(v1alpha1.NodePhaseSucceeded, now.Add(-time.Hour), 5*time.Minute),
+	}
+
+	arrayStatus := nodeStatus(v1alpha1.NodePhaseFailed, now.Add(-time.Hour), time.Minute)
+	arrayStatus.ArrayNodeStatus = &v1alpha1.ArrayNodeStatus{SubNodePhases: subPhases}
+
+	return &v1alpha1.FlyteWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "nested"},
+		WorkflowSpec: &v1alpha1.WorkflowSpec{
+			ID: "nested",
+			Nodes: map[v1alpha1.NodeID]*v1alpha1.NodeSpec{
+				common.StartNodeID: {ID: common.StartNodeID, Kind: v1alpha1.NodeKindStart},
+				"sub": {ID: "sub", Kind: v1alpha1.NodeKindWorkflow, WorkflowNode: &v1alpha1.WorkflowNodeSpec{
+					SubWorkflowReference: &subWorkflowID,
+				}},
+				"branch": {ID: "branch", Kind: v1alpha1.NodeKindBranch, BranchNode: &v1alpha1.BranchNodeSpec{
+					If:   v1alpha1.IfBlock{ThenNode: &thenNode},
+					Else: &elseNode,
+				}},
+				thenNode: {ID: thenNode, Kind: v1alpha1.NodeKindTask},
+				elseNode: {ID: elseNode, Kind: v1alpha1.NodeKindTask},
+				"array": {ID: "array", Kind: v1alpha1.NodeKindArray, ArrayNode: &v1alpha1.ArrayNodeSpec{
+					SubNodeSpec: &v1alpha1.NodeSpec{ID: "array-n0", Kind: v1alpha1.NodeKindTask},
+				}},
+				common.EndNodeID: {ID: common.EndNodeID, Kind: v1alpha1.NodeKindEnd},
+			},
+			Connections: v1alpha1.Connections{
+				Downstream: map[v1alpha1.NodeID][]v1alpha1.NodeID{
+					common.StartNodeID: {"sub"},
+					"sub":              {"branch"},
+					"branch":           {"array"},
+					"array":            {common.EndNodeID},
+				},
+			},
+		},
+		SubWorkflows: map[v1alpha1.WorkflowID]*v1alpha1.WorkflowSpec{
+			subWorkflowID: {
+				ID: subWorkflowID,
+				Nodes: map[v1alpha1.NodeID]*v1alpha1.NodeSpec{
+					"inner": {ID: "inner", Kind: v1alpha1.NodeKindTask},
+				},
+			},
+		},
+		Status: v1alpha1.WorkflowStatus{
+			NodeStatus: map[v1alpha1.NodeID]*v1alpha1.NodeStatus{
+				"sub":    subStatus,
+				"branch": nodeStatus(v1alpha1.NodePhaseSucceeded, now.Add(-time.Hour), 2*time.Minute),
+				thenNode: nodeStatus(v1alpha1.NodePhaseSucceeded, now.Add(-time.Hour), 2*time.Minute),
+				"array":  arrayStatus,
+			},
+		},
+	}
+}
+
+func TestNewGraphFromWorkflow_Subgraphs(t *testing.T) {
+	now := time.Now()
+	g := NewGraphFromWorkflow(newNestedWorkflow(now), now)
+	// The cases of the branch are only part of its subgraph.
+	assert.Len(t, g.Nodes, 5)
+	assert.Nil(t, g.GetNode("branch-n0"))
+
+	sub := g.GetNode("sub").Subgraph
+	if assert.NotNil(t, sub) {
+		assert.Equal(t, "sub-wf", sub.Name)
+		assert.Equal(t, v1alpha1.NodePhaseSucceeded, sub.GetNode("inner").Phase)
+		assert.Equal(t, 5*time.Minute, sub.GetNode("inner").Duration)
+	}
+
+	branch := g.GetNode("branch").Subgraph
+	if assert.NotNil(t, branch) {
+		assert.Len(t, branch.Nodes, 2)
+		assert.Equal(t, v1alpha1.NodePhaseSucceeded, branch.GetNode("branch-n0").Phase)
+		assert.Equal(t, v1alpha1.NodePhaseNotYetStarted, branch.GetNode("branch-n1").Phase)
+	}
+
+	array := g.GetNode("array").Subgraph
+	if assert.NotNil(t, array) {
+		assert.Len(t, array.Nodes, 2)
+		assert.Equal(t, v1alpha1.NodePhaseSucceeded, array.GetNode("array-n0[0]").Phase)
+		assert.Equal(t, v1alpha1.NodePhaseFailed, array.GetNode("array-n0[1]").Phase)
+	}
+
+	_, err := MarkCriticalPath(g)
+	assert.NoError(t, err)
+	assert.True(t, sub.GetNode("inner").Critical)
+	assert.True(t, branch.GetNode("branch-n0").Critical)
+	assert.False(t, branch.GetNode("branch-n1").Critical)
+
+	t.Run("Dot", func(t *testing.T) {
+		dot := ToDot(g)
+		assert.Contains(t, dot, "\tsubgraph \"cluster_sub\" {\n\t\tlabel=\"workflow: sub-wf\";\n")
+		assert.Contains(t, dot, `"sub/inner" [label="inner (task) 5m0s"`)
+		assert.Contains(t, dot, `"sub" -> "sub/inner" [style=dotted,arrowhead=none];`)
+		assert.Contains(t, dot, `"array" -> "array/array-n0[1]" [style=dotted,arrowhead=none];`)
+	})
+
+	t.Run("Mermaid", func(t *testing.T) {
+		mermaid := ToMermaid(g)
+		assert.Contains(t, mermaid, "\tsubgraph s0 [\"array: array\"]\n")
+		assert.Contains(t, mermaid, "\tn4 -.- s2\n")
+		assert.Equal(t, 3, strings.Count(mermaid, "\tend\n"))
+	})
+
+	t.Run("SVG", func(t *testing.T) {
+		svg, err := ToSVG(g)
+		assert.NoError(t, err)
+		assert.Contains(t, svg, ">sub (workflow: sub-wf)</text>")
+		assert.Contains(t, svg, ">branch (branch: branch)</text>")
+		assert.Equal(t, 10, strings.Count(svg, "<rect "))
+	})
+}
@@ -0,0 +1,219 @@
+package visualize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+)
+
+const criticalColor = "#d32f2f"
+
+// phaseColor returns the fill color of nodes in the phase.
+func phaseColor(p v1alpha1.NodePhase) string {
+	switch p {
+	case v1alpha1.NodePhaseQueued:
+		return "#bbdefb"
+	case v1alpha1.NodePhaseRunning, v1alpha1.NodePhaseDynamicRunning, v1alpha1.NodePhaseSucceeding:
+		return "#fff59d"
+	case v1alpha1.NodePhaseSucceeded, v1alpha1.NodePhaseRecovered:
+		return "#a5d6a7"
+	case v1alpha1.NodePhaseFailing, v1alpha1.NodePhaseFailed, v1alpha1.NodePhaseTimingOut, v1alpha1.NodePhaseTimedOut:
+		return "#ef9a9a"
+	case v1alpha1.NodePhaseRetryableFailure:
+		return "#ffcc80"
+	case v1alpha1.NodePhaseSkipped:
+		return "#cfd8dc"
+	}
+
+	return "#f5f5f5"
+}
+
+// legendPhases are the phases shown in legends, one per color.
+var legendPhases = []v1alpha1.NodePhase{
+	v1alpha1.NodePhaseNotYetStarted,
+	v1alpha1.NodePhaseQueued,
+	v1alpha1.NodePhaseRunning,
+	v1alpha1.NodePhaseSucceeded,
+	v1alpha1.NodePhaseRetryableFailure,
+	v1alpha1.NodePhaseFailed,
+	v1alpha1.NodePhaseSkipped,
+}
+
+func formatDuration(d time.Duration) string {
+	if d >= time.Minute {
+		return d.Round(time.Second).String()
+	}
+
+	return d.Round(time.Millisecond).String()
+}
+
+func nodeLabel(n *Node) string {
+	label := fmt.Sprintf("%v (%v)", n.ID, n.Kind)
+	if n.Duration > 0 {
+		label += " " + formatDuration(n.Duration)
+	}
+
+	return label
+}
+
+func nodeTooltip(n *Node) string {
+	tooltip := fmt.Sprintf("%v\nkind: %v\nphase: %v", n.ID, n.Kind, n.Phase)
+	if n.Duration > 0 {
+		tooltip += "\nduration: " + formatDuration(n.Duration)
+	}
+
+	if len(n.Message) > 0 {
+		tooltip += "\n" + n.Message
+	}
+
+	return tooltip
+}
+
+// roots returns the nodes of the graph without upstream nodes, which are linked to the node running the graph.
+func roots(g *Graph) []*Node {
+	downstream := sets.NewString()
+	for _, e := range g.Edges {
+		downstream.Insert(e.To)
+	}
+
+	var res []*Node
+	for _, n := range g.Nodes {
+		if !downstream.Has(n.ID) {
+			res = append(res, n)
+		}
+	}
+
+	return res
+}
+
+// subgraphLabel returns the label of the subgraph of the node.
+func subgraphLabel(n *Node) string {
+	return fmt.Sprintf("%v: %v", n.Kind, n.Subgraph.Name)
+}
+
+// writeDot writes the nodes and edges of the graph and the clusters of its subgraphs. Ids of nodes of subgraphs are
+// prefixed with the ids of the nodes running them to keep them unique.
+func writeDot(sb *strings.Builder, g *Graph, prefix, indent string) {
+	for _, n := range g.Nodes {
+		fmt.Fprintf(sb, "%v%v [label=%v,fillcolor=%v,tooltip=%v", indent, strconv.Quote(prefix+n.ID), strconv.Quote(nodeLabel(n)),
+			strconv.Quote(phaseColor(n.Phase)), strconv.Quote(nodeTooltip(n)))
+		if n.Critical {
+			fmt.Fprintf(sb, ",color=%v,penwidth=3", strconv.Quote(criticalColor))
+		}
+
+		sb.WriteString("];\n")
+	}
+
+	for _, e := range g.Edges {
+		style := styleSolid
+		if e.Label == executionEdgeLabel {
+			style = styleDashed
+		}
+
+		fmt.Fprintf(sb, "%v%v -> %v [label=%v,style=%v", indent, strconv.Quote(prefix+e.From), strconv.Quote(prefix+e.To),
+			strconv.Quote(e.Label), style)
+		if e.Critical {
+			fmt.Fprintf(sb, ",color=%v,penwidth=3", strconv.Quote(criticalColor))
+		}
+
+		sb.WriteString("];\n")
+	}
+
+	for _, n := range g.Nodes {
+		if n.Subgraph == nil {
+			continue
+		}
+
+		subPrefix := prefix + n.ID + "/"
+		fmt.Fprintf(sb, "%vsubgraph %v {\n%v\tlabel=%v;\n%v\tstyle=dashed;\n", indent, strconv.Quote("cluster_"+prefix+n.ID),
+			indent, strconv.Quote(subgraphLabel(n)), indent)
+		writeDot(sb, n.Subgraph, subPrefix, indent+"\t")
+		fmt.Fprintf(sb, "%v}\n", indent)
+		for _, root := range roots(n.Subgraph) {
+			fmt.Fprintf(sb, "%v%v -> %v [style=dotted,arrowhead=none];\n", indent, strconv.Quote(prefix+n.ID), strconv.Quote(subPrefix+root.ID))
+		}
+	}
+}
+
+// ToDot returns the GraphViz https://www.graphviz.org/ representation of the graph, with nodes colored by phase and the
+// critical path highlighted. Subgraphs are drawn as clusters linked to the nodes running them.
+func ToDot(g *Graph) string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "digraph G {\n\trankdir=LR;\n\tlabel=%v;\n\tnode[shape=box,style=\"rounded,filled\"];\n", strconv.Quote(g.Name))
+	writeDot(sb, g, "", "\t")
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// mermaidText escapes text for use in quoted mermaid labels.
+func mermaidText(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", "<br>").Replace(s)
+}
+
+// mermaidWriter numbers the nodes, subgraphs and links of a mermaid flowchart as they are written, since node ids
+// aren't necessarily valid mermaid ids and links are styled by their index.
+type mermaidWriter struct {
+	sb        *strings.Builder
+	nodes     int
+	subgraphs int
+	links     int
+}
+
+func (m *mermaidWriter) write(g *Graph, indent string) {
+	ids := make(map[v1alpha1.NodeID]string, len(g.Nodes))
+	for _, n := range g.Nodes {
+		ids[n.ID] = fmt.Sprintf("n%d", m.nodes)
+		m.nodes++
+	}
+
+	for _, n := range g.Nodes {
+		fmt.Fprintf(m.sb, "%v%v[\"%v\"]\n", indent, ids[n.ID], mermaidText(nodeLabel(n)))
+		fmt.Fprintf(m.sb, "%vstyle %v fill:%v\n", indent, ids[n.ID], phaseColor(n.Phase))
+		if n.Critical {
+			fmt.Fprintf(m.sb, "%vclass %v critical\n", indent, ids[n.ID])
+		}
+	}
+
+	for _, e := range g.Edges {
+		arrow := "-->"
+		if e.Label == executionEdgeLabel {
+			arrow = "-.->"
+		}
+
+		fmt.Fprintf(m.sb, "%v%v %v|\"%v\"| %v\n", indent, ids[e.From], arrow, mermaidText(e.Label), ids[e.To])
+		if e.Critical {
+			fmt.Fprintf(m.sb, "%vlinkStyle %d stroke:%v,stroke-width:3px\n", indent, m.links, criticalColor)
+		}
+
+		m.links++
+	}
+
+	for _, n := range g.Nodes {
+		if n.Subgraph == nil {
+			continue
+		}
+
+		id := fmt.Sprintf("s%d", m.subgraphs)
+		m.subgraphs++
+		fmt.Fprintf(m.sb, "%vsubgraph %v [\"%v\"]\n", indent, id, mermaidText(subgraphLabel(n)))
+		m.write(n.Subgraph, indent+"\t")
+		fmt.Fprintf(m.sb, "%vend\n", indent)
+		fmt.Fprintf(m.sb, "%v%v -.- %v\n", indent, ids[n.ID], id)
+		m.links++
+	}
+}
+
+// ToMermaid returns the Mermaid https://mermaid.js.org/ flowchart of the graph, with nodes colored by phase and the
+// critical path highlighted. Subgraphs are drawn as mermaid subgraphs linked to the nodes running them.
+func ToMermaid(g *Graph) string {
+	sb := &strings.Builder{}
+	sb.WriteString("flowchart LR\n")
+	fmt.Fprintf(sb, "\tclassDef critical stroke:%v,stroke-width:3px\n", criticalColor)
+	(&mermaidWriter{sb: sb}).write(g, "\t")
+	return sb.String()
+}
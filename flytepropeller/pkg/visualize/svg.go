@@ -0,0 +1,291 @@
+package visualize
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"html/template"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+)
+
+const (
+	svgNodeWidth  = 200
+	svgNodeHeight = 48
+	svgGapX       = 80
+	svgGapY       = 24
+	svgMargin     = 20
+	// The height of the titles of the panels of subgraphs.
+	svgTitleHeight = 28
+	// The longest node id that fits the node box, longer ids are shortened and shown in full in the tooltip.
+	svgMaxIDLength = 26
+)
+
+type point struct {
+	x, y int
+}
+
+// layout places the nodes left to right by the length of the longest path reaching them. Nodes of a layer are ordered
+// by the average position of their upstream nodes to reduce edge crossings.
+func layout(g *Graph) (positions map[v1alpha1.NodeID]point, width, height int, err error) {
+	sorted, err := layers(g)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	upstream := make(map[v1alpha1.NodeID][]v1alpha1.NodeID, len(g.Edges))
+	for _, e := range g.Edges {
+		upstream[e.To] = append(upstream[e.To], e.From)
+	}
+
+	row := make(map[v1alpha1.NodeID]float64, len(g.Nodes))
+	positions = make(map[v1alpha1.NodeID]point, len(g.Nodes))
+	rows := 0
+	for col, layer := range sorted {
+		weight := make(map[v1alpha1.NodeID]float64, len(layer))
+		for _, n := range layer {
+			for _, u := range upstream[n.ID] {
+				weight[n.ID] += row[u] / float64(len(upstream[n.ID]))
+			}
+		}
+
+		sort.SliceStable(layer, func(i, j int) bool {
+			return weight[layer[i].ID] < weight[layer[j].ID]
+		})
+
+		for i, n := range layer {
+			row[n.ID] = float64(i)
+			positions[n.ID] = point{
+				x: svgMargin + col*(svgNodeWidth+svgGapX),
+				y: svgMargin + i*(svgNodeHeight+svgGapY),
+			}
+		}
+
+		rows = max(rows, len(layer))
+	}
+
+	width = 2*svgMargin + len(sorted)*(svgNodeWidth+svgGapX) - svgGapX
+	height = 2*svgMargin + rows*(svgNodeHeight+svgGapY) - svgGapY
+	return positions, width, height, nil
+}
+
+func shortenID(id v1alpha1.NodeID) string {
+	if len(id) <= svgMaxIDLength {
+		return id
+	}
+
+	return id[:svgMaxIDLength-3] + "..."
+}
+
+// svgPanel is a graph drawn in its own area of the SVG image, below the panel of the graph running it.
+type svgPanel struct {
+	title     string
+	graph     *Graph
+	positions map[v1alpha1.NodeID]point
+	y         int
+}
+
+// svgPanels returns the panels of the graph and of all its subgraphs, depth first. Panels of subgraphs are titled with
+// the path of the node running them, prefix being the path of the node running g.
+func svgPanels(g *Graph, prefix, title string) []*svgPanel {
+	panels := []*svgPanel{{title: title, graph: g}}
+	for _, n := range g.Nodes {
+		if n.Subgraph != nil {
+			panels = append(panels, svgPanels(n.Subgraph, prefix+n.ID+"/", fmt.Sprintf("%v (%v)", prefix+n.ID, subgraphLabel(n)))...)
+		}
+	}
+
+	return panels
+}
+
+func writeSVGGraph(sb *strings.Builder, panel *svgPanel) {
+	for _, e := range panel.graph.Edges {
+		from, okFrom := panel.positions[e.From]
+		to, okTo := panel.positions[e.To]
+		if !okFrom || !okTo {
+			continue
+		}
+
+		x1, y1 := from.x+svgNodeWidth, panel.y+from.y+svgNodeHeight/2
+		x2, y2 := to.x, panel.y+to.y+svgNodeHeight/2
+		color, strokeWidth, marker := "#616161", 1, "arrow"
+		if e.Critical {
+			color, strokeWidth, marker = criticalColor, 3, "arrow-critical"
+		}
+
+		dash := ""
+		if e.Label == executionEdgeLabel {
+			dash = ` stroke-dasharray="4 3"`
+		}
+
+		fmt.Fprintf(sb, `<path d="M%d,%d C%d,%d %d,%d %d,%d" fill="none" stroke="%s" stroke-width="%d"%s marker-end="url(#%s)"><title>%s</title></path>`,
+			x1, y1, x1+svgGapX/2, y1, x2-svgGapX/2, y2, x2, y2, color, strokeWidth, dash, marker,
+			html.EscapeString(fmt.Sprintf("%v -> %v: %v", e.From, e.To, e.Label)))
+	}
+
+	for _, n := range panel.graph.Nodes {
+		p, ok := panel.positions[n.ID]
+		if !ok {
+			continue
+		}
+
+		stroke, strokeWidth := "#616161", 1
+		if n.Critical {
+			stroke, strokeWidth = criticalColor, 3
+		}
+
+		details := string(n.Kind)
+		if n.Duration > 0 {
+			details += " · " + formatDuration(n.Duration)
+		}
+
+		fmt.Fprintf(sb, `<g><title>%s</title><rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="%s" stroke="%s" stroke-width="%d"/>`,
+			html.EscapeString(nodeTooltip(n)), p.x, panel.y+p.y, svgNodeWidth, svgNodeHeight, phaseColor(n.Phase), stroke, strokeWidth)
+		fmt.Fprintf(sb, `<text x="%d" y="%d" font-weight="bold">%s</text><text x="%d" y="%d" fill="#424242">%s</text></g>`,
+			p.x+8, panel.y+p.y+20, html.EscapeString(shortenID(n.ID)), p.x+8, panel.y+p.y+38, html.EscapeString(details))
+	}
+}
+
+// ToSVG returns a self-contained SVG image of the graph, with nodes colored by phase and the critical path highlighted.
+// Subgraphs are drawn in titled panels below the graph. Hovering a node shows its phase, duration and message.
+func ToSVG(g *Graph) (string, error) {
+	panels := svgPanels(g, "", "")
+	width, height := 0, 0
+	for _, panel := range panels {
+		positions, panelWidth, panelHeight, err := layout(panel.graph)
+		if err != nil {
+			return "", err
+		}
+
+		if panel.title != "" {
+			height += svgTitleHeight
+		}
+
+		panel.positions, panel.y = positions, height
+		width = max(width, panelWidth)
+		height += panelHeight
+	}
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`,
+		width, height, width, height)
+	sb.WriteString(`<defs>`)
+	for _, marker := range []struct{ id, color string }{{"arrow", "#616161"}, {"arrow-critical", criticalColor}} {
+		fmt.Fprintf(sb, `<marker id="%s" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M0,0 L10,5 L0,10 z" fill="%s"/></marker>`,
+			marker.id, marker.color)
+	}
+	sb.WriteString(`</defs>`)
+
+	for _, panel := range panels {
+		if panel.title != "" {
+			fmt.Fprintf(sb, `<line x1="0" y1="%d" x2="%d" y2="%d" stroke="#e0e0e0"/><text x="%d" y="%d" font-weight="bold">%s</text>`,
+				panel.y-svgTitleHeight, width, panel.y-svgTitleHeight, svgMargin, panel.y-svgTitleHeight+svgMargin,
+				html.EscapeString(panel.title))
+		}
+
+		writeSVGGraph(sb, panel)
+	}
+
+	sb.WriteString(`</svg>`)
+	return sb.String(), nil
+}
+
+type legendEntry struct {
+	Phase string
+	Color string
+}
+
+type htmlPage struct {
+	Name                 string
+	SVG                  template.HTML
+	Legend               []legendEntry
+	CriticalColor        string
+	CriticalPathDuration string
+}
+
+var htmlTemplate = template.Must(template.New("workflow").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Name }}</title>
+<style>
+body { margin: 0; font-family: sans-serif; }
+header { padding: 8px 16px; border-bottom: 1px solid #e0e0e0; }
+.legend span { display: inline-block; margin-right: 12px; }
+.legend i { display: inline-block; width: 12px; height: 12px; margin-right: 4px; border: 1px solid #616161; vertical-align: middle; }
+#graph { width: 100vw; height: calc(100vh - 80px); overflow: hidden; cursor: grab; }
+#graph svg { width: 100%; height: 100%; }
+</style>
+</head>
+<body>
+<header>
+<h3>{{ .Name }}</h3>
+<div class="legend">
+{{- range .Legend }}<span><i style="background: {{ .Color }}"></i>{{ .Phase }}</span>{{ end -}}
+<span><i style="border: 3px solid {{ .CriticalColor }}"></i>critical path{{ if .CriticalPathDuration }} ({{ .CriticalPathDuration }}){{ end }}</span>
+</div>
+</header>
+<div id="graph">{{ .SVG }}</div>
+<script>
+(function () {
+  var svg = document.querySelector("#graph svg");
+  var box = svg.viewBox.baseVal;
+  var drag = null;
+  svg.addEventListener("wheel", function (e) {
+    e.preventDefault();
+    var scale = e.deltaY > 0 ? 1.1 : 1 / 1.1;
+    var rect = svg.getBoundingClientRect();
+    var x = box.x + (e.clientX - rect.left) / rect.width * box.width;
+    var y = box.y + (e.clientY - rect.top) / rect.height * box.height;
+    box.x = x - (x - box.x) * scale;
+    box.y = y - (y - box.y) * scale;
+    box.width *= scale;
+    box.height *= scale;
+  });
+  svg.addEventListener("mousedown", function (e) { drag = {x: e.clientX, y: e.clientY}; });
+  window.addEventListener("mouseup", function () { drag = null; });
+  window.addEventListener("mousemove", function (e) {
+    if (!drag) { return; }
+    var rect = svg.getBoundingClientRect();
+    box.x -= (e.clientX - drag.x) / rect.width * box.width;
+    box.y -= (e.clientY - drag.y) / rect.height * box.height;
+    drag = {x: e.clientX, y: e.clientY};
+  });
+})();
+</script>
+</body>
+</html>
+`))
+
+// ToHTML returns a self-contained HTML page showing the SVG image of the graph, which can be zoomed with the mouse
+// wheel and panned by dragging.
+func ToHTML(g *Graph, criticalPathDuration time.Duration) (string, error) {
+	svg, err := ToSVG(g)
+	if err != nil {
+		return "", err
+	}
+
+	page := htmlPage{
+		Name:          g.Name,
+		SVG:           template.HTML(svg), // #nosec G203 all values in the SVG are escaped
+		CriticalColor: criticalColor,
+	}
+
+	if criticalPathDuration > 0 {
+		page.CriticalPathDuration = formatDuration(criticalPathDuration)
+	}
+
+	for _, p := range legendPhases {
+		page.Legend = append(page.Legend, legendEntry{Phase: p.String(), Color: phaseColor(p)})
+	}
+
+	buf := &bytes.Buffer{}
+	if err := htmlTemplate.Execute(buf, page); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
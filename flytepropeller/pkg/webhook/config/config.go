@@ -53,6 +53,23 @@ var (
 			Role:      "flyte",
 			KVVersion: KVVersion2,
 		},
+		CSISecretManagerConfig: CSISecretManagerConfig{
+			Driver:                      "secrets-store.csi.k8s.io",
+			SecretProviderClassTemplate: "{{ .Group }}",
+		},
+		ExternalSecretManagerConfig: ExternalSecretManagerConfig{
+			SidecarImage: "docker.io/library/busybox:1.36",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("100Mi"),
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("100Mi"),
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+				},
+			},
+		},
 	}
 
 	configSection = config.MustRegisterSection("webhook", DefaultConfig)
@@ -79,6 +96,15 @@ const (
 
 	// SecretManagerTypeVault defines a secret manager webhook that pulls secrets from Hashicorp Vault.
 	SecretManagerTypeVault
+
+	// SecretManagerTypeCSI defines a secret manager webhook that mounts secrets through the Secrets Store CSI driver
+	// using a SecretProviderClass per secret group.
+	SecretManagerTypeCSI
+
+	// SecretManagerTypeExternal defines a secret manager webhook that injects an init container running a configurable
+	// command or HTTP request to pull secrets from an arbitrary provider and share them with the other containers in the
+	// pod through an in-memory volume.
+	SecretManagerTypeExternal
 )
 
 // Defines with KV Engine Version to use with VaultSecretManager - https://www.vaultproject.io/docs/secrets/kv#kv-secrets-engine
@@ -92,17 +118,20 @@ const (
 )
 
 type Config struct {
-	MetricsPrefix            string                   `json:"metrics-prefix" pflag:",An optional prefix for all published metrics."`
-	CertDir                  string                   `json:"certDir" pflag:",Certificate directory to use to write generated certs. Defaults to /etc/webhook/certs/"`
-	LocalCert                bool                     `json:"localCert" pflag:",write certs locally. Defaults to false"`
-	ListenPort               int                      `json:"listenPort" pflag:",The port to use to listen to webhook calls. Defaults to 9443"`
-	ServiceName              string                   `json:"serviceName" pflag:",The name of the webhook service."`
-	ServicePort              int32                    `json:"servicePort" pflag:",The port on the service that hosting webhook."`
-	SecretName               string                   `json:"secretName" pflag:",Secret name to write generated certs to."`
-	SecretManagerType        SecretManagerType        `json:"secretManagerType" pflag:"-,Secret manager type to use if secrets are not found in global secrets."`
-	AWSSecretManagerConfig   AWSSecretManagerConfig   `json:"awsSecretManager" pflag:",AWS Secret Manager config."`
-	GCPSecretManagerConfig   GCPSecretManagerConfig   `json:"gcpSecretManager" pflag:",GCP Secret Manager config."`
-	VaultSecretManagerConfig VaultSecretManagerConfig `json:"vaultSecretManager" pflag:",Vault Secret Manager config."`
+	MetricsPrefix               string                      `json:"metrics-prefix" pflag:",An optional prefix for all published metrics."`
+	CertDir                     string                      `json:"certDir" pflag:",Certificate directory to use to write generated certs. Defaults to /etc/webhook/certs/"`
+	LocalCert                   bool                        `json:"localCert" pflag:",write certs locally. Defaults to false"`
+	ListenPort                  int                         `json:"listenPort" pflag:",The port to use to listen to webhook calls. Defaults to 9443"`
+	ServiceName                 string                      `json:"serviceName" pflag:",The name of the webhook service."`
+	ServicePort                 int32                       `json:"servicePort" pflag:",The port on the service that hosting webhook."`
+	SecretName                  string                      `json:"secretName" pflag:",Secret name to write generated certs to."`
+	SecretManagerType           SecretManagerType           `json:"secretManagerType" pflag:"-,Secret manager type to use if secrets are not found in global secrets."`
+	AWSSecretManagerConfig      AWSSecretManagerConfig      `json:"awsSecretManager" pflag:",AWS Secret Manager config."`
+	GCPSecretManagerConfig      GCPSecretManagerConfig      `json:"gcpSecretManager" pflag:",GCP Secret Manager config."`
+	VaultSecretManagerConfig    VaultSecretManagerConfig    `json:"vaultSecretManager" pflag:",Vault Secret Manager config."`
+	CSISecretManagerConfig      CSISecretManagerConfig      `json:"csiSecretManager" pflag:",Secrets Store CSI driver config."`
+	ExternalSecretManagerConfig ExternalSecretManagerConfig `json:"externalSecretManager" pflag:",External Secret Manager config."`
+	SecretAccess                SecretAccessConfig          `json:"secretAccess" pflag:",Controls which secrets the tasks of a project and domain may request."`
 }
 
 func (c Config) ExpandCertDir() string {
@@ -125,6 +154,46 @@ type VaultSecretManagerConfig struct {
 	Annotations map[string]string `json:"annotations" pflag:"-,Annotation to be added to user task pod. The annotation can also be used to override default annotations added by Flyte. Useful to customize Vault integration (https://developer.hashicorp.com/vault/docs/platform/k8s/injector/annotations)"`
 }
 
+type CSISecretManagerConfig struct {
+	Driver string `json:"driver" pflag:",Name of the CSI driver mounting the secrets."`
+	// SecretProviderClassTemplate is a text/template rendering the name of the SecretProviderClass that backs a secret
+	// group. It has access to the Project, Domain, Group, GroupVersion and Key of the requested secret.
+	SecretProviderClassTemplate string `json:"secretProviderClassTemplate" pflag:",Template of the name of the SecretProviderClass to mount for a secret group."`
+	NodePublishSecretRef        string `json:"nodePublishSecretRef" pflag:",Optional name of the K8s secret holding the credentials the CSI driver uses to access the provider."`
+}
+
+// ExternalSecretManagerConfig configures an init container pulling each secret from an arbitrary provider. Either
+// Command or URLTemplate must be set. All templates are text/templates with access to the Project, Domain, Group,
+// GroupVersion and Key of the requested secret as well as the Path the secret must be written to.
+type ExternalSecretManagerConfig struct {
+	SidecarImage string                      `json:"sidecarImage" pflag:",Specifies the sidecar docker image to use"`
+	Resources    corev1.ResourceRequirements `json:"resources" pflag:"-,Specifies resource requirements for the init container."`
+	Command      []string                    `json:"command" pflag:",Templated command writing the secret to {{ .Path }}."`
+	URLTemplate  string                      `json:"urlTemplate" pflag:",Templated URL to download the secret from if no command is set."`
+	// Headers are sent when downloading the secret from the URL. Their values are read from K8s secrets in the namespace
+	// of the pod, so credentials never appear in the pod spec.
+	Headers map[string]corev1.SecretKeySelector `json:"headers" pflag:"-,HTTP headers sent when downloading the secret from the URL, read from K8s secrets in the namespace of the pod."`
+}
+
+// SecretAccessConfig restricts the secrets pods may request based on their project and domain labels. A secret is
+// allowed if it matches one of the rules matching the project and domain of the pod. If no rule matches the project and
+// domain, the secret is allowed unless DefaultDeny is set. The secret groups a project may request are allowlisted with a
+// rule matching the project only.
+type SecretAccessConfig struct {
+	DefaultDeny bool               `json:"defaultDeny" pflag:",Rejects all secrets requested by projects and domains no rule matches."`
+	Rules       []SecretAccessRule `json:"rules" pflag:"-,Rules mapping projects and domains to the secrets they may request."`
+}
+
+type SecretAccessRule struct {
+	// Project is a glob pattern of the projects the rule applies to. Empty matches all projects.
+	Project string `json:"project"`
+	// Domain is a glob pattern of the domains the rule applies to. Empty matches all domains.
+	Domain string `json:"domain"`
+	// Secrets are glob patterns of the allowed secrets, either <group> to allow all keys of matching groups or
+	// <group>/<key> to allow matching keys only.
+	Secrets []string `json:"secrets"`
+}
+
 func GetConfig() *Config {
 	return configSection.GetConfig().(*Config)
 }
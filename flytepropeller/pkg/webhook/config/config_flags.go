@@ -60,5 +60,12 @@ func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "awsSecretManager.sidecarImage"), DefaultConfig.AWSSecretManagerConfig.SidecarImage, "Specifies the sidecar docker image to use")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "gcpSecretManager.sidecarImage"), DefaultConfig.GCPSecretManagerConfig.SidecarImage, "Specifies the sidecar docker image to use")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "vaultSecretManager.role"), DefaultConfig.VaultSecretManagerConfig.Role, "Specifies the vault role to use")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "csiSecretManager.driver"), DefaultConfig.CSISecretManagerConfig.Driver, "Name of the CSI driver mounting the secrets.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "csiSecretManager.secretProviderClassTemplate"), DefaultConfig.CSISecretManagerConfig.SecretProviderClassTemplate, "Template of the name of the SecretProviderClass to mount for a secret group.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "csiSecretManager.nodePublishSecretRef"), DefaultConfig.CSISecretManagerConfig.NodePublishSecretRef, "Optional name of the K8s secret holding the credentials the CSI driver uses to access the provider.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "externalSecretManager.sidecarImage"), DefaultConfig.ExternalSecretManagerConfig.SidecarImage, "Specifies the sidecar docker image to use")
+	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "externalSecretManager.command"), DefaultConfig.ExternalSecretManagerConfig.Command, "Templated command writing the secret to {{ .Path }}.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "externalSecretManager.urlTemplate"), DefaultConfig.ExternalSecretManagerConfig.URLTemplate, "Templated URL to download the secret from if no command is set.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "secretAccess.defaultDeny"), DefaultConfig.SecretAccess.DefaultDeny, "Rejects all secrets requested by projects and domains no rule matches.")
 	return cmdFlags
 }
@@ -239,4 +239,102 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_csiSecretManager.driver", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("csiSecretManager.driver", testValue)
+			if vString, err := cmdFlags.GetString("csiSecretManager.driver"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.CSISecretManagerConfig.Driver)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_csiSecretManager.secretProviderClassTemplate", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("csiSecretManager.secretProviderClassTemplate", testValue)
+			if vString, err := cmdFlags.GetString("csiSecretManager.secretProviderClassTemplate"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.CSISecretManagerConfig.SecretProviderClassTemplate)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_csiSecretManager.nodePublishSecretRef", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("csiSecretManager.nodePublishSecretRef", testValue)
+			if vString, err := cmdFlags.GetString("csiSecretManager.nodePublishSecretRef"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.CSISecretManagerConfig.NodePublishSecretRef)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_externalSecretManager.sidecarImage", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("externalSecretManager.sidecarImage", testValue)
+			if vString, err := cmdFlags.GetString("externalSecretManager.sidecarImage"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.ExternalSecretManagerConfig.SidecarImage)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_externalSecretManager.command", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := join_Config(DefaultConfig.ExternalSecretManagerConfig.Command, ",")
+
+			cmdFlags.Set("externalSecretManager.command", testValue)
+			if vStringSlice, err := cmdFlags.GetStringSlice("externalSecretManager.command"); err == nil {
+				testDecodeRaw_Config(t, join_Config(vStringSlice, ","), &actual.ExternalSecretManagerConfig.Command)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_externalSecretManager.urlTemplate", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("externalSecretManager.urlTemplate", testValue)
+			if vString, err := cmdFlags.GetString("externalSecretManager.urlTemplate"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.ExternalSecretManagerConfig.URLTemplate)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_secretAccess.defaultDeny", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("secretAccess.defaultDeny", testValue)
+			if vBool, err := cmdFlags.GetBool("secretAccess.defaultDeny"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.SecretAccess.DefaultDeny)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
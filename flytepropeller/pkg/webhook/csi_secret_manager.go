@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/encoding"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/config"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+const (
+	// CSISecretsVolumePrefix prefixes the names of the CSI volumes mounting the secret groups.
+	CSISecretsVolumePrefix = "csi-secret-" // #nosec
+	// CSISecretProviderClassAttribute is the volume attribute the Secrets Store CSI driver reads the
+	// SecretProviderClass to mount from.
+	CSISecretProviderClassAttribute = "secretProviderClass" // #nosec
+)
+
+var (
+	// CSISecretMountPath defines the default mount path for secrets
+	CSISecretMountPath = filepath.Join(K8sSecretPathPrefix...)
+)
+
+// CSISecretManagerInjector allows injecting of secrets through the Secrets Store CSI driver
+// (https://secrets-store-csi-driver.sigs.k8s.io). Each secret group is backed by a SecretProviderClass, whose name is
+// rendered from the configured template, and mounted as a read-only CSI volume. The SecretProviderClass decides which
+// objects are fetched from the provider and the file names they are mounted with, which should match the secret keys.
+// Files will be mounted on /etc/flyte/secrets/<SecretGroup>/<SecretKey>
+type CSISecretManagerInjector struct {
+	cfg config.CSISecretManagerConfig
+}
+
+func (i CSISecretManagerInjector) Type() config.SecretManagerType {
+	return config.SecretManagerTypeCSI
+}
+
+func (i CSISecretManagerInjector) Inject(ctx context.Context, secret *core.Secret, p *corev1.Pod) (newP *corev1.Pod, injected bool, err error) {
+	if len(secret.GetGroup()) == 0 {
+		return nil, false, fmt.Errorf("CSI Secrets Webhook requires the group to be set. Secret: [%v]", secret)
+	}
+
+	if err := validateSecretPathNames(secret); err != nil {
+		return nil, false, err
+	}
+
+	switch secret.GetMountRequirement() {
+	case core.Secret_ANY:
+		fallthrough
+	case core.Secret_FILE:
+		secretProviderClass, err := renderSecretTemplate("secretProviderClass", i.cfg.SecretProviderClassTemplate,
+			newSecretTemplateData(secret, p, ""))
+		if err != nil {
+			return p, false, err
+		}
+
+		vol := createCSIVolume(i.cfg, secret, secretProviderClass)
+		p.Spec.Volumes = appendVolumeIfNotExists(p.Spec.Volumes, vol)
+
+		secretVolumeMount := corev1.VolumeMount{
+			Name:      vol.Name,
+			ReadOnly:  true,
+			MountPath: filepath.Join(CSISecretMountPath, strings.ToLower(secret.GetGroup())),
+		}
+
+		p.Spec.Containers = AppendVolumeMounts(p.Spec.Containers, secretVolumeMount)
+		p.Spec.InitContainers = AppendVolumeMounts(p.Spec.InitContainers, secretVolumeMount)
+
+		envVars := []corev1.EnvVar{
+			// Set environment variable to let the container know where to find the mounted files.
+			{
+				Name:  SecretPathDefaultDirEnvVar,
+				Value: CSISecretMountPath,
+			},
+			// Sets an empty prefix to let the containers know the file names will match the secret keys as-is.
+			{
+				Name:  SecretPathFilePrefixEnvVar,
+				Value: "",
+			},
+		}
+
+		for _, envVar := range envVars {
+			p.Spec.InitContainers = AppendEnvVars(p.Spec.InitContainers, envVar)
+			p.Spec.Containers = AppendEnvVars(p.Spec.Containers, envVar)
+		}
+	case core.Secret_ENV_VAR:
+		fallthrough
+	default:
+		err := fmt.Errorf("unrecognized mount requirement [%v] for secret [%v]", secret.GetMountRequirement().String(), secret.GetKey())
+		logger.Error(ctx, err)
+		return p, false, err
+	}
+
+	return p, true, nil
+}
+
+func createCSIVolume(cfg config.CSISecretManagerConfig, secret *core.Secret, secretProviderClass string) corev1.Volume {
+	readOnly := true
+	csi := &corev1.CSIVolumeSource{
+		Driver:   cfg.Driver,
+		ReadOnly: &readOnly,
+		VolumeAttributes: map[string]string{
+			CSISecretProviderClassAttribute: secretProviderClass,
+		},
+	}
+
+	if len(cfg.NodePublishSecretRef) > 0 {
+		csi.NodePublishSecretRef = &corev1.LocalObjectReference{Name: cfg.NodePublishSecretRef}
+	}
+
+	return corev1.Volume{
+		// A volume per secret group so that multiple keys of the same group share the mount.
+		Name:         CSISecretsVolumePrefix + encoding.Base32Encoder.EncodeToString([]byte(secret.GetGroup())),
+		VolumeSource: corev1.VolumeSource{CSI: csi},
+	}
+}
+
+// NewCSISecretManagerInjector creates a SecretInjector that's able to mount secrets through the Secrets Store CSI driver.
+func NewCSISecretManagerInjector(cfg config.CSISecretManagerConfig) CSISecretManagerInjector {
+	return CSISecretManagerInjector{
+		cfg: cfg,
+	}
+}
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/config"
+)
+
+func TestCSISecretManagerInjector_Inject(t *testing.T) {
+	readOnly := true
+	newPod := func() *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{
+				Labels: map[string]string{"project": "flytesnacks", "domain": "development"},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "main"}},
+			},
+		}
+	}
+
+	expectedMount := corev1.VolumeMount{
+		Name:      "csi-secret-krsxg4ctmvrxezlu",
+		ReadOnly:  true,
+		MountPath: "/etc/flyte/secrets/testsecret",
+	}
+
+	expectedEnv := []corev1.EnvVar{
+		{
+			Name:  "FLYTE_SECRETS_DEFAULT_DIR",
+			Value: "/etc/flyte/secrets",
+		},
+		{
+			Name:  "FLYTE_SECRETS_FILE_PREFIX",
+			Value: "",
+		},
+	}
+
+	t.Run("Default config", func(t *testing.T) {
+		injector := NewCSISecretManagerInjector(config.DefaultConfig.CSISecretManagerConfig)
+		expected := newPod()
+		expected.Spec.Volumes = []corev1.Volume{
+			{
+				Name: "csi-secret-krsxg4ctmvrxezlu",
+				VolumeSource: corev1.VolumeSource{
+					CSI: &corev1.CSIVolumeSource{
+						Driver:   "secrets-store.csi.k8s.io",
+						ReadOnly: &readOnly,
+						VolumeAttributes: map[string]string{
+							"secretProviderClass": "TestSecret",
+						},
+					},
+				},
+			},
+		}
+		expected.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{expectedMount}
+		expected.Spec.Containers[0].Env = expectedEnv
+		expected.Spec.InitContainers = []corev1.Container{}
+
+		p := newPod()
+		// Keys of the same group share the volume.
+		for _, key := range []string{"key1", "key2"} {
+			var injected bool
+			var err error
+			p, injected, err = injector.Inject(context.Background(), &core.Secret{Group: "TestSecret", Key: key}, p)
+			assert.NoError(t, err)
+			assert.True(t, injected)
+		}
+
+		if diff := deep.Equal(p, expected); diff != nil {
+			assert.Fail(t, "actual != expected", "Diff: %v", diff)
+		}
+	})
+
+	t.Run("Templated SecretProviderClass", func(t *testing.T) {
+		injector := NewCSISecretManagerInjector(config.CSISecretManagerConfig{
+			Driver:                      "secrets-store.csi.k8s.io",
+			SecretProviderClassTemplate: "{{ .Project }}-{{ .Domain }}-{{ .Group | printf \"%.4s\" }}",
+			NodePublishSecretRef:        "provider-creds",
+		})
+
+		p, injected, err := injector.Inject(context.Background(), &core.Secret{Group: "TestSecret"}, newPod())
+		assert.NoError(t, err)
+		assert.True(t, injected)
+		assert.Len(t, p.Spec.Volumes, 1)
+		assert.Equal(t, "flytesnacks-development-Test", p.Spec.Volumes[0].CSI.VolumeAttributes["secretProviderClass"])
+		assert.Equal(t, &corev1.LocalObjectReference{Name: "provider-creds"}, p.Spec.Volumes[0].CSI.NodePublishSecretRef)
+	})
+
+	t.Run("Invalid template", func(t *testing.T) {
+		injector := NewCSISecretManagerInjector(config.CSISecretManagerConfig{
+			SecretProviderClassTemplate: "{{ .Unknown }}",
+		})
+
+		_, injected, err := injector.Inject(context.Background(), &core.Secret{Group: "TestSecret"}, newPod())
+		assert.Error(t, err)
+		assert.False(t, injected)
+	})
+
+	t.Run("Missing group", func(t *testing.T) {
+		injector := NewCSISecretManagerInjector(config.DefaultConfig.CSISecretManagerConfig)
+		_, injected, err := injector.Inject(context.Background(), &core.Secret{Key: "key1"}, newPod())
+		assert.Error(t, err)
+		assert.False(t, injected)
+	})
+
+	t.Run("Group escaping the mount path", func(t *testing.T) {
+		injector := NewCSISecretManagerInjector(config.DefaultConfig.CSISecretManagerConfig)
+		_, injected, err := injector.Inject(context.Background(), &core.Secret{Group: "../../var/run"}, newPod())
+		assert.Error(t, err)
+		assert.False(t, injected)
+	})
+
+	t.Run("Env var mount requirement", func(t *testing.T) {
+		injector := NewCSISecretManagerInjector(config.DefaultConfig.CSISecretManagerConfig)
+		_, injected, err := injector.Inject(context.Background(), &core.Secret{
+			Group:            "TestSecret",
+			MountRequirement: core.Secret_ENV_VAR,
+		}, newPod())
+		assert.Error(t, err)
+		assert.False(t, injected)
+	})
+}
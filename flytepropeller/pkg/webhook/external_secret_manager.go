@@ -0,0 +1,195 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/config"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+const (
+	// ExternalSecretsVolumeName defines the static name of the volume used for mounting/sharing secrets between
+	// init-container sidecars and the rest of the containers in the pod.
+	ExternalSecretsVolumeName = "external-secret-vol" // #nosec
+
+	// externalSecretHeaderEnvVarPrefix prefixes the environment variables of the init container holding the values of
+	// the headers. The values are expanded into the arguments by the kubelet.
+	externalSecretHeaderEnvVarPrefix = "FLYTE_EXTERNAL_SECRET_HEADER_" // #nosec
+)
+
+var (
+	// ExternalSecretMountPath defines the default mount path for secrets
+	ExternalSecretMountPath = filepath.Join(K8sSecretPathPrefix...)
+)
+
+// ExternalSecretManagerInjector allows injecting of secrets from any secret provider the webhook doesn't know about.
+// For each secret it adds an init container that either runs the configured command or downloads the secret from the
+// configured URL with wget. Both are rendered from templates with the project, domain and secret being requested. The
+// secret is written to a local volume shared with all other containers in the pod.
+// Files will be mounted on /etc/flyte/secrets/<SecretGroup>/<SecretKey>
+type ExternalSecretManagerInjector struct {
+	cfg config.ExternalSecretManagerConfig
+}
+
+func formatExternalInitContainerName(index int) string {
+	return fmt.Sprintf("external-pull-secret-%v", index)
+}
+
+// formatExternalSecretAccessCommand renders the command pulling the secret. The command is wrapped in a shell creating
+// the directory of the secret first, the rendered arguments are passed as positional parameters so they're never
+// interpreted by the shell. The values of the headers are read from K8s secrets into the returned environment variables.
+func (i ExternalSecretManagerInjector) formatExternalSecretAccessCommand(secret *core.Secret, p *corev1.Pod) ([]string, []corev1.EnvVar, error) {
+	secretDir := strings.ToLower(filepath.Join(ExternalSecretMountPath, secret.GetGroup()))
+	secretPath := strings.ToLower(filepath.Join(secretDir, secret.GetKey()))
+	data := newSecretTemplateData(secret, p, secretPath)
+
+	var command []string
+	var envVars []corev1.EnvVar
+	switch {
+	case len(i.cfg.Command) > 0:
+		for _, arg := range i.cfg.Command {
+			rendered, err := renderSecretTemplate("command", arg, data)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			command = append(command, rendered)
+		}
+	case len(i.cfg.URLTemplate) > 0:
+		url, err := renderSecretTemplate("url", i.cfg.URLTemplate, data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		command = []string{"wget", "-q", "-O", secretPath}
+		headers := make([]string, 0, len(i.cfg.Headers))
+		for name := range i.cfg.Headers {
+			headers = append(headers, name)
+		}
+
+		sort.Strings(headers)
+		for index, name := range headers {
+			selector := i.cfg.Headers[name]
+			envVar := corev1.EnvVar{
+				Name:      fmt.Sprintf("%v%v", externalSecretHeaderEnvVarPrefix, index),
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &selector},
+			}
+
+			envVars = append(envVars, envVar)
+			command = append(command, "--header", fmt.Sprintf("%v: $(%v)", name, envVar.Name))
+		}
+
+		command = append(command, url)
+	default:
+		return nil, nil, fmt.Errorf("external secret manager requires either a command or a url template to be configured")
+	}
+
+	return append([]string{"sh", "-ec", `mkdir -p "$0" && exec "$@"`, secretDir}, command...), envVars, nil
+}
+
+func (i ExternalSecretManagerInjector) Type() config.SecretManagerType {
+	return config.SecretManagerTypeExternal
+}
+
+func (i ExternalSecretManagerInjector) Inject(ctx context.Context, secret *core.Secret, p *corev1.Pod) (newP *corev1.Pod, injected bool, err error) {
+	if len(secret.GetGroup()) == 0 || len(secret.GetKey()) == 0 {
+		return nil, false, fmt.Errorf("External Secrets Webhook requires both key and group to be set. "+
+			"Secret: [%v]", secret)
+	}
+
+	if err := validateSecretPathNames(secret); err != nil {
+		return nil, false, err
+	}
+
+	switch secret.GetMountRequirement() {
+	case core.Secret_ANY:
+		fallthrough
+	case core.Secret_FILE:
+		command, headerEnvVars, err := i.formatExternalSecretAccessCommand(secret, p)
+		if err != nil {
+			logger.Error(ctx, err)
+			return p, false, err
+		}
+
+		// A Volume with a static name so that if we try to inject multiple secrets, we won't mount multiple volumes.
+		// We use Memory as the storage medium for volume source to avoid writing secrets to disk.
+		vol := corev1.Volume{
+			Name: ExternalSecretsVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{
+					Medium: corev1.StorageMediumMemory,
+				},
+			},
+		}
+
+		p.Spec.Volumes = appendVolumeIfNotExists(p.Spec.Volumes, vol)
+		p.Spec.InitContainers = append(p.Spec.InitContainers, createExternalSidecarContainer(i.cfg, p, command, headerEnvVars))
+
+		secretVolumeMount := corev1.VolumeMount{
+			Name:      ExternalSecretsVolumeName,
+			ReadOnly:  true,
+			MountPath: ExternalSecretMountPath,
+		}
+
+		p.Spec.Containers = AppendVolumeMounts(p.Spec.Containers, secretVolumeMount)
+		p.Spec.InitContainers = AppendVolumeMounts(p.Spec.InitContainers, secretVolumeMount)
+
+		envVars := []corev1.EnvVar{
+			// Set environment variable to let the container know where to find the mounted files.
+			{
+				Name:  SecretPathDefaultDirEnvVar,
+				Value: ExternalSecretMountPath,
+			},
+			// Sets an empty prefix to let the containers know the file names will match the secret keys as-is.
+			{
+				Name:  SecretPathFilePrefixEnvVar,
+				Value: "",
+			},
+		}
+
+		for _, envVar := range envVars {
+			p.Spec.InitContainers = AppendEnvVars(p.Spec.InitContainers, envVar)
+			p.Spec.Containers = AppendEnvVars(p.Spec.Containers, envVar)
+		}
+	case core.Secret_ENV_VAR:
+		fallthrough
+	default:
+		err := fmt.Errorf("unrecognized mount requirement [%v] for secret [%v]", secret.GetMountRequirement().String(), secret.GetKey())
+		logger.Error(ctx, err)
+		return p, false, err
+	}
+
+	return p, true, nil
+}
+
+func createExternalSidecarContainer(cfg config.ExternalSecretManagerConfig, p *corev1.Pod, command []string, env []corev1.EnvVar) corev1.Container {
+	return corev1.Container{
+		Image: cfg.SidecarImage,
+		// Create a unique name to allow multiple secrets to be mounted.
+		Name:    formatExternalInitContainerName(len(p.Spec.InitContainers)),
+		Command: command,
+		Env:     env,
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      ExternalSecretsVolumeName,
+				MountPath: ExternalSecretMountPath,
+			},
+		},
+		Resources: cfg.Resources,
+	}
+}
+
+// NewExternalSecretManagerInjector creates a SecretInjector that's able to mount secrets pulled by a configurable
+// command or HTTP request.
+func NewExternalSecretManagerInjector(cfg config.ExternalSecretManagerConfig) ExternalSecretManagerInjector {
+	return ExternalSecretManagerInjector{
+		cfg: cfg,
+	}
+}
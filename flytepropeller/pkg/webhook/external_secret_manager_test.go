@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/config"
+)
+
+func TestExternalSecretManagerInjector_Inject(t *testing.T) {
+	newPod := func() *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{
+				Labels: map[string]string{"project": "flytesnacks", "domain": "development"},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{},
+			},
+		}
+	}
+
+	inputSecret := &core.Secret{
+		Group:        "TestSecret",
+		GroupVersion: "2",
+		Key:          "Token",
+	}
+
+	t.Run("Command", func(t *testing.T) {
+		cfg := config.DefaultConfig.ExternalSecretManagerConfig
+		cfg.Command = []string{"fetch-secret", "--path={{ .Project }}/{{ .Domain }}/{{ .Group }}/{{ .Key }}", "--version={{ .GroupVersion }}", "--out={{ .Path }}"}
+		injector := NewExternalSecretManagerInjector(cfg)
+
+		expected := newPod()
+		expected.Spec.Volumes = []corev1.Volume{
+			{
+				Name: "external-secret-vol",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{
+						Medium: corev1.StorageMediumMemory,
+					},
+				},
+			},
+		}
+		expected.Spec.InitContainers = []corev1.Container{
+			{
+				Name:  "external-pull-secret-0",
+				Image: "docker.io/library/busybox:1.36",
+				Command: []string{
+					"sh",
+					"-ec",
+					`mkdir -p "$0" && exec "$@"`,
+					"/etc/flyte/secrets/testsecret",
+					"fetch-secret",
+					"--path=flytesnacks/development/TestSecret/Token",
+					"--version=2",
+					"--out=/etc/flyte/secrets/testsecret/token",
+				},
+				Env: []corev1.EnvVar{
+					{
+						Name:  "FLYTE_SECRETS_DEFAULT_DIR",
+						Value: "/etc/flyte/secrets",
+					},
+					{
+						Name:  "FLYTE_SECRETS_FILE_PREFIX",
+						Value: "",
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      "external-secret-vol",
+						MountPath: "/etc/flyte/secrets",
+					},
+				},
+				Resources: config.DefaultConfig.ExternalSecretManagerConfig.Resources,
+			},
+		}
+
+		actualP, injected, err := injector.Inject(context.Background(), inputSecret, newPod())
+		assert.NoError(t, err)
+		assert.True(t, injected)
+		if diff := deep.Equal(actualP, expected); diff != nil {
+			assert.Fail(t, "actual != expected", "Diff: %v", diff)
+		}
+	})
+
+	t.Run("URL", func(t *testing.T) {
+		cfg := config.DefaultConfig.ExternalSecretManagerConfig
+		cfg.URLTemplate = "http://secrets.internal/v1/{{ .Project }}/{{ .Group }}/{{ .Key }}"
+		token := corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secrets-api"}, Key: "token"}
+		cfg.Headers = map[string]corev1.SecretKeySelector{
+			"Authorization": token,
+		}
+		injector := NewExternalSecretManagerInjector(cfg)
+
+		p := newPod()
+		for _, key := range []string{"Token", "Password"} {
+			var injected bool
+			var err error
+			p, injected, err = injector.Inject(context.Background(), &core.Secret{Group: "TestSecret", Key: key}, p)
+			assert.NoError(t, err)
+			assert.True(t, injected)
+		}
+
+		assert.Len(t, p.Spec.Volumes, 1)
+		assert.Len(t, p.Spec.InitContainers, 2)
+		assert.Equal(t, "external-pull-secret-1", p.Spec.InitContainers[1].Name)
+		assert.Equal(t, []string{
+			"sh",
+			"-ec",
+			`mkdir -p "$0" && exec "$@"`,
+			"/etc/flyte/secrets/testsecret",
+			"wget",
+			"-q",
+			"-O",
+			"/etc/flyte/secrets/testsecret/token",
+			"--header",
+			"Authorization: $(FLYTE_EXTERNAL_SECRET_HEADER_0)",
+			"http://secrets.internal/v1/flytesnacks/TestSecret/Token",
+		}, p.Spec.InitContainers[0].Command)
+		assert.Contains(t, p.Spec.InitContainers[0].Env, corev1.EnvVar{
+			Name:      "FLYTE_EXTERNAL_SECRET_HEADER_0",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &token},
+		})
+	})
+
+	t.Run("Not configured", func(t *testing.T) {
+		injector := NewExternalSecretManagerInjector(config.DefaultConfig.ExternalSecretManagerConfig)
+		_, injected, err := injector.Inject(context.Background(), inputSecret, newPod())
+		assert.Error(t, err)
+		assert.False(t, injected)
+	})
+
+	t.Run("Missing key", func(t *testing.T) {
+		cfg := config.DefaultConfig.ExternalSecretManagerConfig
+		cfg.Command = []string{"fetch-secret"}
+		injector := NewExternalSecretManagerInjector(cfg)
+		_, injected, err := injector.Inject(context.Background(), &core.Secret{Group: "TestSecret"}, newPod())
+		assert.Error(t, err)
+		assert.False(t, injected)
+	})
+
+	t.Run("Key escaping the template", func(t *testing.T) {
+		cfg := config.DefaultConfig.ExternalSecretManagerConfig
+		cfg.URLTemplate = "http://secrets.internal/v1/{{ .Group }}/{{ .Key }}"
+		injector := NewExternalSecretManagerInjector(cfg)
+		_, injected, err := injector.Inject(context.Background(), &core.Secret{Group: "TestSecret", Key: "token?admin=true"}, newPod())
+		assert.Error(t, err)
+		assert.False(t, injected)
+	})
+
+	t.Run("Env var mount requirement", func(t *testing.T) {
+		cfg := config.DefaultConfig.ExternalSecretManagerConfig
+		cfg.Command = []string{"fetch-secret"}
+		injector := NewExternalSecretManagerInjector(cfg)
+		_, injected, err := injector.Inject(context.Background(), &core.Secret{
+			Group:            "TestSecret",
+			Key:              "Token",
+			MountRequirement: core.Secret_ENV_VAR,
+		}, newPod())
+		assert.Error(t, err)
+		assert.False(t, injected)
+	})
+}
@@ -14,6 +14,9 @@
 //   - Once it intercepts the admission request, it goes over all registered Mutators and invoke them in the order they
 //     are registered as. If a Mutator fails, and it's marked as `required`, the operation will fail and the admission
 //     will be rejected.
+//   - Before injecting a secret, the SecretsMutator checks it against the secret access rules of the project and
+//     domain labels of the Pod. Pods requesting secrets they may not access are always rejected and an audit log line
+//     is written.
 //   - The SecretsMutator will attempt to look up the requested secret from the process environment. If the secret is
 //     already mounted, it'll inject it as plain-text into the Pod Spec (Less secure).
 //   - If it's not found in the environment it'll, instead, fallback to the enabled Secrets Injector (K8s, Confidant,
@@ -30,6 +33,7 @@ package webhook
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -80,6 +84,10 @@ func (pm PodMutator) Handle(ctx context.Context, request admission.Request) admi
 
 	newObj, changed, err := pm.Mutate(ctx, obj)
 	if err != nil {
+		if errors.As(err, &SecretAccessDeniedError{}) {
+			return admission.Denied(err.Error())
+		}
+
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
@@ -103,6 +111,12 @@ func (pm PodMutator) Mutate(ctx context.Context, p *corev1.Pod) (newP *corev1.Po
 		tempChanged := false
 		tempP, tempChanged, err = m.Mutator.Mutate(ctx, tempP)
 		if err != nil {
+			// Pods requesting secrets they may not access are rejected even if the mutator is optional.
+			if errors.As(err, &SecretAccessDeniedError{}) {
+				logger.Info(ctx, err)
+				return p, false, err
+			}
+
 			if m.Required {
 				err = fmt.Errorf("failed to mutate using [%v]. Since it's a required mutator, failing early. Error: %v", m.Mutator.ID(), err)
 				logger.Info(ctx, err)
@@ -3,6 +3,7 @@ package webhook
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -79,6 +80,24 @@ func TestPodMutator_Mutate(t *testing.T) {
 		_, _, err := pm.Mutate(ctx, inputPod.DeepCopy())
 		assert.NoError(t, err)
 	})
+
+	t.Run("Non-required Mutator Denied Secret Access", func(t *testing.T) {
+		deniedMutator := &mocks.Mutator{}
+		deniedMutator.OnID().Return("DenyingMutator")
+		deniedMutator.OnMutateMatch(mock.Anything, mock.Anything).Return(nil, false, SecretAccessDeniedError{})
+
+		pm := &PodMutator{
+			Mutators: []MutatorConfig{
+				{
+					Mutator:  deniedMutator,
+					Required: false,
+				},
+			},
+		}
+		ctx := context.Background()
+		_, _, err := pm.Mutate(ctx, inputPod.DeepCopy())
+		assert.Error(t, err)
+	})
 }
 
 func Test_CreateMutationWebhookConfiguration(t *testing.T) {
@@ -150,3 +169,46 @@ func Test_Handle(t *testing.T) {
 	resp := pm.Handle(context.Background(), req)
 	assert.True(t, resp.Allowed)
 }
+
+func Test_HandleSecretAccessDenied(t *testing.T) {
+	pm := NewPodMutator(&config.Config{
+		CertDir:      "testdata",
+		ServiceName:  "my-service",
+		SecretAccess: config.SecretAccessConfig{DefaultDeny: true},
+	}, latest.Scheme, promutils.NewTestScope())
+
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Object: runtime.RawExtension{
+				Raw: []byte(`{
+    "apiVersion": "v1",
+    "kind": "Pod",
+    "metadata": {
+        "name": "foo",
+        "namespace": "default",
+        "labels": {
+            "project": "flytesnacks",
+            "domain": "development"
+        },
+        "annotations": {
+            "flyte.secrets/s0": "nnsxsorcnv4v623fperca"
+        }
+    },
+    "spec": {
+        "containers": [
+            {
+                "image": "bar:v2",
+                "name": "bar"
+            }
+        ]
+    }
+}`),
+			},
+		},
+	}
+
+	resp := pm.Handle(context.Background(), req)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, int32(http.StatusForbidden), resp.Result.Code)
+	assert.Contains(t, resp.Result.Message, "is not allowed for project [flytesnacks] and domain [development]")
+}
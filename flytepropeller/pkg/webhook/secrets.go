@@ -3,10 +3,12 @@ package webhook
 import (
 	"context"
 
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	secretUtils "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils/secrets"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/secretmanager"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/config"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
@@ -20,8 +22,15 @@ const (
 )
 
 type SecretsMutator struct {
-	cfg       *config.Config
-	injectors []SecretsInjector
+	cfg        *config.Config
+	injectors  []SecretsInjector
+	authorizer secretsAuthorizer
+	metrics    secretsMetrics
+}
+
+type secretsMetrics struct {
+	allowed prometheus.Counter
+	denied  prometheus.Counter
 }
 
 type SecretsInjector interface {
@@ -39,7 +48,19 @@ func (s *SecretsMutator) Mutate(ctx context.Context, p *corev1.Pod) (newP *corev
 		return p, false, err
 	}
 
+	project, domain := p.GetLabels()[k8s.ProjectLabel], p.GetLabels()[k8s.DomainLabel]
 	for _, secret := range secrets {
+		if err := s.authorizer.Authorize(project, domain, secret); err != nil {
+			logger.Warnf(ctx, "Secret access audit: denied secret [%v] requested by pod [%v/%v] of project [%v] and domain [%v]",
+				formatSecret(secret), p.GetNamespace(), p.GetName(), project, domain)
+			s.metrics.denied.Inc()
+			return p, false, err
+		}
+
+		logger.Debugf(ctx, "Secret access audit: allowed secret [%v] requested by pod [%v/%v] of project [%v] and domain [%v]",
+			formatSecret(secret), p.GetNamespace(), p.GetName(), project, domain)
+		s.metrics.allowed.Inc()
+
 		for _, injector := range s.injectors {
 			if injector.Type() != config.SecretManagerTypeGlobal && injector.Type() != s.cfg.SecretManagerType {
 				logger.Infof(ctx, "Skipping SecretManager [%v] since it's not enabled.", injector.Type())
@@ -62,17 +83,30 @@ func (s *SecretsMutator) Mutate(ctx context.Context, p *corev1.Pod) (newP *corev
 	return p, injected, nil
 }
 
+func newSecretsMetrics(scope promutils.Scope) secretsMetrics {
+	return secretsMetrics{
+		allowed: scope.MustNewCounter("secret_access_allowed", "Number of secret requests allowed by the access rules"),
+		denied:  scope.MustNewCounter("secret_access_denied", "Number of secret requests denied by the access rules"),
+	}
+}
+
 // NewSecretsMutator creates a new SecretsMutator with all available plugins. Depending on the selected plugins in the
 // config, only the global plugin and one other plugin can be enabled.
-func NewSecretsMutator(cfg *config.Config, _ promutils.Scope) *SecretsMutator {
+func NewSecretsMutator(cfg *config.Config, scope promutils.Scope) *SecretsMutator {
 	return &SecretsMutator{
 		cfg: cfg,
+		authorizer: secretsAuthorizer{
+			access: cfg.SecretAccess,
+		},
+		metrics: newSecretsMetrics(scope),
 		injectors: []SecretsInjector{
 			NewGlobalSecrets(secretmanager.NewFileEnvSecretManager(secretmanager.GetConfig())),
 			NewK8sSecretsInjector(),
 			NewAWSSecretManagerInjector(cfg.AWSSecretManagerConfig),
 			NewGCPSecretManagerInjector(cfg.GCPSecretManagerConfig),
 			NewVaultSecretManagerInjector(cfg.VaultSecretManagerConfig),
+			NewCSISecretManagerInjector(cfg.CSISecretManagerConfig),
+			NewExternalSecretManagerInjector(cfg.ExternalSecretManagerConfig),
 		},
 	}
 }
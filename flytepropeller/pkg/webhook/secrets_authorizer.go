@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/config"
+)
+
+// SecretAccessDeniedError is returned when a pod requests a secret its project and domain may not access. Pods
+// requesting such secrets are rejected at admission.
+type SecretAccessDeniedError struct {
+	Project string
+	Domain  string
+	Secret  *core.Secret
+}
+
+func (e SecretAccessDeniedError) Error() string {
+	return fmt.Sprintf("secret [%v] is not allowed for project [%v] and domain [%v]", formatSecret(e.Secret),
+		e.Project, e.Domain)
+}
+
+func formatSecret(secret *core.Secret) string {
+	if len(secret.GetKey()) == 0 {
+		return secret.GetGroup()
+	}
+
+	return secret.GetGroup() + "/" + secret.GetKey()
+}
+
+// secretsAuthorizer decides which secrets the pods of a project and domain may request.
+type secretsAuthorizer struct {
+	access config.SecretAccessConfig
+}
+
+// Authorize returns a SecretAccessDeniedError if the secret is not allowed for the project and domain.
+func (a secretsAuthorizer) Authorize(project, domain string, secret *core.Secret) error {
+	if !isSecretAccessAllowed(a.access, project, domain, secret) {
+		return SecretAccessDeniedError{
+			Project: project,
+			Domain:  domain,
+			Secret:  secret,
+		}
+	}
+
+	return nil
+}
+
+// isSecretAccessAllowed checks the secret against the rules matching the project and domain.
+func isSecretAccessAllowed(cfg config.SecretAccessConfig, project, domain string, secret *core.Secret) bool {
+	ruleFound := false
+	for _, rule := range cfg.Rules {
+		if !matchesPattern(rule.Project, project) || !matchesPattern(rule.Domain, domain) {
+			continue
+		}
+
+		ruleFound = true
+		for _, pattern := range rule.Secrets {
+			group, key, hasKey := strings.Cut(pattern, "/")
+			if matchesPattern(group, secret.GetGroup()) && (!hasKey || matchesPattern(key, secret.GetKey())) {
+				return true
+			}
+		}
+	}
+
+	return !ruleFound && !cfg.DefaultDeny
+}
+
+// matchesPattern matches the value against a glob pattern, an empty pattern matches everything.
+func matchesPattern(pattern, value string) bool {
+	if len(pattern) == 0 {
+		return true
+	}
+
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
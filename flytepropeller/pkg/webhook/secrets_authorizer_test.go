@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/config"
+)
+
+func TestSecretsAuthorizer_Authorize(t *testing.T) {
+	access := config.SecretAccessConfig{
+		Rules: []config.SecretAccessRule{
+			{Project: "team-a", Secrets: []string{"team-a-*"}},
+			{Project: "team-a", Domain: "production", Secrets: []string{"prod-db/password"}},
+			{Project: "team-b", Domain: "dev*", Secrets: []string{"shared/*-token"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		access  config.SecretAccessConfig
+		project string
+		domain  string
+		secret  *core.Secret
+		allowed bool
+	}{
+		{"Group", access, "team-a", "development", &core.Secret{Group: "team-a-db", Key: "password"}, true},
+		{"GroupOfOtherProject", access, "team-b", "development", &core.Secret{Group: "team-a-db", Key: "password"}, false},
+		{"KeyInDomain", access, "team-a", "production", &core.Secret{Group: "prod-db", Key: "password"}, true},
+		{"KeyInOtherDomain", access, "team-a", "development", &core.Secret{Group: "prod-db", Key: "password"}, false},
+		{"OtherKey", access, "team-a", "production", &core.Secret{Group: "prod-db", Key: "admin-password"}, false},
+		{"KeyPattern", access, "team-b", "development", &core.Secret{Group: "shared", Key: "api-token"}, true},
+		{"KeyPatternInOtherDomain", access, "team-b", "production", &core.Secret{Group: "shared", Key: "api-token"}, true},
+		{"NoRule", access, "team-c", "development", &core.Secret{Group: "team-a-db", Key: "password"}, true},
+		{"NoRuleDefaultDeny", config.SecretAccessConfig{DefaultDeny: true, Rules: access.Rules}, "team-c", "development",
+			&core.Secret{Group: "shared", Key: "api-token"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			authorizer := secretsAuthorizer{access: test.access}
+			err := authorizer.Authorize(test.project, test.domain, test.secret)
+			if test.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, SecretAccessDeniedError{Project: test.project, Domain: test.domain, Secret: test.secret}, err)
+			}
+		})
+	}
+}
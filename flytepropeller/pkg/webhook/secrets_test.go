@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -10,8 +11,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	secretUtils "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils/secrets"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/config"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/mocks"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 func TestSecretsWebhook_Mutate(t *testing.T) {
@@ -37,6 +41,7 @@ func TestSecretsWebhook_Mutate(t *testing.T) {
 
 		m := SecretsMutator{
 			injectors: []SecretsInjector{mutator},
+			metrics:   newSecretsMetrics(promutils.NewTestScope()),
 		}
 
 		_, changed, err := m.Mutate(context.Background(), podWithAnnotations.DeepCopy())
@@ -51,10 +56,51 @@ func TestSecretsWebhook_Mutate(t *testing.T) {
 
 		m := SecretsMutator{
 			injectors: []SecretsInjector{mutator},
+			metrics:   newSecretsMetrics(promutils.NewTestScope()),
 		}
 
 		_, changed, err := m.Mutate(context.Background(), podWithAnnotations.DeepCopy())
 		assert.NoError(t, err)
 		assert.True(t, changed)
 	})
+
+	t.Run("Allowlisted", func(t *testing.T) {
+		annotations, err := secretUtils.MarshalSecretsToMapStrings([]*core.Secret{{Group: "team-a-db", Key: "password"}})
+		assert.NoError(t, err)
+
+		p := &corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{
+				Labels:      map[string]string{"project": "team-a"},
+				Annotations: annotations,
+			},
+		}
+
+		mutator := &mocks.SecretsInjector{}
+		mutator.OnInjectMatch(mock.Anything, mock.Anything, mock.Anything).Return(&corev1.Pod{}, true, nil)
+		mutator.OnType().Return(config.SecretManagerTypeGlobal)
+
+		m := SecretsMutator{
+			injectors: []SecretsInjector{mutator},
+			authorizer: secretsAuthorizer{
+				access: config.SecretAccessConfig{
+					Rules: []config.SecretAccessRule{
+						{Project: "team-a", Secrets: []string{"team-a-*"}},
+						{Project: "team-b", Secrets: []string{"team-b-*"}},
+					},
+				},
+			},
+			metrics: newSecretsMetrics(promutils.NewTestScope()),
+		}
+
+		_, changed, err := m.Mutate(context.Background(), p.DeepCopy())
+		assert.NoError(t, err)
+		assert.True(t, changed)
+
+		p.Labels["project"] = "team-b"
+		_, changed, err = m.Mutate(context.Background(), p.DeepCopy())
+		assert.EqualError(t, err, "secret [team-a-db/password] is not allowed for project [team-b] and domain []")
+		assert.True(t, errors.As(err, &SecretAccessDeniedError{}))
+		assert.False(t, changed)
+		mutator.AssertNumberOfCalls(t, "Inject", 1)
+	})
 }
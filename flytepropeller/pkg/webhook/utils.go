@@ -1,18 +1,44 @@
 package webhook
 
 import (
+	"bytes"
 	"fmt"
+	"net/url"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"text/template"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
 
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/encoding"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/webhook/config"
 )
 
+// secretPathNameRegex is the charset allowed in the groups and keys of secrets which are joined into mount paths or
+// rendered into templates.
+var secretPathNameRegex = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// validateSecretPathNames rejects secrets whose group or key could escape the directory they're mounted in or alter the
+// templates they're rendered into. Empty names are left to the checks of the injectors.
+func validateSecretPathNames(secret *core.Secret) error {
+	for _, name := range []string{secret.GetGroup(), secret.GetKey()} {
+		if len(name) == 0 {
+			continue
+		}
+
+		if !secretPathNameRegex.MatchString(name) || strings.Contains(name, "..") {
+			return fmt.Errorf("secret group and key may only contain alphanumeric characters, '-', '_' and '.' and "+
+				"must not contain '..'. Secret: [%v]", formatSecret(secret))
+		}
+	}
+
+	return nil
+}
+
 func hasEnvVar(envVars []corev1.EnvVar, envVarKey string) bool {
 	for _, e := range envVars {
 		if e.Name == envVarKey {
@@ -163,3 +189,39 @@ func CreateVaultAnnotationsForSecret(secret *core.Secret, kvversion config.KVVer
 	}
 	return secretVaultAnnotations
 }
+
+// secretTemplateData is the data available to the templates configured for the CSI and external secret managers. The
+// group, group version and key are path escaped.
+type secretTemplateData struct {
+	Project      string
+	Domain       string
+	Group        string
+	GroupVersion string
+	Key          string
+	Path         string
+}
+
+func newSecretTemplateData(secret *core.Secret, p *corev1.Pod, path string) secretTemplateData {
+	return secretTemplateData{
+		Project:      p.GetLabels()[k8s.ProjectLabel],
+		Domain:       p.GetLabels()[k8s.DomainLabel],
+		Group:        url.PathEscape(secret.GetGroup()),
+		GroupVersion: url.PathEscape(secret.GetGroupVersion()),
+		Key:          url.PathEscape(secret.GetKey()),
+		Path:         path,
+	}
+}
+
+func renderSecretTemplate(name, text string, data secretTemplateData) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %v template: %w", name, err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return "", fmt.Errorf("failed to render %v template: %w", name, err)
+	}
+
+	return buf.String(), nil
+}
@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 )
 
 func Test_hasEnvVar(t *testing.T) {
@@ -212,3 +215,30 @@ func TestAppendVolume(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSecretPathNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret *core.Secret
+		valid  bool
+	}{
+		{"Valid", &core.Secret{Group: "team-a.db_1", Key: "Password"}, true},
+		{"GroupOnly", &core.Secret{Group: "team-a-db"}, true},
+		{"ParentDirectory", &core.Secret{Group: "..", Key: "password"}, false},
+		{"ParentDirectoryInName", &core.Secret{Group: "team..db", Key: "password"}, false},
+		{"SlashInGroup", &core.Secret{Group: "team/db", Key: "password"}, false},
+		{"SlashInKey", &core.Secret{Group: "team-a-db", Key: "../password"}, false},
+		{"TemplateCharacters", &core.Secret{Group: "team-a-db", Key: "{{ .Project }}"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSecretPathNames(tt.secret)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
@@ -23,6 +24,10 @@ const (
 	TypeStow   Type = "stow"
 )
 
+const (
+	defaultDedupPrefix = ".flyte-dedup"
+)
+
 const (
 	KiB int64 = 1024
 	MiB int64 = 1024 * KiB
@@ -40,6 +45,17 @@ var (
 			AuthType: "iam",
 		},
 		MultiContainerEnabled: false,
+		Dedup: DedupConfig{
+			Prefix:             defaultDedupPrefix,
+			ReleaseGracePeriod: config.Duration{Duration: time.Hour},
+		},
+		Encryption: EncryptionConfig{
+			KeyProvider: KeyProviderFile,
+		},
+		MultipartUpload: MultipartUploadConfig{
+			PartSizeMegabytes: 16,
+			Parallelism:       4,
+		},
 	}
 )
 
@@ -63,6 +79,49 @@ type Config struct {
 	Limits            LimitsConfig     `json:"limits" pflag:",Sets limits for stores."`
 	DefaultHTTPClient HTTPClientConfig `json:"defaultHttpClient" pflag:",Sets the default http client config."`
 	SignedURL         SignedURLConfig  `json:"signedUrl" pflag:",Sets config for SignedURL."`
+	// Dedup stores raw blobs by the hash of their content so identical payloads (e.g. offloaded outputs of reruns)
+	// are only written once.
+	Dedup DedupConfig `json:"dedup" pflag:",Sets config for content-addressed deduplication of raw blobs."`
+	// Encryption enables client-side envelope encryption of all the content written through the DataStore. This
+	// doesn't rely on the bucket to be configured with server-side encryption.
+	Encryption EncryptionConfig `json:"encryption" pflag:",Sets config for client-side encryption."`
+	// MultipartUpload streams large writes to the stow backends which support it as parallel multipart uploads
+	// instead of a single request. With dedup enabled, writes are spooled to a temporary file to hash them before
+	// they're uploaded. With encryption enabled, writes have to pass their size, writes of unknown size fail.
+	MultipartUpload MultipartUploadConfig `json:"multipartUpload" pflag:",Sets config for multipart uploads of large objects."`
+}
+
+// MultipartUploadConfig encapsulates configs for multipart uploads done by the stow store.
+type MultipartUploadConfig struct {
+	Enabled           bool  `json:"enabled" pflag:",If true, writes larger than a single part are uploaded in parts for the stow kinds which support it [s3/google/azure]."`
+	PartSizeMegabytes int64 `json:"partSizeMBs" pflag:",Size (in MBs) of a single part. Has to be at least 5MB. Bounds the size of an object to 10000 parts."`
+	Parallelism       int   `json:"parallelism" pflag:",Maximum number of parts of a single object uploaded concurrently. At most parallelism + 1 parts are held in memory."`
+}
+
+// EncryptionConfig encapsulates configs for the encrypting RawStore.
+type EncryptionConfig struct {
+	Enabled     bool                  `json:"enabled" pflag:",If true, content is encrypted with AES-GCM before it's written and decrypted after it's read."`
+	KeyProvider string                `json:"keyProvider" pflag:",Key provider used to wrap data keys [file]."`
+	File        FileKeyProviderConfig `json:"file" pflag:",Config for the file key provider."`
+	// AllowPlaintextReads is only meant to migrate stores written to before encryption was enabled. Otherwise reading
+	// content that isn't encrypted fails, so encrypted objects can't be downgraded or substituted with plaintext.
+	// Uploads through signed URLs aren't encrypted, so they're only allowed if plaintext reads are.
+	AllowPlaintextReads bool `json:"allowPlaintextReads" pflag:",If true, content that isn't encrypted is read as is. Only meant for migrating existing data."`
+}
+
+// FileKeyProviderConfig encapsulates configs for the file-backed KeyProvider.
+type FileKeyProviderConfig struct {
+	KeyPath string `json:"keyPath" pflag:",Path to a file containing the base64 encoded 32 bytes key encryption key."`
+}
+
+// DedupConfig encapsulates configs for the content-addressed deduplicating RawStore.
+type DedupConfig struct {
+	Enabled bool   `json:"enabled" pflag:",If true, blobs are stored by the sha256 of their content and logical references point to them."`
+	Prefix  string `json:"prefix" pflag:",Key prefix, within each container, under which content-addressed blobs and their reference index are stored."`
+	// ReleaseGracePeriod is how long a blob has to remain unreferenced before it's deleted. Object stores can't check
+	// the references to a blob and delete it atomically, the grace period lets concurrent writes of the same content
+	// reference the blob again before it's released.
+	ReleaseGracePeriod config.Duration `json:"releaseGracePeriod" pflag:",How long a blob has to remain unreferenced before it's deleted."`
 }
 
 // SignedURLConfig encapsulates configs specifically used for SignedURL behavior.
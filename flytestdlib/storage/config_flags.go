@@ -65,5 +65,15 @@ func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "cache.target_gc_percent"), defaultConfig.Cache.TargetGCPercent, "Sets the garbage collection target percentage.")
 	cmdFlags.Int64(fmt.Sprintf("%v%v", prefix, "limits.maxDownloadMBs"), defaultConfig.Limits.GetLimitMegabytes, "Maximum allowed download size (in MBs) per call.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "defaultHttpClient.timeout"), defaultConfig.DefaultHTTPClient.Timeout.String(), "Sets time out on the http client.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "dedup.enabled"), defaultConfig.Dedup.Enabled, "If true,  blobs are stored by the sha256 of their content and logical references point to them.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "dedup.prefix"), defaultConfig.Dedup.Prefix, "Key prefix,  within each container,  under which content-addressed blobs and their reference index are stored.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "dedup.releaseGracePeriod"), defaultConfig.Dedup.ReleaseGracePeriod.String(), "How long a blob has to remain unreferenced before it's deleted.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "encryption.enabled"), defaultConfig.Encryption.Enabled, "If true,  content is encrypted with AES-GCM before it's written and decrypted after it's read.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "encryption.keyProvider"), defaultConfig.Encryption.KeyProvider, "Key provider used to wrap data keys [file].")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "encryption.file.keyPath"), defaultConfig.Encryption.File.KeyPath, "Path to a file containing the base64 encoded 32 bytes key encryption key.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "encryption.allowPlaintextReads"), defaultConfig.Encryption.AllowPlaintextReads, "If true,  content that isn't encrypted is read as is. Only meant for migrating existing data.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "multipartUpload.enabled"), defaultConfig.MultipartUpload.Enabled, "If true,  writes larger than a single part are uploaded in parts for the stow kinds which support it [s3/google/azure].")
+	cmdFlags.Int64(fmt.Sprintf("%v%v", prefix, "multipartUpload.partSizeMBs"), defaultConfig.MultipartUpload.PartSizeMegabytes, "Size (in MBs) of a single part. Has to be at least 5MB. Bounds the size of an object to 10000 parts.")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "multipartUpload.parallelism"), defaultConfig.MultipartUpload.Parallelism, "Maximum number of parts of a single object uploaded concurrently. At most parallelism + 1 parts are held in memory.")
 	return cmdFlags
 }
@@ -309,4 +309,144 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_dedup.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("dedup.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("dedup.enabled"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.Dedup.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_dedup.prefix", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("dedup.prefix", testValue)
+			if vString, err := cmdFlags.GetString("dedup.prefix"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Dedup.Prefix)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_dedup.releaseGracePeriod", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultConfig.Dedup.ReleaseGracePeriod.String()
+
+			cmdFlags.Set("dedup.releaseGracePeriod", testValue)
+			if vString, err := cmdFlags.GetString("dedup.releaseGracePeriod"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Dedup.ReleaseGracePeriod)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_encryption.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("encryption.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("encryption.enabled"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.Encryption.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_encryption.keyProvider", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("encryption.keyProvider", testValue)
+			if vString, err := cmdFlags.GetString("encryption.keyProvider"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Encryption.KeyProvider)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_encryption.file.keyPath", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("encryption.file.keyPath", testValue)
+			if vString, err := cmdFlags.GetString("encryption.file.keyPath"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vString), &actual.Encryption.File.KeyPath)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_encryption.allowPlaintextReads", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("encryption.allowPlaintextReads", testValue)
+			if vBool, err := cmdFlags.GetBool("encryption.allowPlaintextReads"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.Encryption.AllowPlaintextReads)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_multipartUpload.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("multipartUpload.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("multipartUpload.enabled"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.MultipartUpload.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_multipartUpload.partSizeMBs", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("multipartUpload.partSizeMBs", testValue)
+			if vInt64, err := cmdFlags.GetInt64("multipartUpload.partSizeMBs"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt64), &actual.MultipartUpload.PartSizeMegabytes)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_multipartUpload.parallelism", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("multipartUpload.parallelism", testValue)
+			if vInt, err := cmdFlags.GetInt("multipartUpload.parallelism"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vInt), &actual.MultipartUpload.Parallelism)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
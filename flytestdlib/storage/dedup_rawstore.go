@@ -0,0 +1,691 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/utils/clock"
+
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/otelutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/stow"
+)
+
+const (
+	dedupHashAlgorithm = "sha256"
+	dedupBlobsDir      = "blobs"
+	dedupRefsDir       = "refs"
+	dedupReleasesDir   = "releases"
+	// dedupPointerMagic prefixes every pointer object written at a logical reference. Objects that do not start with
+	// it (e.g. written before deduplication was enabled or through a signed URL) are passed through untouched.
+	dedupPointerMagic = "#flyte-dedup-pointer/v1\n"
+	// dedupHashMetadataKey and dedupSizeMetadataKey are set on pointer objects, so a single Head tells pointers apart
+	// from regular content and describes the blob they point to.
+	dedupHashMetadataKey = "flyteDedupHash"
+	dedupSizeMetadataKey = "flyteDedupSize"
+	// dedupMaxPointerSize is an upper bound on the size of a pointer object. Anything larger is never inspected.
+	dedupMaxPointerSize = 1 * KiB
+	dedupListBatchSize  = 100
+)
+
+type dedupMetrics struct {
+	DedupHit      prometheus.Counter
+	DedupMiss     prometheus.Counter
+	BytesSaved    prometheus.Counter
+	BlobsReleased prometheus.Counter
+	copyMetrics   *copyMetrics
+}
+
+// dedupPointer is the content of the object written at a logical reference. It points to the content-addressed blob
+// holding the actual bytes.
+type dedupPointer struct {
+	Hash string `json:"hash"`
+	Size int64  `json:"size"`
+}
+
+// dedupMetadata is the metadata of a deduplicated reference, as described by the metadata of its pointer.
+type dedupMetadata struct {
+	Metadata
+	pointer dedupPointer
+}
+
+func (m dedupMetadata) Size() int64 {
+	return m.pointer.Size
+}
+
+func (m dedupMetadata) Etag() string {
+	return m.pointer.Hash
+}
+
+// dedupRawStore stores blobs by the hash of their content. Every logical reference holds a small pointer to the
+// content-addressed blob and an entry in a reference index. Writing or copying identical content multiple times only
+// ever stores one copy of the bytes.
+//
+// Object stores can't check the references to a blob and delete it atomically. Blobs whose last reference is deleted
+// are marked for release instead, and only deleted once they've remained unreferenced for the release grace period.
+// Writing or copying the content again in the meantime cancels the release.
+type dedupRawStore struct {
+	RawStore
+	// fallbackCopy copies content that isn't deduplicated (or crosses containers) through this store.
+	fallbackCopy       copyImpl
+	prefix             string
+	releaseGracePeriod time.Duration
+	clock              clock.Clock
+	// lastRelease records, per container, when expired release markers were last processed.
+	lastRelease sync.Map
+	metrics     *dedupMetrics
+}
+
+// dedupReference returns the location of the given kind of dedup object for the hash, within the container of
+// reference.
+func (s *dedupRawStore) dedupReference(reference DataReference, dir string, elems ...string) (DataReference, error) {
+	scheme, container, _, err := reference.Split()
+	if err != nil {
+		return "", err
+	}
+
+	key := strings.Join(append([]string{s.prefix, dir, dedupHashAlgorithm}, elems...), "/")
+	return NewDataReference(scheme, container, key), nil
+}
+
+// blobReference returns the location of the content-addressed blob for the given hash. Blobs live in the same
+// container as the logical reference.
+func (s *dedupRawStore) blobReference(reference DataReference, hash string) (DataReference, error) {
+	return s.dedupReference(reference, dedupBlobsDir, hash)
+}
+
+// indexPrefix returns the prefix under which all the references to the given blob are recorded.
+func (s *dedupRawStore) indexPrefix(reference DataReference, hash string) (DataReference, error) {
+	return s.dedupReference(reference, dedupRefsDir, hash)
+}
+
+// indexReference returns the index entry recording that reference points to the blob with the given hash.
+func (s *dedupRawStore) indexReference(reference DataReference, hash string) (DataReference, error) {
+	refHash := sha256.Sum256([]byte(reference))
+	return s.dedupReference(reference, dedupRefsDir, hash, hex.EncodeToString(refHash[:]))
+}
+
+// releaseReference returns the marker recording that the blob with the given hash is no longer referenced.
+func (s *dedupRawStore) releaseReference(reference DataReference, hash string) (DataReference, error) {
+	return s.dedupReference(reference, dedupReleasesDir, hash)
+}
+
+// readPointer returns the pointer stored at reference if there is one. A nil pointer with no error is returned when
+// the reference holds regular content.
+func (s *dedupRawStore) readPointer(ctx context.Context, reference DataReference) (*dedupPointer, error) {
+	m, err := s.RawStore.Head(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pointerOf(ctx, reference, m)
+}
+
+// pointerOf returns the pointer stored at reference given its metadata. Pointers are identified by their metadata
+// when the underlying store keeps it, otherwise only objects small enough to be pointers are read, so regular content
+// is never subject to the download limit of the underlying store.
+func (s *dedupRawStore) pointerOf(ctx context.Context, reference DataReference, m Metadata) (*dedupPointer, error) {
+	if !m.Exists() {
+		return nil, errs.Wrapf(os.ErrNotExist, "path:%v", reference)
+	}
+
+	if um, ok := m.(UserMetadata); ok {
+		return pointerFromMetadata(um)
+	}
+
+	if m.Size() > dedupMaxPointerSize {
+		return nil, nil
+	}
+
+	rc, err := s.RawStore.ReadRaw(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := rc.Close(); err != nil {
+			logger.Warnf(ctx, "Failed to close reader [%v]. Error: %v", reference, err)
+		}
+	}()
+
+	return parsePointer(bufio.NewReader(rc))
+}
+
+func pointerFromMetadata(m UserMetadata) (*dedupPointer, error) {
+	hash, ok := m.UserMetadataValue(dedupHashMetadataKey)
+	if !ok {
+		return nil, nil
+	}
+
+	size, ok := m.UserMetadataValue(dedupSizeMetadataKey)
+	if !ok {
+		return nil, fmt.Errorf("dedup pointer to [%v] has no size", hash)
+	}
+
+	p := &dedupPointer{Hash: hash}
+	var err error
+	if p.Size, err = strconv.ParseInt(size, 10, 64); err != nil {
+		return nil, fmt.Errorf("failed to parse the size of dedup pointer to [%v]. Error: %w", hash, err)
+	}
+
+	return p, nil
+}
+
+func parsePointer(r *bufio.Reader) (*dedupPointer, error) {
+	header, err := r.Peek(len(dedupPointerMagic))
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+
+	if string(header) != dedupPointerMagic {
+		return nil, nil
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(r, dedupMaxPointerSize))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dedupPointer{}
+	if err = json.Unmarshal(raw[len(dedupPointerMagic):], p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal dedup pointer. Error: %w", err)
+	}
+
+	return p, nil
+}
+
+func (s *dedupRawStore) writePointer(ctx context.Context, reference DataReference, opts Options, p dedupPointer) error {
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	metadata := make(map[string]interface{}, len(opts.Metadata)+2)
+	for k, v := range opts.Metadata {
+		metadata[k] = v
+	}
+
+	metadata[dedupHashMetadataKey] = p.Hash
+	metadata[dedupSizeMetadataKey] = strconv.FormatInt(p.Size, 10)
+	content := append([]byte(dedupPointerMagic), raw...)
+	return s.RawStore.WriteRaw(ctx, reference, int64(len(content)), Options{Metadata: metadata}, bytes.NewReader(content))
+}
+
+// addReference records reference in the index of the blob and cancels any pending release of the blob.
+func (s *dedupRawStore) addReference(ctx context.Context, reference DataReference, hash string) error {
+	indexRef, err := s.indexReference(reference, hash)
+	if err != nil {
+		return err
+	}
+
+	if err = s.RawStore.WriteRaw(ctx, indexRef, int64(len(reference)), Options{}, strings.NewReader(reference.String())); err != nil {
+		return err
+	}
+
+	releaseRef, err := s.releaseReference(reference, hash)
+	if err != nil {
+		return err
+	}
+
+	if err = s.RawStore.Delete(ctx, releaseRef); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// removeReference removes reference from the index of the blob it points to. The blob is marked for release once it's
+// no longer referenced.
+func (s *dedupRawStore) removeReference(ctx context.Context, reference DataReference, hash string) error {
+	indexRef, err := s.indexReference(reference, hash)
+	if err != nil {
+		return err
+	}
+
+	if err = s.RawStore.Delete(ctx, indexRef); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	prefix, err := s.indexPrefix(reference, hash)
+	if err != nil {
+		return err
+	}
+
+	referenced, err := s.hasReferences(ctx, prefix)
+	if err != nil || referenced {
+		return err
+	}
+
+	releaseRef, err := s.releaseReference(reference, hash)
+	if err != nil {
+		return err
+	}
+
+	markedAt := s.clock.Now().UTC().Format(time.RFC3339)
+	return s.RawStore.WriteRaw(ctx, releaseRef, int64(len(markedAt)), Options{}, strings.NewReader(markedAt))
+}
+
+// releaseBlobs deletes the blobs, within the container of reference, that have been marked for release for longer
+// than the grace period and still aren't referenced. Markers are processed at most once per grace period per
+// container.
+func (s *dedupRawStore) releaseBlobs(ctx context.Context, reference DataReference) error {
+	scheme, container, _, err := reference.Split()
+	if err != nil {
+		return err
+	}
+
+	now := s.clock.Now()
+	key := NewDataReference(scheme, container, "")
+	if last, ok := s.lastRelease.Load(key); ok && now.Sub(last.(time.Time)) < s.releaseGracePeriod {
+		return nil
+	}
+
+	s.lastRelease.Store(key, now)
+	releasesPrefix, err := s.dedupReference(reference, dedupReleasesDir)
+	if err != nil {
+		return err
+	}
+
+	cursor := NewCursorAtStart()
+	for !IsCursorEnd(cursor) {
+		markers, next, err := s.RawStore.List(ctx, releasesPrefix, dedupListBatchSize, cursor)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		for _, marker := range markers {
+			if err = s.releaseBlob(ctx, marker, path.Base(marker.String()), now); err != nil {
+				return err
+			}
+		}
+
+		cursor = next
+	}
+
+	return nil
+}
+
+// releaseBlob deletes the blob with the given hash if its release marker has expired and it's still not referenced.
+func (s *dedupRawStore) releaseBlob(ctx context.Context, marker DataReference, hash string, now time.Time) error {
+	rc, err := s.RawStore.ReadRaw(ctx, marker)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(rc, dedupMaxPointerSize))
+	if closeErr := rc.Close(); closeErr != nil {
+		logger.Warnf(ctx, "Failed to close reader [%v]. Error: %v", marker, closeErr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	markedAt, err := time.Parse(time.RFC3339, string(raw))
+	if err != nil {
+		logger.Warnf(ctx, "Ignoring invalid dedup release marker [%v]. Error: %v", marker, err)
+		return nil
+	}
+
+	if now.Sub(markedAt) < s.releaseGracePeriod {
+		return nil
+	}
+
+	prefix, err := s.indexPrefix(marker, hash)
+	if err != nil {
+		return err
+	}
+
+	referenced, err := s.hasReferences(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	if !referenced {
+		blobRef, err := s.blobReference(marker, hash)
+		if err != nil {
+			return err
+		}
+
+		if err = s.RawStore.Delete(ctx, blobRef); err != nil && !IsNotFound(err) {
+			return err
+		}
+
+		s.metrics.BlobsReleased.Inc()
+	}
+
+	if err = s.RawStore.Delete(ctx, marker); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// hasReferences checks whether any index entry exists under prefix. Some stores (e.g. local) apply the page size
+// before filtering by prefix so it keeps paging until an entry is found or the listing is exhausted.
+func (s *dedupRawStore) hasReferences(ctx context.Context, prefix DataReference) (bool, error) {
+	cursor := NewCursorAtStart()
+	for !IsCursorEnd(cursor) {
+		items, next, err := s.RawStore.List(ctx, prefix, dedupListBatchSize, cursor)
+		if err != nil {
+			if IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		if len(items) > 0 {
+			return true, nil
+		}
+
+		cursor = next
+	}
+
+	return false, nil
+}
+
+// Head gets metadata about the reference. For deduplicated references the size and hash of the blob are returned.
+func (s *dedupRawStore) Head(ctx context.Context, reference DataReference) (Metadata, error) {
+	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.dedupRawStore/Head")
+	defer span.End()
+
+	m, err := s.RawStore.Head(ctx, reference)
+	if err != nil || !m.Exists() {
+		return m, err
+	}
+
+	p, err := s.pointerOf(ctx, reference, m)
+	if err != nil || p == nil {
+		return m, err
+	}
+
+	return dedupMetadata{Metadata: m, pointer: *p}, nil
+}
+
+// ReadRaw retrieves a byte array from the Blob store or an error. Pointers are transparently resolved.
+func (s *dedupRawStore) ReadRaw(ctx context.Context, reference DataReference) (io.ReadCloser, error) {
+	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.dedupRawStore/ReadRaw")
+	defer span.End()
+
+	rc, err := s.RawStore.ReadRaw(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(rc)
+	p, err := parsePointer(br)
+	if err != nil {
+		if closeErr := rc.Close(); closeErr != nil {
+			logger.Warnf(ctx, "Failed to close reader [%v]. Error: %v", reference, closeErr)
+		}
+
+		return nil, err
+	} else if p == nil {
+		return readCloser{Reader: br, Closer: rc}, nil
+	}
+
+	if err = rc.Close(); err != nil {
+		logger.Warnf(ctx, "Failed to close reader [%v]. Error: %v", reference, err)
+	}
+
+	blobRef, err := s.blobReference(reference, p.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.RawStore.ReadRaw(ctx, blobRef)
+}
+
+// WriteRaw hashes the content and only writes it to the underlying store if no blob with the same hash exists. The
+// content is spooled to a temporary file while it's hashed, so payloads are never buffered in memory.
+func (s *dedupRawStore) WriteRaw(ctx context.Context, reference DataReference, size int64, opts Options, raw io.Reader) error {
+	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.dedupRawStore/WriteRaw")
+	defer span.End()
+
+	f, err := os.CreateTemp("", "flyte-dedup-")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := f.Close(); err != nil {
+			logger.Warnf(ctx, "Failed to close temporary file [%v]. Error: %v", f.Name(), err)
+		}
+
+		if err := os.Remove(f.Name()); err != nil {
+			logger.Warnf(ctx, "Failed to remove temporary file [%v]. Error: %v", f.Name(), err)
+		}
+	}()
+
+	h := sha256.New()
+	written, err := io.Copy(io.MultiWriter(f, h), raw)
+	if err != nil {
+		return err
+	}
+
+	p := dedupPointer{
+		Hash: hex.EncodeToString(h.Sum(nil)),
+		Size: written,
+	}
+
+	return s.replace(ctx, reference, opts, p, func(blobRef DataReference, exists bool) error {
+		if exists {
+			s.metrics.DedupHit.Inc()
+			s.metrics.BytesSaved.Add(float64(p.Size))
+			return nil
+		}
+
+		s.metrics.DedupMiss.Inc()
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+
+		return s.RawStore.WriteRaw(ctx, blobRef, p.Size, opts, f)
+	})
+}
+
+// replace points reference at a new blob, releasing the blob it previously pointed to (if any). The reference is
+// recorded in the index of the new blob before ensureBlob is called with whether the blob exists, so a concurrent
+// release of the blob is either cancelled or observed.
+func (s *dedupRawStore) replace(ctx context.Context, reference DataReference, opts Options, p dedupPointer,
+	ensureBlob func(blobRef DataReference, exists bool) error) error {
+	previous, err := s.readPointer(ctx, reference)
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	if err = s.addReference(ctx, reference, p.Hash); err != nil {
+		return err
+	}
+
+	blobRef, err := s.blobReference(reference, p.Hash)
+	if err != nil {
+		return err
+	}
+
+	m, err := s.RawStore.Head(ctx, blobRef)
+	if err == nil {
+		err = ensureBlob(blobRef, m.Exists())
+	}
+
+	if err == nil {
+		err = s.writePointer(ctx, reference, opts, p)
+	}
+
+	if err != nil {
+		if previous == nil || previous.Hash != p.Hash {
+			if removeErr := s.removeReference(ctx, reference, p.Hash); removeErr != nil {
+				logger.Warnf(ctx, "Failed to remove dedup reference [%v]. Error: %v", reference, removeErr)
+			}
+		}
+
+		return err
+	}
+
+	if previous != nil && previous.Hash != p.Hash {
+		return s.unlink(ctx, reference, previous.Hash)
+	}
+
+	return nil
+}
+
+// unlink removes reference from the index of the blob and releases the blobs that have been unreferenced for long
+// enough.
+func (s *dedupRawStore) unlink(ctx context.Context, reference DataReference, hash string) error {
+	if err := s.removeReference(ctx, reference, hash); err != nil {
+		return err
+	}
+
+	return s.releaseBlobs(ctx, reference)
+}
+
+// CopyRaw copies from source to destination. When source is deduplicated only a new pointer is written.
+func (s *dedupRawStore) CopyRaw(ctx context.Context, source, destination DataReference, opts Options) error {
+	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.dedupRawStore/CopyRaw")
+	defer span.End()
+
+	p, err := s.readPointer(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	if p == nil {
+		return s.fallbackCopy.CopyRaw(ctx, source, destination, opts)
+	}
+
+	srcScheme, srcContainer, _, err := source.Split()
+	if err != nil {
+		return err
+	}
+
+	dstScheme, dstContainer, _, err := destination.Split()
+	if err != nil {
+		return err
+	}
+
+	if srcScheme != dstScheme || srcContainer != dstContainer {
+		// Blobs are scoped to a container, fall back to copying the content.
+		return s.fallbackCopy.CopyRaw(ctx, source, destination, opts)
+	}
+
+	return s.replace(ctx, destination, opts, *p, func(blobRef DataReference, exists bool) error {
+		if !exists {
+			return errs.Wrapf(os.ErrNotExist, "blob %v of %v", blobRef, source)
+		}
+
+		s.metrics.DedupHit.Inc()
+		s.metrics.BytesSaved.Add(float64(p.Size))
+		return nil
+	})
+}
+
+// Delete removes the pointer at reference and marks the blob for release once it's no longer referenced.
+func (s *dedupRawStore) Delete(ctx context.Context, reference DataReference) error {
+	p, err := s.readPointer(ctx, reference)
+	if err != nil {
+		return err
+	}
+
+	if err = s.RawStore.Delete(ctx, reference); err != nil {
+		return err
+	}
+
+	if p == nil {
+		return nil
+	}
+
+	return s.unlink(ctx, reference, p.Hash)
+}
+
+// CreateSignedURL creates a signed url with the provided properties. Signed URLs to read a deduplicated reference are
+// issued for the underlying blob.
+func (s *dedupRawStore) CreateSignedURL(ctx context.Context, reference DataReference, properties SignedURLProperties) (SignedURLResponse, error) {
+	if properties.Scope != stow.ClientMethodGet {
+		return s.RawStore.CreateSignedURL(ctx, reference, properties)
+	}
+
+	p, err := s.readPointer(ctx, reference)
+	if err != nil && !IsNotFound(err) {
+		return SignedURLResponse{}, err
+	}
+
+	if p == nil {
+		return s.RawStore.CreateSignedURL(ctx, reference, properties)
+	}
+
+	blobRef, err := s.blobReference(reference, p.Hash)
+	if err != nil {
+		return SignedURLResponse{}, err
+	}
+
+	return s.RawStore.CreateSignedURL(ctx, blobRef, properties)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func newDedupMetrics(scope promutils.Scope) *dedupMetrics {
+	return &dedupMetrics{
+		DedupHit:      scope.MustNewCounter("hit", "Number of writes and copies whose content was already stored"),
+		DedupMiss:     scope.MustNewCounter("miss", "Number of writes whose content had to be stored"),
+		BytesSaved:    scope.MustNewCounter("bytes_saved", "Number of bytes that didn't have to be written because identical content was already stored"),
+		BlobsReleased: scope.MustNewCounter("blobs_released", "Number of blobs deleted after remaining unreferenced for the release grace period"),
+		copyMetrics:   newCopyMetrics(scope.NewSubScope("copy")),
+	}
+}
+
+// NewDedupRawStore wraps store in a content-addressed deduplicating RawStore. Use it with NewCompositeDataStore to
+// build a DataStore over an existing RawStore. Metrics are registered on the passed scope, so it must not be shared
+// with another DataStore.
+func NewDedupRawStore(cfg DedupConfig, store RawStore, scope promutils.Scope) RawStore {
+	return newDedupRawStore(cfg, store, newDedupMetrics(scope))
+}
+
+// Creates a dedupRawStore if deduplication is enabled, otherwise returns the RawStore
+func newDedupRawStore(cfg DedupConfig, store RawStore, metrics *dedupMetrics) RawStore {
+	if !cfg.Enabled {
+		return store
+	}
+
+	prefix := strings.Trim(cfg.Prefix, "/")
+	if len(prefix) == 0 {
+		prefix = defaultDedupPrefix
+	}
+
+	self := &dedupRawStore{
+		RawStore:           store,
+		prefix:             prefix,
+		releaseGracePeriod: cfg.ReleaseGracePeriod.Duration,
+		clock:              clock.RealClock{},
+		metrics:            metrics,
+	}
+
+	self.fallbackCopy = newCopyImpl(self, metrics.copyMetrics)
+	return self
+}
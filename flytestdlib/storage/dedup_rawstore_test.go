@@ -0,0 +1,280 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/errors"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func TestNewDedupRawStore(t *testing.T) {
+	store, err := NewInMemoryRawStore(context.TODO(), &Config{}, metrics)
+	assert.NoError(t, err)
+
+	t.Run("Disabled", func(t *testing.T) {
+		assert.Equal(t, store, newDedupRawStore(DedupConfig{}, store, metrics.dedupMetrics))
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		dStore := NewDedupRawStore(DedupConfig{Enabled: true}, store, promutils.NewTestScope())
+		if assert.IsType(t, &dedupRawStore{}, dStore) {
+			assert.Equal(t, defaultDedupPrefix, dStore.(*dedupRawStore).prefix)
+		}
+	})
+}
+
+// limitedRawStore refuses to read objects larger than limit, like stores configured with a download limit.
+type limitedRawStore struct {
+	RawStore
+	limit int64
+}
+
+func (s limitedRawStore) ReadRaw(ctx context.Context, reference DataReference) (io.ReadCloser, error) {
+	m, err := s.RawStore.Head(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Size() > s.limit {
+		return nil, errors.Errorf(ErrExceedsLimit, "limit exceeded. %v > %v", m.Size(), s.limit)
+	}
+
+	return s.RawStore.ReadRaw(ctx, reference)
+}
+
+func readAllRaw(t *testing.T, store RawStore, reference DataReference) []byte {
+	rc, err := store.ReadRaw(context.TODO(), reference)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+
+	defer func() {
+		assert.NoError(t, rc.Close())
+	}()
+
+	raw, err := ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+	return raw
+}
+
+func TestDedupRawStore(t *testing.T) {
+	ctx := context.TODO()
+	content := []byte("some large offloaded output")
+
+	underlying, err := NewInMemoryRawStore(ctx, &Config{}, metrics)
+	assert.NoError(t, err)
+
+	m := newDedupMetrics(promutils.NewTestScope())
+	store := newDedupRawStore(DedupConfig{
+		Enabled:            true,
+		Prefix:             "cas",
+		ReleaseGracePeriod: config.Duration{Duration: time.Hour},
+	}, limitedRawStore{RawStore: underlying, limit: 2 * dedupMaxPointerSize}, m).(*dedupRawStore)
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	store.clock = fakeClock
+
+	ref1 := DataReference("file://container/a/outputs.pb")
+	ref2 := DataReference("file://container/b/outputs.pb")
+	ref3 := DataReference("file://container/c/outputs.pb")
+	blobRef, err := store.blobReference(ref1, "")
+	assert.NoError(t, err)
+	assert.Equal(t, DataReference("file://container/cas/blobs/sha256/"), blobRef)
+
+	t.Run("Write identical content", func(t *testing.T) {
+		assert.NoError(t, store.WriteRaw(ctx, ref1, int64(len(content)), Options{}, bytes.NewReader(content)))
+		assert.NoError(t, store.WriteRaw(ctx, ref2, int64(len(content)), Options{}, bytes.NewReader(content)))
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.DedupMiss))
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.DedupHit))
+		assert.Equal(t, float64(len(content)), testutil.ToFloat64(m.BytesSaved))
+
+		assert.Equal(t, content, readAllRaw(t, store, ref1))
+		assert.Equal(t, content, readAllRaw(t, store, ref2))
+
+		// The logical reference only holds a pointer.
+		p, err := store.readPointer(ctx, ref1)
+		assert.NoError(t, err)
+		if assert.NotNil(t, p) {
+			assert.Equal(t, int64(len(content)), p.Size)
+		}
+
+		md, err := store.Head(ctx, ref1)
+		assert.NoError(t, err)
+		assert.True(t, md.Exists())
+		assert.Equal(t, int64(len(content)), md.Size())
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		assert.NoError(t, store.CopyRaw(ctx, ref1, ref3, Options{}))
+		assert.Equal(t, float64(2), testutil.ToFloat64(m.DedupHit))
+		assert.Equal(t, content, readAllRaw(t, store, ref3))
+	})
+
+	t.Run("Copy missing source", func(t *testing.T) {
+		err := store.CopyRaw(ctx, "file://container/missing", "file://container/d", Options{})
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("Passthrough regular content", func(t *testing.T) {
+		plainRef := DataReference("file://container/plain")
+		assert.NoError(t, underlying.WriteRaw(ctx, plainRef, 5, Options{}, bytes.NewReader([]byte("plain"))))
+		assert.Equal(t, []byte("plain"), readAllRaw(t, store, plainRef))
+
+		md, err := store.Head(ctx, plainRef)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), md.Size())
+	})
+
+	t.Run("Large regular content", func(t *testing.T) {
+		largeRef := DataReference("file://container/large")
+		large := bytes.Repeat([]byte("x"), int(3*dedupMaxPointerSize))
+		assert.NoError(t, underlying.WriteRaw(ctx, largeRef, int64(len(large)), Options{}, bytes.NewReader(large)))
+
+		p, err := store.readPointer(ctx, largeRef)
+		assert.NoError(t, err)
+		assert.Nil(t, p)
+
+		assert.NoError(t, store.Delete(ctx, largeRef))
+		md, err := underlying.Head(ctx, largeRef)
+		assert.NoError(t, err)
+		assert.False(t, md.Exists())
+	})
+
+	t.Run("Overwrite marks previous blob for release", func(t *testing.T) {
+		other := []byte("other content")
+		assert.NoError(t, store.WriteRaw(ctx, ref3, int64(len(other)), Options{}, bytes.NewReader(other)))
+		assert.Equal(t, other, readAllRaw(t, store, ref3))
+		assert.Equal(t, float64(0), testutil.ToFloat64(m.BlobsReleased))
+		assert.Equal(t, content, readAllRaw(t, store, ref1))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		p, err := store.readPointer(ctx, ref1)
+		assert.NoError(t, err)
+		blobRef, err := store.blobReference(ref1, p.Hash)
+		assert.NoError(t, err)
+		releaseRef, err := store.releaseReference(ref1, p.Hash)
+		assert.NoError(t, err)
+
+		assert.NoError(t, store.Delete(ctx, ref1))
+		assert.NoError(t, store.Delete(ctx, ref2))
+		md, err := store.Head(ctx, ref2)
+		assert.NoError(t, err)
+		assert.False(t, md.Exists())
+
+		// The blob is only marked for release.
+		md, err = underlying.Head(ctx, releaseRef)
+		assert.NoError(t, err)
+		assert.True(t, md.Exists())
+
+		// Writing the content again cancels the release.
+		assert.NoError(t, store.WriteRaw(ctx, ref1, int64(len(content)), Options{}, bytes.NewReader(content)))
+		md, err = underlying.Head(ctx, releaseRef)
+		assert.NoError(t, err)
+		assert.False(t, md.Exists())
+
+		assert.NoError(t, store.Delete(ctx, ref1))
+		fakeClock.Step(2 * time.Hour)
+		assert.NoError(t, store.Delete(ctx, ref3))
+
+		md, err = underlying.Head(ctx, blobRef)
+		assert.NoError(t, err)
+		assert.False(t, md.Exists())
+		md, err = underlying.Head(ctx, releaseRef)
+		assert.NoError(t, err)
+		assert.False(t, md.Exists())
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.BlobsReleased))
+	})
+}
+
+// countingRawStore counts the calls made to the store. Its Head optionally hides the metadata objects were written
+// with, like stores that can't keep it.
+type countingRawStore struct {
+	RawStore
+	heads        int
+	reads        int
+	hideMetadata bool
+}
+
+type plainMetadata struct {
+	Metadata
+}
+
+func (s *countingRawStore) Head(ctx context.Context, reference DataReference) (Metadata, error) {
+	s.heads++
+	m, err := s.RawStore.Head(ctx, reference)
+	if err != nil || !s.hideMetadata {
+		return m, err
+	}
+
+	return plainMetadata{Metadata: m}, nil
+}
+
+func (s *countingRawStore) ReadRaw(ctx context.Context, reference DataReference) (io.ReadCloser, error) {
+	s.reads++
+	return s.RawStore.ReadRaw(ctx, reference)
+}
+
+func TestDedupRawStore_Head(t *testing.T) {
+	ctx := context.TODO()
+	content := []byte("some large offloaded output")
+	ref := DataReference("file://container/a/outputs.pb")
+	plainRef := DataReference("file://container/plain")
+
+	for _, hideMetadata := range []bool{false, true} {
+		underlying, err := NewInMemoryRawStore(ctx, &Config{}, metrics)
+		assert.NoError(t, err)
+
+		counting := &countingRawStore{RawStore: underlying, hideMetadata: hideMetadata}
+		store := newDedupRawStore(DedupConfig{Enabled: true}, counting, newDedupMetrics(promutils.NewTestScope()))
+		assert.NoError(t, store.WriteRaw(ctx, ref, int64(len(content)), Options{}, bytes.NewReader(content)))
+		assert.NoError(t, underlying.WriteRaw(ctx, plainRef, 5, Options{}, bytes.NewReader([]byte("plain"))))
+
+		counting.heads, counting.reads = 0, 0
+		md, err := store.Head(ctx, ref)
+		assert.NoError(t, err)
+		assert.True(t, md.Exists())
+		assert.Equal(t, int64(len(content)), md.Size())
+
+		md, err = store.Head(ctx, plainRef)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), md.Size())
+
+		assert.Equal(t, 2, counting.heads)
+		if hideMetadata {
+			// Without the metadata, objects small enough to be pointers have to be read.
+			assert.Equal(t, 2, counting.reads)
+		} else {
+			assert.Equal(t, 0, counting.reads)
+		}
+	}
+}
+
+func TestDedupDataStore(t *testing.T) {
+	ctx := context.TODO()
+	underlying, err := NewInMemoryRawStore(ctx, &Config{}, metrics)
+	assert.NoError(t, err)
+
+	rawStore := NewDedupRawStore(DedupConfig{Enabled: true}, underlying, promutils.NewTestScope())
+	ds := NewCompositeDataStore(NewURLPathConstructor(), NewDefaultProtobufStore(rawStore, promutils.NewTestScope()))
+
+	msg := &mockProtoMessage{X: 5}
+	assert.NoError(t, ds.WriteProtobuf(ctx, "s3://container/a", Options{}, msg))
+	assert.NoError(t, ds.WriteProtobuf(ctx, "s3://container/b", Options{}, msg))
+
+	actual := &mockProtoMessage{}
+	assert.NoError(t, ds.ReadProtobuf(ctx, "s3://container/b", actual))
+	assert.Equal(t, msg.X, actual.X)
+
+	// Two pointers, two index entries and a single blob.
+	assert.Len(t, underlying.(*InMemoryStore).cache, 5)
+}
@@ -0,0 +1,597 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/flyteorg/flyte/flytestdlib/errors"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/otelutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/stow"
+)
+
+const (
+	// encryptedMagic prefixes every object written by the encryptedRawStore. Objects that do not start with it (e.g.
+	// written before encryption was enabled) are only read as is if plaintext reads are allowed.
+	encryptedMagic = "#flyte-encrypted/v1\n"
+	// plaintextSizeMetadataKey is set on encrypted objects to the size of their content, so Head doesn't have to read
+	// their envelope header.
+	plaintextSizeMetadataKey = "flytePlaintextSize"
+	// dataKeySize is the size of the per-object AES-256 data key.
+	dataKeySize = 32
+	// maxEnvelopeHeaderSize bounds the size of the envelope header to protect against corrupted objects.
+	maxEnvelopeHeaderSize = 64 * KiB
+	// encryptionChunkSize is the size of the plaintext chunks that are sealed individually, so content is encrypted
+	// and decrypted as it's streamed instead of being buffered in memory.
+	encryptionChunkSize = 64 * 1024
+	// noncePrefixSize is the size of the random prefix of the chunk nonces. The rest of the nonce holds the index of
+	// the chunk and whether it's the last one, so chunks can't be reordered or truncated.
+	noncePrefixSize = 7
+)
+
+type encryptionMetrics struct {
+	EncryptLatency promutils.StopWatch
+	DecryptLatency promutils.StopWatch
+	DecryptFailure prometheus.Counter
+}
+
+// envelopeHeader is stored in front of the ciphertext of every encrypted object. It carries everything needed to
+// decrypt the object except for the key encryption key, which is only known to the KeyProvider.
+type envelopeHeader struct {
+	KeyID       string `json:"keyId"`
+	WrappedKey  []byte `json:"wrappedKey"`
+	NoncePrefix []byte `json:"noncePrefix"`
+	ChunkSize   int    `json:"chunkSize"`
+}
+
+// encryptedRawStore is a RawStore decorator that does client-side envelope encryption. Each object is encrypted with
+// its own random AES-GCM data key which is in turn wrapped by a KeyProvider and stored alongside the ciphertext. Since
+// encrypted objects are self-contained, copying them byte-for-byte (e.g. CopyRaw) keeps them readable.
+//
+// Content is split in chunks that are sealed individually (the STREAM construction), the header is authenticated as
+// the additional data of every chunk.
+type encryptedRawStore struct {
+	RawStore
+	keyProvider KeyProvider
+	// allowPlaintextReads reads objects that aren't encrypted as is, instead of failing with ErrDecryptionFailed. It's
+	// only meant to migrate stores that were written to before encryption was enabled.
+	allowPlaintextReads bool
+	metrics             *encryptionMetrics
+}
+
+// envelope holds what's needed to seal or open the chunks of an encrypted object.
+type envelope struct {
+	aead        cipher.AEAD
+	header      []byte
+	noncePrefix []byte
+	chunkSize   int
+}
+
+// prefixSize returns the number of bytes in front of the first chunk.
+func (e envelope) prefixSize() int64 {
+	return int64(len(encryptedMagic) + 4 + len(e.header))
+}
+
+// ciphertextSize returns the size of the encrypted object for plaintextSize bytes of content.
+func (e envelope) ciphertextSize(plaintextSize int64) int64 {
+	chunks := (plaintextSize + int64(e.chunkSize) - 1) / int64(e.chunkSize)
+	if chunks == 0 {
+		// Empty content is still sealed as a single (last) chunk.
+		chunks = 1
+	}
+
+	return e.prefixSize() + plaintextSize + chunks*int64(e.aead.Overhead())
+}
+
+// plaintextSize returns the size of the content of an encrypted object of ciphertextSize bytes.
+func (e envelope) plaintextSize(ciphertextSize int64) int64 {
+	sealedSize := ciphertextSize - e.prefixSize()
+	sealedChunkSize := int64(e.chunkSize + e.aead.Overhead())
+	chunks := (sealedSize + sealedChunkSize - 1) / sealedChunkSize
+	return sealedSize - chunks*int64(e.aead.Overhead())
+}
+
+// nonce returns the nonce of the chunk at index.
+func (e envelope) nonce(index uint32, last bool) []byte {
+	nonce := make([]byte, e.aead.NonceSize())
+	copy(nonce, e.noncePrefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], index)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+
+	return nonce
+}
+
+func (s *encryptedRawStore) newEnvelope(ctx context.Context) (envelope, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return envelope{}, err
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return envelope{}, err
+	}
+
+	noncePrefix := make([]byte, noncePrefixSize)
+	if _, err = io.ReadFull(rand.Reader, noncePrefix); err != nil {
+		return envelope{}, err
+	}
+
+	keyID, wrappedKey, err := s.keyProvider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return envelope{}, fmt.Errorf("failed to wrap data key. Error: %w", err)
+	}
+
+	header, err := json.Marshal(envelopeHeader{
+		KeyID:       keyID,
+		WrappedKey:  wrappedKey,
+		NoncePrefix: noncePrefix,
+		ChunkSize:   encryptionChunkSize,
+	})
+	if err != nil {
+		return envelope{}, err
+	}
+
+	return envelope{
+		aead:        gcm,
+		header:      header,
+		noncePrefix: noncePrefix,
+		chunkSize:   encryptionChunkSize,
+	}, nil
+}
+
+// readEnvelope reads the envelope header of an encrypted object, r must be positioned at its magic.
+func (s *encryptedRawStore) readEnvelope(ctx context.Context, r *bufio.Reader) (envelope, error) {
+	if _, err := r.Discard(len(encryptedMagic)); err != nil {
+		return envelope{}, err
+	}
+
+	var headerLen uint32
+	if err := binary.Read(r, binary.BigEndian, &headerLen); err != nil {
+		return envelope{}, err
+	}
+
+	if headerLen > uint32(maxEnvelopeHeaderSize) {
+		return envelope{}, fmt.Errorf("envelope header size [%v] exceeds the maximum allowed [%v]", headerLen, maxEnvelopeHeaderSize)
+	}
+
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return envelope{}, err
+	}
+
+	h := envelopeHeader{}
+	if err := json.Unmarshal(header, &h); err != nil {
+		return envelope{}, fmt.Errorf("failed to unmarshal envelope header. Error: %w", err)
+	}
+
+	if h.ChunkSize <= 0 || len(h.NoncePrefix) != noncePrefixSize {
+		return envelope{}, fmt.Errorf("invalid envelope header")
+	}
+
+	dataKey, err := s.keyProvider.UnwrapKey(ctx, h.KeyID, h.WrappedKey)
+	if err != nil {
+		return envelope{}, fmt.Errorf("failed to unwrap data key [%v]. Error: %w", h.KeyID, err)
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return envelope{}, err
+	}
+
+	return envelope{
+		aead:        gcm,
+		header:      header,
+		noncePrefix: h.NoncePrefix,
+		chunkSize:   h.ChunkSize,
+	}, nil
+}
+
+// isEncrypted peeks at the beginning of r to check whether it holds encrypted content.
+func isEncrypted(r *bufio.Reader) (bool, error) {
+	magic, err := r.Peek(len(encryptedMagic))
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	return string(magic) == encryptedMagic, nil
+}
+
+// encryptingReader streams the encrypted object for the content read from src.
+type encryptingReader struct {
+	envelope
+	src       *bufio.Reader
+	plaintext []byte
+	sealed    []byte
+	pending   []byte
+	index     uint32
+	done      bool
+}
+
+func newEncryptingReader(e envelope, src io.Reader) (*encryptingReader, error) {
+	var prefix bytes.Buffer
+	prefix.WriteString(encryptedMagic)
+	if err := binary.Write(&prefix, binary.BigEndian, uint32(len(e.header))); err != nil {
+		return nil, err
+	}
+
+	prefix.Write(e.header)
+	return &encryptingReader{
+		envelope:  e,
+		src:       bufio.NewReader(src),
+		plaintext: make([]byte, e.chunkSize),
+		pending:   prefix.Bytes(),
+	}, nil
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		if err := r.sealChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *encryptingReader) sealChunk() error {
+	n, err := io.ReadFull(r.src, r.plaintext)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	last := n < len(r.plaintext)
+	if !last {
+		// A full chunk is only the last one if nothing follows it.
+		if _, err = r.src.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if !last && r.index == math.MaxUint32 {
+		return fmt.Errorf("content exceeds the maximum number of encrypted chunks")
+	}
+
+	r.sealed = r.aead.Seal(r.sealed[:0], r.nonce(r.index, last), r.plaintext[:n], r.header)
+	r.pending = r.sealed
+	r.index++
+	r.done = last
+	return nil
+}
+
+// decryptingReader streams the content of the encrypted object read from src, which must be positioned at the first
+// chunk.
+type decryptingReader struct {
+	envelope
+	reference DataReference
+	src       *bufio.Reader
+	sealed    []byte
+	plaintext []byte
+	pending   []byte
+	index     uint32
+	done      bool
+	metrics   *encryptionMetrics
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		if err := r.openChunk(); err != nil {
+			r.metrics.DecryptFailure.Inc()
+			return 0, errors.Wrapf(ErrDecryptionFailed, err, "path:%v", r.reference)
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *decryptingReader) openChunk() error {
+	n, err := io.ReadFull(r.src, r.sealed)
+	if err == io.EOF {
+		return fmt.Errorf("encrypted content is truncated")
+	} else if err != nil && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	last := n < len(r.sealed)
+	if !last {
+		if _, err = r.src.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	r.plaintext, err = r.aead.Open(r.plaintext[:0], r.nonce(r.index, last), r.sealed[:n], r.header)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt content. Error: %w", err)
+	}
+
+	r.pending = r.plaintext
+	r.index++
+	r.done = last
+	return nil
+}
+
+// encryptedMetadata reports the size of the content of encrypted objects.
+type encryptedMetadata struct {
+	Metadata
+	size int64
+}
+
+func (m encryptedMetadata) Size() int64 {
+	return m.size
+}
+
+// encryptedUserMetadata reports the size of the content of encrypted objects written to stores that keep the metadata
+// objects are written with.
+type encryptedUserMetadata struct {
+	encryptedMetadata
+	UserMetadata
+}
+
+// Head gets metadata about the reference. The size of encrypted objects is the size of their content, which is
+// recorded in their metadata. For stores that can't keep the metadata of objects, it's computed from the envelope
+// header at the beginning of the object, objects larger than the download limit can't be inspected and their size is
+// reported as is.
+func (s *encryptedRawStore) Head(ctx context.Context, reference DataReference) (Metadata, error) {
+	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.encryptedRawStore/Head")
+	defer span.End()
+
+	m, err := s.RawStore.Head(ctx, reference)
+	if err != nil || !m.Exists() {
+		return m, err
+	}
+
+	if um, ok := m.(UserMetadata); ok {
+		size, found := um.UserMetadataValue(plaintextSizeMetadataKey)
+		if !found {
+			return m, nil
+		}
+
+		plaintextSize, err := strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ErrDecryptionFailed, err, "path:%v", reference)
+		}
+
+		return encryptedUserMetadata{
+			encryptedMetadata: encryptedMetadata{Metadata: m, size: plaintextSize},
+			UserMetadata:      um,
+		}, nil
+	}
+
+	rc, err := s.RawStore.ReadRaw(ctx, reference)
+	if err != nil {
+		if IsExceedsLimit(err) {
+			return m, nil
+		}
+
+		return nil, err
+	}
+
+	defer func() {
+		if err := rc.Close(); err != nil {
+			logger.Warnf(ctx, "Failed to close reader [%v]. Error: %v", reference, err)
+		}
+	}()
+
+	// Only the envelope header is read before the object is closed.
+	br := bufio.NewReaderSize(rc, len(encryptedMagic))
+	encrypted, err := isEncrypted(br)
+	if err != nil || !encrypted {
+		return m, err
+	}
+
+	e, err := s.readEnvelope(ctx, br)
+	if err != nil {
+		return nil, errors.Wrapf(ErrDecryptionFailed, err, "path:%v", reference)
+	}
+
+	return encryptedMetadata{Metadata: m, size: e.plaintextSize(m.Size())}, nil
+}
+
+// ReadRaw retrieves a byte array from the Blob store or an error. Encrypted content is decrypted as it's read, a
+// reader fails with ErrDecryptionFailed if the content was tampered with. Reading content that isn't encrypted fails
+// with ErrDecryptionFailed unless plaintext reads are allowed, so encrypted objects can't be substituted with
+// plaintext.
+func (s *encryptedRawStore) ReadRaw(ctx context.Context, reference DataReference) (io.ReadCloser, error) {
+	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.encryptedRawStore/ReadRaw")
+	defer span.End()
+
+	rc, err := s.RawStore.ReadRaw(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(rc)
+	encrypted, err := isEncrypted(br)
+	if err == nil && !encrypted {
+		if s.allowPlaintextReads {
+			return readCloser{Reader: br, Closer: rc}, nil
+		}
+
+		s.metrics.DecryptFailure.Inc()
+		err = errors.Errorf(ErrDecryptionFailed, "path:%v is not encrypted", reference)
+	}
+
+	if err == nil && encrypted {
+		t := s.metrics.DecryptLatency.Start()
+		var e envelope
+		e, err = s.readEnvelope(ctx, br)
+		t.Stop()
+		if err == nil {
+			return readCloser{
+				Reader: &decryptingReader{
+					envelope:  e,
+					reference: reference,
+					src:       br,
+					sealed:    make([]byte, e.chunkSize+e.aead.Overhead()),
+					metrics:   s.metrics,
+				},
+				Closer: rc,
+			}, nil
+		}
+
+		s.metrics.DecryptFailure.Inc()
+		err = errors.Wrapf(ErrDecryptionFailed, err, "path:%v", reference)
+	}
+
+	if closeErr := rc.Close(); closeErr != nil {
+		logger.Warnf(ctx, "Failed to close reader [%v]. Error: %v", reference, closeErr)
+	}
+
+	return nil, err
+}
+
+// WriteRaw encrypts and stores a raw byte array. Content is encrypted as it's streamed to the underlying store, and its
+// size is recorded in the metadata of the object.
+func (s *encryptedRawStore) WriteRaw(ctx context.Context, reference DataReference, size int64, opts Options, raw io.Reader) error {
+	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.encryptedRawStore/WriteRaw")
+	defer span.End()
+
+	if seeker, isSeeker := raw.(io.Seeker); isSeeker && size <= 0 {
+		// Like the underlying stores, rely on Seekers to report the size of the content when it isn't passed.
+		var err error
+		if size, err = remainingSize(seeker); err != nil {
+			return err
+		}
+	}
+
+	if size < 0 {
+		// The envelope records the size of the ciphertext up front, content of unknown size can't be streamed through.
+		return fmt.Errorf("failed to write [%v], encrypting content of unknown size is not supported", reference)
+	}
+
+	t := s.metrics.EncryptLatency.Start()
+	e, err := s.newEnvelope(ctx)
+	t.Stop()
+	if err != nil {
+		return err
+	}
+
+	r, err := newEncryptingReader(e, io.LimitReader(raw, size))
+	if err != nil {
+		return err
+	}
+
+	metadata := make(map[string]interface{}, len(opts.Metadata)+1)
+	for k, v := range opts.Metadata {
+		metadata[k] = v
+	}
+
+	metadata[plaintextSizeMetadataKey] = strconv.FormatInt(size, 10)
+	return s.RawStore.WriteRaw(ctx, reference, e.ciphertextSize(size), Options{Metadata: metadata}, r)
+}
+
+// remainingSize returns the number of bytes between the current offset of seeker and its end.
+func remainingSize(seeker io.Seeker) (int64, error) {
+	offset, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+
+	end, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = seeker.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return end - offset, nil
+}
+
+// CreateSignedURL creates a signed url with the provided properties. Objects are encrypted client-side so signed URLs
+// can only be used to upload new (unencrypted) content, which is only readable if plaintext reads are allowed.
+func (s *encryptedRawStore) CreateSignedURL(ctx context.Context, reference DataReference, properties SignedURLProperties) (SignedURLResponse, error) {
+	if properties.Scope == stow.ClientMethodGet {
+		return SignedURLResponse{}, fmt.Errorf("signed urls to read client-side encrypted data are not supported")
+	}
+
+	if !s.allowPlaintextReads {
+		return SignedURLResponse{}, fmt.Errorf("signed urls to upload unencrypted data are only supported if plaintext reads are allowed")
+	}
+
+	return s.RawStore.CreateSignedURL(ctx, reference, properties)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func newEncryptionMetrics(scope promutils.Scope) *encryptionMetrics {
+	return &encryptionMetrics{
+		EncryptLatency: scope.MustNewStopWatch("encrypt", "Time to generate and wrap the data key of content before writing it", time.Millisecond),
+		DecryptLatency: scope.MustNewStopWatch("decrypt", "Time to unwrap the data key of content before reading it", time.Millisecond),
+		DecryptFailure: scope.MustNewCounter("decrypt_failure", "Number of objects that failed to decrypt"),
+	}
+}
+
+// NewEncryptedRawStore wraps store in a RawStore that encrypts content on write and decrypts it on read using keys
+// wrapped by keyProvider. Content that isn't encrypted can't be read. Use it with NewCompositeDataStore to build a DataStore over an existing RawStore.
+func NewEncryptedRawStore(store RawStore, keyProvider KeyProvider, scope promutils.Scope) RawStore {
+	return &encryptedRawStore{
+		RawStore:    store,
+		keyProvider: keyProvider,
+		metrics:     newEncryptionMetrics(scope),
+	}
+}
+
+// Creates an encryptedRawStore if encryption is enabled, otherwise returns the RawStore
+func newEncryptedRawStore(ctx context.Context, cfg EncryptionConfig, store RawStore, metrics *encryptionMetrics) (RawStore, error) {
+	if !cfg.Enabled {
+		return store, nil
+	}
+
+	fn, found := keyProviders[cfg.KeyProvider]
+	if !found {
+		return nil, fmt.Errorf("key provider is of an invalid value [%v]", cfg.KeyProvider)
+	}
+
+	keyProvider, err := fn(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptedRawStore{
+		RawStore:            store,
+		keyProvider:         keyProvider,
+		allowPlaintextReads: cfg.AllowPlaintextReads,
+		metrics:             metrics,
+	}, nil
+}
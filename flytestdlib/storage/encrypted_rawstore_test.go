@@ -0,0 +1,248 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/stow"
+)
+
+type failingKeyProvider struct{}
+
+func (failingKeyProvider) WrapKey(context.Context, []byte) (string, []byte, error) {
+	return "", nil, fmt.Errorf("kms unavailable")
+}
+
+func (failingKeyProvider) UnwrapKey(context.Context, string, []byte) ([]byte, error) {
+	return nil, fmt.Errorf("kms unavailable")
+}
+
+func writeTestKeyFile(t *testing.T, dir string) string {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	assert.NoError(t, err)
+
+	path := filepath.Join(dir, "kek")
+	assert.NoError(t, os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+	return path
+}
+
+func TestNewFileKeyProvider(t *testing.T) {
+	ctx := context.TODO()
+	tmpDir, err := ioutil.TempDir("", "stdlib_kek")
+	assert.NoError(t, err)
+
+	t.Run("Wrap and unwrap", func(t *testing.T) {
+		p, err := NewFileKeyProvider(writeTestKeyFile(t, tmpDir))
+		assert.NoError(t, err)
+
+		keyID, wrapped, err := p.WrapKey(ctx, []byte("data key"))
+		assert.NoError(t, err)
+		assert.NotContains(t, string(wrapped), "data key")
+
+		unwrapped, err := p.UnwrapKey(ctx, keyID, wrapped)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("data key"), unwrapped)
+
+		_, err = p.UnwrapKey(ctx, "file:other", wrapped)
+		assert.Error(t, err)
+	})
+
+	t.Run("Missing file", func(t *testing.T) {
+		_, err := NewFileKeyProvider(filepath.Join(tmpDir, "missing"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Wrong key size", func(t *testing.T) {
+		path := filepath.Join(tmpDir, "short")
+		assert.NoError(t, os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString([]byte("short"))), 0600))
+		_, err := NewFileKeyProvider(path)
+		assert.Error(t, err)
+	})
+}
+
+func TestEncryptedRawStore(t *testing.T) {
+	ctx := context.TODO()
+	tmpDir, err := ioutil.TempDir("", "stdlib_encrypted")
+	assert.NoError(t, err)
+
+	underlying, err := NewInMemoryRawStore(ctx, &Config{}, metrics)
+	assert.NoError(t, err)
+
+	keyProvider, err := NewFileKeyProvider(writeTestKeyFile(t, tmpDir))
+	assert.NoError(t, err)
+
+	store := NewEncryptedRawStore(underlying, keyProvider, promutils.NewTestScope())
+	content := []byte("regulated data")
+	ref := DataReference("file://container/a/data")
+
+	t.Run("Read after write", func(t *testing.T) {
+		assert.NoError(t, store.WriteRaw(ctx, ref, int64(len(content)), Options{}, bytes.NewReader(content)))
+		assert.Equal(t, content, readAllRaw(t, store, ref))
+
+		// Content at rest is encrypted.
+		raw := readAllRaw(t, underlying, ref)
+		assert.NotContains(t, string(raw), string(content))
+	})
+
+	t.Run("Content of unknown size", func(t *testing.T) {
+		unknownSizeRef := DataReference("file://container/a/unknown")
+		err := store.WriteRaw(ctx, unknownSizeRef, -1, Options{}, io.MultiReader(bytes.NewReader(content)))
+		assert.Error(t, err)
+		metadata, err := underlying.Head(ctx, unknownSizeRef)
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		dst := DataReference("file://container/b/data")
+		assert.NoError(t, store.CopyRaw(ctx, ref, dst, Options{}))
+		assert.Equal(t, content, readAllRaw(t, store, dst))
+	})
+
+	t.Run("Unencrypted content", func(t *testing.T) {
+		plainRef := DataReference("file://container/plain")
+		assert.NoError(t, underlying.WriteRaw(ctx, plainRef, 5, Options{}, bytes.NewReader([]byte("plain"))))
+		_, err := store.ReadRaw(ctx, plainRef)
+		assert.True(t, IsDecryptionFailed(err))
+
+		migrating := NewEncryptedRawStore(underlying, keyProvider, promutils.NewTestScope())
+		migrating.(*encryptedRawStore).allowPlaintextReads = true
+		assert.Equal(t, []byte("plain"), readAllRaw(t, migrating, plainRef))
+		assert.Equal(t, content, readAllRaw(t, migrating, ref))
+	})
+
+	t.Run("Tampered content", func(t *testing.T) {
+		raw := readAllRaw(t, underlying, ref)
+		raw[len(raw)-1] ^= 0xff
+		tamperedRef := DataReference("file://container/tampered")
+		assert.NoError(t, underlying.WriteRaw(ctx, tamperedRef, int64(len(raw)), Options{}, bytes.NewReader(raw)))
+
+		rc, err := store.ReadRaw(ctx, tamperedRef)
+		assert.NoError(t, err)
+		_, err = ioutil.ReadAll(rc)
+		assert.True(t, IsDecryptionFailed(err))
+		assert.NoError(t, rc.Close())
+	})
+
+	t.Run("Chunked content", func(t *testing.T) {
+		for _, size := range []int{0, encryptionChunkSize, 2*encryptionChunkSize + 5} {
+			large := make([]byte, size)
+			_, err := rand.Read(large)
+			assert.NoError(t, err)
+
+			largeRef := DataReference(fmt.Sprintf("file://container/large/%v", size))
+			assert.NoError(t, store.WriteRaw(ctx, largeRef, int64(len(large)), Options{}, bytes.NewReader(large)))
+			assert.Equal(t, large, readAllRaw(t, store, largeRef))
+
+			md, err := store.Head(ctx, largeRef)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(size), md.Size())
+		}
+	})
+
+	t.Run("Truncated content", func(t *testing.T) {
+		large := bytes.Repeat([]byte("x"), 2*encryptionChunkSize+5)
+		largeRef := DataReference("file://container/large/truncated")
+		assert.NoError(t, store.WriteRaw(ctx, largeRef, int64(len(large)), Options{}, bytes.NewReader(large)))
+
+		raw := readAllRaw(t, underlying, largeRef)
+		raw = raw[:len(raw)-5-16]
+		truncatedRef := DataReference("file://container/truncated")
+		assert.NoError(t, underlying.WriteRaw(ctx, truncatedRef, int64(len(raw)), Options{}, bytes.NewReader(raw)))
+
+		rc, err := store.ReadRaw(ctx, truncatedRef)
+		assert.NoError(t, err)
+		_, err = ioutil.ReadAll(rc)
+		assert.True(t, IsDecryptionFailed(err))
+		assert.NoError(t, rc.Close())
+	})
+
+	t.Run("Head", func(t *testing.T) {
+		md, err := store.Head(ctx, ref)
+		assert.NoError(t, err)
+		assert.True(t, md.Exists())
+		assert.Equal(t, int64(len(content)), md.Size())
+
+		md, err = store.Head(ctx, "file://container/plain")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), md.Size())
+
+		md, err = store.Head(ctx, "file://container/missing")
+		assert.NoError(t, err)
+		assert.False(t, md.Exists())
+
+		// The size is read from the metadata of the object, or from its envelope header if the store doesn't keep it.
+		for _, hideMetadata := range []bool{false, true} {
+			counting := &countingRawStore{RawStore: underlying, hideMetadata: hideMetadata}
+			md, err = NewEncryptedRawStore(counting, keyProvider, promutils.NewTestScope()).Head(ctx, ref)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(content)), md.Size())
+			assert.Equal(t, 1, counting.heads)
+			if hideMetadata {
+				assert.Equal(t, 1, counting.reads)
+			} else {
+				assert.Equal(t, 0, counting.reads)
+			}
+		}
+	})
+
+	t.Run("Different key", func(t *testing.T) {
+		otherProvider, err := NewFileKeyProvider(writeTestKeyFile(t, t.TempDir()))
+		assert.NoError(t, err)
+
+		_, err = NewEncryptedRawStore(underlying, otherProvider, promutils.NewTestScope()).ReadRaw(ctx, ref)
+		assert.True(t, IsDecryptionFailed(err))
+	})
+
+	t.Run("Signed URL", func(t *testing.T) {
+		_, err := store.CreateSignedURL(ctx, ref, SignedURLProperties{Scope: stow.ClientMethodGet})
+		assert.Error(t, err)
+		_, err = store.CreateSignedURL(ctx, ref, SignedURLProperties{Scope: stow.ClientMethodPut})
+		assert.Error(t, err)
+	})
+
+	t.Run("Key provider failure", func(t *testing.T) {
+		s := NewEncryptedRawStore(underlying, failingKeyProvider{}, promutils.NewTestScope())
+		assert.Error(t, s.WriteRaw(ctx, ref, int64(len(content)), Options{}, bytes.NewReader(content)))
+	})
+}
+
+func TestEncryptedDataStore(t *testing.T) {
+	ctx := context.TODO()
+	cfg := &Config{
+		Type: TypeMemory,
+		Encryption: EncryptionConfig{
+			Enabled:     true,
+			KeyProvider: KeyProviderFile,
+			File: FileKeyProviderConfig{
+				KeyPath: writeTestKeyFile(t, t.TempDir()),
+			},
+		},
+	}
+
+	s, err := NewDataStore(cfg, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.WriteProtobuf(ctx, "s3://container/msg", Options{}, &mockProtoMessage{X: 5}))
+	m := &mockProtoMessage{}
+	assert.NoError(t, s.ReadProtobuf(ctx, "s3://container/msg", m))
+	assert.Equal(t, int64(5), m.X)
+
+	t.Run("Unknown key provider", func(t *testing.T) {
+		cfg.Encryption.KeyProvider = "unknown"
+		_, err := NewDataStore(cfg, promutils.NewTestScope())
+		assert.Error(t, err)
+	})
+}
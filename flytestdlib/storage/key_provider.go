@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	KeyProviderFile = "file"
+)
+
+//go:generate mockery -name KeyProvider -case=underscore
+
+// KeyProvider wraps and unwraps the per-object data keys used by the encrypting RawStore. Implementations typically
+// delegate to a KMS so that the key encryption key never leaves it.
+type KeyProvider interface {
+	// WrapKey encrypts dataKey and returns the encrypted key along with an identifier of the key used to encrypt it.
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrappedKey []byte, err error)
+
+	// UnwrapKey decrypts wrappedKey using the key identified by keyID.
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) (dataKey []byte, err error)
+}
+
+type keyProviderCreateFn func(ctx context.Context, cfg EncryptionConfig) (KeyProvider, error)
+
+var keyProviders = map[string]keyProviderCreateFn{
+	KeyProviderFile: newFileKeyProvider,
+}
+
+// RegisterKeyProvider registers a new kind of KeyProvider that can be selected through EncryptionConfig.
+func RegisterKeyProvider(kind string, f func(ctx context.Context, cfg EncryptionConfig) (KeyProvider, error)) error {
+	if _, ok := keyProviders[kind]; ok {
+		return fmt.Errorf("key provider [%v] already registered", kind)
+	}
+
+	keyProviders[kind] = f
+	return nil
+}
+
+// FileKeyProvider wraps data keys with an AES-256 key read from a file (e.g. a mounted k8s secret). The file must
+// contain the base64 encoded 32 bytes key.
+type FileKeyProvider struct {
+	keyID string
+	key   []byte
+}
+
+func (f FileKeyProvider) WrapKey(_ context.Context, dataKey []byte) (keyID string, wrappedKey []byte, err error) {
+	gcm, err := newGCM(f.key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, err
+	}
+
+	return f.keyID, gcm.Seal(nonce, nonce, dataKey, []byte(f.keyID)), nil
+}
+
+func (f FileKeyProvider) UnwrapKey(_ context.Context, keyID string, wrappedKey []byte) (dataKey []byte, err error) {
+	if keyID != f.keyID {
+		return nil, fmt.Errorf("data key was wrapped with key [%v] but the configured key is [%v]", keyID, f.keyID)
+	}
+
+	gcm, err := newGCM(f.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrappedKey) < gcm.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+
+	nonce, ciphertext := wrappedKey[:gcm.NonceSize()], wrappedKey[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, []byte(keyID))
+}
+
+// NewFileKeyProvider creates a FileKeyProvider that reads the key encryption key from path. The key is identified by
+// a fingerprint of its content so that objects encrypted with a different key fail with a clear error.
+func NewFileKeyProvider(path string) (FileKeyProvider, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return FileKeyProvider{}, fmt.Errorf("failed to read key file [%v]. Error: %w", path, err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return FileKeyProvider{}, fmt.Errorf("failed to decode key file [%v]. Error: %w", path, err)
+	}
+
+	if len(key) != dataKeySize {
+		return FileKeyProvider{}, fmt.Errorf("key in file [%v] must be %v bytes long, found %v", path, dataKeySize, len(key))
+	}
+
+	fingerprint := sha256.Sum256(key)
+	return FileKeyProvider{
+		keyID: KeyProviderFile + ":" + hex.EncodeToString(fingerprint[:8]),
+		key:   key,
+	}, nil
+}
+
+func newFileKeyProvider(_ context.Context, cfg EncryptionConfig) (KeyProvider, error) {
+	return NewFileKeyProvider(cfg.File.KeyPath)
+}
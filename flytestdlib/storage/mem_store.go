@@ -17,8 +17,9 @@ type rawFile = []byte
 
 type InMemoryStore struct {
 	copyImpl
-	cache   map[DataReference]rawFile
-	rwMutex sync.RWMutex
+	cache    map[DataReference]rawFile
+	metadata map[DataReference]map[string]interface{}
+	rwMutex  sync.RWMutex
 }
 
 type MemoryMetadata struct {
@@ -26,6 +27,7 @@ type MemoryMetadata struct {
 	size       int64
 	etag       string
 	contentMD5 string
+	metadata   map[string]interface{}
 }
 
 func (m MemoryMetadata) Size() int64 {
@@ -44,6 +46,11 @@ func (m MemoryMetadata) ContentMD5() string {
 	return m.contentMD5
 }
 
+func (m MemoryMetadata) UserMetadataValue(key string) (string, bool) {
+	value, ok := m.metadata[key].(string)
+	return value, ok
+}
+
 func (s *InMemoryStore) Head(ctx context.Context, reference DataReference) (Metadata, error) {
 	s.rwMutex.RLock()
 	defer s.rwMutex.RUnlock()
@@ -56,7 +63,8 @@ func (s *InMemoryStore) Head(ctx context.Context, reference DataReference) (Meta
 
 	return MemoryMetadata{
 		exists: found, size: int64(len(data)),
-		etag: hex.EncodeToString(hash[:]),
+		etag:     hex.EncodeToString(hash[:]),
+		metadata: s.metadata[reference],
 	}, nil
 }
 
@@ -98,6 +106,7 @@ func (s *InMemoryStore) Delete(ctx context.Context, reference DataReference) err
 	}
 
 	delete(s.cache, reference)
+	delete(s.metadata, reference)
 
 	return nil
 }
@@ -113,6 +122,7 @@ func (s *InMemoryStore) WriteRaw(ctx context.Context, reference DataReference, s
 	}
 
 	s.cache[reference] = rawBytes
+	s.metadata[reference] = opts.Metadata
 	return nil
 }
 
@@ -121,6 +131,7 @@ func (s *InMemoryStore) Clear(ctx context.Context) error {
 	defer s.rwMutex.Unlock()
 
 	s.cache = map[DataReference]rawFile{}
+	s.metadata = map[DataReference]map[string]interface{}{}
 	return nil
 }
 
@@ -135,7 +146,8 @@ func (s *InMemoryStore) CreateSignedURL(ctx context.Context, reference DataRefer
 
 func NewInMemoryRawStore(_ context.Context, _ *Config, metrics *dataStoreMetrics) (RawStore, error) {
 	self := &InMemoryStore{
-		cache: map[DataReference]rawFile{},
+		cache:    map[DataReference]rawFile{},
+		metadata: map[DataReference]map[string]interface{}{},
 	}
 
 	self.copyImpl = newCopyImpl(self, metrics.copyMetrics)
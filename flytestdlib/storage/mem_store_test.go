@@ -27,6 +27,22 @@ func TestInMemoryStore_Head(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, metadata.Exists())
 	})
+
+	t.Run("User metadata", func(t *testing.T) {
+		s, err := NewInMemoryRawStore(context.TODO(), &Config{}, metrics)
+		assert.NoError(t, err)
+		err = s.WriteRaw(context.TODO(), DataReference("hello"), 0, Options{Metadata: map[string]interface{}{"key": "value"}},
+			bytes.NewReader([]byte{}))
+		assert.NoError(t, err)
+
+		metadata, err := s.Head(context.TODO(), DataReference("hello"))
+		assert.NoError(t, err)
+		if assert.Implements(t, (*UserMetadata)(nil), metadata) {
+			value, ok := metadata.(UserMetadata).UserMetadataValue("key")
+			assert.True(t, ok)
+			assert.Equal(t, "value", value)
+		}
+	})
 }
 
 func TestInMemoryStore_ReadRaw(t *testing.T) {
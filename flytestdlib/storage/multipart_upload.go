@@ -0,0 +1,207 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	errs "github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/stow"
+	"github.com/flyteorg/stow/azure"
+	"github.com/flyteorg/stow/google"
+	"github.com/flyteorg/stow/s3"
+)
+
+const (
+	// The smallest part S3 accepts for all but the last part of an upload.
+	minPartSizeMegabytes = 5
+	// The largest number of parts S3 accepts for a single upload.
+	maxParts = 10000
+)
+
+// MultipartUpload is a single in-progress upload of an object in parts. Parts can be uploaded concurrently and in any
+// order, the object is assembled from the parts in the order of their numbers once the upload is completed.
+type MultipartUpload interface {
+	// UploadPart uploads the part with the given 1-based number.
+	UploadPart(ctx context.Context, partNumber int, part []byte) error
+	// Complete assembles the object from all the uploaded parts.
+	Complete(ctx context.Context) error
+	// Abort discards the upload along with its uploaded parts.
+	Abort(ctx context.Context) error
+}
+
+// MultipartUploader starts multipart uploads against the native API of a stow backend.
+type MultipartUploader interface {
+	CreateUpload(ctx context.Context, container, key string, metadata map[string]interface{}) (MultipartUpload, error)
+}
+
+// MultipartUploaderFn creates the MultipartUploader for a stow location given the config the location was dialed with.
+type MultipartUploaderFn func(ctx context.Context, loc stow.Location, cfg stow.ConfigMap) (MultipartUploader, error)
+
+var multipartUploaderFn = map[string]MultipartUploaderFn{
+	s3.Kind:     newS3MultipartUploader,
+	google.Kind: newGCSMultipartUploader,
+	azure.Kind:  newAzureMultipartUploader,
+}
+
+// RegisterMultipartUploader registers the multipart uploader of a stow kind.
+func RegisterMultipartUploader(kind string, f MultipartUploaderFn) error {
+	if _, ok := multipartUploaderFn[kind]; ok {
+		return fmt.Errorf("multipart uploader for kind [%v] already registered", kind)
+	}
+
+	multipartUploaderFn[kind] = f
+	return nil
+}
+
+// multipartWriter streams a reader to a MultipartUploader, uploading up to parallelism parts concurrently.
+type multipartWriter struct {
+	uploader    MultipartUploader
+	partSize    int64
+	parallelism int
+}
+
+// newMultipartWriter creates the multipart writer for the stow kind, nil if the kind doesn't support multipart uploads.
+func newMultipartWriter(ctx context.Context, kind string, loc stow.Location, cfgMap stow.ConfigMap, cfg MultipartUploadConfig) (*multipartWriter, error) {
+	if cfg.PartSizeMegabytes < minPartSizeMegabytes {
+		return nil, fmt.Errorf("part size of %vMB is smaller than the minimum of %vMB", cfg.PartSizeMegabytes, minPartSizeMegabytes)
+	}
+
+	if cfg.Parallelism < 1 {
+		return nil, fmt.Errorf("parallelism has to be at least 1, found %v", cfg.Parallelism)
+	}
+
+	fn, ok := multipartUploaderFn[kind]
+	if !ok {
+		logger.Infof(ctx, "Multipart uploads are not supported by stow kind [%v], falling back to single uploads", kind)
+		return nil, nil
+	}
+
+	uploader, err := fn(ctx, loc, cfgMap)
+	if err != nil || uploader == nil {
+		return nil, err
+	}
+
+	return &multipartWriter{
+		uploader:    uploader,
+		partSize:    cfg.PartSizeMegabytes * MiB,
+		parallelism: cfg.Parallelism,
+	}, nil
+}
+
+// write uploads everything read from raw as the object at key and returns the number of uploaded parts.
+func (w multipartWriter) write(ctx context.Context, container, key string, metadata map[string]interface{}, raw io.Reader) (int, error) {
+	upload, err := w.uploader.CreateUpload(ctx, container, key, metadata)
+	if err != nil {
+		return 0, errs.Wrapf(err, "failed to create multipart upload of [%v]", key)
+	}
+
+	// Every part in flight holds on to its buffer, one more is needed for the part being read.
+	buffers := make(chan []byte, w.parallelism+1)
+	for i := 0; i < cap(buffers); i++ {
+		buffers <- make([]byte, w.partSize)
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(w.parallelism)
+
+	partNumber := 0
+	var readErr error
+	for readErr == nil && groupCtx.Err() == nil {
+		buf := <-buffers
+		var n int
+		n, readErr = io.ReadFull(raw, buf)
+		if readErr == io.EOF && partNumber > 0 {
+			// The previous part ended exactly at the end of the payload. An empty payload is still uploaded as a
+			// single empty part so the object gets created.
+			break
+		}
+
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			break
+		}
+
+		partNumber++
+		if partNumber > maxParts {
+			readErr = fmt.Errorf("payload exceeds %v parts of %vb", maxParts, w.partSize)
+			break
+		}
+
+		currentPart := partNumber
+		group.Go(func() error {
+			defer func() { buffers <- buf }()
+			return upload.UploadPart(groupCtx, currentPart, buf[:n])
+		})
+	}
+
+	err = group.Wait()
+	if err == nil && readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+		err = errs.Wrapf(readErr, "failed to read part [%v]", partNumber+1)
+	}
+
+	if err == nil {
+		err = upload.Complete(ctx)
+	}
+
+	if err != nil {
+		if abortErr := upload.Abort(context.Background()); abortErr != nil {
+			logger.Warnf(ctx, "Failed to abort multipart upload of [%v]. Error: %v", key, abortErr)
+		}
+
+		return partNumber, errs.Wrapf(err, "failed multipart upload of [%v]", key)
+	}
+
+	return partNumber, nil
+}
+
+// stringMetadata converts stow metadata to the string values all the native APIs expect.
+func stringMetadata(metadata map[string]interface{}) (map[string]string, error) {
+	res := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value of key '%s' in metadata must be of type string", key)
+		}
+
+		res[key] = str
+	}
+
+	return res, nil
+}
+
+// sortedParts keeps track of the parts uploaded so far in part number order.
+type sortedParts[T any] struct {
+	lock  sync.Mutex
+	parts map[int]T
+}
+
+func (p *sortedParts[T]) add(partNumber int, part T) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.parts == nil {
+		p.parts = map[int]T{}
+	}
+
+	p.parts[partNumber] = part
+}
+
+// list returns the parts ordered by their number, failing if any part in between is missing.
+func (p *sortedParts[T]) list() ([]T, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	res := make([]T, 0, len(p.parts))
+	for i := 1; i <= len(p.parts); i++ {
+		part, ok := p.parts[i]
+		if !ok {
+			return nil, fmt.Errorf("part [%v] is missing", i)
+		}
+
+		res = append(res, part)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/stow"
+	"github.com/flyteorg/stow/local"
+)
+
+type mockMultipartUpload struct {
+	lock      sync.Mutex
+	parts     sortedParts[[]byte]
+	failPart  int
+	completed []byte
+	aborted   bool
+}
+
+func (m *mockMultipartUpload) UploadPart(_ context.Context, partNumber int, part []byte) error {
+	if partNumber == m.failPart {
+		return fmt.Errorf("failed to upload part %v", partNumber)
+	}
+
+	// The buffer is reused once the part is uploaded.
+	m.parts.add(partNumber, append([]byte{}, part...))
+	return nil
+}
+
+func (m *mockMultipartUpload) Complete(context.Context) error {
+	parts, err := m.parts.list()
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.completed = bytes.Join(parts, nil)
+	return nil
+}
+
+func (m *mockMultipartUpload) Abort(context.Context) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.aborted = true
+	return nil
+}
+
+type mockMultipartUploader struct {
+	uploads map[string]*mockMultipartUpload
+	// The part number to fail uploading, 0 to not fail any.
+	failPart int
+}
+
+func (m *mockMultipartUploader) CreateUpload(_ context.Context, container, key string, _ map[string]interface{}) (MultipartUpload, error) {
+	upload := &mockMultipartUpload{failPart: m.failPart}
+	m.uploads[container+"/"+key] = upload
+	return upload, nil
+}
+
+func newMockMultipartUploader() *mockMultipartUploader {
+	return &mockMultipartUploader{uploads: map[string]*mockMultipartUpload{}}
+}
+
+func TestMultipartWriter_Write(t *testing.T) {
+	ctx := context.TODO()
+	payload := bytes.Repeat([]byte("0123456789"), 10)
+
+	for _, tc := range []struct {
+		name          string
+		payload       []byte
+		expectedParts int
+	}{
+		{"Parts of equal size", payload, 10},
+		{"Shorter last part", payload[:95], 10},
+		{"Single part", payload[:5], 1},
+		{"Empty payload", []byte{}, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			uploader := newMockMultipartUploader()
+			w := multipartWriter{uploader: uploader, partSize: 10, parallelism: 3}
+
+			parts, err := w.write(ctx, "container", "key", nil, bytes.NewReader(tc.payload))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedParts, parts)
+			assert.Equal(t, string(tc.payload), string(uploader.uploads["container/key"].completed))
+			assert.False(t, uploader.uploads["container/key"].aborted)
+		})
+	}
+
+	t.Run("Failed part aborts the upload", func(t *testing.T) {
+		uploader := newMockMultipartUploader()
+		uploader.failPart = 3
+		w := multipartWriter{uploader: uploader, partSize: 10, parallelism: 2}
+
+		_, err := w.write(ctx, "container", "key", nil, bytes.NewReader(payload))
+		assert.Error(t, err)
+		assert.Nil(t, uploader.uploads["container/key"].completed)
+		assert.True(t, uploader.uploads["container/key"].aborted)
+	})
+}
+
+func TestNewMultipartWriter(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("Part size too small", func(t *testing.T) {
+		_, err := newMultipartWriter(ctx, local.Kind, nil, nil, MultipartUploadConfig{PartSizeMegabytes: 1, Parallelism: 1})
+		assert.Error(t, err)
+	})
+
+	t.Run("No parallelism", func(t *testing.T) {
+		_, err := newMultipartWriter(ctx, local.Kind, nil, nil, MultipartUploadConfig{PartSizeMegabytes: 5})
+		assert.Error(t, err)
+	})
+
+	t.Run("Unsupported kind", func(t *testing.T) {
+		w, err := newMultipartWriter(ctx, local.Kind, nil, nil, MultipartUploadConfig{PartSizeMegabytes: 5, Parallelism: 1})
+		assert.NoError(t, err)
+		assert.Nil(t, w)
+	})
+
+	t.Run("S3", func(t *testing.T) {
+		w, err := newMultipartWriter(ctx, "s3", nil, stow.ConfigMap{"region": "us-west-2"}, MultipartUploadConfig{PartSizeMegabytes: 8, Parallelism: 2})
+		assert.NoError(t, err)
+		if assert.NotNil(t, w) {
+			assert.Equal(t, 8*MiB, w.partSize)
+			assert.Equal(t, 2, w.parallelism)
+		}
+	})
+
+	t.Run("S3 with v2 signing", func(t *testing.T) {
+		w, err := newMultipartWriter(ctx, "s3", nil, stow.ConfigMap{"v2_signing": "true"}, MultipartUploadConfig{PartSizeMegabytes: 8, Parallelism: 2})
+		assert.NoError(t, err)
+		assert.Nil(t, w)
+	})
+}
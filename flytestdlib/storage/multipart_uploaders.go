@@ -0,0 +1,365 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	s32 "github.com/aws/aws-sdk-go/service/s3"
+	"google.golang.org/api/iterator"
+
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/stow"
+	"github.com/flyteorg/stow/azure"
+	"github.com/flyteorg/stow/google"
+	"github.com/flyteorg/stow/s3"
+)
+
+// The largest number of source objects a single GCS compose request accepts.
+const maxComposeSources = 32
+
+func newUploadID() (string, error) {
+	raw := make([]byte, 8)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(raw), nil
+}
+
+type s3MultipartUploader struct {
+	client *s32.S3
+}
+
+type s3MultipartUpload struct {
+	client   *s32.S3
+	bucket   string
+	key      string
+	uploadID *string
+	parts    sortedParts[*s32.CompletedPart]
+}
+
+func (u *s3MultipartUpload) UploadPart(ctx context.Context, partNumber int, part []byte) error {
+	resp, err := u.client.UploadPartWithContext(ctx, &s32.UploadPartInput{
+		Bucket:        aws.String(u.bucket),
+		Key:           aws.String(u.key),
+		UploadId:      u.uploadID,
+		PartNumber:    aws.Int64(int64(partNumber)),
+		ContentLength: aws.Int64(int64(len(part))),
+		Body:          bytes.NewReader(part),
+	})
+	if err != nil {
+		return err
+	}
+
+	u.parts.add(partNumber, &s32.CompletedPart{ETag: resp.ETag, PartNumber: aws.Int64(int64(partNumber))})
+	return nil
+}
+
+func (u *s3MultipartUpload) Complete(ctx context.Context) error {
+	parts, err := u.parts.list()
+	if err != nil {
+		return err
+	}
+
+	_, err = u.client.CompleteMultipartUploadWithContext(ctx, &s32.CompleteMultipartUploadInput{
+		Bucket:          aws.String(u.bucket),
+		Key:             aws.String(u.key),
+		UploadId:        u.uploadID,
+		MultipartUpload: &s32.CompletedMultipartUpload{Parts: parts},
+	})
+	return err
+}
+
+func (u *s3MultipartUpload) Abort(ctx context.Context) error {
+	_, err := u.client.AbortMultipartUploadWithContext(ctx, &s32.AbortMultipartUploadInput{
+		Bucket:   aws.String(u.bucket),
+		Key:      aws.String(u.key),
+		UploadId: u.uploadID,
+	})
+	return err
+}
+
+func (u s3MultipartUploader) CreateUpload(ctx context.Context, container, key string, metadata map[string]interface{}) (MultipartUpload, error) {
+	md, err := stringMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.client.CreateMultipartUploadWithContext(ctx, &s32.CreateMultipartUploadInput{
+		Bucket:   aws.String(container),
+		Key:      aws.String(key),
+		Metadata: aws.StringMap(md),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3MultipartUpload{
+		client:   u.client,
+		bucket:   container,
+		key:      key,
+		uploadID: resp.UploadId,
+	}, nil
+}
+
+// newS3MultipartUploader creates an S3 client the same way stow does for the given config.
+func newS3MultipartUploader(ctx context.Context, _ stow.Location, cfg stow.ConfigMap) (MultipartUploader, error) {
+	if v2Signing, _ := cfg.Config(s3.ConfigV2Signing); v2Signing == "true" {
+		logger.Infof(ctx, "Multipart uploads are not supported with v2 signing, falling back to single uploads")
+		return nil, nil
+	}
+
+	awsConfig := aws.NewConfig().WithRegion("us-east-1")
+	if region, _ := cfg.Config(s3.ConfigRegion); region != "" {
+		awsConfig.WithRegion(region)
+	}
+
+	if authType, _ := cfg.Config(s3.ConfigAuthType); authType == "" || authType == "accesskey" {
+		accessKeyID, _ := cfg.Config(s3.ConfigAccessKeyID)
+		secretKey, _ := cfg.Config(s3.ConfigSecretKey)
+		token, _ := cfg.Config(s3.ConfigToken)
+		awsConfig.WithCredentials(credentials.NewStaticCredentials(accessKeyID, secretKey, token))
+	}
+
+	if endpoint, ok := cfg.Config(s3.ConfigEndpoint); ok {
+		awsConfig.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+
+	if disableSSL, _ := cfg.Config(s3.ConfigDisableSSL); disableSSL == "true" {
+		awsConfig.WithDisableSSL(true)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return s3MultipartUploader{client: s32.New(sess)}, nil
+}
+
+// gcsMultipartUploader uploads every part as a temporary object and composes the object from them once all parts are
+// uploaded.
+type gcsMultipartUploader struct {
+	client *gcs.Client
+}
+
+type gcsMultipartUpload struct {
+	bucket      *gcs.BucketHandle
+	key         string
+	partsPrefix string
+	metadata    map[string]string
+	parts       sortedParts[*gcs.ObjectHandle]
+}
+
+func (u *gcsMultipartUpload) UploadPart(ctx context.Context, partNumber int, part []byte) error {
+	obj := u.bucket.Object(fmt.Sprintf("%s%05d", u.partsPrefix, partNumber))
+	w := obj.NewWriter(ctx)
+	// Parts are already in memory, upload them in a single request.
+	w.ChunkSize = 0
+	if _, err := w.Write(part); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	u.parts.add(partNumber, obj)
+	return nil
+}
+
+func (u *gcsMultipartUpload) Complete(ctx context.Context) error {
+	parts, err := u.parts.list()
+	if err != nil {
+		return err
+	}
+
+	// A single compose request takes up to 32 sources, larger objects are composed incrementally by appending to the
+	// object composed so far.
+	dst := u.bucket.Object(u.key)
+	sources := parts[:min(len(parts), maxComposeSources)]
+	parts = parts[len(sources):]
+	for {
+		composer := dst.ComposerFrom(sources...)
+		composer.Metadata = u.metadata
+		if _, err := composer.Run(ctx); err != nil {
+			return err
+		}
+
+		if len(parts) == 0 {
+			break
+		}
+
+		sources = append([]*gcs.ObjectHandle{dst}, parts[:min(len(parts), maxComposeSources-1)]...)
+		parts = parts[len(sources)-1:]
+	}
+
+	return u.Abort(ctx)
+}
+
+// Abort removes the temporary part objects.
+func (u *gcsMultipartUpload) Abort(ctx context.Context) error {
+	it := u.bucket.Objects(ctx, &gcs.Query{Prefix: u.partsPrefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := u.bucket.Object(attrs.Name).Delete(ctx); err != nil && err != gcs.ErrObjectNotExist {
+			return err
+		}
+	}
+}
+
+func (u gcsMultipartUploader) CreateUpload(_ context.Context, container, key string, metadata map[string]interface{}) (MultipartUpload, error) {
+	md, err := stringMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadID, err := newUploadID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &gcsMultipartUpload{
+		bucket:      u.client.Bucket(container),
+		key:         key,
+		partsPrefix: fmt.Sprintf("%s.parts/%s/", key, uploadID),
+		metadata:    md,
+	}, nil
+}
+
+// newGCSMultipartUploader reuses the client of the stow google location.
+func newGCSMultipartUploader(_ context.Context, loc stow.Location, _ stow.ConfigMap) (MultipartUploader, error) {
+	googleLoc, ok := loc.(*google.Location)
+	if !ok {
+		return nil, fmt.Errorf("unexpected location type [%T] for kind [%v]", loc, google.Kind)
+	}
+
+	return gcsMultipartUploader{client: googleLoc.Service()}, nil
+}
+
+// azureMultipartUploader stages every part as a block of a block blob and commits the block list once all parts are
+// uploaded.
+type azureMultipartUploader struct {
+	client *azblob.Client
+}
+
+type azureMultipartUpload struct {
+	client   *blockblob.Client
+	uploadID string
+	metadata map[string]*string
+	blockIDs sortedParts[string]
+}
+
+func (u *azureMultipartUpload) UploadPart(ctx context.Context, partNumber int, part []byte) error {
+	// Block IDs have to be of the same length for all blocks of a blob.
+	blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s-%05d", u.uploadID, partNumber)))
+	if _, err := u.client.StageBlock(ctx, blockID, streaming.NopCloser(bytes.NewReader(part)), nil); err != nil {
+		return err
+	}
+
+	u.blockIDs.add(partNumber, blockID)
+	return nil
+}
+
+func (u *azureMultipartUpload) Complete(ctx context.Context) error {
+	blockIDs, err := u.blockIDs.list()
+	if err != nil {
+		return err
+	}
+
+	_, err = u.client.CommitBlockList(ctx, blockIDs, &blockblob.CommitBlockListOptions{Metadata: u.metadata})
+	return err
+}
+
+// Abort is a no-op, uncommitted blocks are garbage collected by Azure.
+func (u *azureMultipartUpload) Abort(context.Context) error {
+	return nil
+}
+
+func (u azureMultipartUploader) CreateUpload(_ context.Context, container, key string, metadata map[string]interface{}) (MultipartUpload, error) {
+	md, err := stringMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	azureMetadata := make(map[string]*string, len(md))
+	for k, v := range md {
+		v := v
+		azureMetadata[k] = &v
+	}
+
+	uploadID, err := newUploadID()
+	if err != nil {
+		return nil, err
+	}
+
+	// Same as stow, which doesn't allow spaces in blob names.
+	key = strings.Replace(key, " ", "+", -1)
+	return &azureMultipartUpload{
+		client:   u.client.ServiceClient().NewContainerClient(container).NewBlockBlobClient(key),
+		uploadID: uploadID,
+		metadata: azureMetadata,
+	}, nil
+}
+
+// newAzureMultipartUploader creates an azure blob client the same way stow does for the given config.
+func newAzureMultipartUploader(_ context.Context, _ stow.Location, cfg stow.ConfigMap) (MultipartUploader, error) {
+	account, ok := cfg.Config(azure.ConfigAccount)
+	if !ok {
+		return nil, fmt.Errorf("missing account id")
+	}
+
+	domainSuffix := "core.windows.net"
+	if suffix, _ := cfg.Config(azure.ConfigDomainSuffix); suffix != "" {
+		domainSuffix = suffix
+	} else if suffix, _ := cfg.Config(azure.ConfigBaseUrlDepreciated); suffix != "" {
+		domainSuffix = suffix
+	}
+
+	serviceURL := fmt.Sprintf("https://%s.blob.%s", account, domainSuffix)
+	if key, _ := cfg.Config(azure.ConfigKey); key != "" {
+		cred, err := azblob.NewSharedKeyCredential(account, key)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := azblob.NewClientWithSharedKeyCredential(serviceURL, cred, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return azureMultipartUploader{client: client}, nil
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := azblob.NewClient(serviceURL, cred, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return azureMultipartUploader{client: client}, nil
+}
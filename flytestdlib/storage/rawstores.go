@@ -56,19 +56,23 @@ func createHTTPClient(cfg HTTPClientConfig) *http.Client {
 }
 
 type dataStoreMetrics struct {
-	cacheMetrics *cacheMetrics
-	protoMetrics *protoMetrics
-	copyMetrics  *copyMetrics
-	stowMetrics  *stowMetrics
+	cacheMetrics      *cacheMetrics
+	protoMetrics      *protoMetrics
+	copyMetrics       *copyMetrics
+	stowMetrics       *stowMetrics
+	dedupMetrics      *dedupMetrics
+	encryptionMetrics *encryptionMetrics
 }
 
 // newDataStoreMetrics initialises all metrics required for DataStore
 func newDataStoreMetrics(scope promutils.Scope) *dataStoreMetrics {
 	return &dataStoreMetrics{
-		cacheMetrics: newCacheMetrics(scope),
-		protoMetrics: newProtoMetrics(scope),
-		copyMetrics:  newCopyMetrics(scope.NewSubScope("copy")),
-		stowMetrics:  newStowMetrics(scope),
+		cacheMetrics:      newCacheMetrics(scope),
+		protoMetrics:      newProtoMetrics(scope),
+		copyMetrics:       newCopyMetrics(scope.NewSubScope("copy")),
+		stowMetrics:       newStowMetrics(scope),
+		dedupMetrics:      newDedupMetrics(scope.NewSubScope("dedup")),
+		encryptionMetrics: newEncryptionMetrics(scope.NewSubScope("encryption")),
 	}
 }
 
@@ -111,6 +115,12 @@ func (ds *DataStore) RefreshConfig(ctx context.Context, cfg *Config) error {
 		return err
 	}
 
+	rawStore, err = newEncryptedRawStore(ctx, cfg.Encryption, rawStore, ds.metrics.encryptionMetrics)
+	if err != nil {
+		return err
+	}
+
+	rawStore = newDedupRawStore(cfg.Dedup, rawStore, ds.metrics.dedupMetrics)
 	rawStore = newCachedRawStore(cfg, rawStore, ds.metrics.cacheMetrics)
 	protoStore := NewDefaultProtobufStoreWithMetrics(rawStore, ds.metrics.protoMetrics)
 	newDS := NewCompositeDataStore(NewURLPathConstructor(), protoStore)
@@ -41,6 +41,13 @@ type Metadata interface {
 	ContentMD5() string
 }
 
+// UserMetadata is implemented by the Metadata of stores that return the metadata objects were written with (see
+// Options.Metadata).
+type UserMetadata interface {
+	// UserMetadataValue returns the value of the metadata key the object was written with, if any.
+	UserMetadataValue(key string) (string, bool)
+}
+
 type CursorState int
 
 const (
@@ -52,6 +52,13 @@ var fQNFn = map[string]func(string) DataReference{
 	},
 }
 
+// signedURLUnsupportedKinds are the kinds of stow stores which can't sign urls. S3, GCS and Azure stores sign urls.
+var signedURLUnsupportedKinds = map[string]bool{
+	local.Kind:  true,
+	oracle.Kind: true,
+	swift.Kind:  true,
+}
+
 // RegisterStowKind registers a new kind of stow store.
 func RegisterStowKind(kind string, f func(string) DataReference) error {
 	if _, ok := fQNFn[kind]; ok {
@@ -104,6 +111,7 @@ type stowMetrics struct {
 	WriteFailure     labeled.Counter
 	WriteLatency     labeled.StopWatch
 	WriteLatencyHist labeled.HistogramStopWatch
+	WriteParts       labeled.Counter
 
 	DeleteFailure     labeled.Counter
 	DeleteLatency     labeled.StopWatch
@@ -134,6 +142,23 @@ func (s StowMetadata) ContentMD5() string {
 	return s.contentMD5
 }
 
+// stowUserMetadata is the metadata returned by stores that keep the metadata objects are written with.
+type stowUserMetadata struct {
+	StowMetadata
+	metadata map[string]interface{}
+}
+
+// UserMetadataValue returns the value of the metadata key. Some stores (e.g. S3) return the keys in lower case.
+func (s stowUserMetadata) UserMetadataValue(key string) (string, bool) {
+	value, found := s.metadata[key]
+	if !found {
+		value, found = s.metadata[strings.ToLower(key)]
+	}
+
+	str, ok := value.(string)
+	return str, found && ok
+}
+
 // Implements DataStore to talk to stow location store.
 type StowStore struct {
 	copyImpl
@@ -148,6 +173,13 @@ type StowStore struct {
 	dynamicContainerMap sync.Map
 	metrics             *stowMetrics
 	baseContainerFQN    DataReference
+	// Writes larger than a single part are uploaded through the multipart writer if set.
+	multipartWriter *multipartWriter
+	// metadataUnsupported is set for stores that can't keep the metadata objects are written with (e.g. local). The
+	// metadata is dropped when writing and isn't returned by Head.
+	metadataUnsupported bool
+	// signedURLUnsupported is set for stores that can't sign urls (e.g. local).
+	signedURLUnsupported bool
 }
 
 func (s *StowStore) CreateContainer(ctx context.Context, container string) (stow.Container, error) {
@@ -245,12 +277,18 @@ func (s *StowStore) Head(ctx context.Context, reference DataReference) (Metadata
 			if !ok {
 				logger.Infof(ctx, "Failed to cast contentMD5 [%v] to string", contentMD5)
 			}
-			return StowMetadata{
+			m := StowMetadata{
 				exists:     true,
 				size:       size,
 				etag:       etag,
 				contentMD5: contentMD5,
-			}, nil
+			}
+
+			if s.metadataUnsupported {
+				return m, nil
+			}
+
+			return stowUserMetadata{StowMetadata: m, metadata: metadata}, nil
 		}
 	}
 
@@ -354,9 +392,20 @@ func (s *StowStore) WriteRaw(ctx context.Context, reference DataReference, size
 		return err
 	}
 
+	metadata := opts.Metadata
+	if s.metadataUnsupported {
+		metadata = nil
+	}
+
 	t1 := s.metrics.WriteLatency.Start(ctx)
 	t2 := s.metrics.WriteLatencyHist.Start(ctx)
-	_, err = container.Put(k, raw, size, opts.Metadata)
+	if s.multipartWriter != nil && (size < 0 || size > s.multipartWriter.partSize) {
+		var parts int
+		parts, err = s.multipartWriter.write(ctx, c, k, metadata, raw)
+		s.metrics.WriteParts.Add(ctx, float64(parts))
+	} else {
+		_, err = container.Put(k, raw, size, metadata)
+	}
 	t1.Stop()
 	t2.Stop()
 
@@ -411,6 +460,10 @@ func (s *StowStore) CreateSignedURL(ctx context.Context, reference DataReference
 		return SignedURLResponse{}, err
 	}
 
+	if s.signedURLUnsupported {
+		return SignedURLResponse{}, errors.Errorf(ErrSignedURLNotSupported, "failed to sign url for [%v], the store doesn't support signed urls", reference)
+	}
+
 	c, err := s.getContainer(ctx, locationIDSignedURL, container)
 	if err != nil {
 		return SignedURLResponse{}, err
@@ -423,7 +476,10 @@ func (s *StowStore) CreateSignedURL(ctx context.Context, reference DataReference
 	})
 
 	if err != nil {
-		return SignedURLResponse{}, err
+		if stow.IsNotSupported(err) {
+			return SignedURLResponse{}, errors.Wrapf(ErrSignedURLNotSupported, err, "failed to sign url for [%v]", reference)
+		}
+		return SignedURLResponse{}, errs.Wrapf(err, "failed to sign url for [%v]", reference)
 	}
 
 	urlVal, err := url.Parse(res.Url)
@@ -506,6 +562,7 @@ func newStowMetrics(scope promutils.Scope) *stowMetrics {
 		WriteFailure:     labeled.NewCounter("write_failure", "Indicates failure in storing/PUT for a given reference", scope, labeled.EmitUnlabeledMetric, failureTypeOption),
 		WriteLatency:     labeled.NewStopWatch("write", "Time to write an object irrespective of size", time.Millisecond, scope, labeled.EmitUnlabeledMetric),
 		WriteLatencyHist: labeled.NewHistogramStopWatch("write", "Time to write an object irrespective of size", scope, labeled.EmitUnlabeledMetric),
+		WriteParts:       labeled.NewCounter("write_parts", "Number of parts uploaded by multipart uploads", scope, labeled.EmitUnlabeledMetric),
 
 		DeleteFailure:     labeled.NewCounter("delete_failure", "Indicates failure in removing/DELETE for a given reference", scope, labeled.EmitUnlabeledMetric, failureTypeOption),
 		DeleteLatency:     labeled.NewStopWatch("delete", "Time to delete an object irrespective of size", time.Millisecond, scope, labeled.EmitUnlabeledMetric),
@@ -514,7 +571,7 @@ func newStowMetrics(scope promutils.Scope) *stowMetrics {
 }
 
 // Constructor for the StowRawStore
-func newStowRawStore(_ context.Context, cfg *Config, metrics *dataStoreMetrics) (RawStore, error) {
+func newStowRawStore(ctx context.Context, cfg *Config, metrics *dataStoreMetrics) (RawStore, error) {
 	if cfg.InitContainer == "" {
 		return nil, fmt.Errorf("initContainer is required even with `enable-multicontainer`")
 	}
@@ -551,7 +608,22 @@ func newStowRawStore(_ context.Context, cfg *Config, metrics *dataStoreMetrics)
 		}
 	}
 
-	return NewStowRawStore(fn(cfg.InitContainer), loc, signedURLLoc, cfg.MultiContainerEnabled, metrics)
+	store, err := NewStowRawStore(fn(cfg.InitContainer), loc, signedURLLoc, cfg.MultiContainerEnabled, metrics)
+	if err != nil {
+		return emptyStore, err
+	}
+
+	store.metadataUnsupported = kind == local.Kind
+	store.signedURLUnsupported = signedURLUnsupportedKinds[kind]
+
+	if cfg.MultipartUpload.Enabled {
+		store.multipartWriter, err = newMultipartWriter(ctx, kind, loc, cfgMap, cfg.MultipartUpload)
+		if err != nil {
+			return emptyStore, fmt.Errorf("unable to configure multipart uploads for %s. Error: %v", kind, err)
+		}
+	}
+
+	return store, nil
 }
 
 func legacyS3ConfigMap(cfg ConnectionConfig) stow.ConfigMap {
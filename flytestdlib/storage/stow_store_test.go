@@ -51,11 +51,16 @@ type mockStowContainer struct {
 	id    string
 	items map[string]mockStowItem
 	putCB func(name string, r io.Reader, size int64, metadata map[string]interface{}) (stow.Item, error)
+	// preSignErr is returned by PreSignRequest if set.
+	preSignErr error
 }
 
 // CreateSignedURL creates a signed url with the provided properties.
 func (m mockStowContainer) PreSignRequest(_ context.Context, _ stow.ClientMethod, s string,
 	_ stow.PresignRequestParams) (response stow.PresignResponse, err error) {
+	if m.preSignErr != nil {
+		return stow.PresignResponse{}, m.preSignErr
+	}
 	return stow.PresignResponse{Url: s}, nil
 }
 
@@ -256,6 +261,34 @@ func TestStowStore_CreateSignedURL(t *testing.T) {
 		_, err = s.CreateSignedURL(context.TODO(), DataReference("s3://container2/path"), SignedURLProperties{})
 		assert.Error(t, err)
 	})
+
+	t.Run("Unsupported kind", func(t *testing.T) {
+		store, err := newStowRawStore(context.TODO(), &Config{
+			Stow: StowConfig{
+				Kind: local.Kind,
+				Config: map[string]string{
+					local.ConfigKeyPath: t.TempDir(),
+				},
+			},
+			InitContainer: container,
+		}, metrics)
+		assert.NoError(t, err)
+
+		_, err = store.CreateSignedURL(context.TODO(), DataReference("file://container/path"), SignedURLProperties{})
+		assert.True(t, IsSignedURLNotSupported(err))
+	})
+
+	t.Run("Not supported by the container", func(t *testing.T) {
+		s, err := NewStowRawStore(fQNFn["s3"](container), &mockStowLoc{
+			ContainerCb: func(id string) (stow.Container, error) {
+				return &mockStowContainer{id: id, preSignErr: stow.NotSupported("presign")}, nil
+			},
+		}, nil, false, metrics)
+		assert.NoError(t, err)
+
+		_, err = s.CreateSignedURL(context.TODO(), DataReference("s3://container/path"), SignedURLProperties{})
+		assert.True(t, IsSignedURLNotSupported(err))
+	})
 }
 
 func TestStowStore_ReadRaw(t *testing.T) {
@@ -511,6 +544,31 @@ func TestNewLocalStore(t *testing.T) {
 		}
 	})
 
+	t.Run("Metadata unsupported", func(t *testing.T) {
+		tmpDir, err := ioutil.TempDir("", "stdlib_local")
+		assert.NoError(t, err)
+
+		store, err := newStowRawStore(context.TODO(), &Config{
+			Stow: StowConfig{
+				Kind: local.Kind,
+				Config: map[string]string{
+					local.ConfigKeyPath: tmpDir,
+				},
+			},
+			InitContainer: "tmp",
+		}, metrics)
+		assert.NoError(t, err)
+
+		ref := DataReference("file://tmp/metadata")
+		assert.NoError(t, store.WriteRaw(context.TODO(), ref, 5, Options{Metadata: map[string]interface{}{"key": "value"}},
+			bytes.NewReader([]byte("hello"))))
+		metadata, err := store.Head(context.TODO(), ref)
+		assert.NoError(t, err)
+		assert.True(t, metadata.Exists())
+		_, ok := metadata.(UserMetadata)
+		assert.False(t, ok)
+	})
+
 	t.Run("missing init container", func(t *testing.T) {
 		tmpDir, err := ioutil.TempDir("", "stdlib_local")
 		assert.NoError(t, err)
@@ -714,6 +772,26 @@ func TestStowStore_WriteRaw(t *testing.T) {
 		err = s.WriteRaw(context.TODO(), DataReference("s3://container/path"), 0, Options{}, bytes.NewReader([]byte{}))
 		assert.EqualError(t, err, "Failed to write data [0b] to path [path].: foo")
 	})
+	t.Run("multipart upload of large payloads", func(t *testing.T) {
+		mockStowContainer := newMockStowContainer(container)
+		s, err := NewStowRawStore(fn(container), &mockStowLoc{
+			ContainerCb: func(id string) (stow.Container, error) {
+				return mockStowContainer, nil
+			},
+		}, nil, false, metrics)
+		assert.NoError(t, err)
+		uploader := newMockMultipartUploader()
+		s.multipartWriter = &multipartWriter{uploader: uploader, partSize: 4, parallelism: 2}
+
+		err = s.WriteRaw(context.TODO(), DataReference("s3://container/small"), 4, Options{}, bytes.NewReader([]byte("1234")))
+		assert.NoError(t, err)
+		assert.Contains(t, mockStowContainer.items, "small")
+
+		err = s.WriteRaw(context.TODO(), DataReference("s3://container/large"), 10, Options{}, bytes.NewReader([]byte("1234567890")))
+		assert.NoError(t, err)
+		assert.NotContains(t, mockStowContainer.items, "large")
+		assert.Equal(t, []byte("1234567890"), uploader.uploads["container/large"].completed)
+	})
 }
 
 func TestStowStore_fQNFn(t *testing.T) {
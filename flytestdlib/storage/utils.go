@@ -14,8 +14,10 @@ import (
 )
 
 var (
-	ErrExceedsLimit       stdErrs.ErrorCode = "LIMIT_EXCEEDED"
-	ErrFailedToWriteCache stdErrs.ErrorCode = "CACHE_WRITE_FAILED"
+	ErrExceedsLimit          stdErrs.ErrorCode = "LIMIT_EXCEEDED"
+	ErrFailedToWriteCache    stdErrs.ErrorCode = "CACHE_WRITE_FAILED"
+	ErrDecryptionFailed      stdErrs.ErrorCode = "DECRYPTION_FAILED"
+	ErrSignedURLNotSupported stdErrs.ErrorCode = "SIGNED_URL_NOT_SUPPORTED"
 )
 
 const (
@@ -57,6 +59,16 @@ func IsFailedWriteToCache(err error) bool {
 	return stdErrs.IsCausedBy(err, ErrFailedToWriteCache)
 }
 
+// IsDecryptionFailed gets a value indicating whether the root cause of error is a failure to decrypt content.
+func IsDecryptionFailed(err error) bool {
+	return stdErrs.IsCausedBy(err, ErrDecryptionFailed)
+}
+
+// IsSignedURLNotSupported gets a value indicating whether the root cause of error is a store which can't sign urls.
+func IsSignedURLNotSupported(err error) bool {
+	return stdErrs.IsCausedBy(err, ErrSignedURLNotSupported)
+}
+
 func MapStrings(mapper func(string) string, strings ...string) []string {
 	if strings == nil {
 		return []string{}